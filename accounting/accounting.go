@@ -201,6 +201,8 @@ const (
 	REQUESTS_500MS   = "requests_500ms"
 	REQUESTS_1000MS  = "requests_1000ms"
 	REQUESTS_5000MS  = "requests_5000ms"
+	PLAN_CACHE_HIT   = "plan_cache_hits"
+	PLAN_CACHE_MISS  = "plan_cache_misses"
 
 	DURATION_0MS    = 0 * time.Millisecond
 	DURATION_250MS  = 250 * time.Millisecond
@@ -215,7 +217,7 @@ const (
 
 var metricNames = []string{REQUESTS, SELECTS, UPDATES, INSERTS, DELETES, ACTIVE_REQUESTS, QUEUED_REQUESTS, INVALID_REQUESTS,
 	REQUEST_TIME, SERVICE_TIME, RESULT_COUNT, RESULT_SIZE, ERRORS, REQUESTS_250MS, REQUESTS_500MS, REQUESTS_1000MS,
-	REQUESTS_5000MS, WARNINGS, MUTATIONS}
+	REQUESTS_5000MS, WARNINGS, MUTATIONS, PLAN_CACHE_HIT, PLAN_CACHE_MISS}
 
 // Map each duration to its metrics
 var slowMetricsMap = map[time.Duration][]string{
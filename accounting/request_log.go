@@ -46,6 +46,8 @@ type RequestLogEntry struct {
 	PhaseTimes     map[string]interface{}
 	PhaseCounts    map[string]interface{}
 	PhaseOperators map[string]interface{}
+	PeakMemory     uint64
+	Profile        map[string]interface{}
 }
 
 const _CACHE_SIZE = 1 << 10
@@ -119,11 +121,12 @@ func RequestsForeach(f func(string, *RequestLogEntry)) {
 
 func LogRequest(request_time time.Duration, service_time time.Duration,
 	result_count int, result_size int,
-	error_count int, stmt string,
+	error_count int, peak_memory uint64, stmt string,
 	plan *plan.Prepared,
 	phaseTimes map[string]interface{},
 	phaseCounts map[string]interface{},
 	phaseOperators map[string]interface{},
+	profile map[string]interface{},
 	state string, id string, clientId string) {
 
 	if requestLog.threshold >= 0 && request_time < time.Millisecond*requestLog.threshold {
@@ -139,6 +142,7 @@ func LogRequest(request_time time.Duration, service_time time.Duration,
 		ResultCount: result_count,
 		ResultSize:  result_size,
 		ErrorCount:  error_count,
+		PeakMemory:  peak_memory,
 		Time:        time.Now(),
 	}
 	if stmt != "" {
@@ -151,6 +155,7 @@ func LogRequest(request_time time.Duration, service_time time.Duration,
 	re.PhaseTimes = phaseTimes
 	re.PhaseCounts = phaseCounts
 	re.PhaseOperators = phaseOperators
+	re.Profile = profile
 
 	requestLog.cache.Add(re, id)
 }
@@ -121,8 +121,8 @@ func (this *Avg) ComputeFinal(cumulative value.Value, context Context) (value.Va
 			sum.Actual(), count.Actual())
 	}
 
-	if count.Actual().(float64) > 0.0 {
-		return value.NewValue(sum.Actual().(float64) / count.Actual().(float64)), nil
+	if n := value.AsNumberValue(count); n.Float64() > 0.0 {
+		return value.AsNumberValue(sum).Div(n), nil
 	} else {
 		return value.NULL_VALUE, nil
 	}
@@ -151,7 +151,7 @@ func (this *Avg) cumulatePart(part, cumulative value.Value, context Context) (va
 			psum.Actual(), pcount.Actual(), csum.Actual(), ccount.Actual())
 	}
 
-	cumulative.SetField("sum", psum.Actual().(float64)+csum.Actual().(float64))
-	cumulative.SetField("count", pcount.Actual().(float64)+ccount.Actual().(float64))
+	cumulative.SetField("sum", value.AsNumberValue(psum).Add(value.AsNumberValue(csum)))
+	cumulative.SetField("count", value.AsNumberValue(pcount).Add(value.AsNumberValue(ccount)))
 	return cumulative, nil
 }
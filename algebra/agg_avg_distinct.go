@@ -120,16 +120,14 @@ func (this *AvgDistinct) ComputeFinal(cumulative value.Value, context Context) (
 		return value.NULL_VALUE, nil
 	}
 
-	sum := 0.0
+	sum := value.ZERO_NUMBER
 	for _, v := range set.Values() {
-		a := v.Actual()
-		switch a := a.(type) {
-		case float64:
-			sum += a
-		default:
-			return nil, fmt.Errorf("Invalid partial AVG %v of type %T.", a, a)
+		a := value.AsNumberValue(v)
+		if a == nil {
+			return nil, fmt.Errorf("Invalid partial AVG %v of type %T.", v.Actual(), v.Actual())
 		}
+		sum = sum.Add(a)
 	}
 
-	return value.NewValue(sum / float64(set.Len())), nil
+	return sum.Div(value.AsNumberValue(value.NewValue(set.Len()))), nil
 }
@@ -131,21 +131,19 @@ func (this *Count) ComputeFinal(cumulative value.Value, context Context) (value.
 
 /*
 Aggregate input partial values into cumulative result number value.
-If the partial and current cumulative result are both float64
-numbers, add them and return.
+If the partial and current cumulative result are both numbers,
+add them and return.
 */
 func (this *Count) cumulatePart(part, cumulative value.Value, context Context) (value.Value, error) {
-	actual := part.Actual()
-	switch actual := actual.(type) {
-	case float64:
-		count := cumulative.Actual()
-		switch count := count.(type) {
-		case float64:
-			return value.NewValue(count + actual), nil
-		default:
-			return nil, fmt.Errorf("Invalid COUNT %v of type %T.", count, count)
-		}
-	default:
-		return nil, fmt.Errorf("Invalid partial COUNT %v of type %T.", actual, actual)
+	actual := value.AsNumberValue(part)
+	if actual == nil {
+		return nil, fmt.Errorf("Invalid partial COUNT %v of type %T.", part.Actual(), part.Actual())
+	}
+
+	count := value.AsNumberValue(cumulative)
+	if count == nil {
+		return nil, fmt.Errorf("Invalid COUNT %v of type %T.", cumulative.Actual(), cumulative.Actual())
 	}
+
+	return count.Add(actual), nil
 }
@@ -112,8 +112,8 @@ func (this *Sum) ComputeFinal(cumulative value.Value, context Context) (value.Va
 
 /*
 Aggregate input partial values into cumulative result number value.
-If the partial and current cumulative result are both float64
-numbers, add them and return.
+If the partial and current cumulative result are both numbers, add
+them and return. Integers are summed exactly.
 */
 func (this *Sum) cumulatePart(part, cumulative value.Value, context Context) (value.Value, error) {
 	if part == value.NULL_VALUE {
@@ -122,17 +122,15 @@ func (this *Sum) cumulatePart(part, cumulative value.Value, context Context) (va
 		return part, nil
 	}
 
-	actual := part.Actual()
-	switch actual := actual.(type) {
-	case float64:
-		sum := cumulative.Actual()
-		switch sum := sum.(type) {
-		case float64:
-			return value.NewValue(sum + actual), nil
-		default:
-			return nil, fmt.Errorf("Invalid SUM %v of type %T.", sum, sum)
-		}
-	default:
-		return nil, fmt.Errorf("Invalid partial SUM %v of type %T.", actual, actual)
+	actual := value.AsNumberValue(part)
+	if actual == nil {
+		return nil, fmt.Errorf("Invalid partial SUM %v of type %T.", part.Actual(), part.Actual())
 	}
+
+	sum := value.AsNumberValue(cumulative)
+	if sum == nil {
+		return nil, fmt.Errorf("Invalid SUM %v of type %T.", cumulative.Actual(), cumulative.Actual())
+	}
+
+	return sum.Add(actual), nil
 }
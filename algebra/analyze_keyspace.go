@@ -0,0 +1,97 @@
+//  Copyright (c) 2016 Couchbase, Inc.
+//  Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file
+//  except in compliance with the License. You may obtain a copy of the License at
+//    http://www.apache.org/licenses/LICENSE-2.0
+//  Unless required by applicable law or agreed to in writing, software distributed under the
+//  License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+//  either express or implied. See the License for the specific language governing permissions
+//  and limitations under the License.
+
+package algebra
+
+import (
+	"encoding/json"
+
+	"github.com/couchbase/query/datastore"
+	"github.com/couchbase/query/errors"
+	"github.com/couchbase/query/expression"
+	"github.com/couchbase/query/value"
+)
+
+/*
+Represents the ANALYZE statement, which samples the documents of a
+keyspace and collects statistics on the given fields, or on the
+top-level fields of the sampled documents if none are given.
+*/
+type AnalyzeKeyspace struct {
+	statementBase
+
+	keyspace *KeyspaceRef           `json:"keyspace"`
+	fields   expression.Expressions `json:"fields"`
+	with     value.Value            `json:"with"`
+}
+
+func NewAnalyzeKeyspace(keyspace *KeyspaceRef, fields expression.Expressions,
+	with value.Value) *AnalyzeKeyspace {
+	rv := &AnalyzeKeyspace{
+		keyspace: keyspace,
+		fields:   fields,
+		with:     with,
+	}
+
+	rv.stmt = rv
+	return rv
+}
+
+func (this *AnalyzeKeyspace) Accept(visitor Visitor) (interface{}, error) {
+	return visitor.VisitAnalyzeKeyspace(this)
+}
+
+func (this *AnalyzeKeyspace) Signature() value.Value {
+	return nil
+}
+
+func (this *AnalyzeKeyspace) Formalize() error {
+	return nil
+}
+
+func (this *AnalyzeKeyspace) MapExpressions(mapper expression.Mapper) error {
+	return this.fields.MapExpressions(mapper)
+}
+
+func (this *AnalyzeKeyspace) Expressions() expression.Expressions {
+	return this.fields
+}
+
+/*
+Returns all required privileges.
+*/
+func (this *AnalyzeKeyspace) Privileges() (datastore.Privileges, errors.Error) {
+	return datastore.Privileges{
+		this.keyspace.Namespace() + ":" + this.keyspace.Keyspace(): datastore.PRIV_DDL,
+	}, nil
+}
+
+func (this *AnalyzeKeyspace) Keyspace() *KeyspaceRef {
+	return this.keyspace
+}
+
+/*
+Returns the fields of the FOR clause, which are evaluated against
+each sampled document.
+*/
+func (this *AnalyzeKeyspace) Fields() expression.Expressions {
+	return this.fields
+}
+
+func (this *AnalyzeKeyspace) With() value.Value {
+	return this.with
+}
+
+func (this *AnalyzeKeyspace) MarshalJSON() ([]byte, error) {
+	r := map[string]interface{}{"type": "AnalyzeKeyspace"}
+	r["keyspaceRef"] = this.keyspace
+	r["fields"] = this.fields
+	r["with"] = this.with
+	return json.Marshal(r)
+}
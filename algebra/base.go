@@ -17,6 +17,50 @@ import (
 
 type statementBase struct {
 	stmt Statement
+	with Withs
+}
+
+/*
+Returns the WITH clause of the statement.
+*/
+func (this *statementBase) With() Withs {
+	return this.with
+}
+
+/*
+Sets the WITH clause of the statement.
+*/
+func (this *statementBase) SetWith(with Withs) {
+	this.with = with
+}
+
+/*
+Adds the privileges required by the WITH clause.
+*/
+func (this *statementBase) withPrivileges(privs datastore.Privileges) errors.Error {
+	if this.with == nil {
+		return nil
+	}
+
+	wprivs, err := this.with.Privileges()
+	if err != nil {
+		return err
+	}
+
+	privs.Add(wprivs)
+	return nil
+}
+
+/*
+Qualify the identifiers of the WITH clause, and return a
+formalizer that allows its aliases.
+*/
+func (this *statementBase) formalizeWith(parent *expression.Formalizer) (*expression.Formalizer, error) {
+	if this.with == nil {
+		return parent, nil
+	}
+
+	return this.with.Formalize(parent)
 }
 
 /*
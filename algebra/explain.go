@@ -23,18 +23,23 @@ a struct that represents the explain json statement.
 type Explain struct {
 	statementBase
 
-	stmt Statement `json:"stmt"`
-	text string    `json:"text"`
+	stmt    Statement `json:"stmt"`
+	text    string    `json:"text"`
+	analyze bool      `json:"analyze"`
 }
 
 /*
 The function NewExplain returns a pointer to the Explain
 struct that has its field stmt set to the input Statement.
+With analyze set, as in EXPLAIN ANALYZE, the statement is
+executed and its plan is returned with the statistics of
+each operator.
 */
-func NewExplain(stmt Statement, text string) *Explain {
+func NewExplain(stmt Statement, text string, analyze bool) *Explain {
 	rv := &Explain{
-		stmt: stmt,
-		text: text,
+		stmt:    stmt,
+		text:    text,
+		analyze: analyze,
 	}
 
 	rv.statementBase.stmt = rv
@@ -79,9 +84,13 @@ func (this *Explain) Expressions() expression.Expressions {
 }
 
 /*
-Returns all required privileges.
+Returns all required privileges. EXPLAIN ANALYZE executes the
+statement, and requires the privileges of the statement.
 */
 func (this *Explain) Privileges() (datastore.Privileges, errors.Error) {
+	if this.analyze {
+		return this.stmt.Privileges()
+	}
 	return nil, nil
 }
 
@@ -98,3 +107,10 @@ Return the text of the statement being explained
 func (this *Explain) Text() string {
 	return this.text
 }
+
+/*
+Returns true for EXPLAIN ANALYZE.
+*/
+func (this *Explain) Analyze() bool {
+	return this.analyze
+}
@@ -0,0 +1,138 @@
+//  Copyright (c) 2016 Couchbase, Inc.
+//  Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file
+//  except in compliance with the License. You may obtain a copy of the License at
+//    http://www.apache.org/licenses/LICENSE-2.0
+//  Unless required by applicable law or agreed to in writing, software distributed under the
+//  License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+//  either express or implied. See the License for the specific language governing permissions
+//  and limitations under the License.
+
+package algebra
+
+import (
+	"github.com/couchbase/query/datastore"
+	"github.com/couchbase/query/errors"
+	"github.com/couchbase/query/expression"
+)
+
+/*
+Represents an expression term in the FROM clause, such as a
+reference to a WITH alias. Each element of the array value of the
+expression becomes an input to the query.
+*/
+type ExpressionTerm struct {
+	fromExpr expression.Expression
+	as       string
+}
+
+func NewExpressionTerm(fromExpr expression.Expression, as string) *ExpressionTerm {
+	return &ExpressionTerm{fromExpr, as}
+}
+
+func (this *ExpressionTerm) Accept(visitor NodeVisitor) (interface{}, error) {
+	return visitor.VisitExpressionTerm(this)
+}
+
+/*
+Apply mapping to the expression.
+*/
+func (this *ExpressionTerm) MapExpressions(mapper expression.Mapper) (err error) {
+	this.fromExpr, err = mapper.Map(this.fromExpr)
+	return
+}
+
+/*
+   Returns all contained Expressions.
+*/
+func (this *ExpressionTerm) Expressions() expression.Expressions {
+	return expression.Expressions{this.fromExpr}
+}
+
+/*
+Returns all required privileges.
+*/
+func (this *ExpressionTerm) Privileges() (datastore.Privileges, errors.Error) {
+	return subqueryPrivileges(expression.Expressions{this.fromExpr})
+}
+
+/*
+   Representation as a N1QL string.
+*/
+func (this *ExpressionTerm) String() string {
+	return this.fromExpr.String() + " as `" + this.as + "`"
+}
+
+/*
+Qualify all identifiers for the parent expression. Checks for
+duplicate aliases. The alias may shadow the WITH alias it refers
+to.
+*/
+func (this *ExpressionTerm) Formalize(parent *expression.Formalizer) (f *expression.Formalizer, err error) {
+	if this.as == "" {
+		err = errors.NewNoTermNameError("FROM", "plan.expression.requires_name_or_alias")
+		return
+	}
+
+	this.fromExpr, err = parent.Map(this.fromExpr)
+	if err != nil {
+		return
+	}
+
+	_, ok := parent.Allowed().Field(this.as)
+	if ok && !parent.IsWith(this.as) {
+		err = errors.NewDuplicateAliasError("FROM expression", this.as, "plan.expression.duplicate_alias")
+		return nil, err
+	}
+
+	f = expression.NewFormalizer(this.as, parent)
+	return
+}
+
+/*
+Return the primary term in the FROM clause.
+*/
+func (this *ExpressionTerm) PrimaryTerm() FromTerm {
+	return this
+}
+
+/*
+Returns the alias string.
+*/
+func (this *ExpressionTerm) Alias() string {
+	return this.as
+}
+
+/*
+Returns the FROM expression.
+*/
+func (this *ExpressionTerm) FromExpression() expression.Expression {
+	return this.fromExpr
+}
+
+/*
+Replace the primary term of a FROM clause by an expression term if
+it refers to a WITH alias, as in FROM cte or FROM cte AS c.
+*/
+func formalizeWithTerm(term FromTerm, parent *expression.Formalizer) FromTerm {
+	switch term := term.(type) {
+	case *KeyspaceTerm:
+		if term.namespace == "" && term.projection == nil && term.keys == nil &&
+			term.indexes == nil && parent.IsWith(term.keyspace) {
+			return NewExpressionTerm(expression.NewIdentifier(term.keyspace), term.Alias())
+		}
+	case *Join:
+		term.left = formalizeWithTerm(term.left, parent)
+	case *AnsiJoin:
+		term.left = formalizeWithTerm(term.left, parent)
+	case *IndexJoin:
+		term.left = formalizeWithTerm(term.left, parent)
+	case *Nest:
+		term.left = formalizeWithTerm(term.left, parent)
+	case *IndexNest:
+		term.left = formalizeWithTerm(term.left, parent)
+	case *Unnest:
+		term.left = formalizeWithTerm(term.left, parent)
+	}
+
+	return term
+}
@@ -0,0 +1,228 @@
+//  Copyright (c) 2016 Couchbase, Inc.
+//  Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file
+//  except in compliance with the License. You may obtain a copy of the License at
+//    http://www.apache.org/licenses/LICENSE-2.0
+//  Unless required by applicable law or agreed to in writing, software distributed under the
+//  License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+//  either express or implied. See the License for the specific language governing permissions
+//  and limitations under the License.
+
+package algebra
+
+import (
+	"encoding/json"
+
+	"github.com/couchbase/query/datastore"
+	"github.com/couchbase/query/errors"
+	"github.com/couchbase/query/expression"
+)
+
+/*
+Represents an ANSI JOIN, ie. a JOIN with an ON clause. Unlike
+lookup joins ON KEYS, the right source can be any keyspace or
+subquery, and the ON clause can be any predicate over the left and
+right sources. A LEFT OUTER JOIN preserves the left source objects
+without a match, and a RIGHT OUTER JOIN preserves the right source
+objects without a match.
+*/
+type AnsiJoin struct {
+	left       FromTerm
+	right      FromTerm
+	outer      bool
+	rightOuter bool
+	onclause   expression.Expression
+}
+
+func NewAnsiJoin(left FromTerm, outer, rightOuter bool, right FromTerm,
+	onclause expression.Expression) *AnsiJoin {
+	return &AnsiJoin{left, right, outer, rightOuter, onclause}
+}
+
+func (this *AnsiJoin) Accept(visitor NodeVisitor) (interface{}, error) {
+	return visitor.VisitAnsiJoin(this)
+}
+
+/*
+Maps left and right source objects and the ON clause of the JOIN.
+*/
+func (this *AnsiJoin) MapExpressions(mapper expression.Mapper) (err error) {
+	err = this.left.MapExpressions(mapper)
+	if err != nil {
+		return
+	}
+
+	err = this.right.MapExpressions(mapper)
+	if err != nil {
+		return
+	}
+
+	this.onclause, err = mapper.Map(this.onclause)
+	return
+}
+
+/*
+   Returns all contained Expressions.
+*/
+func (this *AnsiJoin) Expressions() expression.Expressions {
+	exprs := append(this.left.Expressions(), this.right.Expressions()...)
+	return append(exprs, this.onclause)
+}
+
+/*
+Returns all required privileges.
+*/
+func (this *AnsiJoin) Privileges() (datastore.Privileges, errors.Error) {
+	privs, err := this.left.Privileges()
+	if err != nil {
+		return nil, err
+	}
+
+	rprivs, err := this.right.Privileges()
+	if err != nil {
+		return nil, err
+	}
+
+	privs.Add(rprivs)
+
+	oprivs, err := subqueryPrivileges(expression.Expressions{this.onclause})
+	if err != nil {
+		return nil, err
+	}
+
+	privs.Add(oprivs)
+	return privs, nil
+}
+
+/*
+   Representation as a N1QL string.
+*/
+func (this *AnsiJoin) String() string {
+	s := this.left.String()
+
+	if this.outer {
+		s += " left outer join "
+	} else if this.rightOuter {
+		s += " right outer join "
+	} else {
+		s += " join "
+	}
+
+	s += this.right.String()
+	s += " on " + this.onclause.String()
+	return s
+}
+
+/*
+Qualify all identifiers for the parent expression. Checks if
+a JOIN alias exists and if it is a duplicate alias. The right
+source cannot reference the left source; only the ON clause can
+reference both.
+*/
+func (this *AnsiJoin) Formalize(parent *expression.Formalizer) (f *expression.Formalizer, err error) {
+	f, err = this.left.Formalize(parent)
+	if err != nil {
+		return
+	}
+
+	this.right = formalizeWithTerm(this.right, parent)
+	_, err = this.right.Formalize(parent)
+	if err != nil {
+		return
+	}
+
+	f.SetKeyspace("")
+
+	alias := this.Alias()
+	if alias == "" {
+		err = errors.NewNoTermNameError("JOIN", "plan.ansi_join.requires_name_or_alias")
+		return nil, err
+	}
+
+	_, ok := f.Allowed().Field(alias)
+	if ok && !f.IsWith(alias) {
+		err = errors.NewDuplicateAliasError("JOIN", alias, "plan.ansi_join.duplicate_alias")
+		return nil, err
+	}
+
+	f.Allowed().SetField(alias, alias)
+
+	this.onclause, err = f.Map(this.onclause)
+	if err != nil {
+		return nil, err
+	}
+
+	return
+}
+
+/*
+Returns the primary term in the left source of
+the JOIN.
+*/
+func (this *AnsiJoin) PrimaryTerm() FromTerm {
+	return this.left.PrimaryTerm()
+}
+
+/*
+Returns the alias of the right source.
+*/
+func (this *AnsiJoin) Alias() string {
+	return this.right.Alias()
+}
+
+/*
+Returns the left source object of the JOIN.
+*/
+func (this *AnsiJoin) Left() FromTerm {
+	return this.left
+}
+
+/*
+Returns the right source object of the JOIN.
+*/
+func (this *AnsiJoin) Right() FromTerm {
+	return this.right
+}
+
+/*
+Returns true if this is a LEFT OUTER JOIN.
+*/
+func (this *AnsiJoin) Outer() bool {
+	return this.outer
+}
+
+/*
+Returns true if this is a RIGHT OUTER JOIN.
+*/
+func (this *AnsiJoin) RightOuter() bool {
+	return this.rightOuter
+}
+
+/*
+Returns the ON clause of the JOIN.
+*/
+func (this *AnsiJoin) Onclause() expression.Expression {
+	return this.onclause
+}
+
+/*
+Sets the left source object and the type of the JOIN. Used by the
+parser, which parses the right source and ON clause first.
+*/
+func (this *AnsiJoin) SetLeft(left FromTerm, outer, rightOuter bool) {
+	this.left = left
+	this.outer = outer
+	this.rightOuter = rightOuter
+}
+
+/*
+Marshals input JOIN terms.
+*/
+func (this *AnsiJoin) MarshalJSON() ([]byte, error) {
+	r := map[string]interface{}{"type": "ansiJoin"}
+	r["left"] = this.left
+	r["right"] = this.right
+	r["outer"] = this.outer
+	r["right_outer"] = this.rightOuter
+	r["on_clause"] = expression.NewStringer().Visit(this.onclause)
+	return json.Marshal(r)
+}
@@ -67,7 +67,7 @@ Qualify all identifiers for the parent expression. Checks for
 duplicate aliases.
 */
 func (this *SubqueryTerm) Formalize(parent *expression.Formalizer) (f *expression.Formalizer, err error) {
-	err = this.subquery.Formalize()
+	err = this.subquery.FormalizeSubquery(parent.WithScope())
 	if err != nil {
 		return
 	}
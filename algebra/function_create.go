@@ -0,0 +1,152 @@
+//  Copyright (c) 2016 Couchbase, Inc.
+//  Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file
+//  except in compliance with the License. You may obtain a copy of the License at
+//    http://www.apache.org/licenses/LICENSE-2.0
+//  Unless required by applicable law or agreed to in writing, software distributed under the
+//  License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+//  either express or implied. See the License for the specific language governing permissions
+//  and limitations under the License.
+
+package algebra
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/couchbase/query/datastore"
+	"github.com/couchbase/query/errors"
+	"github.com/couchbase/query/expression"
+	"github.com/couchbase/query/value"
+)
+
+/*
+Represents the CREATE FUNCTION ddl statement, which defines an
+inline function whose body is an expression over its parameters.
+*/
+type CreateFunction struct {
+	statementBase
+
+	name       string                `json:"name"`
+	parameters []string              `json:"parameters"`
+	body       expression.Expression `json:"body"`
+}
+
+func NewCreateFunction(name string, parameters []string, body expression.Expression) *CreateFunction {
+	rv := &CreateFunction{
+		name:       name,
+		parameters: parameters,
+		body:       body,
+	}
+
+	rv.stmt = rv
+	return rv
+}
+
+func (this *CreateFunction) Accept(visitor Visitor) (interface{}, error) {
+	return visitor.VisitCreateFunction(this)
+}
+
+func (this *CreateFunction) Signature() value.Value {
+	return nil
+}
+
+/*
+Checks that the function does not redefine a built-in function, and
+that its body only references its parameters. The body cannot
+contain subqueries or query parameters, since it is evaluated
+outside of any query.
+*/
+func (this *CreateFunction) Formalize() error {
+	if _, ok := expression.GetFunction(this.name); ok {
+		return fmt.Errorf("Cannot redefine built-in function %s.", this.name)
+	}
+
+	if _, ok := GetAggregate(this.name, false); ok {
+		return fmt.Errorf("Cannot redefine built-in function %s.", this.name)
+	}
+
+	if _, ok := GetWindowFunction(this.name, nil); ok {
+		return fmt.Errorf("Cannot redefine built-in function %s.", this.name)
+	}
+
+	formalizer := expression.NewFormalizer("", nil)
+	for _, p := range this.parameters {
+		if _, ok := formalizer.Allowed().Field(p); ok {
+			return fmt.Errorf("Duplicate parameter %s in function %s.", p, this.name)
+		}
+
+		formalizer.Allowed().SetField(p, value.TRUE_VALUE)
+	}
+
+	err := checkFunctionBody(this.name, this.body)
+	if err != nil {
+		return err
+	}
+
+	this.body, err = formalizer.Map(this.body)
+	if err != nil {
+		return fmt.Errorf("Invalid body of function %s: %v", this.name, err)
+	}
+
+	return nil
+}
+
+func checkFunctionBody(name string, expr expression.Expression) error {
+	switch expr.(type) {
+	case *Subquery:
+		return fmt.Errorf("Subqueries are not allowed in the body of function %s.", name)
+	case *NamedParameter, *PositionalParameter:
+		return fmt.Errorf("Query parameters are not allowed in the body of function %s.", name)
+	}
+
+	for _, child := range expr.Children() {
+		if child == nil {
+			continue
+		}
+
+		err := checkFunctionBody(name, child)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (this *CreateFunction) MapExpressions(mapper expression.Mapper) (err error) {
+	this.body, err = mapper.Map(this.body)
+	return
+}
+
+func (this *CreateFunction) Expressions() expression.Expressions {
+	return expression.Expressions{this.body}
+}
+
+/*
+Returns all required privileges.
+*/
+func (this *CreateFunction) Privileges() (datastore.Privileges, errors.Error) {
+	return datastore.Privileges{
+		datastore.FUNCTIONS_PRIVILEGE_KEY: datastore.PRIV_FUNCTION,
+	}, nil
+}
+
+func (this *CreateFunction) Name() string {
+	return this.name
+}
+
+func (this *CreateFunction) Parameters() []string {
+	return this.parameters
+}
+
+func (this *CreateFunction) Body() expression.Expression {
+	return this.body
+}
+
+func (this *CreateFunction) MarshalJSON() ([]byte, error) {
+	r := map[string]interface{}{"type": "createFunction"}
+	r["name"] = this.name
+	r["parameters"] = this.parameters
+	r["body"] = expression.NewStringer().Visit(this.body)
+	return json.Marshal(r)
+}
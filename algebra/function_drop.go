@@ -0,0 +1,76 @@
+//  Copyright (c) 2016 Couchbase, Inc.
+//  Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file
+//  except in compliance with the License. You may obtain a copy of the License at
+//    http://www.apache.org/licenses/LICENSE-2.0
+//  Unless required by applicable law or agreed to in writing, software distributed under the
+//  License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+//  either express or implied. See the License for the specific language governing permissions
+//  and limitations under the License.
+
+package algebra
+
+import (
+	"encoding/json"
+
+	"github.com/couchbase/query/datastore"
+	"github.com/couchbase/query/errors"
+	"github.com/couchbase/query/expression"
+	"github.com/couchbase/query/value"
+)
+
+/*
+Represents the DROP FUNCTION ddl statement.
+*/
+type DropFunction struct {
+	statementBase
+
+	name string `json:"name"`
+}
+
+func NewDropFunction(name string) *DropFunction {
+	rv := &DropFunction{
+		name: name,
+	}
+
+	rv.stmt = rv
+	return rv
+}
+
+func (this *DropFunction) Accept(visitor Visitor) (interface{}, error) {
+	return visitor.VisitDropFunction(this)
+}
+
+func (this *DropFunction) Signature() value.Value {
+	return nil
+}
+
+func (this *DropFunction) Formalize() error {
+	return nil
+}
+
+func (this *DropFunction) MapExpressions(mapper expression.Mapper) error {
+	return nil
+}
+
+func (this *DropFunction) Expressions() expression.Expressions {
+	return nil
+}
+
+/*
+Returns all required privileges.
+*/
+func (this *DropFunction) Privileges() (datastore.Privileges, errors.Error) {
+	return datastore.Privileges{
+		datastore.FUNCTIONS_PRIVILEGE_KEY: datastore.PRIV_FUNCTION,
+	}, nil
+}
+
+func (this *DropFunction) Name() string {
+	return this.name
+}
+
+func (this *DropFunction) MarshalJSON() ([]byte, error) {
+	r := map[string]interface{}{"type": "dropFunction"}
+	r["name"] = this.name
+	return json.Marshal(r)
+}
@@ -174,6 +174,10 @@ func (this *Insert) Privileges() (datastore.Privileges, errors.Error) {
 	privs := datastore.NewPrivileges()
 	privs[this.keyspace.Namespace()+":"+this.keyspace.Keyspace()] = datastore.PRIV_WRITE
 
+	if err := this.withPrivileges(privs); err != nil {
+		return nil, err
+	}
+
 	if this.query != nil {
 		qp, err := this.query.Privileges()
 		if err != nil {
@@ -197,8 +201,13 @@ Fully qualify identifiers for each of the constituent clauses
 in the insert statement.
 */
 func (this *Insert) Formalize() (err error) {
+	root, err := this.formalizeWith(expression.NewFormalizer("", nil))
+	if err != nil {
+		return err
+	}
+
 	if this.values != nil {
-		f := expression.NewFormalizer("", nil)
+		f := expression.NewFormalizer("", root)
 		err = this.values.MapExpressions(f)
 		if err != nil {
 			return
@@ -206,19 +215,19 @@ func (this *Insert) Formalize() (err error) {
 	}
 
 	if this.query != nil {
-		err = this.query.Formalize()
+		err = this.query.FormalizeSubquery(root)
 		if err != nil {
 			return
 		}
 	}
 
-	f, err := this.keyspace.Formalize()
+	f, err := this.keyspace.Formalize(root)
 	if err != nil {
 		return err
 	}
 
 	if this.returning != nil {
-		_, err = this.returning.Formalize(f)
+		_, err = this.returning.FormalizeReturning(f)
 	}
 
 	return
@@ -40,14 +40,14 @@ func NewKeyspaceRef(namespace, keyspace, as string) *KeyspaceRef {
 Qualify identifiers for the keyspace. It also makes sure that the
 keyspace term contains a name or alias.
 */
-func (this *KeyspaceRef) Formalize() (f *expression.Formalizer, err error) {
+func (this *KeyspaceRef) Formalize(parent *expression.Formalizer) (f *expression.Formalizer, err error) {
 	keyspace := this.Alias()
 	if keyspace == "" {
 		err = errors.NewNoTermNameError("Keyspace", "plan.keyspace.reference_requires_name_or_alias")
 		return
 	}
 
-	f = expression.NewFormalizer(keyspace, nil)
+	f = expression.NewFormalizer(keyspace, parent)
 	return
 }
 
@@ -142,6 +142,10 @@ func (this *Merge) Privileges() (datastore.Privileges, errors.Error) {
 	privs := datastore.NewPrivileges()
 	privs[this.keyspace.Namespace()+":"+this.keyspace.Keyspace()] = datastore.PRIV_WRITE
 
+	if err := this.withPrivileges(privs); err != nil {
+		return nil, err
+	}
+
 	sp, err := this.source.Privileges()
 	if err != nil {
 		return nil, err
@@ -163,12 +167,17 @@ Fully qualify identifiers for each of the constituent clauses
 in the merge statement.
 */
 func (this *Merge) Formalize() (err error) {
-	kf, err := this.keyspace.Formalize()
+	root, err := this.formalizeWith(expression.NewFormalizer("", nil))
+	if err != nil {
+		return err
+	}
+
+	kf, err := this.keyspace.Formalize(root)
 	if err != nil {
 		return err
 	}
 
-	sf, err := this.source.Formalize()
+	sf, err := this.source.Formalize(root)
 	if err != nil {
 		return err
 	}
@@ -183,7 +192,7 @@ func (this *Merge) Formalize() (err error) {
 		return fmt.Errorf("Duplicate alias %s.", kf.Keyspace())
 	}
 
-	f := expression.NewFormalizer("", nil)
+	f := expression.NewFormalizer("", root)
 
 	if kf.Keyspace() != "" {
 		f.Allowed().SetField(kf.Keyspace(), kf.Keyspace())
@@ -199,14 +208,14 @@ func (this *Merge) Formalize() (err error) {
 	}
 
 	if this.limit != nil {
-		_, err = this.limit.Accept(expression.NewFormalizer("", nil))
+		_, err = this.limit.Accept(expression.NewFormalizer("", root))
 		if err != nil {
 			return
 		}
 	}
 
 	if this.returning != nil {
-		_, err = this.returning.Formalize(kf)
+		_, err = this.returning.FormalizeReturning(kf)
 	}
 
 	return
@@ -334,16 +343,16 @@ func (this *MergeSource) Privileges() (datastore.Privileges, errors.Error) {
 Fully qualify identifiers for each of the constituent fields
 in the merge source statement.
 */
-func (this *MergeSource) Formalize() (f *expression.Formalizer, err error) {
+func (this *MergeSource) Formalize(parent *expression.Formalizer) (f *expression.Formalizer, err error) {
 	if this.from != nil {
-		_, err = this.from.Formalize(expression.NewFormalizer("", nil))
+		_, err = this.from.Formalize(expression.NewFormalizer("", parent))
 		if err != nil {
 			return
 		}
 	}
 
 	if this.query != nil {
-		err = this.query.Formalize()
+		err = this.query.FormalizeSubquery(parent)
 		if err != nil {
 			return
 		}
@@ -354,7 +363,7 @@ func (this *MergeSource) Formalize() (f *expression.Formalizer, err error) {
 		return nil, fmt.Errorf("MergeSource missing alias.")
 	}
 
-	f = expression.NewFormalizer(keyspace, nil)
+	f = expression.NewFormalizer(keyspace, parent)
 	return
 }
 
@@ -13,6 +13,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"strconv"
+	"strings"
 
 	"github.com/couchbase/query/expression"
 	"github.com/couchbase/query/value"
@@ -186,6 +187,90 @@ func (this *Projection) Formalize(in *expression.Formalizer) (f *expression.Form
 	return
 }
 
+/*
+Names by which the RETURNING clause of a data modification
+statement references the document before and after the
+mutation.
+*/
+const (
+	RETURNING_OLD = "OLD"
+	RETURNING_NEW = "NEW"
+)
+
+/*
+Formalize the RETURNING clause of a data modification
+statement. OLD and NEW are left unqualified, and terms on
+them without an AS clause are aliased with the image name as
+prefix, so that OLD.status and NEW.status do not collide.
+*/
+func (this *Projection) FormalizeReturning(in *expression.Formalizer) (f *expression.Formalizer, err error) {
+	for _, term := range this.terms {
+		if term.as != "" || term.star {
+			continue
+		}
+
+		image := returningImage(term.expr)
+		if image != "" && term.alias != image {
+			term.as = strings.ToLower(image) + "_" + term.alias
+			term.alias = term.as
+		}
+	}
+
+	rf := in.Copy()
+	rf.Allowed().SetField(RETURNING_OLD, RETURNING_OLD)
+	rf.Allowed().SetField(RETURNING_NEW, RETURNING_NEW)
+	return this.Formalize(rf)
+}
+
+/*
+Returns true if the projection references the given image, OLD
+or NEW, which some statements only retrieve when needed.
+*/
+func (this *Projection) ReferencesImage(image string) bool {
+	for _, expr := range this.Expressions() {
+		if referencesIdentifier(expr, image) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func referencesIdentifier(expr expression.Expression, identifier string) bool {
+	if id, ok := expr.(*expression.Identifier); ok {
+		return id.Identifier() == identifier
+	}
+
+	for _, child := range expr.Children() {
+		if referencesIdentifier(child, identifier) {
+			return true
+		}
+	}
+
+	return false
+}
+
+/*
+Returns OLD or NEW if the expression is a path on that image.
+*/
+func returningImage(expr expression.Expression) string {
+	for {
+		switch e := expr.(type) {
+		case *expression.Field:
+			expr = e.First()
+		case *expression.Element:
+			expr = e.First()
+		case *expression.Identifier:
+			if e.Identifier() == RETURNING_OLD || e.Identifier() == RETURNING_NEW {
+				return e.Identifier()
+			}
+			return ""
+		default:
+			return ""
+		}
+	}
+}
+
 /*
 Return true if select clause in the query contains the
 distinct keyword.
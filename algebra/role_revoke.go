@@ -0,0 +1,99 @@
+//  Copyright (c) 2016 Couchbase, Inc.
+//  Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file
+//  except in compliance with the License. You may obtain a copy of the License at
+//    http://www.apache.org/licenses/LICENSE-2.0
+//  Unless required by applicable law or agreed to in writing, software distributed under the
+//  License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+//  either express or implied. See the License for the specific language governing permissions
+//  and limitations under the License.
+
+package algebra
+
+import (
+	"encoding/json"
+
+	"github.com/couchbase/query/datastore"
+	"github.com/couchbase/query/errors"
+	"github.com/couchbase/query/expression"
+	"github.com/couchbase/query/value"
+)
+
+/*
+Represents the REVOKE statement. Revokes roles, on keyspaces or on
+all keyspaces, from users.
+*/
+type RevokeRole struct {
+	statementBase
+
+	roles     []string       `json:"roles"`
+	keyspaces []*KeyspaceRef `json:"keyspaces"`
+	users     []string       `json:"users"`
+}
+
+func NewRevokeRole(roles []string, keyspaces []*KeyspaceRef, users []string) *RevokeRole {
+	rv := &RevokeRole{
+		roles:     roles,
+		keyspaces: keyspaces,
+		users:     users,
+	}
+
+	rv.stmt = rv
+	return rv
+}
+
+func (this *RevokeRole) Accept(visitor Visitor) (interface{}, error) {
+	return visitor.VisitRevokeRole(this)
+}
+
+func (this *RevokeRole) Signature() value.Value {
+	return nil
+}
+
+func (this *RevokeRole) Formalize() error {
+	return nil
+}
+
+func (this *RevokeRole) MapExpressions(mapper expression.Mapper) error {
+	return nil
+}
+
+func (this *RevokeRole) Expressions() expression.Expressions {
+	return nil
+}
+
+/*
+Returns all required privileges.
+*/
+func (this *RevokeRole) Privileges() (datastore.Privileges, errors.Error) {
+	return datastore.Privileges{
+		datastore.SECURITY_PRIVILEGE_KEY: datastore.PRIV_SECURITY,
+	}, nil
+}
+
+func (this *RevokeRole) Roles() []string {
+	return this.roles
+}
+
+func (this *RevokeRole) Keyspaces() []*KeyspaceRef {
+	return this.keyspaces
+}
+
+func (this *RevokeRole) Users() []string {
+	return this.users
+}
+
+func (this *RevokeRole) SetDefaultNamespace(namespace string) {
+	for _, keyspace := range this.keyspaces {
+		keyspace.SetDefaultNamespace(namespace)
+	}
+}
+
+func (this *RevokeRole) MarshalJSON() ([]byte, error) {
+	r := map[string]interface{}{"type": "revokeRole"}
+	r["roles"] = this.roles
+	if len(this.keyspaces) > 0 {
+		r["keyspaces"] = this.keyspaces
+	}
+	r["users"] = this.users
+	return json.Marshal(r)
+}
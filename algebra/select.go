@@ -79,6 +79,13 @@ This method maps all the constituent clauses, namely the subresult,
 order, limit and offset within a Select statement.
 */
 func (this *Select) MapExpressions(mapper expression.Mapper) (err error) {
+	if this.with != nil {
+		err = this.with.MapExpressions(mapper)
+		if err != nil {
+			return
+		}
+	}
+
 	err = this.subresult.MapExpressions(mapper)
 	if err != nil {
 		return
@@ -108,6 +115,10 @@ func (this *Select) MapExpressions(mapper expression.Mapper) (err error) {
 func (this *Select) Expressions() expression.Expressions {
 	exprs := this.subresult.Expressions()
 
+	if this.with != nil {
+		exprs = append(exprs, this.with.Expressions()...)
+	}
+
 	if this.order != nil {
 		exprs = append(exprs, this.order.Expressions()...)
 	}
@@ -132,6 +143,11 @@ func (this *Select) Privileges() (datastore.Privileges, errors.Error) {
 		return nil, err
 	}
 
+	err = this.withPrivileges(privs)
+	if err != nil {
+		return nil, err
+	}
+
 	exprs := make(expression.Expressions, 0, 16)
 
 	if this.order != nil {
@@ -161,6 +177,10 @@ func (this *Select) Privileges() (datastore.Privileges, errors.Error) {
 func (this *Select) String() string {
 	s := this.subresult.String()
 
+	if this.with != nil {
+		s = this.with.String() + " " + s
+	}
+
 	if this.order != nil {
 		s += " " + this.order.String()
 	}
@@ -183,6 +203,11 @@ For the subresult of the subquery, call Formalize, for the order
 by clause call MapExpressions, for limit and offset call Accept.
 */
 func (this *Select) FormalizeSubquery(parent *expression.Formalizer) error {
+	parent, err := this.formalizeWith(parent)
+	if err != nil {
+		return err
+	}
+
 	f, err := this.subresult.Formalize(parent)
 	if err != nil {
 		return err
@@ -200,7 +225,7 @@ func (this *Select) FormalizeSubquery(parent *expression.Formalizer) error {
 			// Determine if this is a correlated subquery
 			immediate := f.Allowed().GetValue().Fields()
 			for ident, _ := range f.Identifiers().Fields() {
-				if _, ok := immediate[ident]; !ok {
+				if _, ok := immediate[ident]; !ok && !f.IsWith(ident) {
 					this.correlated = true
 					break
 				}
@@ -64,6 +64,7 @@ expressions and calls PushBindings for the let clause.
 */
 func (this *Subselect) Formalize(parent *expression.Formalizer) (f *expression.Formalizer, err error) {
 	if this.from != nil {
+		this.from = formalizeWithTerm(this.from, parent)
 		f, err = this.from.Formalize(parent)
 		if err != nil {
 			return nil, err
@@ -103,7 +104,7 @@ func (this *Subselect) Formalize(parent *expression.Formalizer) (f *expression.F
 	immediate := f.Allowed().GetValue().Fields()
 
 	for ident, _ := range f.Identifiers().Fields() {
-		if _, ok := immediate[ident]; !ok {
+		if _, ok := immediate[ident]; !ok && !f.IsWith(ident) {
 			this.correlated = true
 			break
 		}
@@ -0,0 +1,66 @@
+//  Copyright (c) 2016 Couchbase, Inc.
+//  Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file
+//  except in compliance with the License. You may obtain a copy of the License at
+//    http://www.apache.org/licenses/LICENSE-2.0
+//  Unless required by applicable law or agreed to in writing, software distributed under the
+//  License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+//  either express or implied. See the License for the specific language governing permissions
+//  and limitations under the License.
+
+package algebra
+
+import (
+	"encoding/json"
+
+	"github.com/couchbase/query/datastore"
+	"github.com/couchbase/query/errors"
+	"github.com/couchbase/query/expression"
+	"github.com/couchbase/query/value"
+)
+
+/*
+Represents the COMMIT statement. The WORK and TRANSACTION keywords
+are optional.
+*/
+type CommitTransaction struct {
+	statementBase
+}
+
+func NewCommitTransaction() *CommitTransaction {
+	rv := &CommitTransaction{}
+	rv.stmt = rv
+	return rv
+}
+
+func (this *CommitTransaction) Accept(visitor Visitor) (interface{}, error) {
+	return visitor.VisitCommitTransaction(this)
+}
+
+func (this *CommitTransaction) Signature() value.Value {
+	return nil
+}
+
+func (this *CommitTransaction) Formalize() error {
+	return nil
+}
+
+func (this *CommitTransaction) MapExpressions(mapper expression.Mapper) error {
+	return nil
+}
+
+func (this *CommitTransaction) Expressions() expression.Expressions {
+	return nil
+}
+
+/*
+Returns all required privileges. The statements within the
+transaction are authorized individually.
+*/
+func (this *CommitTransaction) Privileges() (datastore.Privileges, errors.Error) {
+	return nil, nil
+}
+
+func (this *CommitTransaction) MarshalJSON() ([]byte, error) {
+	r := map[string]interface{}{"type": "commitTransaction"}
+	return json.Marshal(r)
+}
@@ -154,6 +154,10 @@ func (this *Update) Privileges() (datastore.Privileges, errors.Error) {
 	privs := datastore.NewPrivileges()
 	privs[this.keyspace.Namespace()+":"+this.keyspace.Keyspace()] = datastore.PRIV_WRITE
 
+	if err := this.withPrivileges(privs); err != nil {
+		return nil, err
+	}
+
 	subprivs, err := subqueryPrivileges(this.Expressions())
 	if err != nil {
 		return nil, err
@@ -168,12 +172,17 @@ Fully qualify identifiers for each of the constituent clauses
 in the UPDATE statement.
 */
 func (this *Update) Formalize() (err error) {
-	f, err := this.keyspace.Formalize()
+	root, err := this.formalizeWith(expression.NewFormalizer("", nil))
+	if err != nil {
+		return err
+	}
+
+	f, err := this.keyspace.Formalize(root)
 	if err != nil {
 		return err
 	}
 
-	empty := expression.NewFormalizer("", nil)
+	empty := expression.NewFormalizer("", root)
 
 	if this.keys != nil {
 		_, err = this.keys.Accept(empty)
@@ -211,7 +220,7 @@ func (this *Update) Formalize() (err error) {
 	}
 
 	if this.returning != nil {
-		_, err = this.returning.Formalize(f)
+		_, err = this.returning.FormalizeReturning(f)
 	}
 
 	return
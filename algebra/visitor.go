@@ -56,6 +56,46 @@ type Visitor interface {
 	   Visitor for INFER statements.
 	*/
 	VisitInferKeyspace(stmt *InferKeyspace) (interface{}, error)
+
+	/*
+	   Visitor for ANALYZE statements.
+	*/
+	VisitAnalyzeKeyspace(stmt *AnalyzeKeyspace) (interface{}, error)
+
+	/*
+	   Visitor for CREATE FUNCTION statements.
+	*/
+	VisitCreateFunction(stmt *CreateFunction) (interface{}, error)
+
+	/*
+	   Visitor for DROP FUNCTION statements.
+	*/
+	VisitDropFunction(stmt *DropFunction) (interface{}, error)
+
+	/*
+	   Visitor for START TRANSACTION statements.
+	*/
+	VisitStartTransaction(stmt *StartTransaction) (interface{}, error)
+
+	/*
+	   Visitor for COMMIT statements.
+	*/
+	VisitCommitTransaction(stmt *CommitTransaction) (interface{}, error)
+
+	/*
+	   Visitor for ROLLBACK statements.
+	*/
+	VisitRollbackTransaction(stmt *RollbackTransaction) (interface{}, error)
+
+	/*
+	   Visitor for GRANT statements.
+	*/
+	VisitGrantRole(stmt *GrantRole) (interface{}, error)
+
+	/*
+	   Visitor for REVOKE statements.
+	*/
+	VisitRevokeRole(stmt *RevokeRole) (interface{}, error)
 }
 
 type NodeVisitor interface {
@@ -63,7 +103,9 @@ type NodeVisitor interface {
 	VisitSubselect(node *Subselect) (interface{}, error)
 	VisitKeyspaceTerm(node *KeyspaceTerm) (interface{}, error)
 	VisitSubqueryTerm(node *SubqueryTerm) (interface{}, error)
+	VisitExpressionTerm(node *ExpressionTerm) (interface{}, error)
 	VisitJoin(node *Join) (interface{}, error)
+	VisitAnsiJoin(node *AnsiJoin) (interface{}, error)
 	VisitIndexJoin(node *IndexJoin) (interface{}, error)
 	VisitNest(node *Nest) (interface{}, error)
 	VisitIndexNest(node *IndexNest) (interface{}, error)
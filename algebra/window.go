@@ -0,0 +1,762 @@
+//  Copyright (c) 2016 Couchbase, Inc.
+//  Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file
+//  except in compliance with the License. You may obtain a copy of the License at
+//    http://www.apache.org/licenses/LICENSE-2.0
+//  Unless required by applicable law or agreed to in writing, software distributed under the
+//  License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+//  either express or implied. See the License for the specific language governing permissions
+//  and limitations under the License.
+
+package algebra
+
+import (
+	"fmt"
+
+	"github.com/couchbase/query/expression"
+	"github.com/couchbase/query/value"
+)
+
+/*
+Represents the OVER clause of a window function. It contains the
+PARTITION BY expressions, the window ORDER BY terms, and an
+optional frame.
+*/
+type WindowTerm struct {
+	partitionBy expression.Expressions
+	orderBy     *Order
+	frame       *WindowFrame
+}
+
+/*
+The function NewWindowTerm returns a pointer to the WindowTerm
+struct with the input partition expressions, order and frame.
+*/
+func NewWindowTerm(partitionBy expression.Expressions, orderBy *Order, frame *WindowFrame) *WindowTerm {
+	return &WindowTerm{
+		partitionBy: partitionBy,
+		orderBy:     orderBy,
+		frame:       frame,
+	}
+}
+
+/*
+Returns the PARTITION BY expressions.
+*/
+func (this *WindowTerm) PartitionBy() expression.Expressions {
+	return this.partitionBy
+}
+
+/*
+Returns the window ORDER BY, or nil.
+*/
+func (this *WindowTerm) OrderBy() *Order {
+	return this.orderBy
+}
+
+/*
+Returns the window frame, or nil if not specified.
+*/
+func (this *WindowTerm) Frame() *WindowFrame {
+	return this.frame
+}
+
+/*
+Returns all contained Expressions.
+*/
+func (this *WindowTerm) Expressions() expression.Expressions {
+	exprs := make(expression.Expressions, 0, len(this.partitionBy)+4)
+	exprs = append(exprs, this.partitionBy...)
+	if this.orderBy != nil {
+		exprs = append(exprs, this.orderBy.Expressions()...)
+	}
+	if this.frame != nil {
+		exprs = append(exprs, this.frame.Expressions()...)
+	}
+	return exprs
+}
+
+/*
+Map expressions for the partition, order and frame.
+*/
+func (this *WindowTerm) MapExpressions(mapper expression.Mapper) (err error) {
+	for i, expr := range this.partitionBy {
+		this.partitionBy[i], err = mapper.Map(expr)
+		if err != nil {
+			return
+		}
+	}
+
+	if this.orderBy != nil {
+		err = this.orderBy.MapExpressions(mapper)
+		if err != nil {
+			return
+		}
+	}
+
+	if this.frame != nil {
+		err = this.frame.MapExpressions(mapper)
+	}
+
+	return
+}
+
+/*
+Returns a deep copy of the window term.
+*/
+func (this *WindowTerm) Copy() *WindowTerm {
+	rv := &WindowTerm{
+		partitionBy: this.partitionBy.Copy(),
+	}
+
+	if this.orderBy != nil {
+		terms := make(SortTerms, len(this.orderBy.Terms()))
+		for i, term := range this.orderBy.Terms() {
+			terms[i] = NewSortTerm(term.Expression().Copy(), term.Descending())
+		}
+		rv.orderBy = NewOrder(terms)
+	}
+
+	if this.frame != nil {
+		rv.frame = this.frame.Copy()
+	}
+
+	return rv
+}
+
+/*
+Returns the text of the PARTITION BY and ORDER BY. Window
+functions with the same sort key share the sorting of their input.
+*/
+func (this *WindowTerm) SortKey() string {
+	s := ""
+	if len(this.partitionBy) > 0 {
+		s += "partition by "
+		for i, expr := range this.partitionBy {
+			if i > 0 {
+				s += ", "
+			}
+			s += expr.String()
+		}
+	}
+
+	if this.orderBy != nil {
+		if s != "" {
+			s += " "
+		}
+		s += "order by " + this.orderBy.Terms().String()
+	}
+
+	return s
+}
+
+/*
+Representation as a N1QL string.
+*/
+func (this *WindowTerm) String() string {
+	s := this.SortKey()
+	if this.frame != nil {
+		if s != "" {
+			s += " "
+		}
+		s += this.frame.String()
+	}
+
+	return "over (" + s + ")"
+}
+
+/*
+Window frame extent types.
+*/
+type WindowFrameExtentType int
+
+const (
+	UNBOUNDED_PRECEDING WindowFrameExtentType = iota
+	VALUE_PRECEDING
+	CURRENT_ROW
+	VALUE_FOLLOWING
+	UNBOUNDED_FOLLOWING
+)
+
+/*
+Represents the start or the end of a window frame, such as
+UNBOUNDED PRECEDING, CURRENT ROW or 2 FOLLOWING.
+*/
+type WindowFrameExtent struct {
+	extentType WindowFrameExtentType
+	valueExpr  expression.Expression
+}
+
+func NewWindowFrameExtent(extentType WindowFrameExtentType, valueExpr expression.Expression) *WindowFrameExtent {
+	return &WindowFrameExtent{
+		extentType: extentType,
+		valueExpr:  valueExpr,
+	}
+}
+
+func (this *WindowFrameExtent) ExtentType() WindowFrameExtentType {
+	return this.extentType
+}
+
+/*
+Returns the offset expression of VALUE PRECEDING and VALUE
+FOLLOWING extents, or nil.
+*/
+func (this *WindowFrameExtent) ValueExpression() expression.Expression {
+	return this.valueExpr
+}
+
+func (this *WindowFrameExtent) String() string {
+	switch this.extentType {
+	case UNBOUNDED_PRECEDING:
+		return "unbounded preceding"
+	case VALUE_PRECEDING:
+		return this.valueExpr.String() + " preceding"
+	case CURRENT_ROW:
+		return "current row"
+	case VALUE_FOLLOWING:
+		return this.valueExpr.String() + " following"
+	default:
+		return "unbounded following"
+	}
+}
+
+/*
+Represents the frame of a window, ie. the ROWS or RANGE over
+which framed functions such as aggregates, FIRST_VALUE() and
+LAST_VALUE() are computed.
+*/
+type WindowFrame struct {
+	rows  bool
+	start *WindowFrameExtent
+	end   *WindowFrameExtent
+}
+
+/*
+The function NewWindowFrame returns a pointer to the WindowFrame
+struct. A frame specified with a start only ends at CURRENT ROW.
+*/
+func NewWindowFrame(rows bool, start, end *WindowFrameExtent) *WindowFrame {
+	if end == nil {
+		end = NewWindowFrameExtent(CURRENT_ROW, nil)
+	}
+
+	return &WindowFrame{
+		rows:  rows,
+		start: start,
+		end:   end,
+	}
+}
+
+/*
+Returns true for ROWS frames, false for RANGE frames.
+*/
+func (this *WindowFrame) Rows() bool {
+	return this.rows
+}
+
+func (this *WindowFrame) Start() *WindowFrameExtent {
+	return this.start
+}
+
+func (this *WindowFrame) End() *WindowFrameExtent {
+	return this.end
+}
+
+/*
+Verify that the frame starts before it ends, and that RANGE
+offsets have a single ORDER BY term to apply to.
+*/
+func (this *WindowFrame) Validate(orderBy *Order) error {
+	if this.start.extentType == UNBOUNDED_FOLLOWING {
+		return fmt.Errorf("Window frame cannot start at UNBOUNDED FOLLOWING.")
+	}
+
+	if this.end.extentType == UNBOUNDED_PRECEDING {
+		return fmt.Errorf("Window frame cannot end at UNBOUNDED PRECEDING.")
+	}
+
+	if this.start.extentType > this.end.extentType {
+		return fmt.Errorf("Window frame cannot start after its end.")
+	}
+
+	if !this.rows && (this.start.valueExpr != nil || this.end.valueExpr != nil) &&
+		(orderBy == nil || len(orderBy.Terms()) != 1) {
+		return fmt.Errorf("RANGE window frame with offsets requires exactly one ORDER BY term.")
+	}
+
+	return nil
+}
+
+/*
+Returns the offset expressions of the frame.
+*/
+func (this *WindowFrame) Expressions() expression.Expressions {
+	exprs := make(expression.Expressions, 0, 2)
+	if this.start.valueExpr != nil {
+		exprs = append(exprs, this.start.valueExpr)
+	}
+	if this.end.valueExpr != nil {
+		exprs = append(exprs, this.end.valueExpr)
+	}
+	return exprs
+}
+
+func (this *WindowFrame) MapExpressions(mapper expression.Mapper) (err error) {
+	if this.start.valueExpr != nil {
+		this.start.valueExpr, err = mapper.Map(this.start.valueExpr)
+		if err != nil {
+			return
+		}
+	}
+
+	if this.end.valueExpr != nil {
+		this.end.valueExpr, err = mapper.Map(this.end.valueExpr)
+	}
+
+	return
+}
+
+func (this *WindowFrame) Copy() *WindowFrame {
+	copyExtent := func(e *WindowFrameExtent) *WindowFrameExtent {
+		if e.valueExpr == nil {
+			return NewWindowFrameExtent(e.extentType, nil)
+		}
+		return NewWindowFrameExtent(e.extentType, e.valueExpr.Copy())
+	}
+
+	return NewWindowFrame(this.rows, copyExtent(this.start), copyExtent(this.end))
+}
+
+/*
+Representation as a N1QL string.
+*/
+func (this *WindowFrame) String() string {
+	s := "range"
+	if this.rows {
+		s = "rows"
+	}
+
+	return s + " between " + this.start.String() + " and " + this.end.String()
+}
+
+type WindowFunctions []WindowFunction
+
+/*
+The WindowFunction interface represents functions computed over
+the window of each item, such as ROW_NUMBER(), RANK(), LAG() and
+aggregates with an OVER clause.
+
+Window functions are computed after grouping and before
+projection. The input is sorted by the PARTITION BY and ORDER BY
+of the window, and ComputeWindow() is called once per partition,
+returning one value for each item in the partition. The values are
+attached to the items, and Evaluate() retrieves them.
+*/
+type WindowFunction interface {
+	/*
+	   Represents the window function.
+	*/
+	expression.Function
+
+	/*
+	   The OVER clause of the function.
+	*/
+	Window() *WindowTerm
+
+	/*
+	   Computes the function for every item of a sorted partition.
+	*/
+	ComputeWindow(partition *WindowPartition, context Context) (value.Values, error)
+}
+
+/*
+Base class for window functions. It inherits from expression
+FunctionBase, and holds the OVER clause of the function.
+*/
+type WindowFunctionBase struct {
+	expression.FunctionBase
+	window *WindowTerm
+}
+
+func NewWindowFunctionBase(name string, window *WindowTerm, operands ...expression.Expression) *WindowFunctionBase {
+	return &WindowFunctionBase{
+		*expression.NewFunctionBase(name, operands...),
+		window,
+	}
+}
+
+/*
+Returns the OVER clause.
+*/
+func (this *WindowFunctionBase) Window() *WindowTerm {
+	return this.window
+}
+
+/*
+The OVER clause is part of the text of the function.
+*/
+func (this *WindowFunctionBase) FunctionSuffix() string {
+	return this.window.String()
+}
+
+/*
+Retrieve the value of the window function from the windows
+attachment of the item.
+*/
+func (this *WindowFunctionBase) evaluate(fn WindowFunction, item value.Value,
+	context expression.Context) (result value.Value, err error) {
+	if av, ok := item.(value.AnnotatedValue); ok {
+		windows := av.GetAttachment("windows")
+		if windows != nil {
+			result = windows.(map[string]value.Value)[fn.String()]
+		}
+	}
+
+	if result == nil {
+		err = fmt.Errorf("Window function %s not found.", fn.String())
+	}
+
+	return
+}
+
+/*
+Window functions depend on the data.
+*/
+func (this *WindowFunctionBase) Value() value.Value {
+	return nil
+}
+
+/*
+Not indexable.
+*/
+func (this *WindowFunctionBase) Indexable() bool {
+	return false
+}
+
+/*
+Return false.
+*/
+func (this *WindowFunctionBase) EquivalentTo(other expression.Expression) bool {
+	return false
+}
+
+/*
+Return false.
+*/
+func (this *WindowFunctionBase) SubsetOf(other expression.Expression) bool {
+	return false
+}
+
+/*
+Window functions return NULL rather than propagating MISSING.
+*/
+func (this *WindowFunctionBase) PropagatesMissing() bool {
+	return false
+}
+
+/*
+Window functions do not propagate NULL.
+*/
+func (this *WindowFunctionBase) PropagatesNull() bool {
+	return false
+}
+
+/*
+Return the operands of the function, followed by the
+expressions of the OVER clause.
+*/
+func (this *WindowFunctionBase) Children() expression.Expressions {
+	children := make(expression.Expressions, 0, len(this.Operands())+4)
+	for _, op := range this.Operands() {
+		if op != nil {
+			children = append(children, op)
+		}
+	}
+
+	return append(children, this.window.Expressions()...)
+}
+
+/*
+Map the operands and the expressions of the OVER clause.
+*/
+func (this *WindowFunctionBase) MapChildren(mapper expression.Mapper) error {
+	operands := this.Operands()
+	for i, op := range operands {
+		if op == nil {
+			continue
+		}
+
+		expr, err := mapper.Map(op)
+		if err != nil {
+			return err
+		}
+
+		operands[i] = expr
+	}
+
+	return this.window.MapExpressions(mapper)
+}
+
+/*
+WindowPartition holds the items of one partition, sorted by the
+window ORDER BY, together with their ORDER BY values.
+*/
+type WindowPartition struct {
+	window *WindowTerm
+	items  value.AnnotatedValues
+	keys   []value.Values
+	peers  []int
+}
+
+/*
+The function NewWindowPartition returns a partition of the input
+to the window. The keys are the values of the window ORDER BY
+terms for each item.
+*/
+func NewWindowPartition(window *WindowTerm, items value.AnnotatedValues, keys []value.Values) *WindowPartition {
+	rv := &WindowPartition{
+		window: window,
+		items:  items,
+		keys:   keys,
+		peers:  make([]int, len(items)),
+	}
+
+	// Number the groups of peers, ie. items with equal ORDER BY values
+	for i := 1; i < len(items); i++ {
+		rv.peers[i] = rv.peers[i-1]
+		if !rv.peerOf(i-1, i) {
+			rv.peers[i]++
+		}
+	}
+
+	return rv
+}
+
+/*
+Returns the partition with the frame of the input window. Window
+functions with the same PARTITION BY and ORDER BY share partitions,
+but may have different frames.
+*/
+func (this *WindowPartition) ForWindow(window *WindowTerm) *WindowPartition {
+	rv := *this
+	rv.window = window
+	return &rv
+}
+
+func (this *WindowPartition) Len() int {
+	return len(this.items)
+}
+
+func (this *WindowPartition) Item(i int) value.AnnotatedValue {
+	return this.items[i]
+}
+
+func (this *WindowPartition) peerOf(i, j int) bool {
+	if this.keys == nil {
+		return true
+	}
+
+	for k, key := range this.keys[i] {
+		if key.Collate(this.keys[j][k]) != 0 {
+			return false
+		}
+	}
+
+	return true
+}
+
+/*
+Returns the 0-based number of the group of peers of an item.
+*/
+func (this *WindowPartition) PeerGroup(i int) int {
+	return this.peers[i]
+}
+
+/*
+Returns the range [start, end) of the peers of an item.
+*/
+func (this *WindowPartition) Peers(i int) (int, int) {
+	start, end := i, i+1
+	for start > 0 && this.peers[start-1] == this.peers[i] {
+		start--
+	}
+	for end < len(this.items) && this.peers[end] == this.peers[i] {
+		end++
+	}
+	return start, end
+}
+
+/*
+Returns the range [start, end) of the frame of an item. Without a
+frame, the frame extends from the start of the partition to the
+last peer of the item if the window is ordered, and to the end of
+the partition otherwise.
+*/
+func (this *WindowPartition) Frame(i int, context Context) (int, int, error) {
+	frame := this.window.frame
+	if frame == nil {
+		if this.window.orderBy == nil {
+			return 0, len(this.items), nil
+		}
+
+		_, end := this.Peers(i)
+		return 0, end, nil
+	}
+
+	start, err := this.extent(frame, frame.start, i, true, context)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	end, err := this.extent(frame, frame.end, i, false, context)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	if end < start {
+		end = start
+	}
+
+	return start, end, nil
+}
+
+func (this *WindowPartition) extent(frame *WindowFrame, extent *WindowFrameExtent,
+	i int, start bool, context Context) (int, error) {
+	n := len(this.items)
+
+	switch extent.extentType {
+	case UNBOUNDED_PRECEDING:
+		return 0, nil
+	case UNBOUNDED_FOLLOWING:
+		return n, nil
+	case CURRENT_ROW:
+		if frame.rows {
+			if start {
+				return i, nil
+			}
+			return i + 1, nil
+		}
+
+		ps, pe := this.Peers(i)
+		if start {
+			return ps, nil
+		}
+		return pe, nil
+	}
+
+	offset, err := frameOffset(extent.valueExpr, this.items[i], context)
+	if err != nil {
+		return 0, err
+	}
+
+	if extent.extentType == VALUE_PRECEDING {
+		offset = offset.Neg()
+	}
+
+	if frame.rows {
+		pos := i + int(offset.Int64())
+		if !start {
+			pos++
+		}
+		return clamp(pos, 0, n), nil
+	}
+
+	// RANGE offsets apply to the value of the single ORDER BY term
+	cur := value.AsNumberValue(this.keys[i][0])
+	if cur == nil {
+		ps, pe := this.Peers(i)
+		if start {
+			return ps, nil
+		}
+		return pe, nil
+	}
+
+	if this.window.orderBy.Terms()[0].Descending() {
+		offset = offset.Neg()
+	}
+
+	bound := cur.Add(offset)
+	inside := func(j int) bool {
+		v := value.AsNumberValue(this.keys[j][0])
+		if v == nil {
+			return false
+		}
+
+		c := v.Collate(bound)
+		if this.window.orderBy.Terms()[0].Descending() {
+			if start {
+				return c <= 0
+			}
+			return c >= 0
+		}
+
+		if start {
+			return c >= 0
+		}
+		return c <= 0
+	}
+
+	if start {
+		j := i
+		for j > 0 && inside(j-1) {
+			j--
+		}
+		for j < n && !inside(j) {
+			j++
+		}
+		return j, nil
+	}
+
+	j := i + 1
+	for j < n && inside(j) {
+		j++
+	}
+	for j > 0 && !inside(j-1) {
+		j--
+	}
+	return j, nil
+}
+
+/*
+Evaluate a frame offset, which must be a non-negative number.
+*/
+func frameOffset(expr expression.Expression, item value.Value, context Context) (value.NumberValue, error) {
+	v, err := expr.Evaluate(item, context)
+	if err != nil {
+		return nil, err
+	}
+
+	offset := value.AsNumberValue(v)
+	if offset == nil || offset.Float64() < 0 {
+		return nil, fmt.Errorf("Window frame offset %v must be a non-negative number.", v)
+	}
+
+	return offset, nil
+}
+
+/*
+Evaluate an integer argument of a window function, such as the
+offset of LAG() or the number of buckets of NTILE().
+*/
+func windowIntArg(fn WindowFunction, expr expression.Expression, item value.Value, context Context) (int, error) {
+	v, err := expr.Evaluate(item, context)
+	if err != nil {
+		return 0, err
+	}
+
+	f, ok := value.IsInt(value.AsNumberValue(v))
+	if !ok {
+		return 0, fmt.Errorf("Argument %v of %s() must be an integer.", v, fn.Name())
+	}
+
+	return int(f), nil
+}
+
+func clamp(i, min, max int) int {
+	if i < min {
+		return min
+	} else if i > max {
+		return max
+	}
+	return i
+}
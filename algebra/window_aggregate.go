@@ -0,0 +1,134 @@
+//  Copyright (c) 2016 Couchbase, Inc.
+//  Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file
+//  except in compliance with the License. You may obtain a copy of the License at
+//    http://www.apache.org/licenses/LICENSE-2.0
+//  Unless required by applicable law or agreed to in writing, software distributed under the
+//  License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+//  either express or implied. See the License for the specific language governing permissions
+//  and limitations under the License.
+
+package algebra
+
+import (
+	"github.com/couchbase/query/expression"
+	"github.com/couchbase/query/value"
+)
+
+/*
+This represents an aggregate function with an OVER clause, such
+as SUM(expr) OVER (ORDER BY ...). The aggregate is computed over
+the frame of each item, rather than over a group. Type
+WindowAggregate is a struct that inherits from WindowFunctionBase.
+*/
+type WindowAggregate struct {
+	WindowFunctionBase
+	agg Aggregate
+}
+
+/*
+The function NewWindowAggregate returns a window function that
+computes the input aggregate over the input window.
+*/
+func NewWindowAggregate(agg Aggregate, window *WindowTerm) WindowFunction {
+	rv := &WindowAggregate{
+		*NewWindowFunctionBase(agg.Name(), window, agg.Operands()...),
+		agg,
+	}
+
+	rv.SetExpr(rv)
+	return rv
+}
+
+/*
+It calls the VisitFunction method by passing in the receiver to
+and returns the interface. It is a visitor pattern.
+*/
+func (this *WindowAggregate) Accept(visitor expression.Visitor) (interface{}, error) {
+	return visitor.VisitFunction(this)
+}
+
+/*
+Returns the type of the aggregate.
+*/
+func (this *WindowAggregate) Type() value.Type { return this.agg.Type() }
+
+/*
+Retrieve the value computed for the item.
+*/
+func (this *WindowAggregate) Evaluate(item value.Value, context expression.Context) (value.Value, error) {
+	return this.evaluate(this, item, context)
+}
+
+/*
+True for DISTINCT aggregates.
+*/
+func (this *WindowAggregate) Distinct() bool { return this.agg.Distinct() }
+
+func (this *WindowAggregate) MinArgs() int { return this.agg.MinArgs() }
+
+func (this *WindowAggregate) MaxArgs() int { return this.agg.MaxArgs() }
+
+/*
+Returns the aggregate computed over the window.
+*/
+func (this *WindowAggregate) Aggregate() Aggregate {
+	return this.agg
+}
+
+/*
+The constructor returns a WindowAggregate over a copy of the
+window.
+*/
+func (this *WindowAggregate) Constructor() expression.FunctionConstructor {
+	return func(operands ...expression.Expression) expression.Function {
+		agg := this.agg.Constructor()(operands...).(Aggregate)
+		return NewWindowAggregate(agg, this.window.Copy())
+	}
+}
+
+/*
+Aggregate the frame of each item. When the frame of an item
+starts where the frame of the previous item starts and ends at or
+after it, as for running totals, the previous cumulative value is
+extended rather than recomputed.
+*/
+func (this *WindowAggregate) ComputeWindow(partition *WindowPartition, context Context) (value.Values, error) {
+	rv := make(value.Values, partition.Len())
+	cumulative := this.agg.Default()
+	prevStart, prevEnd := -1, -1
+
+	for i := 0; i < partition.Len(); i++ {
+		start, end, err := partition.Frame(i, context)
+		if err != nil {
+			return nil, err
+		}
+
+		if start == prevStart && end == prevEnd {
+			rv[i] = rv[i-1]
+			continue
+		}
+
+		from := prevEnd
+		if start != prevStart || end < prevEnd {
+			cumulative = this.agg.Default()
+			from = start
+		}
+
+		for j := from; j < end; j++ {
+			cumulative, err = this.agg.CumulateInitial(partition.Item(j), cumulative, context)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		prevStart, prevEnd = start, end
+
+		// Cumulative values are updated in place; finalize a copy
+		rv[i], err = this.agg.ComputeFinal(cumulative.CopyForUpdate(), context)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return rv, nil
+}
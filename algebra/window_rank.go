@@ -0,0 +1,354 @@
+//  Copyright (c) 2016 Couchbase, Inc.
+//  Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file
+//  except in compliance with the License. You may obtain a copy of the License at
+//    http://www.apache.org/licenses/LICENSE-2.0
+//  Unless required by applicable law or agreed to in writing, software distributed under the
+//  License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+//  either express or implied. See the License for the specific language governing permissions
+//  and limitations under the License.
+
+package algebra
+
+import (
+	"fmt"
+
+	"github.com/couchbase/query/expression"
+	"github.com/couchbase/query/value"
+)
+
+/*
+This represents the window function ROW_NUMBER(). It returns the
+1-based position of the item within its partition.
+*/
+type RowNumber struct {
+	WindowFunctionBase
+}
+
+func NewRowNumber(window *WindowTerm) WindowFunction {
+	rv := &RowNumber{
+		*NewWindowFunctionBase("row_number", window),
+	}
+
+	rv.SetExpr(rv)
+	return rv
+}
+
+/*
+It calls the VisitFunction method by passing in the receiver to
+and returns the interface. It is a visitor pattern.
+*/
+func (this *RowNumber) Accept(visitor expression.Visitor) (interface{}, error) {
+	return visitor.VisitFunction(this)
+}
+
+func (this *RowNumber) Type() value.Type { return value.NUMBER }
+
+func (this *RowNumber) Evaluate(item value.Value, context expression.Context) (value.Value, error) {
+	return this.evaluate(this, item, context)
+}
+
+func (this *RowNumber) MinArgs() int { return 0 }
+
+func (this *RowNumber) MaxArgs() int { return 0 }
+
+func (this *RowNumber) Constructor() expression.FunctionConstructor {
+	return func(operands ...expression.Expression) expression.Function {
+		return NewRowNumber(this.window.Copy())
+	}
+}
+
+func (this *RowNumber) ComputeWindow(partition *WindowPartition, context Context) (value.Values, error) {
+	rv := make(value.Values, partition.Len())
+	for i := range rv {
+		rv[i] = value.NewValue(i + 1)
+	}
+
+	return rv, nil
+}
+
+/*
+This represents the window function RANK(). It returns the rank of
+the item within its partition, with gaps after ties: peers get the
+same rank, which is one more than the number of preceding items.
+*/
+type Rank struct {
+	WindowFunctionBase
+}
+
+func NewRank(window *WindowTerm) WindowFunction {
+	rv := &Rank{
+		*NewWindowFunctionBase("rank", window),
+	}
+
+	rv.SetExpr(rv)
+	return rv
+}
+
+/*
+It calls the VisitFunction method by passing in the receiver to
+and returns the interface. It is a visitor pattern.
+*/
+func (this *Rank) Accept(visitor expression.Visitor) (interface{}, error) {
+	return visitor.VisitFunction(this)
+}
+
+func (this *Rank) Type() value.Type { return value.NUMBER }
+
+func (this *Rank) Evaluate(item value.Value, context expression.Context) (value.Value, error) {
+	return this.evaluate(this, item, context)
+}
+
+func (this *Rank) MinArgs() int { return 0 }
+
+func (this *Rank) MaxArgs() int { return 0 }
+
+func (this *Rank) Constructor() expression.FunctionConstructor {
+	return func(operands ...expression.Expression) expression.Function {
+		return NewRank(this.window.Copy())
+	}
+}
+
+func (this *Rank) ComputeWindow(partition *WindowPartition, context Context) (value.Values, error) {
+	rv := make(value.Values, partition.Len())
+	for i := range rv {
+		start, _ := partition.Peers(i)
+		rv[i] = value.NewValue(start + 1)
+	}
+
+	return rv, nil
+}
+
+/*
+This represents the window function DENSE_RANK(). It returns the
+rank of the item within its partition, without gaps after ties.
+*/
+type DenseRank struct {
+	WindowFunctionBase
+}
+
+func NewDenseRank(window *WindowTerm) WindowFunction {
+	rv := &DenseRank{
+		*NewWindowFunctionBase("dense_rank", window),
+	}
+
+	rv.SetExpr(rv)
+	return rv
+}
+
+/*
+It calls the VisitFunction method by passing in the receiver to
+and returns the interface. It is a visitor pattern.
+*/
+func (this *DenseRank) Accept(visitor expression.Visitor) (interface{}, error) {
+	return visitor.VisitFunction(this)
+}
+
+func (this *DenseRank) Type() value.Type { return value.NUMBER }
+
+func (this *DenseRank) Evaluate(item value.Value, context expression.Context) (value.Value, error) {
+	return this.evaluate(this, item, context)
+}
+
+func (this *DenseRank) MinArgs() int { return 0 }
+
+func (this *DenseRank) MaxArgs() int { return 0 }
+
+func (this *DenseRank) Constructor() expression.FunctionConstructor {
+	return func(operands ...expression.Expression) expression.Function {
+		return NewDenseRank(this.window.Copy())
+	}
+}
+
+func (this *DenseRank) ComputeWindow(partition *WindowPartition, context Context) (value.Values, error) {
+	rv := make(value.Values, partition.Len())
+	for i := range rv {
+		rv[i] = value.NewValue(partition.PeerGroup(i) + 1)
+	}
+
+	return rv, nil
+}
+
+/*
+This represents the window function PERCENT_RANK(). It returns
+(rank - 1) / (partition size - 1), or 0 for single-item
+partitions.
+*/
+type PercentRank struct {
+	WindowFunctionBase
+}
+
+func NewPercentRank(window *WindowTerm) WindowFunction {
+	rv := &PercentRank{
+		*NewWindowFunctionBase("percent_rank", window),
+	}
+
+	rv.SetExpr(rv)
+	return rv
+}
+
+/*
+It calls the VisitFunction method by passing in the receiver to
+and returns the interface. It is a visitor pattern.
+*/
+func (this *PercentRank) Accept(visitor expression.Visitor) (interface{}, error) {
+	return visitor.VisitFunction(this)
+}
+
+func (this *PercentRank) Type() value.Type { return value.NUMBER }
+
+func (this *PercentRank) Evaluate(item value.Value, context expression.Context) (value.Value, error) {
+	return this.evaluate(this, item, context)
+}
+
+func (this *PercentRank) MinArgs() int { return 0 }
+
+func (this *PercentRank) MaxArgs() int { return 0 }
+
+func (this *PercentRank) Constructor() expression.FunctionConstructor {
+	return func(operands ...expression.Expression) expression.Function {
+		return NewPercentRank(this.window.Copy())
+	}
+}
+
+func (this *PercentRank) ComputeWindow(partition *WindowPartition, context Context) (value.Values, error) {
+	n := partition.Len()
+	rv := make(value.Values, n)
+	for i := range rv {
+		if n == 1 {
+			rv[i] = value.ZERO_VALUE
+			continue
+		}
+
+		start, _ := partition.Peers(i)
+		rv[i] = value.NewValue(float64(start) / float64(n-1))
+	}
+
+	return rv, nil
+}
+
+/*
+This represents the window function CUME_DIST(). It returns the
+fraction of the items of the partition that precede the item or
+are its peers.
+*/
+type CumeDist struct {
+	WindowFunctionBase
+}
+
+func NewCumeDist(window *WindowTerm) WindowFunction {
+	rv := &CumeDist{
+		*NewWindowFunctionBase("cume_dist", window),
+	}
+
+	rv.SetExpr(rv)
+	return rv
+}
+
+/*
+It calls the VisitFunction method by passing in the receiver to
+and returns the interface. It is a visitor pattern.
+*/
+func (this *CumeDist) Accept(visitor expression.Visitor) (interface{}, error) {
+	return visitor.VisitFunction(this)
+}
+
+func (this *CumeDist) Type() value.Type { return value.NUMBER }
+
+func (this *CumeDist) Evaluate(item value.Value, context expression.Context) (value.Value, error) {
+	return this.evaluate(this, item, context)
+}
+
+func (this *CumeDist) MinArgs() int { return 0 }
+
+func (this *CumeDist) MaxArgs() int { return 0 }
+
+func (this *CumeDist) Constructor() expression.FunctionConstructor {
+	return func(operands ...expression.Expression) expression.Function {
+		return NewCumeDist(this.window.Copy())
+	}
+}
+
+func (this *CumeDist) ComputeWindow(partition *WindowPartition, context Context) (value.Values, error) {
+	n := partition.Len()
+	rv := make(value.Values, n)
+	for i := range rv {
+		_, end := partition.Peers(i)
+		rv[i] = value.NewValue(float64(end) / float64(n))
+	}
+
+	return rv, nil
+}
+
+/*
+This represents the window function NTILE(n). It divides the
+partition into n buckets of as equal size as possible, and returns
+the 1-based bucket of the item. Earlier buckets receive the extra
+items.
+*/
+type Ntile struct {
+	WindowFunctionBase
+}
+
+func NewNtile(window *WindowTerm, operand expression.Expression) WindowFunction {
+	rv := &Ntile{
+		*NewWindowFunctionBase("ntile", window, operand),
+	}
+
+	rv.SetExpr(rv)
+	return rv
+}
+
+/*
+It calls the VisitFunction method by passing in the receiver to
+and returns the interface. It is a visitor pattern.
+*/
+func (this *Ntile) Accept(visitor expression.Visitor) (interface{}, error) {
+	return visitor.VisitFunction(this)
+}
+
+func (this *Ntile) Type() value.Type { return value.NUMBER }
+
+func (this *Ntile) Evaluate(item value.Value, context expression.Context) (value.Value, error) {
+	return this.evaluate(this, item, context)
+}
+
+func (this *Ntile) MinArgs() int { return 1 }
+
+func (this *Ntile) MaxArgs() int { return 1 }
+
+func (this *Ntile) Constructor() expression.FunctionConstructor {
+	return func(operands ...expression.Expression) expression.Function {
+		return NewNtile(this.window.Copy(), operands[0])
+	}
+}
+
+func (this *Ntile) ComputeWindow(partition *WindowPartition, context Context) (value.Values, error) {
+	n := partition.Len()
+	rv := make(value.Values, n)
+	if n == 0 {
+		return rv, nil
+	}
+
+	buckets, err := windowIntArg(this, this.Operands()[0], partition.Item(0), context)
+	if err != nil {
+		return nil, err
+	}
+
+	if buckets <= 0 {
+		return nil, fmt.Errorf("Number of buckets %d of ntile() must be positive.", buckets)
+	}
+
+	size, extra := n/buckets, n%buckets
+	for i := range rv {
+		var bucket int
+		if i < extra*(size+1) {
+			bucket = i / (size + 1)
+		} else {
+			bucket = extra + (i-extra*(size+1))/size
+		}
+
+		rv[i] = value.NewValue(bucket + 1)
+	}
+
+	return rv, nil
+}
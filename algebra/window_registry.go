@@ -0,0 +1,49 @@
+//  Copyright (c) 2016 Couchbase, Inc.
+//  Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file
+//  except in compliance with the License. You may obtain a copy of the License at
+//    http://www.apache.org/licenses/LICENSE-2.0
+//  Unless required by applicable law or agreed to in writing, software distributed under the
+//  License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+//  either express or implied. See the License for the specific language governing permissions
+//  and limitations under the License.
+
+package algebra
+
+import (
+	"strings"
+)
+
+/*
+This method is used to retrieve a window function by the parser.
+Based on the input string name, it looks through a map and
+returns the window function over the input window, without
+operands. The parser then uses the Constructor() of the function
+to supply the operands. Aggregates with an OVER clause are not
+in the map; they are wrapped with NewWindowAggregate().
+*/
+func GetWindowFunction(name string, window *WindowTerm) (WindowFunction, bool) {
+	ctor, ok := _WINDOW_FUNCTIONS[strings.ToLower(name)]
+	if !ok {
+		return nil, false
+	}
+
+	return ctor(window), true
+}
+
+/*
+Window functions that are not aggregates. The variable represents
+a map from string to window function constructor.
+*/
+var _WINDOW_FUNCTIONS = map[string]func(*WindowTerm) WindowFunction{
+	"cume_dist":    NewCumeDist,
+	"dense_rank":   NewDenseRank,
+	"percent_rank": NewPercentRank,
+	"rank":         NewRank,
+	"row_number":   NewRowNumber,
+	"first_value":  func(w *WindowTerm) WindowFunction { return NewFirstValue(w, nil) },
+	"lag":          func(w *WindowTerm) WindowFunction { return NewLag(w) },
+	"last_value":   func(w *WindowTerm) WindowFunction { return NewLastValue(w, nil) },
+	"lead":         func(w *WindowTerm) WindowFunction { return NewLead(w) },
+	"nth_value":    func(w *WindowTerm) WindowFunction { return NewNthValue(w, nil, nil) },
+	"ntile":        func(w *WindowTerm) WindowFunction { return NewNtile(w, nil) },
+}
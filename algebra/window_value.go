@@ -0,0 +1,339 @@
+//  Copyright (c) 2016 Couchbase, Inc.
+//  Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file
+//  except in compliance with the License. You may obtain a copy of the License at
+//    http://www.apache.org/licenses/LICENSE-2.0
+//  Unless required by applicable law or agreed to in writing, software distributed under the
+//  License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+//  either express or implied. See the License for the specific language governing permissions
+//  and limitations under the License.
+
+package algebra
+
+import (
+	"fmt"
+
+	"github.com/couchbase/query/expression"
+	"github.com/couchbase/query/value"
+)
+
+/*
+This represents the window function LAG(expr [, offset [,
+default]]). It returns expr evaluated for the item offset items
+before the item in its partition, or default if there is no such
+item. The offset defaults to 1 and the default to NULL.
+*/
+type Lag struct {
+	WindowFunctionBase
+}
+
+func NewLag(window *WindowTerm, operands ...expression.Expression) WindowFunction {
+	rv := &Lag{
+		*NewWindowFunctionBase("lag", window, operands...),
+	}
+
+	rv.SetExpr(rv)
+	return rv
+}
+
+/*
+It calls the VisitFunction method by passing in the receiver to
+and returns the interface. It is a visitor pattern.
+*/
+func (this *Lag) Accept(visitor expression.Visitor) (interface{}, error) {
+	return visitor.VisitFunction(this)
+}
+
+func (this *Lag) Type() value.Type { return value.JSON }
+
+func (this *Lag) Evaluate(item value.Value, context expression.Context) (value.Value, error) {
+	return this.evaluate(this, item, context)
+}
+
+func (this *Lag) MinArgs() int { return 1 }
+
+func (this *Lag) MaxArgs() int { return 3 }
+
+func (this *Lag) Constructor() expression.FunctionConstructor {
+	return func(operands ...expression.Expression) expression.Function {
+		return NewLag(this.window.Copy(), operands...)
+	}
+}
+
+func (this *Lag) ComputeWindow(partition *WindowPartition, context Context) (value.Values, error) {
+	return computeOffset(this, partition, -1, context)
+}
+
+/*
+This represents the window function LEAD(expr [, offset [,
+default]]). It returns expr evaluated for the item offset items
+after the item in its partition, or default if there is no such
+item. The offset defaults to 1 and the default to NULL.
+*/
+type Lead struct {
+	WindowFunctionBase
+}
+
+func NewLead(window *WindowTerm, operands ...expression.Expression) WindowFunction {
+	rv := &Lead{
+		*NewWindowFunctionBase("lead", window, operands...),
+	}
+
+	rv.SetExpr(rv)
+	return rv
+}
+
+/*
+It calls the VisitFunction method by passing in the receiver to
+and returns the interface. It is a visitor pattern.
+*/
+func (this *Lead) Accept(visitor expression.Visitor) (interface{}, error) {
+	return visitor.VisitFunction(this)
+}
+
+func (this *Lead) Type() value.Type { return value.JSON }
+
+func (this *Lead) Evaluate(item value.Value, context expression.Context) (value.Value, error) {
+	return this.evaluate(this, item, context)
+}
+
+func (this *Lead) MinArgs() int { return 1 }
+
+func (this *Lead) MaxArgs() int { return 3 }
+
+func (this *Lead) Constructor() expression.FunctionConstructor {
+	return func(operands ...expression.Expression) expression.Function {
+		return NewLead(this.window.Copy(), operands...)
+	}
+}
+
+func (this *Lead) ComputeWindow(partition *WindowPartition, context Context) (value.Values, error) {
+	return computeOffset(this, partition, 1, context)
+}
+
+/*
+Compute LAG() and LEAD(). The direction is -1 for LAG() and 1 for
+LEAD().
+*/
+func computeOffset(fn WindowFunction, partition *WindowPartition, direction int,
+	context Context) (value.Values, error) {
+	operands := fn.Operands()
+	rv := make(value.Values, partition.Len())
+
+	for i := range rv {
+		item := partition.Item(i)
+		offset := 1
+		if len(operands) > 1 {
+			var err error
+			offset, err = windowIntArg(fn, operands[1], item, context)
+			if err != nil {
+				return nil, err
+			}
+
+			if offset < 0 {
+				return nil, fmt.Errorf("Offset %d of %s() must not be negative.", offset, fn.Name())
+			}
+		}
+
+		var err error
+		j := i + direction*offset
+		if j >= 0 && j < partition.Len() {
+			rv[i], err = operands[0].Evaluate(partition.Item(j), context)
+		} else if len(operands) > 2 {
+			rv[i], err = operands[2].Evaluate(item, context)
+		} else {
+			rv[i] = value.NULL_VALUE
+		}
+
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return rv, nil
+}
+
+/*
+This represents the window function FIRST_VALUE(expr). It returns
+expr evaluated for the first item of the frame, or NULL if the
+frame is empty.
+*/
+type FirstValue struct {
+	WindowFunctionBase
+}
+
+func NewFirstValue(window *WindowTerm, operand expression.Expression) WindowFunction {
+	rv := &FirstValue{
+		*NewWindowFunctionBase("first_value", window, operand),
+	}
+
+	rv.SetExpr(rv)
+	return rv
+}
+
+/*
+It calls the VisitFunction method by passing in the receiver to
+and returns the interface. It is a visitor pattern.
+*/
+func (this *FirstValue) Accept(visitor expression.Visitor) (interface{}, error) {
+	return visitor.VisitFunction(this)
+}
+
+func (this *FirstValue) Type() value.Type { return value.JSON }
+
+func (this *FirstValue) Evaluate(item value.Value, context expression.Context) (value.Value, error) {
+	return this.evaluate(this, item, context)
+}
+
+func (this *FirstValue) MinArgs() int { return 1 }
+
+func (this *FirstValue) MaxArgs() int { return 1 }
+
+func (this *FirstValue) Constructor() expression.FunctionConstructor {
+	return func(operands ...expression.Expression) expression.Function {
+		return NewFirstValue(this.window.Copy(), operands[0])
+	}
+}
+
+func (this *FirstValue) ComputeWindow(partition *WindowPartition, context Context) (value.Values, error) {
+	return computeNth(this, partition, func(start, end int, item value.Value) (int, error) {
+		return start, nil
+	}, context)
+}
+
+/*
+This represents the window function LAST_VALUE(expr). It returns
+expr evaluated for the last item of the frame, or NULL if the
+frame is empty.
+*/
+type LastValue struct {
+	WindowFunctionBase
+}
+
+func NewLastValue(window *WindowTerm, operand expression.Expression) WindowFunction {
+	rv := &LastValue{
+		*NewWindowFunctionBase("last_value", window, operand),
+	}
+
+	rv.SetExpr(rv)
+	return rv
+}
+
+/*
+It calls the VisitFunction method by passing in the receiver to
+and returns the interface. It is a visitor pattern.
+*/
+func (this *LastValue) Accept(visitor expression.Visitor) (interface{}, error) {
+	return visitor.VisitFunction(this)
+}
+
+func (this *LastValue) Type() value.Type { return value.JSON }
+
+func (this *LastValue) Evaluate(item value.Value, context expression.Context) (value.Value, error) {
+	return this.evaluate(this, item, context)
+}
+
+func (this *LastValue) MinArgs() int { return 1 }
+
+func (this *LastValue) MaxArgs() int { return 1 }
+
+func (this *LastValue) Constructor() expression.FunctionConstructor {
+	return func(operands ...expression.Expression) expression.Function {
+		return NewLastValue(this.window.Copy(), operands[0])
+	}
+}
+
+func (this *LastValue) ComputeWindow(partition *WindowPartition, context Context) (value.Values, error) {
+	return computeNth(this, partition, func(start, end int, item value.Value) (int, error) {
+		return end - 1, nil
+	}, context)
+}
+
+/*
+This represents the window function NTH_VALUE(expr, n). It returns
+expr evaluated for the n-th item of the frame, counting from 1, or
+NULL if the frame has fewer than n items.
+*/
+type NthValue struct {
+	WindowFunctionBase
+}
+
+func NewNthValue(window *WindowTerm, first, second expression.Expression) WindowFunction {
+	rv := &NthValue{
+		*NewWindowFunctionBase("nth_value", window, first, second),
+	}
+
+	rv.SetExpr(rv)
+	return rv
+}
+
+/*
+It calls the VisitFunction method by passing in the receiver to
+and returns the interface. It is a visitor pattern.
+*/
+func (this *NthValue) Accept(visitor expression.Visitor) (interface{}, error) {
+	return visitor.VisitFunction(this)
+}
+
+func (this *NthValue) Type() value.Type { return value.JSON }
+
+func (this *NthValue) Evaluate(item value.Value, context expression.Context) (value.Value, error) {
+	return this.evaluate(this, item, context)
+}
+
+func (this *NthValue) MinArgs() int { return 2 }
+
+func (this *NthValue) MaxArgs() int { return 2 }
+
+func (this *NthValue) Constructor() expression.FunctionConstructor {
+	return func(operands ...expression.Expression) expression.Function {
+		return NewNthValue(this.window.Copy(), operands[0], operands[1])
+	}
+}
+
+func (this *NthValue) ComputeWindow(partition *WindowPartition, context Context) (value.Values, error) {
+	return computeNth(this, partition, func(start, end int, item value.Value) (int, error) {
+		n, err := windowIntArg(this, this.Operands()[1], item, context)
+		if err != nil {
+			return 0, err
+		}
+
+		if n <= 0 {
+			return 0, fmt.Errorf("Position %d of nth_value() must be positive.", n)
+		}
+
+		return start + n - 1, nil
+	}, context)
+}
+
+/*
+Compute FIRST_VALUE(), LAST_VALUE() and NTH_VALUE(). The position
+function selects an item of the frame of each item.
+*/
+func computeNth(fn WindowFunction, partition *WindowPartition,
+	position func(start, end int, item value.Value) (int, error), context Context) (value.Values, error) {
+	rv := make(value.Values, partition.Len())
+
+	for i := range rv {
+		start, end, err := partition.Frame(i, context)
+		if err != nil {
+			return nil, err
+		}
+
+		j, err := position(start, end, partition.Item(i))
+		if err != nil {
+			return nil, err
+		}
+
+		if j < start || j >= end {
+			rv[i] = value.NULL_VALUE
+			continue
+		}
+
+		rv[i], err = fn.Operands()[0].Evaluate(partition.Item(j), context)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return rv, nil
+}
@@ -0,0 +1,275 @@
+//  Copyright (c) 2016 Couchbase, Inc.
+//  Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file
+//  except in compliance with the License. You may obtain a copy of the License at
+//    http://www.apache.org/licenses/LICENSE-2.0
+//  Unless required by applicable law or agreed to in writing, software distributed under the
+//  License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+//  either express or implied. See the License for the specific language governing permissions
+//  and limitations under the License.
+
+package algebra
+
+import (
+	"fmt"
+
+	"github.com/couchbase/query/datastore"
+	"github.com/couchbase/query/errors"
+	"github.com/couchbase/query/expression"
+)
+
+/*
+Represents a common table expression, ie. a named subquery in the
+WITH clause of a statement. The alias is bound to the results of
+the subquery, which are evaluated once and are visible to the
+rest of the statement, including nested subqueries and FROM.
+
+A recursive common table expression is a UNION or UNION ALL of an
+anchor query and a recursive query. The recursive query is
+evaluated repeatedly, with the alias bound to the results of the
+previous evaluation, until it returns no new results.
+*/
+type With struct {
+	alias     string
+	query     *Select
+	recursive bool
+	anchor    *Select
+	step      *Select
+	distinct  bool
+}
+
+/*
+The function NewWith returns a common table expression. If
+recursive is true and the query is a UNION or UNION ALL, the
+common table expression is recursive.
+*/
+func NewWith(alias string, query *Select, recursive bool) *With {
+	rv := &With{
+		alias: alias,
+		query: query,
+	}
+
+	if !recursive || query.Order() != nil || query.Offset() != nil || query.Limit() != nil {
+		return rv
+	}
+
+	switch sub := query.Subresult().(type) {
+	case *Union:
+		rv.anchor, rv.step = NewSelect(sub.first, nil, nil, nil), NewSelect(sub.second, nil, nil, nil)
+		rv.distinct = true
+	case *UnionAll:
+		rv.anchor, rv.step = NewSelect(sub.first, nil, nil, nil), NewSelect(sub.second, nil, nil, nil)
+	default:
+		return rv
+	}
+
+	rv.recursive = true
+	return rv
+}
+
+/*
+Returns the alias of the common table expression.
+*/
+func (this *With) Alias() string {
+	return this.alias
+}
+
+/*
+Returns the subquery of the common table expression.
+*/
+func (this *With) Query() *Select {
+	return this.query
+}
+
+/*
+Returns true if the common table expression is recursive.
+*/
+func (this *With) Recursive() bool {
+	return this.recursive
+}
+
+/*
+Returns the anchor query of a recursive common table expression,
+ie. the first term of its UNION.
+*/
+func (this *With) Anchor() *Select {
+	return this.anchor
+}
+
+/*
+Returns the recursive query of a recursive common table
+expression, ie. the second term of its UNION.
+*/
+func (this *With) Step() *Select {
+	return this.step
+}
+
+/*
+Returns true if the recursive common table expression is a UNION,
+which eliminates duplicate results, rather than a UNION ALL.
+*/
+func (this *With) Distinct() bool {
+	return this.distinct
+}
+
+/*
+Representation as a N1QL string.
+*/
+func (this *With) String() string {
+	return "`" + this.alias + "` as (" + this.query.String() + ")"
+}
+
+/*
+Represents the WITH clause of a statement.
+*/
+type Withs []*With
+
+/*
+Returns true if any of the common table expressions is recursive.
+*/
+func (this Withs) Recursive() bool {
+	for _, with := range this {
+		if with.recursive {
+			return true
+		}
+	}
+
+	return false
+}
+
+/*
+Qualify all identifiers of the common table expressions, and
+return a formalizer that allows their aliases. Each alias is
+visible to the common table expressions that follow it, and a
+recursive common table expression is visible to its own recursive
+query.
+*/
+func (this Withs) Formalize(parent *expression.Formalizer) (*expression.Formalizer, error) {
+	f := expression.NewFormalizer("", parent)
+	aliases := make(map[string]bool, len(this))
+
+	for _, with := range this {
+		if aliases[with.alias] {
+			return nil, fmt.Errorf("Duplicate WITH alias %s.", with.alias)
+		}
+
+		aliases[with.alias] = true
+
+		if !with.recursive {
+			err := with.query.FormalizeSubquery(f)
+			if err != nil {
+				return nil, err
+			}
+
+			f.SetWith(with.alias)
+			continue
+		}
+
+		err := with.anchor.FormalizeSubquery(f)
+		if err != nil {
+			return nil, err
+		}
+
+		f.SetWith(with.alias)
+
+		err = with.step.FormalizeSubquery(f)
+		if err != nil {
+			return nil, err
+		}
+
+		// A UNION that does not reference its alias is not recursive
+		if !referencesAlias(with.step.Expressions(), with.alias) {
+			with.recursive = false
+			with.anchor, with.step = nil, nil
+		}
+	}
+
+	return f, nil
+}
+
+/*
+Returns true if the expressions, or the subqueries they contain,
+reference the alias.
+*/
+func referencesAlias(exprs expression.Expressions, alias string) bool {
+	for _, expr := range exprs {
+		switch expr := expr.(type) {
+		case *expression.Identifier:
+			if expr.Identifier() == alias {
+				return true
+			}
+		case *Subquery:
+			if referencesAlias(expr.Select().Expressions(), alias) {
+				return true
+			}
+		}
+
+		if referencesAlias(expr.Children(), alias) {
+			return true
+		}
+	}
+
+	return false
+}
+
+/*
+Apply mapper to the subqueries of the common table expressions.
+*/
+func (this Withs) MapExpressions(mapper expression.Mapper) (err error) {
+	for _, with := range this {
+		err = with.query.MapExpressions(mapper)
+		if err != nil {
+			return
+		}
+	}
+
+	return
+}
+
+/*
+Returns all contained Expressions.
+*/
+func (this Withs) Expressions() expression.Expressions {
+	exprs := make(expression.Expressions, 0, 16)
+	for _, with := range this {
+		exprs = append(exprs, with.query.Expressions()...)
+	}
+
+	return exprs
+}
+
+/*
+Returns all required privileges.
+*/
+func (this Withs) Privileges() (datastore.Privileges, errors.Error) {
+	privs := datastore.NewPrivileges()
+	for _, with := range this {
+		wprivs, err := with.query.Privileges()
+		if err != nil {
+			return nil, err
+		}
+
+		privs.Add(wprivs)
+	}
+
+	return privs, nil
+}
+
+/*
+Representation as a N1QL string.
+*/
+func (this Withs) String() string {
+	s := "with "
+	if this.Recursive() {
+		s += "recursive "
+	}
+
+	for i, with := range this {
+		if i > 0 {
+			s += ", "
+		}
+
+		s += with.String()
+	}
+
+	return s
+}
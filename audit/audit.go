@@ -0,0 +1,206 @@
+//  Copyright (c) 2016 Couchbase, Inc.
+//  Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file
+//  except in compliance with the License. You may obtain a copy of the License at
+//    http://www.apache.org/licenses/LICENSE-2.0
+//  Unless required by applicable law or agreed to in writing, software distributed under the
+//  License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+//  either express or implied. See the License for the specific language governing permissions
+//  and limitations under the License.
+
+/*
+Package audit records a durable trail of the requests served by the
+engine. Entries are written to a pluggable audit sink, and can be
+filtered by statement type. Authorization failures are always
+recorded while auditing is enabled.
+*/
+package audit
+
+import (
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/couchbase/query/errors"
+	"github.com/couchbase/query/logging"
+)
+
+// Auditor represents a sink for audit entries
+type Auditor interface {
+	Id() string                       // Id of this Auditor
+	URL() string                      // URL to this Auditor
+	Submit(entry *Entry) errors.Error // Record an entry
+	Close() errors.Error              // Flush and release the sink
+}
+
+// The record of a single request
+type Entry struct {
+	Timestamp       time.Time              `json:"timestamp"`
+	RequestId       string                 `json:"requestId"`
+	ClientContextId string                 `json:"clientContextId,omitempty"`
+	Users           []string               `json:"users,omitempty"`
+	ClientAddress   string                 `json:"clientAddress,omitempty"`
+	StatementType   string                 `json:"statementType,omitempty"`
+	Statement       string                 `json:"statement,omitempty"`
+	PreparedName    string                 `json:"preparedName,omitempty"`
+	NamedArgs       map[string]interface{} `json:"namedArgs,omitempty"`
+	PositionalArgs  []interface{}          `json:"positionalArgs,omitempty"`
+	Privileges      map[string]string      `json:"privileges,omitempty"`
+	Status          string                 `json:"status"`
+	ErrorCodes      []int                  `json:"errorCodes,omitempty"`
+	ElapsedTime     string                 `json:"elapsedTime"`
+}
+
+// Replaces parameter values in the audit trail when redaction is on
+const REDACTED = "<redacted>"
+
+// Statement types can be filtered by group
+var _GROUPS = map[string][]string{
+	"QUERY":       {"SELECT", "EXPLAIN", "INFER"},
+	"DML":         {"INSERT", "UPSERT", "UPDATE", "DELETE", "MERGE"},
+	"DDL":         {"CREATE_PRIMARY_INDEX", "CREATE_INDEX", "DROP_INDEX", "ALTER_INDEX", "BUILD_INDEX", "CREATE_FUNCTION", "DROP_FUNCTION"},
+	"SECURITY":    {"GRANT", "REVOKE"},
+	"TRANSACTION": {"START_TRANSACTION", "COMMIT", "ROLLBACK"},
+}
+
+type auditState struct {
+	sync.RWMutex
+	auditor        Auditor
+	enabled        bool
+	redact         bool
+	statementTypes []string
+	filter         map[string]bool
+}
+
+var state = &auditState{}
+
+/*
+Sets the audit sink. The previous sink, if any, is closed. Auditing
+is enabled if a sink is given, and disabled otherwise.
+*/
+func SetAuditor(auditor Auditor) {
+	state.Lock()
+	previous := state.auditor
+	state.auditor = auditor
+	state.enabled = auditor != nil
+	state.Unlock()
+
+	if previous != nil {
+		previous.Close()
+	}
+}
+
+func GetAuditor() Auditor {
+	state.RLock()
+	defer state.RUnlock()
+	return state.auditor
+}
+
+// Auditing requires both a sink and the audit trail being enabled
+func Enabled() bool {
+	state.RLock()
+	defer state.RUnlock()
+	return state.enabled && state.auditor != nil
+}
+
+func SetEnabled(enabled bool) {
+	state.Lock()
+	defer state.Unlock()
+	state.enabled = enabled
+}
+
+func RedactParameters() bool {
+	state.RLock()
+	defer state.RUnlock()
+	return state.redact
+}
+
+func SetRedactParameters(redact bool) {
+	state.Lock()
+	defer state.Unlock()
+	state.redact = redact
+}
+
+func StatementTypes() []string {
+	state.RLock()
+	defer state.RUnlock()
+	return state.statementTypes
+}
+
+/*
+Restricts the audit trail to the given statement types or groups of
+types, such as DDL. No types means all statements are recorded.
+*/
+func SetStatementTypes(types []string) {
+	var filter map[string]bool
+	if len(types) > 0 {
+		filter = make(map[string]bool, len(types))
+		for _, t := range types {
+			t = strings.ToUpper(t)
+			if group, ok := _GROUPS[t]; ok {
+				for _, g := range group {
+					filter[g] = true
+				}
+			} else {
+				filter[t] = true
+			}
+		}
+	}
+
+	state.Lock()
+	defer state.Unlock()
+	state.statementTypes = types
+	state.filter = filter
+}
+
+func audits(statementType string) bool {
+	state.RLock()
+	defer state.RUnlock()
+	return state.filter == nil || state.filter[statementType]
+}
+
+/*
+Records the entry, if auditing is enabled and the statement type
+passes the filters. Failures to write the audit trail are logged.
+*/
+func Record(entry *Entry) {
+	auditor := GetAuditor()
+	if auditor == nil || !Enabled() {
+		return
+	}
+
+	if !entry.authorizationFailure() && !audits(entry.StatementType) {
+		return
+	}
+
+	if RedactParameters() {
+		entry.redact()
+	}
+
+	err := auditor.Submit(entry)
+	if err != nil {
+		logging.Errorp("Failed to record audit entry",
+			logging.Pair{"requestId", entry.RequestId},
+			logging.Pair{"error", err},
+		)
+	}
+}
+
+func (this *Entry) authorizationFailure() bool {
+	for _, code := range this.ErrorCodes {
+		if code == errors.AUTHORIZATION_ERROR {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (this *Entry) redact() {
+	for name := range this.NamedArgs {
+		this.NamedArgs[name] = REDACTED
+	}
+
+	for i := range this.PositionalArgs {
+		this.PositionalArgs[i] = REDACTED
+	}
+}
@@ -0,0 +1,190 @@
+//  Copyright (c) 2016 Couchbase, Inc.
+//  Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file
+//  except in compliance with the License. You may obtain a copy of the License at
+//    http://www.apache.org/licenses/LICENSE-2.0
+//  Unless required by applicable law or agreed to in writing, software distributed under the
+//  License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+//  either express or implied. See the License for the specific language governing permissions
+//  and limitations under the License.
+
+/*
+Package audit_file provides an audit sink writing one JSON entry per
+line to a file. The file is rotated when it reaches its maximum size;
+rotated files are suffixed .1, .2 and so on, .1 being the most recent,
+and the oldest are removed beyond the maximum number of files.
+
+The sink is specified as PATH, optionally followed by the rotation
+parameters, e.g. /var/log/query_audit.log?max_size=10485760&max_files=5
+*/
+package audit_file
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/couchbase/query/audit"
+	"github.com/couchbase/query/errors"
+)
+
+const (
+	_MAX_SIZE  = 100 * 1024 * 1024
+	_MAX_FILES = 10
+)
+
+type auditor struct {
+	sync.Mutex
+	path     string
+	maxSize  int64
+	maxFiles int
+	file     *os.File
+	size     int64
+}
+
+func NewAuditor(spec string) (audit.Auditor, errors.Error) {
+	path := spec
+	rv := &auditor{
+		maxSize:  _MAX_SIZE,
+		maxFiles: _MAX_FILES,
+	}
+
+	if i := strings.IndexByte(spec, '?'); i >= 0 {
+		path = spec[:i]
+		params, e := url.ParseQuery(spec[i+1:])
+		if e != nil {
+			return nil, errors.NewAdminAuditError(e, spec)
+		}
+
+		for name, values := range params {
+			n, e := strconv.ParseInt(values[0], 10, 64)
+			if e != nil || n <= 0 {
+				return nil, errors.NewAdminAuditError(e, fmt.Sprintf("- invalid %s %s", name, values[0]))
+			}
+
+			switch name {
+			case "max_size":
+				rv.maxSize = n
+			case "max_files":
+				rv.maxFiles = int(n)
+			default:
+				return nil, errors.NewAdminAuditError(nil, "- unknown parameter "+name)
+			}
+		}
+	}
+
+	path, e := filepath.Abs(path)
+	if e != nil {
+		return nil, errors.NewAdminAuditError(e, path)
+	}
+	rv.path = path
+
+	err := rv.open()
+	if err != nil {
+		return nil, err
+	}
+
+	return rv, nil
+}
+
+func (this *auditor) Id() string {
+	return this.path
+}
+
+func (this *auditor) URL() string {
+	return "file:" + this.path
+}
+
+func (this *auditor) Submit(entry *audit.Entry) errors.Error {
+	bytes, e := json.Marshal(entry)
+	if e != nil {
+		return errors.NewAdminAuditError(e, this.path)
+	}
+	bytes = append(bytes, '\n')
+
+	this.Lock()
+	defer this.Unlock()
+
+	if this.file == nil {
+		return errors.NewAdminAuditError(nil, "- sink "+this.path+" is closed")
+	}
+
+	if this.size > 0 && this.size+int64(len(bytes)) > this.maxSize {
+		err := this.rotate()
+		if err != nil {
+			return err
+		}
+	}
+
+	n, e := this.file.Write(bytes)
+	this.size += int64(n)
+	if e != nil {
+		return errors.NewAdminAuditError(e, this.path)
+	}
+
+	return nil
+}
+
+func (this *auditor) Close() errors.Error {
+	this.Lock()
+	defer this.Unlock()
+
+	if this.file == nil {
+		return nil
+	}
+
+	e := this.file.Close()
+	this.file = nil
+	if e != nil {
+		return errors.NewAdminAuditError(e, this.path)
+	}
+
+	return nil
+}
+
+// Opens the audit file for appending, creating it if needed
+func (this *auditor) open() errors.Error {
+	file, e := os.OpenFile(this.path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0600)
+	if e != nil {
+		return errors.NewAdminAuditError(e, this.path)
+	}
+
+	info, e := file.Stat()
+	if e != nil {
+		file.Close()
+		return errors.NewAdminAuditError(e, this.path)
+	}
+
+	this.file = file
+	this.size = info.Size()
+	return nil
+}
+
+// Shifts the rotated files, dropping the oldest, and starts a new file
+func (this *auditor) rotate() errors.Error {
+	e := this.file.Close()
+	this.file = nil
+	if e != nil {
+		return errors.NewAdminAuditError(e, this.path)
+	}
+
+	os.Remove(this.rotated(this.maxFiles))
+	for i := this.maxFiles - 1; i > 0; i-- {
+		os.Rename(this.rotated(i), this.rotated(i+1))
+	}
+
+	e = os.Rename(this.path, this.rotated(1))
+	if e != nil {
+		return errors.NewAdminAuditError(e, this.path)
+	}
+
+	return this.open()
+}
+
+func (this *auditor) rotated(n int) string {
+	return fmt.Sprintf("%s.%d", this.path, n)
+}
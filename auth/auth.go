@@ -0,0 +1,193 @@
+//  Copyright (c) 2016 Couchbase, Inc.
+//  Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file
+//  except in compliance with the License. You may obtain a copy of the License at
+//    http://www.apache.org/licenses/LICENSE-2.0
+//  Unless required by applicable law or agreed to in writing, software distributed under the
+//  License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+//  either express or implied. See the License for the specific language governing permissions
+//  and limitations under the License.
+
+/*
+Package auth provides engine-level users and roles. Roles are granted
+to users with GRANT and revoked with REVOKE, and are kept in a
+pluggable credential store. When a credential store is configured,
+requests are authorized against the roles of their users rather than
+by the datastore.
+*/
+package auth
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/couchbase/query/datastore"
+	"github.com/couchbase/query/errors"
+)
+
+// CredentialStore represents a store of users and their roles
+type CredentialStore interface {
+	Id() string                                               // Id of this CredentialStore
+	URL() string                                              // URL to this CredentialStore
+	Authenticate(user, password string) (*User, errors.Error) // The user with these credentials
+	User(name string) (*User, errors.Error)                   // The user with this name
+	Users() ([]*User, errors.Error)                           // All the users, sorted by id
+	GrantRoles(users []string, roles []Role) errors.Error     // Grant roles to users
+	RevokeRoles(users []string, roles []Role) errors.Error    // Revoke roles from users
+}
+
+type User struct {
+	Id    string `json:"id"`
+	Name  string `json:"name,omitempty"`
+	Roles []Role `json:"roles,omitempty"`
+}
+
+/*
+A role granted to a user. The keyspace is of the form
+"namespace:keyspace"; if it is empty, the role applies to all
+keyspaces.
+*/
+type Role struct {
+	Name     string `json:"role"`
+	Keyspace string `json:"keyspace,omitempty"`
+}
+
+const (
+	ROLE_ADMIN                 = "admin"
+	ROLE_QUERY_SELECT          = "query_select"
+	ROLE_QUERY_UPDATE          = "query_update"
+	ROLE_QUERY_MANAGE_INDEX    = "query_manage_index"
+	ROLE_QUERY_MANAGE_FUNCTION = "query_manage_function"
+	ROLE_QUERY_SYSTEM_CATALOG  = "query_system_catalog"
+)
+
+/*
+The privilege each role grants. Roles without a keyspace privilege
+apply to all keyspaces, and cannot be granted on a keyspace.
+query_update grants the privilege of every DML statement that writes,
+that is INSERT, UPSERT, UPDATE, DELETE and MERGE.
+*/
+var _ROLES = map[string]struct {
+	privilege datastore.Privilege
+	keyspace  bool
+}{
+	ROLE_ADMIN:                 {0, false},
+	ROLE_QUERY_SELECT:          {datastore.PRIV_READ, true},
+	ROLE_QUERY_UPDATE:          {datastore.PRIV_WRITE, true},
+	ROLE_QUERY_MANAGE_INDEX:    {datastore.PRIV_DDL, true},
+	ROLE_QUERY_MANAGE_FUNCTION: {datastore.PRIV_FUNCTION, false},
+	ROLE_QUERY_SYSTEM_CATALOG:  {datastore.PRIV_READ, false},
+}
+
+const _SYSTEM_PREFIX = "#system:"
+
+/*
+Returns the roles with the given names, granted on each of the
+keyspaces, or on all keyspaces if there are none.
+*/
+func NewRoles(names, keyspaces []string) ([]Role, errors.Error) {
+	rv := make([]Role, 0, len(names)*(len(keyspaces)+1))
+	for _, name := range names {
+		name = strings.ToLower(name)
+		role, ok := _ROLES[name]
+		if !ok {
+			return nil, errors.NewInvalidRoleError(name)
+		}
+
+		if len(keyspaces) == 0 {
+			rv = append(rv, Role{Name: name})
+			continue
+		}
+
+		if !role.keyspace {
+			return nil, errors.NewRoleKeyspaceError(name)
+		}
+
+		for _, keyspace := range keyspaces {
+			rv = append(rv, Role{Name: name, Keyspace: keyspace})
+		}
+	}
+
+	return rv, nil
+}
+
+/*
+Returns true if the role grants the privilege on the key, which is
+either "namespace:keyspace" or one of the privilege keys that do not
+belong to a keyspace.
+*/
+func (this Role) Allows(key string, privilege datastore.Privilege) bool {
+	if this.Name == ROLE_ADMIN {
+		return true
+	}
+
+	role, ok := _ROLES[this.Name]
+	if !ok || role.privilege != privilege {
+		return false
+	}
+
+	switch {
+	case key == datastore.FUNCTIONS_PRIVILEGE_KEY:
+		return this.Name == ROLE_QUERY_MANAGE_FUNCTION
+	case key == datastore.SECURITY_PRIVILEGE_KEY:
+		return false
+	case strings.HasPrefix(key, _SYSTEM_PREFIX):
+		return this.Name == ROLE_QUERY_SYSTEM_CATALOG
+	default:
+		return role.keyspace && (this.Keyspace == "" || this.Keyspace == key)
+	}
+}
+
+func (this Role) String() string {
+	if this.Keyspace == "" {
+		return this.Name
+	}
+
+	return this.Name + " on " + this.Keyspace
+}
+
+var _CREDENTIAL_STORE CredentialStore
+
+func SetCredentialStore(store CredentialStore) {
+	_CREDENTIAL_STORE = store
+}
+
+func GetCredentialStore() CredentialStore {
+	return _CREDENTIAL_STORE
+}
+
+/*
+Authenticates the users of the credentials against the store, and
+checks that their roles grant all the privileges.
+*/
+func Authorize(store CredentialStore, privileges datastore.Privileges,
+	credentials datastore.Credentials) errors.Error {
+	var roles []Role
+	for name, password := range credentials {
+		// Basic authorization supports user names like "local:xxx"
+		user, err := store.Authenticate(strings.TrimPrefix(name, "local:"), password)
+		if err != nil {
+			return err
+		}
+
+		roles = append(roles, user.Roles...)
+	}
+
+	for key, privilege := range privileges {
+		if !allows(roles, key, privilege) {
+			return errors.NewDatastoreAuthorizationError(nil,
+				fmt.Sprintf("- no role grants the privilege %s on %s", privilege, key))
+		}
+	}
+
+	return nil
+}
+
+func allows(roles []Role, key string, privilege datastore.Privilege) bool {
+	for _, role := range roles {
+		if role.Allows(key, privilege) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,345 @@
+//  Copyright (c) 2016 Couchbase, Inc.
+//  Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file
+//  except in compliance with the License. You may obtain a copy of the License at
+//    http://www.apache.org/licenses/LICENSE-2.0
+//  Unless required by applicable law or agreed to in writing, software distributed under the
+//  License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+//  either express or implied. See the License for the specific language governing permissions
+//  and limitations under the License.
+
+/*
+Package auth_file provides a credential store backed by a JSON file.
+The file holds an array of users, each with an id, an optional name, a
+password and the roles granted to the user:
+
+	[{"id": "alice", "password": "...", "roles": [{"role": "admin"}]}]
+
+Users are added to the file by the administrator, and the file is
+reloaded when it changes. Passwords are only kept as salted bcrypt
+hashes: when the file is loaded, any plaintext password is replaced by
+its hash, and the file rewritten. Roles granted and revoked with GRANT
+and REVOKE are written back to the file.
+*/
+package auth_file
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/couchbase/query/auth"
+	"github.com/couchbase/query/errors"
+	"golang.org/x/crypto/bcrypt"
+)
+
+type store struct {
+	sync.Mutex
+	path     string
+	modTime  time.Time
+	users    map[string]*user
+	verified map[string][sha256.Size]byte // Digests of the last password verified for each user
+}
+
+// Password is the plaintext password supplied by the administrator,
+// which is hashed into Hash when the file is loaded.
+type user struct {
+	Id       string      `json:"id"`
+	Name     string      `json:"name,omitempty"`
+	Password string      `json:"password,omitempty"`
+	Hash     string      `json:"hash,omitempty"`
+	Roles    []auth.Role `json:"roles,omitempty"`
+}
+
+func NewCredentialStore(path string) (auth.CredentialStore, errors.Error) {
+	path, e := filepath.Abs(path)
+	if e != nil {
+		return nil, errors.NewCredentialStoreError(e, path)
+	}
+
+	rv := &store{
+		path: path,
+	}
+
+	err := rv.load()
+	if err != nil {
+		return nil, err
+	}
+
+	return rv, nil
+}
+
+func (this *store) Id() string {
+	return this.path
+}
+
+func (this *store) URL() string {
+	return "file:" + this.path
+}
+
+// bcrypt is deliberately slow, so the password is compared outside the
+// lock, and only once: a digest of the hash and password is kept for
+// each user, and a later request with the same password is checked
+// against the digest. A changed password changes the hash, and so no
+// longer matches the digest.
+func (this *store) Authenticate(name, password string) (*auth.User, errors.Error) {
+	this.Lock()
+	err := this.load()
+	u, ok := this.users[name]
+	var verified [sha256.Size]byte
+	if ok {
+		u = u.copy()
+		verified = this.verified[name]
+	}
+	this.Unlock()
+
+	if err != nil {
+		return nil, err
+	}
+
+	if !ok {
+		return nil, errors.NewDatastoreAuthorizationError(nil, "- invalid credentials for user "+name)
+	}
+
+	digest := passwordDigest(u.Hash, password)
+	if digest != verified {
+		if bcrypt.CompareHashAndPassword([]byte(u.Hash), []byte(password)) != nil {
+			return nil, errors.NewDatastoreAuthorizationError(nil, "- invalid credentials for user "+name)
+		}
+
+		this.Lock()
+		this.verified[name] = digest
+		this.Unlock()
+	}
+
+	return u.user(), nil
+}
+
+func (this *store) User(name string) (*auth.User, errors.Error) {
+	this.Lock()
+	defer this.Unlock()
+
+	err := this.load()
+	if err != nil {
+		return nil, err
+	}
+
+	u, ok := this.users[name]
+	if !ok {
+		return nil, errors.NewUserNotFoundError(name)
+	}
+
+	return u.user(), nil
+}
+
+func (this *store) Users() ([]*auth.User, errors.Error) {
+	this.Lock()
+	defer this.Unlock()
+
+	err := this.load()
+	if err != nil {
+		return nil, err
+	}
+
+	rv := make([]*auth.User, 0, len(this.users))
+	for _, u := range this.users {
+		rv = append(rv, u.user())
+	}
+
+	sort.Sort(users(rv))
+	return rv, nil
+}
+
+func (this *store) GrantRoles(names []string, roles []auth.Role) errors.Error {
+	return this.update(names, func(u *user) {
+		for _, role := range roles {
+			if !hasRole(u.Roles, role) {
+				u.Roles = append(u.Roles, role)
+			}
+		}
+	})
+}
+
+func (this *store) RevokeRoles(names []string, roles []auth.Role) errors.Error {
+	return this.update(names, func(u *user) {
+		kept := u.Roles[:0]
+		for _, role := range u.Roles {
+			if !hasRole(roles, role) {
+				kept = append(kept, role)
+			}
+		}
+		u.Roles = kept
+	})
+}
+
+// Applies the change to copies of each of the users, and writes the
+// users back to the file. The copies replace the users only once the
+// file is written, so either all the users are changed, or none is.
+func (this *store) update(names []string, change func(*user)) errors.Error {
+	this.Lock()
+	defer this.Unlock()
+
+	err := this.load()
+	if err != nil {
+		return err
+	}
+
+	for _, name := range names {
+		if _, ok := this.users[name]; !ok {
+			return errors.NewUserNotFoundError(name)
+		}
+	}
+
+	updated := make(map[string]*user, len(this.users))
+	for id, u := range this.users {
+		updated[id] = u
+	}
+
+	for _, name := range names {
+		u := updated[name].copy()
+		change(u)
+		updated[name] = u
+	}
+
+	err = this.save(updated)
+	if err != nil {
+		return err
+	}
+
+	this.users = updated
+	return nil
+}
+
+// Reads the users from the file, if it changed since it was read.
+func (this *store) load() errors.Error {
+	info, e := os.Stat(this.path)
+	if e != nil {
+		return errors.NewCredentialStoreError(e, this.path)
+	}
+
+	if this.users != nil && info.ModTime().Equal(this.modTime) {
+		return nil
+	}
+
+	bytes, e := ioutil.ReadFile(this.path)
+	if e != nil {
+		return errors.NewCredentialStoreError(e, this.path)
+	}
+
+	var list []*user
+	e = json.Unmarshal(bytes, &list)
+	if e != nil {
+		return errors.NewCredentialStoreError(e, this.path)
+	}
+
+	loaded := make(map[string]*user, len(list))
+	hashed := false
+	for _, u := range list {
+		if u.Password != "" {
+			hash, e := bcrypt.GenerateFromPassword([]byte(u.Password), bcrypt.DefaultCost)
+			if e != nil {
+				return errors.NewCredentialStoreError(e, this.path)
+			}
+
+			u.Hash = string(hash)
+			u.Password = ""
+			hashed = true
+		}
+
+		loaded[u.Id] = u
+	}
+
+	// Replace the plaintext passwords in the file
+	if hashed {
+		err := this.save(loaded)
+		if err != nil {
+			return err
+		}
+	} else {
+		this.modTime = info.ModTime()
+	}
+
+	this.users = loaded
+	this.verified = make(map[string][sha256.Size]byte, len(loaded))
+	return nil
+}
+
+// Writes the users to a temporary file, which then replaces the file,
+// so that the file is never left partially written.
+func (this *store) save(users map[string]*user) errors.Error {
+	list := make([]*user, 0, len(users))
+	for _, u := range users {
+		list = append(list, u)
+	}
+
+	sort.Sort(userList(list))
+
+	bytes, e := json.MarshalIndent(list, "", "    ")
+	if e != nil {
+		return errors.NewCredentialStoreError(e, this.path)
+	}
+
+	temp := this.path + ".tmp"
+	e = ioutil.WriteFile(temp, bytes, 0600)
+	if e == nil {
+		e = os.Rename(temp, this.path)
+	}
+
+	if e != nil {
+		return errors.NewCredentialStoreError(e, this.path)
+	}
+
+	info, e := os.Stat(this.path)
+	if e == nil {
+		this.modTime = info.ModTime()
+	}
+
+	return nil
+}
+
+func (this *user) copy() *user {
+	rv := *this
+	rv.Roles = make([]auth.Role, len(this.Roles))
+	copy(rv.Roles, this.Roles)
+	return &rv
+}
+
+func (this *user) user() *auth.User {
+	roles := make([]auth.Role, len(this.Roles))
+	copy(roles, this.Roles)
+	return &auth.User{
+		Id:    this.Id,
+		Name:  this.Name,
+		Roles: roles,
+	}
+}
+
+func passwordDigest(hash, password string) [sha256.Size]byte {
+	return sha256.Sum256([]byte(hash + "\x00" + password))
+}
+
+func hasRole(roles []auth.Role, role auth.Role) bool {
+	for _, r := range roles {
+		if r == role {
+			return true
+		}
+	}
+
+	return false
+}
+
+type userList []*user
+
+func (this userList) Len() int           { return len(this) }
+func (this userList) Less(i, j int) bool { return this[i].Id < this[j].Id }
+func (this userList) Swap(i, j int)      { this[i], this[j] = this[j], this[i] }
+
+type users []*auth.User
+
+func (this users) Len() int           { return len(this) }
+func (this users) Less(i, j int) bool { return this[i].Id < this[j].Id }
+func (this users) Swap(i, j int)      { this[i], this[j] = this[j], this[i] }
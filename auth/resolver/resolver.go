@@ -0,0 +1,26 @@
+//  Copyright (c) 2016 Couchbase, Inc.
+//  Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file
+//  except in compliance with the License. You may obtain a copy of the License at
+//    http://www.apache.org/licenses/LICENSE-2.0
+//  Unless required by applicable law or agreed to in writing, software distributed under the
+//  License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+//  either express or implied. See the License for the specific language governing permissions
+//  and limitations under the License.
+
+package resolver
+
+import (
+	"strings"
+
+	"github.com/couchbase/query/auth"
+	"github.com/couchbase/query/auth/file"
+	"github.com/couchbase/query/errors"
+)
+
+func NewCredentialStore(uri string) (auth.CredentialStore, errors.Error) {
+	if strings.HasPrefix(uri, "file:") {
+		return auth_file.NewCredentialStore(uri[len("file:"):])
+	}
+
+	return nil, errors.NewAdminInvalidURL("CredentialStore", uri)
+}
@@ -101,6 +101,18 @@ func doAuth(username, password, bucket string, requested datastore.Privilege) (b
 			return false, err
 		}
 
+	} else if requested == datastore.PRIV_FUNCTION {
+		authResult, err := creds.IsAllowed("cluster.n1ql.udf!manage")
+		if err != nil || authResult == false {
+			return false, err
+		}
+
+	} else if requested == datastore.PRIV_SECURITY {
+		authResult, err := creds.IsAllowed("cluster.admin.security!write")
+		if err != nil || authResult == false {
+			return false, err
+		}
+
 	} else if requested == datastore.PRIV_READ {
 		authResult, err := creds.IsAllowed(fmt.Sprintf("cluster.bucket[%s].data!read", bucket))
 		if err != nil || authResult == false {
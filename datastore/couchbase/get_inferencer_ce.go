@@ -10,8 +10,9 @@
 // implied. See the License for the specific language governing
 // permissions and limitations under the License.
 //
-// Currently, the community edition does not have access to schema
-// inferencing, so this stub returns an error.
+// The community edition does not have access to the schema
+// inferencer of couchbase/cbq-gui, so it uses the generic default
+// inferencer of the datastore package.
 
 // +build !enterprise
 
@@ -20,23 +21,8 @@ package couchbase
 import (
 	"github.com/couchbase/query/datastore"
 	"github.com/couchbase/query/errors"
-	"github.com/couchbase/query/value"
 )
 
-type NopInferencer struct {
-	cluster_url string
-}
-
-func (di *NopInferencer) Name() datastore.InferenceType {
-	return ("InferencingUnsuppored")
-}
-
-func (di *NopInferencer) InferKeyspace(ks datastore.Keyspace, with value.Value, conn *datastore.ValueConnection) {
-	conn.Error(errors.NewOtherNotImplementedError(nil, "INFER"))
-	close(conn.ValueChannel())
-}
-
 func GetDefaultInferencer(store datastore.Datastore) (datastore.Inferencer, errors.Error) {
-	inferencer := new(NopInferencer)
-	return inferencer, nil
+	return datastore.NewDefaultInferencer(), nil
 }
@@ -29,6 +29,7 @@ type viewIndexer struct {
 	indexes          map[string]datastore.Index
 	primary          map[string]datastore.PrimaryIndex
 	nonUsableIndexes []string // indexes that cannot be used
+	version          uint64   // changed whenever the indexes are updated
 	sync.RWMutex
 }
 
@@ -251,6 +252,7 @@ func (view *viewIndexer) loadViewIndexes() errors.Error {
 			view.Lock()
 			view.indexes = indexes
 			view.primary = primary
+			view.version++
 			view.Unlock()
 		}
 	}()
@@ -286,6 +288,12 @@ func (view *viewIndexer) Refresh() errors.Error {
 	return nil
 }
 
+func (view *viewIndexer) MetadataVersion() uint64 {
+	view.RLock()
+	defer view.RUnlock()
+	return view.version
+}
+
 func (view *viewIndexer) SetLogLevel(level logging.Level) {
 	// No-op, uses query engine logger
 }
@@ -180,7 +180,7 @@ func encodeValue(val interface{}) interface{} {
 		return []interface{}{TYPE_NULL}
 	case bool:
 		return []interface{}{TYPE_BOOLEAN, val}
-	case float64:
+	case float64, int64:
 		return []interface{}{TYPE_NUMBER, val}
 	case string:
 		return []interface{}{TYPE_STRING, encodeStringAsNumericArray(val)}
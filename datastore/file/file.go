@@ -24,6 +24,7 @@ import (
 	"strings"
 	"sync"
 
+	atomic "github.com/couchbase/go-couchbase/platform"
 	"github.com/couchbase/query/datastore"
 	"github.com/couchbase/query/errors"
 	"github.com/couchbase/query/expression"
@@ -37,6 +38,7 @@ type store struct {
 	path           string
 	namespaces     map[string]*namespace
 	namespaceNames []string
+	functionLock   sync.Mutex // Serializes updates of the functions file
 }
 
 func (s *store) Id() string {
@@ -77,11 +79,180 @@ func (s *store) SetLogLevel(level logging.Level) {
 }
 
 func (s *store) Inferencer(name datastore.InferenceType) (datastore.Inferencer, errors.Error) {
-	return nil, errors.NewOtherNotImplementedError(nil, "INFER")
+	return datastore.DefaultInferencer(name)
 }
 
 func (s *store) Inferencers() ([]datastore.Inferencer, errors.Error) {
-	return nil, errors.NewOtherNotImplementedError(nil, "INFER")
+	return datastore.DefaultInferencers()
+}
+
+// The statistics of a keyspace are persisted as a JSON file next to
+// the directory of the keyspace.
+func (s *store) statisticsPath(namespaceName, keyspaceName string) (string, errors.Error) {
+	p, e := s.NamespaceByName(namespaceName)
+	if e != nil {
+		return "", e
+	}
+
+	b, e := p.KeyspaceByName(keyspaceName)
+	if e != nil {
+		return "", e
+	}
+
+	return filepath.Join(p.(*namespace).path(), b.Name()+".statistics.json"), nil
+}
+
+func (s *store) SetKeyspaceStatistics(stats *datastore.KeyspaceStatistics) errors.Error {
+	path, e := s.statisticsPath(stats.Namespace, stats.Keyspace)
+	if e != nil {
+		return e
+	}
+
+	bytes, er := json.Marshal(stats)
+	if er != nil {
+		return errors.NewFileDatastoreError(er, "")
+	}
+
+	er = ioutil.WriteFile(path, bytes, 0644)
+	if er != nil {
+		return errors.NewFileDatastoreError(er, "")
+	}
+
+	return nil
+}
+
+func (s *store) KeyspaceStatistics(namespace, keyspace string) (*datastore.KeyspaceStatistics, errors.Error) {
+	path, e := s.statisticsPath(namespace, keyspace)
+	if e != nil {
+		return nil, e
+	}
+
+	bytes, er := ioutil.ReadFile(path)
+	if os.IsNotExist(er) {
+		return nil, nil
+	} else if er != nil {
+		return nil, errors.NewFileDatastoreError(er, "")
+	}
+
+	stats := &datastore.KeyspaceStatistics{}
+	er = json.Unmarshal(bytes, stats)
+	if er != nil {
+		return nil, errors.NewFileDatastoreError(er, "")
+	}
+
+	return stats, nil
+}
+
+func (s *store) AllKeyspaceStatistics() ([]*datastore.KeyspaceStatistics, errors.Error) {
+	rv := make([]*datastore.KeyspaceStatistics, 0, len(s.namespaces))
+	for _, namespaceName := range s.namespaceNames {
+		p := s.namespaces[strings.ToUpper(namespaceName)]
+		for _, keyspaceName := range p.keyspaceNames {
+			stats, e := s.KeyspaceStatistics(p.name, keyspaceName)
+			if e != nil {
+				return nil, e
+			}
+
+			if stats != nil {
+				rv = append(rv, stats)
+			}
+		}
+	}
+
+	return rv, nil
+}
+
+// The functions created with CREATE FUNCTION are persisted together
+// in a JSON file at the root of the store, next to the namespace
+// directories.
+func (s *store) functionsPath() string {
+	return filepath.Join(s.path, "functions.json")
+}
+
+func (s *store) SetFunction(definition *datastore.FunctionDefinition) errors.Error {
+	s.functionLock.Lock()
+	defer s.functionLock.Unlock()
+
+	functions, e := s.Functions()
+	if e != nil {
+		return e
+	}
+
+	rv := functions[:0]
+	for _, f := range functions {
+		if f.Name != definition.Name {
+			rv = append(rv, f)
+		}
+	}
+
+	return s.saveFunctions(append(rv, definition))
+}
+
+func (s *store) DeleteFunction(name string) errors.Error {
+	s.functionLock.Lock()
+	defer s.functionLock.Unlock()
+
+	functions, e := s.Functions()
+	if e != nil {
+		return e
+	}
+
+	rv := functions[:0]
+	for _, f := range functions {
+		if f.Name != name {
+			rv = append(rv, f)
+		}
+	}
+
+	return s.saveFunctions(rv)
+}
+
+func (s *store) Functions() ([]*datastore.FunctionDefinition, errors.Error) {
+	bytes, er := ioutil.ReadFile(s.functionsPath())
+	if os.IsNotExist(er) {
+		return nil, nil
+	} else if er != nil {
+		return nil, errors.NewFileDatastoreError(er, "")
+	}
+
+	var functions []*datastore.FunctionDefinition
+	er = json.Unmarshal(bytes, &functions)
+	if er != nil {
+		return nil, errors.NewFileDatastoreError(er, "")
+	}
+
+	return functions, nil
+}
+
+// Writes the functions to a temporary file, which then replaces the
+// functions file. The file is removed once there are no functions.
+func (s *store) saveFunctions(functions []*datastore.FunctionDefinition) errors.Error {
+	path := s.functionsPath()
+	if len(functions) == 0 {
+		er := os.Remove(path)
+		if er != nil && !os.IsNotExist(er) {
+			return errors.NewFileDatastoreError(er, "")
+		}
+
+		return nil
+	}
+
+	bytes, er := json.MarshalIndent(functions, "", "    ")
+	if er != nil {
+		return errors.NewFileDatastoreError(er, "")
+	}
+
+	temp := path + ".tmp"
+	er = ioutil.WriteFile(temp, bytes, 0644)
+	if er == nil {
+		er = os.Rename(temp, path)
+	}
+
+	if er != nil {
+		return errors.NewFileDatastoreError(er, "")
+	}
+
+	return nil
 }
 
 // NewStore creates a new file-based store for the given filepath.
@@ -221,7 +392,8 @@ func (p *namespace) loadKeyspaces() (e errors.Error) {
 type keyspace struct {
 	namespace *namespace
 	name      string
-	fi        datastore.Indexer
+	fi        *fileIndexer
+	ft        *ftsIndexer
 	fileLock  sync.Mutex
 }
 
@@ -242,15 +414,25 @@ func (b *keyspace) Count() (int64, errors.Error) {
 	if er != nil {
 		return 0, errors.NewFileDatastoreError(er, "")
 	}
-	return int64(len(dirEntries)), nil
+
+	var count int64
+	for _, dirEntry := range dirEntries {
+		if !dirEntry.IsDir() {
+			count++
+		}
+	}
+	return count, nil
 }
 
 func (b *keyspace) Indexer(name datastore.IndexType) (datastore.Indexer, errors.Error) {
+	if name == datastore.FTS {
+		return b.ft, nil
+	}
 	return b.fi, nil
 }
 
 func (b *keyspace) Indexers() ([]datastore.Indexer, errors.Error) {
-	return []datastore.Indexer{b.fi}, nil
+	return []datastore.Indexer{b.fi, b.ft}, nil
 }
 
 func (b *keyspace) Fetch(keys []string) ([]value.AnnotatedPair, []errors.Error) {
@@ -322,19 +504,25 @@ func (b *keyspace) performOp(op int, kvPairs []value.Pair) ([]value.Pair, errors
 		return nil, errors.NewFileNoKeysInsertError(nil, "keyspace "+b.Name())
 	}
 
-	insertedKeys := make([]value.Pair, 0)
-	var returnErr errors.Error
-
 	// this lock can be mode more granular FIXME
 	b.fileLock.Lock()
 	defer b.fileLock.Unlock()
 
+	return b.writeDocs(op, kvPairs)
+}
+
+// Writes documents to their files. Called with fileLock held.
+func (b *keyspace) writeDocs(op int, kvPairs []value.Pair) ([]value.Pair, errors.Error) {
+	insertedKeys := make([]value.Pair, 0)
+	indexedDocs := make([]value.AnnotatedPair, 0, len(kvPairs))
+	var returnErr errors.Error
+
 	for _, kv := range kvPairs {
 		var file *os.File
 		var err error
 
 		key := kv.Name
-		value, _ := json.Marshal(kv.Value.Actual())
+		data, _ := json.Marshal(kv.Value.Actual())
 		filename := filepath.Join(b.path(), key+".json")
 
 		switch op {
@@ -346,7 +534,7 @@ func (b *keyspace) performOp(op int, kvPairs []value.Pair) ([]value.Pair, errors
 			} else {
 				// create and write the file
 				if file, err = os.Create(filename); err == nil {
-					_, err = file.Write(value)
+					_, err = file.Write(data)
 					file.Close()
 				}
 			}
@@ -355,7 +543,7 @@ func (b *keyspace) performOp(op int, kvPairs []value.Pair) ([]value.Pair, errors
 			if _, err = os.Stat(filename); err == nil {
 				// open and write the file
 				if file, err = os.OpenFile(filename, os.O_TRUNC|os.O_RDWR, 0666); err == nil {
-					_, err = file.Write(value)
+					_, err = file.Write(data)
 					file.Close()
 				}
 			}
@@ -363,7 +551,7 @@ func (b *keyspace) performOp(op int, kvPairs []value.Pair) ([]value.Pair, errors
 		case UPSERT:
 			// open the file for writing, if doesn't exist then create
 			if file, err = os.OpenFile(filename, os.O_CREATE|os.O_TRUNC|os.O_RDWR, 0666); err == nil {
-				_, err = file.Write(value)
+				_, err = file.Write(data)
 				file.Close()
 			}
 		}
@@ -372,9 +560,20 @@ func (b *keyspace) performOp(op int, kvPairs []value.Pair) ([]value.Pair, errors
 			returnErr = errors.NewFileDMLError(returnErr, opToString(op)+" Failed "+err.Error())
 		} else {
 			insertedKeys = append(insertedKeys, kv)
+
+			doc := newDocument(key, data)
+			indexedDocs = append(indexedDocs, value.AnnotatedPair{Name: key, Value: doc})
 		}
 	}
 
+	if err := b.fi.indexDocs(indexedDocs); err != nil && returnErr == nil {
+		returnErr = err
+	}
+
+	if err := b.ft.indexDocs(indexedDocs); err != nil && returnErr == nil {
+		returnErr = err
+	}
+
 	return insertedKeys, returnErr
 
 }
@@ -393,6 +592,14 @@ func (b *keyspace) Upsert(upserts []value.Pair) ([]value.Pair, errors.Error) {
 
 func (b *keyspace) Delete(deletes []string) ([]string, errors.Error) {
 
+	b.fileLock.Lock()
+	defer b.fileLock.Unlock()
+
+	return b.removeDocs(deletes)
+}
+
+// Removes the files of documents. Called with fileLock held.
+func (b *keyspace) removeDocs(deletes []string) ([]string, errors.Error) {
 	var fileError []string
 	var deleted []string
 	for _, key := range deletes {
@@ -406,12 +613,52 @@ func (b *keyspace) Delete(deletes []string) ([]string, errors.Error) {
 		}
 	}
 
+	err := b.fi.unindexDocs(deleted)
+	if ferr := b.ft.unindexDocs(deleted); ferr != nil && err == nil {
+		err = ferr
+	}
+
 	if len(fileError) > 0 {
 		errLine := fmt.Sprintf("Delete failed on some keys %v", fileError)
-		return deleted, errors.NewFileDatastoreError(nil, errLine)
+		return deleted, errors.NewFileDatastoreError(err, errLine)
 	}
 
-	return deleted, nil
+	return deleted, err
+}
+
+// Other mutations wait for the commit to finish.
+func (b *keyspace) BeginCommit() {
+	b.fileLock.Lock()
+}
+
+func (b *keyspace) ApplyMutations(mutations []datastore.Mutation) errors.Error {
+	var upserts []value.Pair
+	var deletes []string
+	for _, m := range mutations {
+		if m.Value == nil {
+			deletes = append(deletes, m.Key)
+		} else {
+			upserts = append(upserts, value.Pair{Name: m.Key, Value: m.Value})
+		}
+	}
+
+	if len(upserts) > 0 {
+		if _, err := b.writeDocs(UPSERT, upserts); err != nil {
+			return err
+		}
+	}
+
+	if len(deletes) > 0 {
+		if _, err := b.removeDocs(deletes); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (b *keyspace) EndCommit() {
+	b.fileLock.Unlock()
 }
 
 func (b *keyspace) Release() {
@@ -421,6 +668,10 @@ func (b *keyspace) path() string {
 	return filepath.Join(b.namespace.path(), b.name)
 }
 
+func (b *keyspace) indexPath() string {
+	return filepath.Join(b.path(), _INDEX_DIR)
+}
+
 // newKeyspace creates a new keyspace.
 func newKeyspace(p *namespace, dir string) (b *keyspace, e errors.Error) {
 	b = new(keyspace)
@@ -438,24 +689,130 @@ func newKeyspace(p *namespace, dir string) (b *keyspace, e errors.Error) {
 
 	b.fi = newFileIndexer(b)
 	b.fi.CreatePrimaryIndex("", "#primary", nil)
+	b.fi.loadIndexes()
+
+	b.ft = newFtsIndexer(b)
+	b.ft.loadIndexes()
 
 	return
 }
 
 type fileIndexer struct {
-	keyspace *keyspace
-	indexes  map[string]datastore.Index
-	primary  datastore.PrimaryIndex
+	sync.RWMutex
+	keyspace  *keyspace
+	indexes   map[string]datastore.Index
+	primary   datastore.PrimaryIndex
+	secondary map[string]*secondaryIndex
+	version   atomic.AlignedUint64 // Changed whenever an index is created, dropped or built
 }
 
-func newFileIndexer(keyspace *keyspace) datastore.Indexer {
+func newFileIndexer(keyspace *keyspace) *fileIndexer {
 
 	return &fileIndexer{
-		keyspace: keyspace,
-		indexes:  make(map[string]datastore.Index),
+		keyspace:  keyspace,
+		indexes:   make(map[string]datastore.Index),
+		secondary: make(map[string]*secondaryIndex),
 	}
 }
 
+// loadIndexes restores the secondary indexes persisted in the
+// keyspace's index directory.
+func (fi *fileIndexer) loadIndexes() {
+	dirEntries, er := ioutil.ReadDir(fi.keyspace.indexPath())
+	if er != nil {
+		if !os.IsNotExist(er) {
+			logging.Errorp("Loading file indexes", logging.Pair{"keyspace", fi.keyspace.Name()},
+				logging.Pair{"error", er})
+		}
+		return
+	}
+
+	for _, dirEntry := range dirEntries {
+		if dirEntry.IsDir() || filepath.Ext(dirEntry.Name()) != _INDEX_DEF {
+			continue
+		}
+
+		si, err := loadIndex(fi.keyspace, filepath.Join(fi.keyspace.indexPath(), dirEntry.Name()))
+		if err != nil {
+			logging.Errorp("Loading file index", logging.Pair{"keyspace", fi.keyspace.Name()},
+				logging.Pair{"file", dirEntry.Name()}, logging.Pair{"error", err})
+			continue
+		}
+
+		fi.indexes[si.Name()] = si
+		fi.secondary[si.Name()] = si
+	}
+}
+
+func (fi *fileIndexer) secondaryIndexes() []*secondaryIndex {
+	fi.RLock()
+	defer fi.RUnlock()
+
+	rv := make([]*secondaryIndex, 0, len(fi.secondary))
+	for _, si := range fi.secondary {
+		rv = append(rv, si)
+	}
+	return rv
+}
+
+// indexDocs brings the secondary indexes up to date with inserted or
+// modified documents.
+func (fi *fileIndexer) indexDocs(docs []value.AnnotatedPair) (rv errors.Error) {
+	if len(docs) == 0 {
+		return
+	}
+
+	for _, si := range fi.secondaryIndexes() {
+		if err := si.indexDocs(docs); err != nil {
+			rv = err
+		}
+	}
+	return
+}
+
+// unindexDocs removes deleted documents from the secondary indexes.
+func (fi *fileIndexer) unindexDocs(keys []string) (rv errors.Error) {
+	if len(keys) == 0 {
+		return
+	}
+
+	for _, si := range fi.secondaryIndexes() {
+		if err := si.unindexDocs(keys); err != nil {
+			rv = err
+		}
+	}
+	return
+}
+
+func (fi *fileIndexer) dropIndex(si *secondaryIndex) errors.Error {
+	fi.Lock()
+	defer fi.Unlock()
+
+	if fi.secondary[si.name] != si {
+		return errors.NewFileIdxNotFound(nil, si.name)
+	}
+
+	si.Lock()
+	defer si.Unlock()
+
+	for _, path := range []string{si.defPath(), si.dataPath(), si.logPath()} {
+		if er := os.Remove(path); er != nil && !os.IsNotExist(er) {
+			return errors.NewFileDatastoreError(er, "")
+		}
+	}
+
+	// Remove the index directory once the last index is gone
+	os.Remove(fi.keyspace.indexPath())
+
+	delete(fi.indexes, si.name)
+	delete(fi.secondary, si.name)
+	si.state = datastore.OFFLINE
+	si.entries = nil
+	si.docs = nil
+	atomic.AddUint64(&fi.version, 1)
+	return nil
+}
+
 func (fi *fileIndexer) KeyspaceId() string {
 	return fi.keyspace.Id()
 }
@@ -465,6 +822,9 @@ func (fi *fileIndexer) Name() datastore.IndexType {
 }
 
 func (fi *fileIndexer) IndexIds() ([]string, errors.Error) {
+	fi.RLock()
+	defer fi.RUnlock()
+
 	rv := make([]string, 0, len(fi.indexes))
 	for name, _ := range fi.indexes {
 		rv = append(rv, name)
@@ -473,6 +833,9 @@ func (fi *fileIndexer) IndexIds() ([]string, errors.Error) {
 }
 
 func (fi *fileIndexer) IndexNames() ([]string, errors.Error) {
+	fi.RLock()
+	defer fi.RUnlock()
+
 	rv := make([]string, 0, len(fi.indexes))
 	for name, _ := range fi.indexes {
 		rv = append(rv, name)
@@ -485,6 +848,9 @@ func (fi *fileIndexer) IndexById(id string) (datastore.Index, errors.Error) {
 }
 
 func (fi *fileIndexer) IndexByName(name string) (datastore.Index, errors.Error) {
+	fi.RLock()
+	defer fi.RUnlock()
+
 	index, ok := fi.indexes[name]
 	if !ok {
 		return nil, errors.NewFileIdxNotFound(nil, name)
@@ -497,36 +863,134 @@ func (fi *fileIndexer) PrimaryIndexes() ([]datastore.PrimaryIndex, errors.Error)
 }
 
 func (fi *fileIndexer) Indexes() ([]datastore.Index, errors.Error) {
-	return []datastore.Index{fi.primary}, nil
+	fi.RLock()
+	defer fi.RUnlock()
+
+	rv := make([]datastore.Index, 0, len(fi.indexes))
+	rv = append(rv, fi.primary)
+	for _, si := range fi.secondary {
+		rv = append(rv, si)
+	}
+	return rv, nil
 }
 
 func (fi *fileIndexer) CreatePrimaryIndex(requestId, name string, with value.Value) (
 	datastore.PrimaryIndex, errors.Error) {
+	fi.Lock()
+	defer fi.Unlock()
+
 	if fi.primary == nil {
 		pi := new(primaryIndex)
 		fi.primary = pi
 		pi.keyspace = fi.keyspace
 		pi.name = name
 		fi.indexes[pi.name] = pi
+		atomic.AddUint64(&fi.version, 1)
 	}
 
 	return fi.primary, nil
 }
 
-func (b *fileIndexer) CreateIndex(requestId, name string, seekKey, rangeKey expression.Expressions,
+func (fi *fileIndexer) CreateIndex(requestId, name string, seekKey, rangeKey expression.Expressions,
 	where expression.Expression, with value.Value) (datastore.Index, errors.Error) {
-	return nil, errors.NewFileNotSupported(nil, "CREATE INDEX is not supported for file-based datastore.")
+	if len(rangeKey) == 0 {
+		return nil, errors.NewFileNotSupported(nil, "Index "+name+" requires at least one index key.")
+	}
+
+	deferred := false
+	if with != nil {
+		if db, ok := with.Field("defer_build"); ok {
+			deferred = db.Truth()
+		}
+	}
+
+	si, err := newSecondaryIndex(fi.keyspace, name, seekKey, rangeKey, where)
+	if err != nil {
+		return nil, err
+	}
+
+	// Hold the keyspace lock while building, so that no mutation
+	// is missed between the build and the index going online.
+	// It is always acquired before the indexer lock.
+	fi.keyspace.fileLock.Lock()
+	defer fi.keyspace.fileLock.Unlock()
+
+	fi.Lock()
+	defer fi.Unlock()
+
+	if _, ok := fi.indexes[name]; ok {
+		return nil, errors.NewFileIdxExists(nil, name)
+	}
+
+	if _, err := fi.keyspace.ft.IndexByName(name); err == nil {
+		return nil, errors.NewFileIdxExists(nil, name)
+	}
+
+	er := os.MkdirAll(fi.keyspace.indexPath(), 0777)
+	if er != nil {
+		return nil, errors.NewFileDatastoreError(er, "")
+	}
+
+	if deferred {
+		err = si.saveDef()
+	} else {
+		err = si.build()
+	}
+
+	if err != nil {
+		os.Remove(si.defPath())
+		os.Remove(si.dataPath())
+		os.Remove(si.logPath())
+		return nil, err
+	}
+
+	fi.indexes[name] = si
+	fi.secondary[name] = si
+	atomic.AddUint64(&fi.version, 1)
+	return si, nil
 }
 
-func (b *fileIndexer) BuildIndexes(requestId string, names ...string) errors.Error {
-	return errors.NewFileNotSupported(nil, "BUILD INDEXES is not supported for file-based datastore.")
+func (fi *fileIndexer) BuildIndexes(requestId string, names ...string) errors.Error {
+	fi.keyspace.fileLock.Lock()
+	defer fi.keyspace.fileLock.Unlock()
+
+	fi.RLock()
+	defer fi.RUnlock()
+
+	indexes := make([]*secondaryIndex, 0, len(names))
+	for _, name := range names {
+		si, ok := fi.secondary[name]
+		if !ok {
+			return errors.NewFileIdxNotFound(nil, name)
+		}
+		indexes = append(indexes, si)
+	}
+
+	for _, si := range indexes {
+		state, _, _ := si.State()
+		if state == datastore.ONLINE {
+			continue
+		}
+
+		err := si.build()
+		atomic.AddUint64(&fi.version, 1)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
 }
 
-func (b *fileIndexer) Refresh() errors.Error {
+func (fi *fileIndexer) Refresh() errors.Error {
 	return nil
 }
 
-func (b *fileIndexer) SetLogLevel(level logging.Level) {
+func (fi *fileIndexer) MetadataVersion() uint64 {
+	return atomic.LoadUint64(&fi.version)
+}
+
+func (fi *fileIndexer) SetLogLevel(level logging.Level) {
 	// No-op, uses query engine logger
 }
 
@@ -679,13 +1143,16 @@ func fetch(path string) (item value.AnnotatedValue, e errors.Error) {
 		return nil, errors.NewFileDatastoreError(er, "")
 	}
 
-	doc := value.NewAnnotatedValue(value.NewValue(bytes))
-	doc.SetAttachment("meta", map[string]interface{}{"id": documentPathToId(path)})
-	item = doc
-
+	item = newDocument(documentPathToId(path), bytes)
 	return
 }
 
+func newDocument(key string, bytes []byte) value.AnnotatedValue {
+	doc := value.NewAnnotatedValue(value.NewValue(bytes))
+	doc.SetAttachment("meta", map[string]interface{}{"id": key})
+	return doc
+}
+
 func documentPathToId(p string) string {
 	_, file := filepath.Split(p)
 	ext := filepath.Ext(file)
@@ -0,0 +1,985 @@
+//  Copyright (c) 2016 Couchbase, Inc.
+//  Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file
+//  except in compliance with the License. You may obtain a copy of the License at
+//    http://www.apache.org/licenses/LICENSE-2.0
+//  Unless required by applicable law or agreed to in writing, software distributed under the
+//  License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+//  either express or implied. See the License for the specific language governing permissions
+//  and limitations under the License.
+
+package file
+
+import (
+	"bufio"
+	"encoding/json"
+	"io/ioutil"
+	"math"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+
+	atomic "github.com/couchbase/go-couchbase/platform"
+	"github.com/couchbase/query/datastore"
+	"github.com/couchbase/query/errors"
+	"github.com/couchbase/query/expression"
+	"github.com/couchbase/query/expression/parser"
+	"github.com/couchbase/query/logging"
+	"github.com/couchbase/query/timestamp"
+	"github.com/couchbase/query/value"
+)
+
+// Full-text indexes are kept in the index directory beside the
+// secondary indexes. Each index has a definition file, a data file
+// holding the analyzed terms of each document, one JSON document per
+// line, and a log of the changes made since the data file was
+// written, as for secondary indexes. The inverted index is rebuilt
+// on loading.
+const (
+	_FTS_DEF  = ".fts"
+	_FTS_DATA = ".ftx"
+	_FTS_LOG  = ".ftl"
+)
+
+// Parameters of the Okapi BM25 relevance score.
+const (
+	_BM25_K1 = 1.2
+	_BM25_B  = 0.75
+)
+
+// ftsDoc holds the term frequencies of an indexed document. It is
+// also the on-disk form of the document.
+type ftsDoc struct {
+	Id     string         `json:"id"`
+	Terms  map[string]int `json:"terms"`
+	Length int            `json:"length"`
+}
+
+// ftsLogRecord is a change to a full-text index: the new terms of a
+// document, or none if the document is no longer indexed.
+type ftsLogRecord struct {
+	Id  string  `json:"id"`
+	Doc *ftsDoc `json:"doc,omitempty"`
+}
+
+// ftsHit is a document matching a search, with its score.
+type ftsHit struct {
+	id    string
+	score float64
+}
+
+// fullTextIndex is an inverted index over the text of a single key.
+type fullTextIndex struct {
+	sync.RWMutex
+	name     string
+	keyspace *keyspace
+	key      expression.Expression
+	where    expression.Expression
+	state    datastore.IndexState
+
+	// Formalized copies of the key and condition, evaluated
+	// against documents scoped by the keyspace name.
+	evalKey   expression.Expression
+	evalWhere expression.Expression
+
+	docs     map[string]*ftsDoc
+	postings map[string]map[string]int // term -> document -> frequency
+	length   int                       // total number of terms
+	logged   int                       // Records in the log
+}
+
+func newFullTextIndex(keyspace *keyspace, name string, rangeKey expression.Expressions,
+	where expression.Expression) (*fullTextIndex, errors.Error) {
+	if len(rangeKey) != 1 {
+		return nil, errors.NewFileNotSupported(nil, "Full-text index "+name+" requires a single index key.")
+	}
+
+	if isArray, _ := rangeKey[0].IsArrayIndexKey(); isArray {
+		return nil, errors.NewFileNotSupported(nil, "Full-text index "+name+" on an array index key.")
+	}
+
+	fti := &fullTextIndex{
+		name:     name,
+		keyspace: keyspace,
+		key:      rangeKey[0],
+		where:    where,
+		state:    datastore.DEFERRED,
+	}
+	fti.clear()
+
+	formalizer := expression.NewFormalizer(keyspace.Name(), nil)
+
+	key, err := formalizer.Map(fti.key.Copy())
+	if err != nil {
+		return nil, errors.NewFileDatastoreError(err, "index "+name)
+	}
+	fti.evalKey = key
+
+	if where != nil {
+		cond, err := formalizer.Map(where.Copy())
+		if err != nil {
+			return nil, errors.NewFileDatastoreError(err, "index "+name)
+		}
+		fti.evalWhere = cond
+	}
+
+	return fti, nil
+}
+
+func (fti *fullTextIndex) KeyspaceId() string {
+	return fti.keyspace.Id()
+}
+
+func (fti *fullTextIndex) Id() string {
+	return fti.Name()
+}
+
+func (fti *fullTextIndex) Name() string {
+	return fti.name
+}
+
+func (fti *fullTextIndex) Type() datastore.IndexType {
+	return datastore.FTS
+}
+
+func (fti *fullTextIndex) SeekKey() expression.Expressions {
+	return nil
+}
+
+func (fti *fullTextIndex) RangeKey() expression.Expressions {
+	return expression.Expressions{fti.key}
+}
+
+func (fti *fullTextIndex) Condition() expression.Expression {
+	return fti.where
+}
+
+func (fti *fullTextIndex) IsPrimary() bool {
+	return false
+}
+
+func (fti *fullTextIndex) State() (state datastore.IndexState, msg string, err errors.Error) {
+	fti.RLock()
+	defer fti.RUnlock()
+	return fti.state, "", nil
+}
+
+// Statistics describes the documents matching the query of the
+// span, or all the indexed documents without a span.
+func (fti *fullTextIndex) Statistics(requestId string, span *datastore.Span) (
+	datastore.Statistics, errors.Error) {
+	fti.RLock()
+	defer fti.RUnlock()
+
+	var entries []*indexEntry
+	if query, ok := spanQuery(span); ok {
+		hits := fti.search(query)
+		entries = make([]*indexEntry, len(hits))
+		for i, hit := range hits {
+			entries[len(hits)-1-i] = &indexEntry{key: value.Values{value.NewValue(hit.score)}, id: hit.id}
+		}
+	} else {
+		entries = make([]*indexEntry, 0, len(fti.docs))
+		for id, _ := range fti.docs {
+			entries = append(entries, &indexEntry{key: value.Values{value.NULL_VALUE}, id: id})
+		}
+	}
+
+	return newStatistics(entries), nil
+}
+
+func (fti *fullTextIndex) Drop(requestId string) errors.Error {
+	return fti.keyspace.ft.dropIndex(fti)
+}
+
+func (fti *fullTextIndex) Scan(requestId string, span *datastore.Span, distinct bool, limit int64,
+	cons datastore.ScanConsistency, vector timestamp.Vector, conn *datastore.IndexConnection) {
+	defer close(conn.EntryChannel())
+
+	query, ok := spanQuery(span)
+	if !ok {
+		conn.Error(errors.NewFileNotSupported(nil, "Scan of full-text index "+fti.name+" without a search query."))
+		return
+	}
+
+	fti.RLock()
+	if fti.state == datastore.OFFLINE {
+		fti.RUnlock()
+		conn.Error(errors.NewFileIdxNotFound(nil, fti.name))
+		return
+	}
+
+	hits := fti.search(query)
+	fti.RUnlock()
+
+	var n int64 = 0
+	for _, hit := range hits {
+		if limit > 0 && n >= limit {
+			break
+		}
+
+		entry := &datastore.IndexEntry{
+			EntryKey:   value.Values{value.NewValue(hit.score)},
+			PrimaryKey: hit.id,
+		}
+
+		select {
+		case conn.EntryChannel() <- entry:
+			n++
+		case <-conn.StopChannel():
+			return
+		}
+	}
+}
+
+// spanQuery returns the search query of a span, which is its low
+// bound. A query that is not a string matches nothing.
+func spanQuery(span *datastore.Span) ([]string, bool) {
+	if span == nil || len(span.Range.Low) == 0 || span.Range.Low[0] == nil {
+		return nil, false
+	}
+
+	query := span.Range.Low[0]
+	if query.Type() != value.STRING {
+		return nil, true
+	}
+
+	return expression.SearchTerms(query), true
+}
+
+// search returns the documents containing every term of the query,
+// in descending order of score. Caller must hold the lock.
+func (fti *fullTextIndex) search(query []string) []*ftsHit {
+	terms := make([]string, 0, len(query))
+	seen := make(map[string]bool, len(query))
+	for _, term := range query {
+		if !seen[term] {
+			seen[term] = true
+			terms = append(terms, term)
+		}
+	}
+
+	if len(terms) == 0 {
+		return nil
+	}
+
+	// Visit the documents of the rarest term
+	rarest := fti.postings[terms[0]]
+	for _, term := range terms[1:] {
+		if len(fti.postings[term]) < len(rarest) {
+			rarest = fti.postings[term]
+		}
+	}
+
+	n := float64(len(fti.docs))
+	avgLength := float64(fti.length) / math.Max(n, 1)
+
+	hits := make([]*ftsHit, 0, len(rarest))
+outer:
+	for id, _ := range rarest {
+		doc := fti.docs[id]
+		score := 0.0
+		for _, term := range terms {
+			tf, ok := fti.postings[term][id]
+			if !ok {
+				continue outer
+			}
+
+			df := float64(len(fti.postings[term]))
+			idf := math.Log(1.0 + (n-df+0.5)/(df+0.5))
+			norm := 1.0 - _BM25_B + _BM25_B*float64(doc.Length)/math.Max(avgLength, 1)
+			score += idf * float64(tf) * (_BM25_K1 + 1.0) / (float64(tf) + _BM25_K1*norm)
+		}
+
+		hits = append(hits, &ftsHit{id: id, score: score})
+	}
+
+	sort.Sort(hitSorter(hits))
+	return hits
+}
+
+type hitSorter []*ftsHit
+
+func (this hitSorter) Len() int      { return len(this) }
+func (this hitSorter) Swap(i, j int) { this[i], this[j] = this[j], this[i] }
+func (this hitSorter) Less(i, j int) bool {
+	if this[i].score != this[j].score {
+		return this[i].score > this[j].score
+	}
+	return this[i].id < this[j].id
+}
+
+// analyze computes the terms of a document. A document that fails
+// the index condition, or has no terms, is not indexed.
+func (fti *fullTextIndex) analyze(key string, doc value.Value) (*ftsDoc, error) {
+	context := expression.NewIndexContext()
+	item := value.NewScopeValue(map[string]interface{}{fti.keyspace.Name(): doc}, nil)
+
+	if fti.evalWhere != nil {
+		cond, err := fti.evalWhere.Evaluate(item, context)
+		if err != nil {
+			return nil, err
+		}
+
+		if !cond.Truth() {
+			return nil, nil
+		}
+	}
+
+	text, err := fti.evalKey.Evaluate(item, context)
+	if err != nil {
+		return nil, err
+	}
+
+	terms := expression.SearchTerms(text)
+	if len(terms) == 0 {
+		return nil, nil
+	}
+
+	rv := &ftsDoc{Id: key, Terms: make(map[string]int, len(terms)), Length: len(terms)}
+	for _, term := range terms {
+		rv.Terms[term]++
+	}
+
+	return rv, nil
+}
+
+// clear empties the index. Caller must hold the write lock.
+func (fti *fullTextIndex) clear() {
+	fti.docs = make(map[string]*ftsDoc)
+	fti.postings = make(map[string]map[string]int)
+	fti.length = 0
+}
+
+// insert adds a document to the inverted index. Caller must hold
+// the write lock.
+func (fti *fullTextIndex) insert(doc *ftsDoc) {
+	fti.docs[doc.Id] = doc
+	fti.length += doc.Length
+
+	for term, tf := range doc.Terms {
+		docs, ok := fti.postings[term]
+		if !ok {
+			docs = make(map[string]int)
+			fti.postings[term] = docs
+		}
+		docs[doc.Id] = tf
+	}
+}
+
+// remove deletes a document from the inverted index. Caller must
+// hold the write lock.
+func (fti *fullTextIndex) remove(key string) bool {
+	doc, ok := fti.docs[key]
+	if !ok {
+		return false
+	}
+
+	for term, _ := range doc.Terms {
+		docs := fti.postings[term]
+		delete(docs, key)
+		if len(docs) == 0 {
+			delete(fti.postings, term)
+		}
+	}
+
+	fti.length -= doc.Length
+	delete(fti.docs, key)
+	return true
+}
+
+// indexDocs re-indexes the given documents.
+func (fti *fullTextIndex) indexDocs(docs []value.AnnotatedPair) errors.Error {
+	fti.Lock()
+	defer fti.Unlock()
+
+	if fti.state != datastore.ONLINE {
+		return nil
+	}
+
+	records := make([]*ftsLogRecord, 0, len(docs))
+	for _, doc := range docs {
+		removed := fti.remove(doc.Name)
+
+		fd, err := fti.analyze(doc.Name, doc.Value)
+		if err != nil {
+			logging.Errorp("File full-text index maintenance", logging.Pair{"index", fti.name},
+				logging.Pair{"key", doc.Name}, logging.Pair{"error", err})
+			fd = nil
+		}
+
+		if !removed && fd == nil {
+			continue
+		}
+
+		if fd != nil {
+			fti.insert(fd)
+		}
+
+		records = append(records, &ftsLogRecord{Id: doc.Name, Doc: fd})
+	}
+
+	return fti.appendLog(records)
+}
+
+// unindexDocs removes the given documents from the index.
+func (fti *fullTextIndex) unindexDocs(keys []string) errors.Error {
+	fti.Lock()
+	defer fti.Unlock()
+
+	if fti.state != datastore.ONLINE {
+		return nil
+	}
+
+	var records []*ftsLogRecord
+	for _, key := range keys {
+		if fti.remove(key) {
+			records = append(records, &ftsLogRecord{Id: key})
+		}
+	}
+
+	return fti.appendLog(records)
+}
+
+// build scans the whole keyspace and brings the index online.
+func (fti *fullTextIndex) build() errors.Error {
+	dirEntries, er := ioutil.ReadDir(fti.keyspace.path())
+	if er != nil {
+		return errors.NewFileDatastoreError(er, "")
+	}
+
+	docs := make([]*ftsDoc, 0, len(dirEntries))
+	for _, dirEntry := range dirEntries {
+		if dirEntry.IsDir() {
+			continue
+		}
+
+		key := documentPathToId(dirEntry.Name())
+		doc, e := fti.keyspace.fetchOne(key)
+		if e != nil {
+			return e
+		}
+
+		fd, err := fti.analyze(key, doc)
+		if err != nil {
+			return errors.NewFileDatastoreError(err, "build index "+fti.name)
+		}
+
+		if fd != nil {
+			docs = append(docs, fd)
+		}
+	}
+
+	fti.Lock()
+	defer fti.Unlock()
+
+	fti.clear()
+	for _, fd := range docs {
+		fti.insert(fd)
+	}
+	fti.state = datastore.ONLINE
+
+	e := fti.compact()
+	if e != nil {
+		return e
+	}
+
+	return fti.saveDef()
+}
+
+func (fti *fullTextIndex) defPath() string {
+	return filepath.Join(fti.keyspace.indexPath(), fti.name+_FTS_DEF)
+}
+
+func (fti *fullTextIndex) dataPath() string {
+	return filepath.Join(fti.keyspace.indexPath(), fti.name+_FTS_DATA)
+}
+
+func (fti *fullTextIndex) logPath() string {
+	return filepath.Join(fti.keyspace.indexPath(), fti.name+_FTS_LOG)
+}
+
+// saveDef persists the index definition. Caller must hold the lock.
+func (fti *fullTextIndex) saveDef() errors.Error {
+	def := &indexDef{
+		Name:     fti.name,
+		RangeKey: []string{fti.key.String()},
+		State:    fti.state,
+	}
+
+	if fti.where != nil {
+		def.Where = fti.where.String()
+	}
+
+	bytes, err := json.Marshal(def)
+	if err != nil {
+		return errors.NewFileDatastoreError(err, "index "+fti.name)
+	}
+
+	return writeFile(fti.defPath(), bytes)
+}
+
+// saveEntries writes the analyzed documents to the data file in key
+// order. Caller must hold the lock.
+func (fti *fullTextIndex) saveEntries() errors.Error {
+	keys := make([]string, 0, len(fti.docs))
+	for key, _ := range fti.docs {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	buf := make([]byte, 0, 128*len(keys))
+	for _, key := range keys {
+		bytes, err := json.Marshal(fti.docs[key])
+		if err != nil {
+			return errors.NewFileDatastoreError(err, "index "+fti.name)
+		}
+
+		buf = append(buf, bytes...)
+		buf = append(buf, '\n')
+	}
+
+	return writeFile(fti.dataPath(), buf)
+}
+
+// appendLog records changes in the log, or compacts the log into the
+// data file once it holds more records than the index holds
+// documents. Caller must hold the lock.
+func (fti *fullTextIndex) appendLog(records []*ftsLogRecord) errors.Error {
+	if len(records) == 0 {
+		return nil
+	}
+
+	logged := fti.logged + len(records)
+	if logged > _INDEX_LOG_MIN && logged > len(fti.docs) {
+		return fti.compact()
+	}
+
+	buf := make([]byte, 0, 128*len(records))
+	for _, record := range records {
+		bytes, err := json.Marshal(record)
+		if err != nil {
+			return errors.NewFileDatastoreError(err, "index "+fti.name)
+		}
+
+		buf = append(buf, bytes...)
+		buf = append(buf, '\n')
+	}
+
+	e := appendFile(fti.logPath(), buf)
+	if e != nil {
+		return e
+	}
+
+	fti.logged = logged
+	return nil
+}
+
+// compact writes the analyzed documents to the data file, and
+// discards the log. Caller must hold the lock.
+func (fti *fullTextIndex) compact() errors.Error {
+	e := fti.saveEntries()
+	if e != nil {
+		return e
+	}
+
+	er := os.Remove(fti.logPath())
+	if er != nil && !os.IsNotExist(er) {
+		return errors.NewFileDatastoreError(er, "")
+	}
+
+	fti.logged = 0
+	return nil
+}
+
+// loadEntries reads the analyzed documents from the data file,
+// applies the changes recorded in the log, and rebuilds the inverted
+// index.
+func (fti *fullTextIndex) loadEntries() errors.Error {
+	file, er := os.Open(fti.dataPath())
+	if er != nil {
+		return errors.NewFileDatastoreError(er, "")
+	}
+	defer file.Close()
+
+	fti.clear()
+
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 64*1024), 64*1024*1024)
+	for scanner.Scan() {
+		fd := &ftsDoc{}
+		er = json.Unmarshal(scanner.Bytes(), fd)
+		if er != nil || fd.Id == "" {
+			fti.clear()
+			return errors.NewFileIdxMetadataError(er, fti.dataPath())
+		}
+
+		fti.insert(fd)
+	}
+
+	if er = scanner.Err(); er != nil {
+		fti.clear()
+		return errors.NewFileDatastoreError(er, "")
+	}
+
+	e := fti.replayLog()
+	if e != nil {
+		fti.clear()
+		return e
+	}
+
+	return nil
+}
+
+// replayLog applies the changes recorded in the log since the data
+// file was written.
+func (fti *fullTextIndex) replayLog() errors.Error {
+	fti.logged = 0
+
+	file, er := os.Open(fti.logPath())
+	if er != nil {
+		if os.IsNotExist(er) {
+			return nil
+		}
+		return errors.NewFileDatastoreError(er, "")
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 64*1024), 64*1024*1024)
+	for scanner.Scan() {
+		var record ftsLogRecord
+		er = json.Unmarshal(scanner.Bytes(), &record)
+		if er != nil || record.Id == "" {
+			return errors.NewFileIdxMetadataError(er, fti.logPath())
+		}
+
+		fti.remove(record.Id)
+		if record.Doc != nil {
+			fti.insert(record.Doc)
+		}
+		fti.logged++
+	}
+
+	if er = scanner.Err(); er != nil {
+		return errors.NewFileDatastoreError(er, "")
+	}
+
+	return nil
+}
+
+// loadFullTextIndex restores a full-text index from its definition
+// file.
+func loadFullTextIndex(keyspace *keyspace, path string) (*fullTextIndex, errors.Error) {
+	bytes, er := ioutil.ReadFile(path)
+	if er != nil {
+		return nil, errors.NewFileDatastoreError(er, "")
+	}
+
+	var def indexDef
+	er = json.Unmarshal(bytes, &def)
+	if er != nil {
+		return nil, errors.NewFileIdxMetadataError(er, path)
+	}
+
+	rangeKey, er := parseExpressions(def.RangeKey)
+	if er != nil || len(rangeKey) == 0 {
+		return nil, errors.NewFileIdxMetadataError(er, path)
+	}
+
+	var where expression.Expression
+	if def.Where != "" {
+		where, er = parser.Parse(def.Where)
+		if er != nil {
+			return nil, errors.NewFileIdxMetadataError(er, path)
+		}
+	}
+
+	fti, e := newFullTextIndex(keyspace, def.Name, rangeKey, where)
+	if e != nil {
+		return nil, e
+	}
+
+	if def.State != datastore.ONLINE {
+		return fti, nil
+	}
+
+	// Rebuild the index if its data file is unusable
+	e = fti.loadEntries()
+	if e != nil {
+		logging.Errorp("Rebuilding file full-text index", logging.Pair{"index", fti.name},
+			logging.Pair{"error", e})
+		e = fti.build()
+		if e != nil {
+			return nil, e
+		}
+	}
+
+	fti.state = datastore.ONLINE
+	return fti, nil
+}
+
+// ftsIndexer provides the full-text indexes of a keyspace.
+type ftsIndexer struct {
+	sync.RWMutex
+	keyspace *keyspace
+	indexes  map[string]*fullTextIndex
+	version  atomic.AlignedUint64 // Changed whenever an index is created, dropped or built
+}
+
+func newFtsIndexer(keyspace *keyspace) *ftsIndexer {
+	return &ftsIndexer{
+		keyspace: keyspace,
+		indexes:  make(map[string]*fullTextIndex),
+	}
+}
+
+// loadIndexes restores the full-text indexes persisted in the
+// keyspace's index directory.
+func (ft *ftsIndexer) loadIndexes() {
+	dirEntries, er := ioutil.ReadDir(ft.keyspace.indexPath())
+	if er != nil {
+		if !os.IsNotExist(er) {
+			logging.Errorp("Loading file full-text indexes", logging.Pair{"keyspace", ft.keyspace.Name()},
+				logging.Pair{"error", er})
+		}
+		return
+	}
+
+	for _, dirEntry := range dirEntries {
+		if dirEntry.IsDir() || filepath.Ext(dirEntry.Name()) != _FTS_DEF {
+			continue
+		}
+
+		fti, err := loadFullTextIndex(ft.keyspace, filepath.Join(ft.keyspace.indexPath(), dirEntry.Name()))
+		if err != nil {
+			logging.Errorp("Loading file full-text index", logging.Pair{"keyspace", ft.keyspace.Name()},
+				logging.Pair{"file", dirEntry.Name()}, logging.Pair{"error", err})
+			continue
+		}
+
+		ft.indexes[fti.Name()] = fti
+	}
+}
+
+func (ft *ftsIndexer) fullTextIndexes() []*fullTextIndex {
+	ft.RLock()
+	defer ft.RUnlock()
+
+	rv := make([]*fullTextIndex, 0, len(ft.indexes))
+	for _, fti := range ft.indexes {
+		rv = append(rv, fti)
+	}
+	return rv
+}
+
+// indexDocs brings the full-text indexes up to date with inserted or
+// modified documents.
+func (ft *ftsIndexer) indexDocs(docs []value.AnnotatedPair) (rv errors.Error) {
+	if len(docs) == 0 {
+		return
+	}
+
+	for _, fti := range ft.fullTextIndexes() {
+		if err := fti.indexDocs(docs); err != nil {
+			rv = err
+		}
+	}
+	return
+}
+
+// unindexDocs removes deleted documents from the full-text indexes.
+func (ft *ftsIndexer) unindexDocs(keys []string) (rv errors.Error) {
+	if len(keys) == 0 {
+		return
+	}
+
+	for _, fti := range ft.fullTextIndexes() {
+		if err := fti.unindexDocs(keys); err != nil {
+			rv = err
+		}
+	}
+	return
+}
+
+func (ft *ftsIndexer) dropIndex(fti *fullTextIndex) errors.Error {
+	ft.Lock()
+	defer ft.Unlock()
+
+	if ft.indexes[fti.name] != fti {
+		return errors.NewFileIdxNotFound(nil, fti.name)
+	}
+
+	fti.Lock()
+	defer fti.Unlock()
+
+	for _, path := range []string{fti.defPath(), fti.dataPath(), fti.logPath()} {
+		if er := os.Remove(path); er != nil && !os.IsNotExist(er) {
+			return errors.NewFileDatastoreError(er, "")
+		}
+	}
+
+	// Remove the index directory once the last index is gone
+	os.Remove(ft.keyspace.indexPath())
+
+	delete(ft.indexes, fti.name)
+	fti.state = datastore.OFFLINE
+	fti.clear()
+	atomic.AddUint64(&ft.version, 1)
+	return nil
+}
+
+func (ft *ftsIndexer) KeyspaceId() string {
+	return ft.keyspace.Id()
+}
+
+func (ft *ftsIndexer) Name() datastore.IndexType {
+	return datastore.FTS
+}
+
+func (ft *ftsIndexer) IndexIds() ([]string, errors.Error) {
+	return ft.IndexNames()
+}
+
+func (ft *ftsIndexer) IndexNames() ([]string, errors.Error) {
+	ft.RLock()
+	defer ft.RUnlock()
+
+	rv := make([]string, 0, len(ft.indexes))
+	for name, _ := range ft.indexes {
+		rv = append(rv, name)
+	}
+	return rv, nil
+}
+
+func (ft *ftsIndexer) IndexById(id string) (datastore.Index, errors.Error) {
+	return ft.IndexByName(id)
+}
+
+func (ft *ftsIndexer) IndexByName(name string) (datastore.Index, errors.Error) {
+	ft.RLock()
+	defer ft.RUnlock()
+
+	index, ok := ft.indexes[name]
+	if !ok {
+		return nil, errors.NewFileIdxNotFound(nil, name)
+	}
+	return index, nil
+}
+
+func (ft *ftsIndexer) PrimaryIndexes() ([]datastore.PrimaryIndex, errors.Error) {
+	return nil, nil
+}
+
+func (ft *ftsIndexer) Indexes() ([]datastore.Index, errors.Error) {
+	ft.RLock()
+	defer ft.RUnlock()
+
+	rv := make([]datastore.Index, 0, len(ft.indexes))
+	for _, fti := range ft.indexes {
+		rv = append(rv, fti)
+	}
+	return rv, nil
+}
+
+func (ft *ftsIndexer) CreatePrimaryIndex(requestId, name string, with value.Value) (
+	datastore.PrimaryIndex, errors.Error) {
+	return nil, errors.NewFileNotSupported(nil, "Primary full-text index "+name)
+}
+
+func (ft *ftsIndexer) CreateIndex(requestId, name string, seekKey, rangeKey expression.Expressions,
+	where expression.Expression, with value.Value) (datastore.Index, errors.Error) {
+	deferred := false
+	if with != nil {
+		if db, ok := with.Field("defer_build"); ok {
+			deferred = db.Truth()
+		}
+	}
+
+	fti, err := newFullTextIndex(ft.keyspace, name, rangeKey, where)
+	if err != nil {
+		return nil, err
+	}
+
+	// As for secondary indexes, hold the keyspace lock while
+	// building, before the indexer lock.
+	ft.keyspace.fileLock.Lock()
+	defer ft.keyspace.fileLock.Unlock()
+
+	ft.Lock()
+	defer ft.Unlock()
+
+	if _, ok := ft.indexes[name]; ok {
+		return nil, errors.NewFileIdxExists(nil, name)
+	}
+
+	if _, err := ft.keyspace.fi.IndexByName(name); err == nil {
+		return nil, errors.NewFileIdxExists(nil, name)
+	}
+
+	er := os.MkdirAll(ft.keyspace.indexPath(), 0777)
+	if er != nil {
+		return nil, errors.NewFileDatastoreError(er, "")
+	}
+
+	if deferred {
+		err = fti.saveDef()
+	} else {
+		err = fti.build()
+	}
+
+	if err != nil {
+		os.Remove(fti.defPath())
+		os.Remove(fti.dataPath())
+		os.Remove(fti.logPath())
+		return nil, err
+	}
+
+	ft.indexes[name] = fti
+	atomic.AddUint64(&ft.version, 1)
+	return fti, nil
+}
+
+func (ft *ftsIndexer) BuildIndexes(requestId string, names ...string) errors.Error {
+	ft.keyspace.fileLock.Lock()
+	defer ft.keyspace.fileLock.Unlock()
+
+	ft.RLock()
+	defer ft.RUnlock()
+
+	indexes := make([]*fullTextIndex, 0, len(names))
+	for _, name := range names {
+		fti, ok := ft.indexes[name]
+		if !ok {
+			return errors.NewFileIdxNotFound(nil, name)
+		}
+		indexes = append(indexes, fti)
+	}
+
+	for _, fti := range indexes {
+		state, _, _ := fti.State()
+		if state == datastore.ONLINE {
+			continue
+		}
+
+		err := fti.build()
+		atomic.AddUint64(&ft.version, 1)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (ft *ftsIndexer) Refresh() errors.Error {
+	return nil
+}
+
+func (ft *ftsIndexer) MetadataVersion() uint64 {
+	return atomic.LoadUint64(&ft.version)
+}
+
+func (ft *ftsIndexer) SetLogLevel(level logging.Level) {
+	// No-op, uses query engine logger
+}
@@ -0,0 +1,956 @@
+//  Copyright (c) 2016 Couchbase, Inc.
+//  Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file
+//  except in compliance with the License. You may obtain a copy of the License at
+//    http://www.apache.org/licenses/LICENSE-2.0
+//  Unless required by applicable law or agreed to in writing, software distributed under the
+//  License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+//  either express or implied. See the License for the specific language governing permissions
+//  and limitations under the License.
+
+package file
+
+import (
+	"bufio"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/couchbase/query/datastore"
+	"github.com/couchbase/query/errors"
+	"github.com/couchbase/query/expression"
+	"github.com/couchbase/query/expression/parser"
+	"github.com/couchbase/query/logging"
+	"github.com/couchbase/query/timestamp"
+	"github.com/couchbase/query/value"
+)
+
+// Secondary indexes are kept in a hidden directory inside the
+// keyspace directory. Each index has a definition file, a data file
+// holding its entries in index order, one JSON entry per line, and a
+// log of the changes made since the data file was written, one JSON
+// record per line. Mutations append to the log, which is compacted
+// into the data file once it outgrows the index.
+const (
+	_INDEX_DIR  = ".index"
+	_INDEX_DEF  = ".def"
+	_INDEX_DATA = ".idx"
+	_INDEX_LOG  = ".log"
+)
+
+// The log is only compacted once it holds more records than this.
+const _INDEX_LOG_MIN = 1024
+
+// Number of bins returned by Statistics.Bins().
+const _STATS_BINS = 16
+
+// indexDef is the persisted definition of a secondary index.
+type indexDef struct {
+	Name     string               `json:"name"`
+	SeekKey  []string             `json:"seek_key,omitempty"`
+	RangeKey []string             `json:"range_key"`
+	Where    string               `json:"where,omitempty"`
+	State    datastore.IndexState `json:"state"`
+}
+
+// indexEntry is a single entry of a secondary index.
+type indexEntry struct {
+	key value.Values
+	id  string
+}
+
+// persistedEntry is the on-disk form of an indexEntry. MISSING
+// cannot be represented in JSON, so the positions of MISSING keys
+// are recorded separately.
+type persistedEntry struct {
+	Id      string        `json:"id"`
+	Key     []interface{} `json:"key"`
+	Missing []int         `json:"missing,omitempty"`
+}
+
+// logRecord is a change to a secondary index: the new entries of a
+// document, or none if the document is no longer indexed.
+type logRecord struct {
+	Id      string           `json:"id"`
+	Entries []persistedEntry `json:"entries,omitempty"`
+}
+
+// secondaryIndex is a sorted index over the documents of a keyspace.
+type secondaryIndex struct {
+	sync.RWMutex
+	name     string
+	keyspace *keyspace
+	seekKey  expression.Expressions
+	rangeKey expression.Expressions
+	where    expression.Expression
+	state    datastore.IndexState
+
+	// Formalized copies of the keys and condition, evaluated
+	// against documents scoped by the keyspace name.
+	evalKey   expression.Expressions
+	evalWhere expression.Expression
+
+	entries []*indexEntry
+	docs    map[string][]*indexEntry
+	logged  int // Records in the log
+}
+
+func newSecondaryIndex(keyspace *keyspace, name string, seekKey, rangeKey expression.Expressions,
+	where expression.Expression) (*secondaryIndex, errors.Error) {
+	si := &secondaryIndex{
+		name:     name,
+		keyspace: keyspace,
+		seekKey:  seekKey,
+		rangeKey: rangeKey,
+		where:    where,
+		state:    datastore.DEFERRED,
+		docs:     make(map[string][]*indexEntry),
+	}
+
+	formalizer := expression.NewFormalizer(keyspace.Name(), nil)
+
+	si.evalKey = make(expression.Expressions, len(rangeKey))
+	for i, key := range rangeKey {
+		key, err := formalizer.Map(key.Copy())
+		if err != nil {
+			return nil, errors.NewFileDatastoreError(err, "index "+name)
+		}
+		si.evalKey[i] = key
+	}
+
+	if where != nil {
+		cond, err := formalizer.Map(where.Copy())
+		if err != nil {
+			return nil, errors.NewFileDatastoreError(err, "index "+name)
+		}
+		si.evalWhere = cond
+	}
+
+	return si, nil
+}
+
+func (si *secondaryIndex) KeyspaceId() string {
+	return si.keyspace.Id()
+}
+
+func (si *secondaryIndex) Id() string {
+	return si.Name()
+}
+
+func (si *secondaryIndex) Name() string {
+	return si.name
+}
+
+func (si *secondaryIndex) Type() datastore.IndexType {
+	return datastore.DEFAULT
+}
+
+func (si *secondaryIndex) SeekKey() expression.Expressions {
+	return si.seekKey
+}
+
+func (si *secondaryIndex) RangeKey() expression.Expressions {
+	return si.rangeKey
+}
+
+func (si *secondaryIndex) Condition() expression.Expression {
+	return si.where
+}
+
+func (si *secondaryIndex) IsPrimary() bool {
+	return false
+}
+
+func (si *secondaryIndex) State() (state datastore.IndexState, msg string, err errors.Error) {
+	si.RLock()
+	defer si.RUnlock()
+	return si.state, "", nil
+}
+
+func (si *secondaryIndex) Statistics(requestId string, span *datastore.Span) (
+	datastore.Statistics, errors.Error) {
+	// Statistics outlive the lock, so take a snapshot of the entries,
+	// as Scan does
+	si.RLock()
+	entries := si.spanEntries(span)
+	snapshot := make([]*indexEntry, len(entries))
+	copy(snapshot, entries)
+	si.RUnlock()
+
+	return newStatistics(snapshot), nil
+}
+
+func (si *secondaryIndex) Count(span *datastore.Span, cons datastore.ScanConsistency,
+	vector timestamp.Vector) (int64, errors.Error) {
+	si.RLock()
+	defer si.RUnlock()
+
+	if si.state == datastore.OFFLINE {
+		return 0, errors.NewFileIdxNotFound(nil, si.name)
+	}
+
+	return int64(len(si.spanEntries(span))), nil
+}
+
+func (si *secondaryIndex) Drop(requestId string) errors.Error {
+	return si.keyspace.fi.dropIndex(si)
+}
+
+func (si *secondaryIndex) Scan(requestId string, span *datastore.Span, distinct bool, limit int64,
+	cons datastore.ScanConsistency, vector timestamp.Vector, conn *datastore.IndexConnection) {
+	defer close(conn.EntryChannel())
+
+	si.RLock()
+	if si.state == datastore.OFFLINE {
+		si.RUnlock()
+		conn.Error(errors.NewFileIdxNotFound(nil, si.name))
+		return
+	}
+
+	// Take a snapshot of the matching entries, so that concurrent
+	// mutations do not block on a slow consumer.
+	entries := si.spanEntries(span)
+	snapshot := make([]*indexEntry, len(entries))
+	copy(snapshot, entries)
+	si.RUnlock()
+
+	// A distinct scan returns each document once, where an array
+	// index holds several entries for it
+	var seen map[string]bool
+	if distinct {
+		seen = make(map[string]bool, len(snapshot))
+	}
+
+	var n int64 = 0
+	for _, ie := range snapshot {
+		if limit > 0 && n >= limit {
+			break
+		}
+
+		if distinct {
+			if seen[ie.id] {
+				continue
+			}
+			seen[ie.id] = true
+		}
+
+		entry := &datastore.IndexEntry{EntryKey: ie.key, PrimaryKey: ie.id}
+		select {
+		case conn.EntryChannel() <- entry:
+			n++
+		case <-conn.StopChannel():
+			return
+		}
+	}
+}
+
+// spanEntries returns the slice of entries that fall within the
+// span. Caller must hold the lock.
+func (si *secondaryIndex) spanEntries(span *datastore.Span) []*indexEntry {
+	if span == nil {
+		return si.entries
+	}
+
+	rng := &span.Range
+	start := sort.Search(len(si.entries), func(i int) bool {
+		return !belowLow(si.entries[i].key, rng)
+	})
+
+	end := start + sort.Search(len(si.entries)-start, func(i int) bool {
+		return aboveHigh(si.entries[start+i].key, rng)
+	})
+
+	return si.entries[start:end]
+}
+
+func belowLow(key value.Values, rng *datastore.Range) bool {
+	if len(rng.Low) == 0 {
+		return false
+	}
+
+	c := collatePrefix(key, rng.Low)
+	return c < 0 || (c == 0 && rng.Inclusion&datastore.LOW == 0)
+}
+
+func aboveHigh(key value.Values, rng *datastore.Range) bool {
+	if len(rng.High) == 0 {
+		return false
+	}
+
+	c := collatePrefix(key, rng.High)
+	return c > 0 || (c == 0 && rng.Inclusion&datastore.HIGH == 0)
+}
+
+// collatePrefix compares the leading keys of an entry with a span
+// bound. A nil bound value leaves the remaining keys unbounded.
+func collatePrefix(key, bound value.Values) int {
+	for i, b := range bound {
+		if b == nil {
+			return 0
+		}
+
+		if i >= len(key) {
+			return -1
+		}
+
+		c := key[i].Collate(b)
+		if c != 0 {
+			return c
+		}
+	}
+
+	return 0
+}
+
+func compareEntries(a, b *indexEntry) int {
+	for i := 0; i < len(a.key) && i < len(b.key); i++ {
+		c := a.key[i].Collate(b.key[i])
+		if c != 0 {
+			return c
+		}
+	}
+
+	if len(a.key) != len(b.key) {
+		return len(a.key) - len(b.key)
+	}
+
+	return strings.Compare(a.id, b.id)
+}
+
+type entrySorter []*indexEntry
+
+func (this entrySorter) Len() int           { return len(this) }
+func (this entrySorter) Less(i, j int) bool { return compareEntries(this[i], this[j]) < 0 }
+func (this entrySorter) Swap(i, j int)      { this[i], this[j] = this[j], this[i] }
+
+// docEntries computes the index entries for a document. A document
+// that fails the index condition, or whose leading key is MISSING,
+// produces no entries.
+func (si *secondaryIndex) docEntries(key string, doc value.AnnotatedValue) ([]*indexEntry, error) {
+	context := expression.NewIndexContext()
+	item := value.NewScopeValue(map[string]interface{}{si.keyspace.Name(): doc}, nil)
+
+	if si.evalWhere != nil {
+		cond, err := si.evalWhere.Evaluate(item, context)
+		if err != nil {
+			return nil, err
+		}
+
+		if !cond.Truth() {
+			return nil, nil
+		}
+	}
+
+	keys := make([]value.Values, len(si.evalKey))
+	for i, expr := range si.evalKey {
+		if isArray, distinct := expr.IsArrayIndexKey(); isArray {
+			_, vals, err := expr.EvaluateForIndex(item, context)
+			if err != nil {
+				return nil, err
+			}
+
+			if distinct {
+				vals = distinctValues(vals)
+			}
+
+			if len(vals) == 0 {
+				vals = value.Values{value.MISSING_VALUE}
+			}
+
+			keys[i] = vals
+		} else {
+			val, err := expr.Evaluate(item, context)
+			if err != nil {
+				return nil, err
+			}
+
+			keys[i] = value.Values{val}
+		}
+	}
+
+	rv := make([]*indexEntry, 0, len(keys[0]))
+	for _, lead := range keys[0] {
+		if lead.Type() == value.MISSING {
+			continue
+		}
+
+		composites := []value.Values{value.Values{lead}}
+		for _, vals := range keys[1:] {
+			next := make([]value.Values, 0, len(composites)*len(vals))
+			for _, c := range composites {
+				for _, v := range vals {
+					nc := make(value.Values, len(c), len(c)+1)
+					copy(nc, c)
+					next = append(next, append(nc, v))
+				}
+			}
+			composites = next
+		}
+
+		for _, c := range composites {
+			rv = append(rv, &indexEntry{key: c, id: key})
+		}
+	}
+
+	return rv, nil
+}
+
+func distinctValues(vals value.Values) value.Values {
+	rv := make(value.Values, 0, len(vals))
+outer:
+	for _, v := range vals {
+		for _, r := range rv {
+			if v.Collate(r) == 0 {
+				continue outer
+			}
+		}
+		rv = append(rv, v)
+	}
+	return rv
+}
+
+// insert adds entries in index order. Caller must hold the write lock.
+func (si *secondaryIndex) insert(entries []*indexEntry) {
+	for _, ie := range entries {
+		i := sort.Search(len(si.entries), func(i int) bool {
+			return compareEntries(si.entries[i], ie) >= 0
+		})
+
+		si.entries = append(si.entries, nil)
+		copy(si.entries[i+1:], si.entries[i:])
+		si.entries[i] = ie
+	}
+
+	if len(entries) > 0 {
+		si.docs[entries[0].id] = append(si.docs[entries[0].id], entries...)
+	}
+}
+
+// remove deletes all the entries of a document. Caller must hold the
+// write lock.
+func (si *secondaryIndex) remove(key string) bool {
+	entries, ok := si.docs[key]
+	if !ok {
+		return false
+	}
+
+	for _, ie := range entries {
+		i := sort.Search(len(si.entries), func(i int) bool {
+			return compareEntries(si.entries[i], ie) >= 0
+		})
+
+		// A document can hold several entries with the same key, as
+		// in a non-distinct array index; find this one in the run of
+		// equal entries
+		for ; i < len(si.entries) && compareEntries(si.entries[i], ie) == 0; i++ {
+			if si.entries[i] == ie {
+				si.entries = append(si.entries[:i], si.entries[i+1:]...)
+				break
+			}
+		}
+	}
+
+	delete(si.docs, key)
+	return true
+}
+
+// indexDocs re-indexes the given documents.
+func (si *secondaryIndex) indexDocs(docs []value.AnnotatedPair) errors.Error {
+	si.Lock()
+	defer si.Unlock()
+
+	if si.state != datastore.ONLINE {
+		return nil
+	}
+
+	records := make([]*logRecord, 0, len(docs))
+	for _, doc := range docs {
+		removed := si.remove(doc.Name)
+
+		entries, err := si.docEntries(doc.Name, doc.Value)
+		if err != nil {
+			logging.Errorp("File index maintenance", logging.Pair{"index", si.name},
+				logging.Pair{"key", doc.Name}, logging.Pair{"error", err})
+			entries = nil
+		}
+
+		if !removed && len(entries) == 0 {
+			continue
+		}
+
+		si.insert(entries)
+
+		record := &logRecord{Id: doc.Name, Entries: make([]persistedEntry, len(entries))}
+		for i, ie := range entries {
+			record.Entries[i] = persistEntry(ie)
+		}
+		records = append(records, record)
+	}
+
+	return si.appendLog(records)
+}
+
+// unindexDocs removes the given documents from the index.
+func (si *secondaryIndex) unindexDocs(keys []string) errors.Error {
+	si.Lock()
+	defer si.Unlock()
+
+	if si.state != datastore.ONLINE {
+		return nil
+	}
+
+	var records []*logRecord
+	for _, key := range keys {
+		if si.remove(key) {
+			records = append(records, &logRecord{Id: key})
+		}
+	}
+
+	return si.appendLog(records)
+}
+
+// build scans the whole keyspace and brings the index online.
+func (si *secondaryIndex) build() errors.Error {
+	dirEntries, er := ioutil.ReadDir(si.keyspace.path())
+	if er != nil {
+		return errors.NewFileDatastoreError(er, "")
+	}
+
+	entries := make([]*indexEntry, 0, len(dirEntries))
+	docs := make(map[string][]*indexEntry, len(dirEntries))
+
+	for _, dirEntry := range dirEntries {
+		if dirEntry.IsDir() {
+			continue
+		}
+
+		key := documentPathToId(dirEntry.Name())
+		doc, e := si.keyspace.fetchOne(key)
+		if e != nil {
+			return e
+		}
+
+		de, err := si.docEntries(key, doc)
+		if err != nil {
+			return errors.NewFileDatastoreError(err, "build index "+si.name)
+		}
+
+		if len(de) > 0 {
+			entries = append(entries, de...)
+			docs[key] = de
+		}
+	}
+
+	sort.Sort(entrySorter(entries))
+
+	si.Lock()
+	defer si.Unlock()
+
+	si.entries = entries
+	si.docs = docs
+	si.state = datastore.ONLINE
+
+	e := si.compact()
+	if e != nil {
+		return e
+	}
+
+	return si.saveDef()
+}
+
+func (si *secondaryIndex) defPath() string {
+	return filepath.Join(si.keyspace.indexPath(), si.name+_INDEX_DEF)
+}
+
+func (si *secondaryIndex) dataPath() string {
+	return filepath.Join(si.keyspace.indexPath(), si.name+_INDEX_DATA)
+}
+
+func (si *secondaryIndex) logPath() string {
+	return filepath.Join(si.keyspace.indexPath(), si.name+_INDEX_LOG)
+}
+
+// saveDef persists the index definition. Caller must hold the lock.
+func (si *secondaryIndex) saveDef() errors.Error {
+	def := &indexDef{
+		Name:     si.name,
+		SeekKey:  expressionStrings(si.seekKey),
+		RangeKey: expressionStrings(si.rangeKey),
+		State:    si.state,
+	}
+
+	if si.where != nil {
+		def.Where = si.where.String()
+	}
+
+	bytes, err := json.Marshal(def)
+	if err != nil {
+		return errors.NewFileDatastoreError(err, "index "+si.name)
+	}
+
+	return writeFile(si.defPath(), bytes)
+}
+
+// saveEntries writes the index entries to the data file in index
+// order. Caller must hold the lock.
+func (si *secondaryIndex) saveEntries() errors.Error {
+	buf := make([]byte, 0, 64*len(si.entries))
+	for _, ie := range si.entries {
+		pe := persistEntry(ie)
+		bytes, err := json.Marshal(&pe)
+		if err != nil {
+			return errors.NewFileDatastoreError(err, "index "+si.name)
+		}
+
+		buf = append(buf, bytes...)
+		buf = append(buf, '\n')
+	}
+
+	return writeFile(si.dataPath(), buf)
+}
+
+// appendLog records changes in the log, or compacts the log into the
+// data file once it holds more records than the index holds
+// documents. Caller must hold the lock.
+func (si *secondaryIndex) appendLog(records []*logRecord) errors.Error {
+	if len(records) == 0 {
+		return nil
+	}
+
+	logged := si.logged + len(records)
+	if logged > _INDEX_LOG_MIN && logged > len(si.docs) {
+		return si.compact()
+	}
+
+	buf := make([]byte, 0, 64*len(records))
+	for _, record := range records {
+		bytes, err := json.Marshal(record)
+		if err != nil {
+			return errors.NewFileDatastoreError(err, "index "+si.name)
+		}
+
+		buf = append(buf, bytes...)
+		buf = append(buf, '\n')
+	}
+
+	e := appendFile(si.logPath(), buf)
+	if e != nil {
+		return e
+	}
+
+	si.logged = logged
+	return nil
+}
+
+// compact writes the index entries to the data file, and discards the
+// log. A log left behind by a failure only repeats changes already in
+// the data file. Caller must hold the lock.
+func (si *secondaryIndex) compact() errors.Error {
+	e := si.saveEntries()
+	if e != nil {
+		return e
+	}
+
+	er := os.Remove(si.logPath())
+	if er != nil && !os.IsNotExist(er) {
+		return errors.NewFileDatastoreError(er, "")
+	}
+
+	si.logged = 0
+	return nil
+}
+
+// loadEntries reads the index entries from the data file, and
+// applies the changes recorded in the log.
+func (si *secondaryIndex) loadEntries() errors.Error {
+	file, er := os.Open(si.dataPath())
+	if er != nil {
+		return errors.NewFileDatastoreError(er, "")
+	}
+	defer file.Close()
+
+	entries := make([]*indexEntry, 0, 1024)
+	docs := make(map[string][]*indexEntry, 1024)
+
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 64*1024), 64*1024*1024)
+	for scanner.Scan() {
+		var pe persistedEntry
+		er = json.Unmarshal(scanner.Bytes(), &pe)
+		if er != nil {
+			return errors.NewFileIdxMetadataError(er, si.dataPath())
+		}
+
+		ie := restoreEntry(&pe)
+		entries = append(entries, ie)
+		docs[ie.id] = append(docs[ie.id], ie)
+	}
+
+	if er = scanner.Err(); er != nil {
+		return errors.NewFileDatastoreError(er, "")
+	}
+
+	// Entries are written in index order, but do not rely on it
+	if !sort.IsSorted(entrySorter(entries)) {
+		sort.Sort(entrySorter(entries))
+	}
+
+	si.entries = entries
+	si.docs = docs
+	return si.replayLog()
+}
+
+// replayLog applies the changes recorded in the log since the data
+// file was written.
+func (si *secondaryIndex) replayLog() errors.Error {
+	si.logged = 0
+
+	file, er := os.Open(si.logPath())
+	if er != nil {
+		if os.IsNotExist(er) {
+			return nil
+		}
+		return errors.NewFileDatastoreError(er, "")
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 64*1024), 64*1024*1024)
+	for scanner.Scan() {
+		var record logRecord
+		er = json.Unmarshal(scanner.Bytes(), &record)
+		if er != nil {
+			return errors.NewFileIdxMetadataError(er, si.logPath())
+		}
+
+		si.remove(record.Id)
+
+		entries := make([]*indexEntry, len(record.Entries))
+		for i := range record.Entries {
+			entries[i] = restoreEntry(&record.Entries[i])
+		}
+
+		si.insert(entries)
+		si.logged++
+	}
+
+	if er = scanner.Err(); er != nil {
+		return errors.NewFileDatastoreError(er, "")
+	}
+
+	return nil
+}
+
+// persistEntry converts an index entry to its on-disk form.
+func persistEntry(ie *indexEntry) persistedEntry {
+	pe := persistedEntry{
+		Id:  ie.id,
+		Key: make([]interface{}, len(ie.key)),
+	}
+
+	for i, k := range ie.key {
+		if k.Type() == value.MISSING {
+			pe.Missing = append(pe.Missing, i)
+		} else {
+			pe.Key[i] = k
+		}
+	}
+
+	return pe
+}
+
+// restoreEntry converts an on-disk entry to an index entry.
+func restoreEntry(pe *persistedEntry) *indexEntry {
+	ie := &indexEntry{id: pe.Id, key: make(value.Values, len(pe.Key))}
+	for i, k := range pe.Key {
+		ie.key[i] = value.NewValue(k)
+	}
+
+	for _, m := range pe.Missing {
+		if m >= 0 && m < len(ie.key) {
+			ie.key[m] = value.MISSING_VALUE
+		}
+	}
+
+	return ie
+}
+
+// loadIndex restores a secondary index from its definition file.
+func loadIndex(keyspace *keyspace, path string) (*secondaryIndex, errors.Error) {
+	bytes, er := ioutil.ReadFile(path)
+	if er != nil {
+		return nil, errors.NewFileDatastoreError(er, "")
+	}
+
+	var def indexDef
+	er = json.Unmarshal(bytes, &def)
+	if er != nil {
+		return nil, errors.NewFileIdxMetadataError(er, path)
+	}
+
+	seekKey, er := parseExpressions(def.SeekKey)
+	if er != nil {
+		return nil, errors.NewFileIdxMetadataError(er, path)
+	}
+
+	rangeKey, er := parseExpressions(def.RangeKey)
+	if er != nil || len(rangeKey) == 0 {
+		return nil, errors.NewFileIdxMetadataError(er, path)
+	}
+
+	var where expression.Expression
+	if def.Where != "" {
+		where, er = parser.Parse(def.Where)
+		if er != nil {
+			return nil, errors.NewFileIdxMetadataError(er, path)
+		}
+	}
+
+	si, e := newSecondaryIndex(keyspace, def.Name, seekKey, rangeKey, where)
+	if e != nil {
+		return nil, e
+	}
+
+	if def.State != datastore.ONLINE {
+		return si, nil
+	}
+
+	// Rebuild the index if its data file is unusable
+	e = si.loadEntries()
+	if e != nil {
+		logging.Errorp("Rebuilding file index", logging.Pair{"index", si.name},
+			logging.Pair{"error", e})
+		e = si.build()
+		if e != nil {
+			return nil, e
+		}
+	}
+
+	si.state = datastore.ONLINE
+	return si, nil
+}
+
+func expressionStrings(exprs expression.Expressions) []string {
+	if len(exprs) == 0 {
+		return nil
+	}
+
+	rv := make([]string, len(exprs))
+	for i, expr := range exprs {
+		rv[i] = expr.String()
+	}
+	return rv
+}
+
+func parseExpressions(strs []string) (expression.Expressions, error) {
+	if len(strs) == 0 {
+		return nil, nil
+	}
+
+	rv := make(expression.Expressions, len(strs))
+	for i, s := range strs {
+		expr, err := parser.Parse(s)
+		if err != nil {
+			return nil, err
+		}
+		rv[i] = expr
+	}
+	return rv, nil
+}
+
+// writeFile replaces the contents of a file via a rename, so that
+// readers never observe a partially written file.
+func writeFile(path string, bytes []byte) errors.Error {
+	tmp := path + ".tmp"
+	er := ioutil.WriteFile(tmp, bytes, 0666)
+	if er != nil {
+		return errors.NewFileDatastoreError(er, "")
+	}
+
+	er = os.Rename(tmp, path)
+	if er != nil {
+		os.Remove(tmp)
+		return errors.NewFileDatastoreError(er, "")
+	}
+
+	return nil
+}
+
+func appendFile(path string, bytes []byte) errors.Error {
+	file, er := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0666)
+	if er != nil {
+		return errors.NewFileDatastoreError(er, "")
+	}
+
+	_, er = file.Write(bytes)
+	if cer := file.Close(); er == nil {
+		er = cer
+	}
+
+	if er != nil {
+		return errors.NewFileDatastoreError(er, "")
+	}
+
+	return nil
+}
+
+// statistics describes the entries within a span.
+type statistics struct {
+	entries []*indexEntry
+}
+
+func newStatistics(entries []*indexEntry) *statistics {
+	return &statistics{entries: entries}
+}
+
+func (this *statistics) Count() (int64, errors.Error) {
+	return int64(len(this.entries)), nil
+}
+
+func (this *statistics) Min() (value.Values, errors.Error) {
+	if len(this.entries) == 0 {
+		return nil, nil
+	}
+	return this.entries[0].key, nil
+}
+
+func (this *statistics) Max() (value.Values, errors.Error) {
+	if len(this.entries) == 0 {
+		return nil, nil
+	}
+	return this.entries[len(this.entries)-1].key, nil
+}
+
+func (this *statistics) DistinctCount() (int64, errors.Error) {
+	var n int64
+	var prev value.Values
+	for _, ie := range this.entries {
+		if prev == nil || collatePrefix(ie.key, prev) != 0 {
+			n++
+		}
+		prev = ie.key
+	}
+	return n, nil
+}
+
+// Bins returns equi-depth partitions of the entries.
+func (this *statistics) Bins() ([]datastore.Statistics, errors.Error) {
+	n := len(this.entries)
+	if n == 0 {
+		return nil, nil
+	}
+
+	size := (n + _STATS_BINS - 1) / _STATS_BINS
+	rv := make([]datastore.Statistics, 0, _STATS_BINS)
+	for i := 0; i < n; i += size {
+		j := i + size
+		if j > n {
+			j = n
+		}
+		rv = append(rv, newStatistics(this.entries[i:j]))
+	}
+	return rv, nil
+}
@@ -11,11 +11,16 @@ package file
 
 import (
 	"fmt"
+	"io/ioutil"
 	"math"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/couchbase/query/datastore"
 	"github.com/couchbase/query/errors"
+	"github.com/couchbase/query/expression"
+	"github.com/couchbase/query/expression/parser"
 	"github.com/couchbase/query/value"
 )
 
@@ -181,6 +186,468 @@ func TestFile(t *testing.T) {
 
 }
 
+func TestFileIndex(t *testing.T) {
+	dir, er := ioutil.TempDir("", "file_index")
+	if er != nil {
+		t.Fatalf("failed to create temp dir: %v", er)
+	}
+	defer os.RemoveAll(dir)
+
+	ksPath := filepath.Join(dir, "default", "people")
+	os.MkdirAll(ksPath, 0777)
+	docs := map[string]string{
+		"ann":   `{"name": "ann", "age": 31, "tags": ["a", "b"]}`,
+		"bob":   `{"name": "bob", "age": 25, "tags": ["b"]}`,
+		"carl":  `{"name": "carl", "age": 40}`,
+		"dave":  `{"name": "dave"}`,
+		"nonam": `{"age": 50}`,
+	}
+	for k, d := range docs {
+		ioutil.WriteFile(filepath.Join(ksPath, k+".json"), []byte(d), 0666)
+	}
+
+	keyspace := fileKeyspace(t, dir)
+	indexer, err := keyspace.Indexer(datastore.DEFAULT)
+	if err != nil {
+		t.Fatalf("failed to get indexer: %v", err)
+	}
+
+	_, err = indexer.CreateIndex("", "ageidx", nil, parseExprs(t, "age", "name"), nil, nil)
+	if err != nil {
+		t.Fatalf("failed to create index: %v", err)
+	}
+
+	_, err = indexer.CreateIndex("", "ageidx", nil, parseExprs(t, "age"), nil, nil)
+	if err == nil {
+		t.Errorf("expected duplicate index error")
+	}
+
+	_, err = indexer.CreateIndex("", "tagidx", nil,
+		parseExprs(t, "DISTINCT ARRAY t FOR t IN tags END"), parseExprs(t, "age > 30")[0],
+		value.NewValue(map[string]interface{}{"defer_build": true}))
+	if err != nil {
+		t.Fatalf("failed to create deferred index: %v", err)
+	}
+
+	index, _ := indexer.IndexByName("tagidx")
+	if state, _, _ := index.State(); state != datastore.DEFERRED {
+		t.Errorf("expected deferred index, got %v", state)
+	}
+
+	indexes, _ := indexer.Indexes()
+	if len(indexes) != 3 {
+		t.Errorf("expected 3 indexes, got %d", len(indexes))
+	}
+
+	// Range scan: age >= 30
+	span := &datastore.Span{Range: datastore.Range{
+		Low:       value.Values{value.NewValue(30)},
+		Inclusion: datastore.LOW,
+	}}
+	checkScan(t, indexer, "ageidx", span, "ann", "carl", "nonam")
+
+	// Full scan excludes documents with a MISSING leading key
+	checkScan(t, indexer, "ageidx", nil, "bob", "ann", "carl", "nonam")
+
+	// Incremental maintenance
+	keyspace.Insert([]value.Pair{{Name: "eve", Value: value.NewValue(map[string]interface{}{"age": 35})}})
+	keyspace.Update([]value.Pair{{Name: "carl", Value: value.NewValue(map[string]interface{}{"age": 20})}})
+	keyspace.Delete([]string{"nonam"})
+	checkScan(t, indexer, "ageidx", span, "ann", "eve")
+
+	stats, _ := index.Statistics("", nil)
+	if count, _ := stats.Count(); count != 0 {
+		t.Errorf("expected no entries in deferred index, got %d", count)
+	}
+
+	err = indexer.BuildIndexes("", "tagidx")
+	if err != nil {
+		t.Fatalf("failed to build index: %v", err)
+	}
+
+	span = &datastore.Span{Range: datastore.Range{
+		Low:       value.Values{value.NewValue("b")},
+		High:      value.Values{value.NewValue("b")},
+		Inclusion: datastore.BOTH,
+	}}
+	checkScan(t, indexer, "tagidx", span, "ann")
+
+	// A non-distinct array index holds an entry per element, and
+	// removes all of them on update
+	_, err = indexer.CreateIndex("", "allidx", nil, parseExprs(t, "ALL ARRAY t FOR t IN tags END"), nil, nil)
+	if err != nil {
+		t.Fatalf("failed to create index: %v", err)
+	}
+
+	qspan := &datastore.Span{Range: datastore.Range{
+		Low:       value.Values{value.NewValue("q")},
+		High:      value.Values{value.NewValue("q")},
+		Inclusion: datastore.BOTH,
+	}}
+	keyspace.Upsert([]value.Pair{{Name: "quinn", Value: value.NewValue(map[string]interface{}{"tags": []interface{}{"q", "q"}})}})
+	checkScan(t, indexer, "allidx", qspan, "quinn", "quinn")
+	keyspace.Update([]value.Pair{{Name: "quinn", Value: value.NewValue(map[string]interface{}{"tags": []interface{}{"r"}})}})
+	checkScan(t, indexer, "allidx", qspan)
+	keyspace.Delete([]string{"quinn"})
+	checkScan(t, indexer, "allidx", nil, "ann", "ann", "bob")
+
+	// Mutations are logged rather than rewriting the data file
+	logPath := filepath.Join(ksPath, _INDEX_DIR, "ageidx"+_INDEX_LOG)
+	if _, er := os.Stat(logPath); er != nil {
+		t.Errorf("expected index log: %v", er)
+	}
+
+	// Indexes, and their logs, survive a restart
+	keyspace = fileKeyspace(t, dir)
+	indexer, _ = keyspace.Indexer(datastore.DEFAULT)
+	checkScan(t, indexer, "ageidx", nil, "carl", "bob", "ann", "eve")
+	checkScan(t, indexer, "tagidx", nil, "ann", "ann")
+	checkDistinctScan(t, indexer, "tagidx", nil, "ann")
+
+	// The log is compacted once it outgrows the index
+	bulk := make([]value.Pair, _INDEX_LOG_MIN+1)
+	keys := make([]string, len(bulk))
+	for i := range bulk {
+		keys[i] = fmt.Sprintf("bulk%04d", i)
+		bulk[i] = value.Pair{Name: keys[i], Value: value.NewValue(map[string]interface{}{"age": 99})}
+	}
+
+	keyspace.Upsert(bulk)
+	keyspace.Delete(keys)
+	if _, er := os.Stat(logPath); !os.IsNotExist(er) {
+		t.Errorf("expected compacted index log, got %v", er)
+	}
+
+	keyspace = fileKeyspace(t, dir)
+	indexer, _ = keyspace.Indexer(datastore.DEFAULT)
+	checkScan(t, indexer, "ageidx", nil, "carl", "bob", "ann", "eve")
+
+	index, _ = indexer.IndexByName("ageidx")
+	stats, _ = index.Statistics("", nil)
+	if count, _ := stats.Count(); count != 4 {
+		t.Errorf("expected 4 entries, got %d", count)
+	}
+	if min, _ := stats.Min(); min[0].Actual() != float64(20) {
+		t.Errorf("expected min 20, got %v", min)
+	}
+
+	if err = index.Drop(""); err != nil {
+		t.Errorf("failed to drop index: %v", err)
+	}
+	if _, err = indexer.IndexByName("ageidx"); err == nil {
+		t.Errorf("expected dropped index to be gone")
+	}
+	if count, _ := keyspace.Count(); count != 5 {
+		t.Errorf("expected 5 documents, got %d", count)
+	}
+}
+
+func TestFullTextIndex(t *testing.T) {
+	dir, er := ioutil.TempDir("", "file_fts")
+	if er != nil {
+		t.Fatalf("failed to create temp dir: %v", er)
+	}
+	defer os.RemoveAll(dir)
+
+	ksPath := filepath.Join(dir, "default", "people")
+	os.MkdirAll(ksPath, 0777)
+	docs := map[string]string{
+		"ann":  `{"bio": "Runs marathons and writes about running"}`,
+		"bob":  `{"bio": "Writes databases for a living; has run a marathon"}`,
+		"carl": `{"bio": "Collects stamps"}`,
+		"dave": `{"bio": 42}`,
+	}
+	for k, d := range docs {
+		ioutil.WriteFile(filepath.Join(ksPath, k+".json"), []byte(d), 0666)
+	}
+
+	keyspace := fileKeyspace(t, dir)
+	indexer, err := keyspace.Indexer(datastore.FTS)
+	if err != nil {
+		t.Fatalf("failed to get indexer: %v", err)
+	}
+
+	_, err = indexer.CreateIndex("", "bioidx", nil, parseExprs(t, "bio", "name"), nil, nil)
+	if err == nil {
+		t.Errorf("expected error creating full-text index on two keys")
+	}
+
+	_, err = indexer.CreateIndex("", "bioidx", nil, parseExprs(t, "bio"), nil, nil)
+	if err != nil {
+		t.Fatalf("failed to create index: %v", err)
+	}
+
+	index, _ := indexer.IndexByName("bioidx")
+	if index.Type() != datastore.FTS {
+		t.Errorf("expected full-text index, got %v", index.Type())
+	}
+
+	secondary, _ := keyspace.Indexer(datastore.DEFAULT)
+	if _, err = secondary.CreateIndex("", "bioidx", nil, parseExprs(t, "bio"), nil, nil); err == nil {
+		t.Errorf("expected duplicate index error")
+	}
+
+	search := func(query string) *datastore.Span {
+		return &datastore.Span{Range: datastore.Range{
+			Low:       value.Values{value.NewValue(query)},
+			High:      value.Values{value.NewValue(query)},
+			Inclusion: datastore.BOTH,
+		}}
+	}
+
+	// Stemmed terms match; the document with more occurrences of the
+	// term scores higher
+	checkScan(t, indexer, "bioidx", search("running"), "ann", "bob")
+	checkScan(t, indexer, "bioidx", search("marathon writes"), "ann", "bob")
+	checkScan(t, indexer, "bioidx", search("Databases"), "bob")
+	checkScan(t, indexer, "bioidx", search("run stamps"))
+	checkScan(t, indexer, "bioidx", search("the"))
+
+	// Incremental maintenance
+	keyspace.Insert([]value.Pair{{Name: "eve", Value: value.NewValue(map[string]interface{}{
+		"bio": "Stamps, stamps and more stamps"})}})
+	keyspace.Update([]value.Pair{{Name: "ann", Value: value.NewValue(map[string]interface{}{
+		"bio": "Retired"})}})
+	keyspace.Delete([]string{"carl"})
+	checkScan(t, indexer, "bioidx", search("stamp"), "eve")
+	checkScan(t, indexer, "bioidx", search("running"), "bob")
+
+	// Mutations are logged rather than rewriting the data file
+	logPath := filepath.Join(ksPath, _INDEX_DIR, "bioidx"+_FTS_LOG)
+	if _, er := os.Stat(logPath); er != nil {
+		t.Errorf("expected index log: %v", er)
+	}
+
+	// Indexes, and their logs, survive a restart
+	keyspace = fileKeyspace(t, dir)
+	indexer, _ = keyspace.Indexer(datastore.FTS)
+	checkScan(t, indexer, "bioidx", search("retire"), "ann")
+	checkScan(t, indexer, "bioidx", search("stamp"), "eve")
+	checkScan(t, indexer, "bioidx", search("running"), "bob")
+
+	// The log is compacted once it outgrows the index
+	bulk := make([]value.Pair, _INDEX_LOG_MIN+1)
+	keys := make([]string, len(bulk))
+	for i := range bulk {
+		keys[i] = fmt.Sprintf("bulk%04d", i)
+		bulk[i] = value.Pair{Name: keys[i], Value: value.NewValue(map[string]interface{}{"bio": "Bulk"})}
+	}
+
+	keyspace.Upsert(bulk)
+	keyspace.Delete(keys)
+	if _, er := os.Stat(logPath); !os.IsNotExist(er) {
+		t.Errorf("expected compacted index log, got %v", er)
+	}
+
+	keyspace = fileKeyspace(t, dir)
+	indexer, _ = keyspace.Indexer(datastore.FTS)
+	checkScan(t, indexer, "bioidx", search("retire"), "ann")
+	checkScan(t, indexer, "bioidx", search("bulk"))
+
+	index, _ = indexer.IndexByName("bioidx")
+	stats, _ := index.Statistics("", search("stamps"))
+	if count, _ := stats.Count(); count != 1 {
+		t.Errorf("expected 1 match, got %d", count)
+	}
+
+	if err = index.Drop(""); err != nil {
+		t.Errorf("failed to drop index: %v", err)
+	}
+	if _, err = indexer.IndexByName("bioidx"); err == nil {
+		t.Errorf("expected dropped index to be gone")
+	}
+}
+
+func TestInfer(t *testing.T) {
+	store, err := NewDatastore("../../test/filestore/json")
+	if err != nil {
+		t.Fatalf("failed to create store: %v", err)
+	}
+
+	namespace, err := store.NamespaceByName("default")
+	if err != nil {
+		t.Fatalf("failed to get namespace: %v", err)
+	}
+
+	keyspace, err := namespace.KeyspaceByName("contacts")
+	if err != nil {
+		t.Fatalf("failed to get keyspace: %v", err)
+	}
+
+	inferencer, err := store.Inferencer(datastore.INF_DEFAULT)
+	if err != nil {
+		t.Fatalf("failed to get inferencer: %v", err)
+	}
+
+	flavors, errs := infer(inferencer, keyspace, `{"num_sample_values": 2, "similarity_metric": 0}`)
+	if len(errs) != 0 || len(flavors) != 1 {
+		t.Fatalf("expected one flavor, got %v, errors %v", flavors, errs)
+	}
+
+	schema := flavors[0].(map[string]interface{})
+	if schema["#docs"] != int64(6) || schema["type"] != "object" || schema["Flavor"] != "`type` = \"contact\"" {
+		t.Errorf("unexpected schema %v", schema)
+	}
+
+	properties := schema["properties"].(map[string]interface{})
+	name := properties["name"].(map[string]interface{})
+	if name["%docs"] != 100.0 || name["type"] != "string" ||
+		fmt.Sprint(name["samples"]) != "[dave earl]" {
+		t.Errorf("unexpected schema of name %v", name)
+	}
+
+	hobbies := properties["hobbies"].(map[string]interface{})
+	if hobbies["#docs"] != int64(4) || hobbies["type"] != "array" ||
+		hobbies["items"].(map[string]interface{})["type"] != "string" {
+		t.Errorf("unexpected schema of hobbies %v", hobbies)
+	}
+
+	children := properties["children"].(map[string]interface{})
+	child := children["items"].(map[string]interface{})["properties"].(map[string]interface{})
+	if child["age"].(map[string]interface{})["type"] != "number" {
+		t.Errorf("unexpected schema of children %v", children)
+	}
+
+	// Documents with different fields are different flavors
+	flavors, errs = infer(inferencer, keyspace, `{"similarity_metric": 1}`)
+	if len(errs) != 0 || len(flavors) < 2 {
+		t.Errorf("expected several flavors, got %v, errors %v", flavors, errs)
+	}
+
+	flavors, errs = infer(inferencer, keyspace, `{"sample_size": 2}`)
+	if len(errs) != 0 || len(flavors) != 1 || flavors[0].(map[string]interface{})["#docs"] != int64(2) {
+		t.Errorf("expected a sample of 2 documents, got %v, errors %v", flavors, errs)
+	}
+
+	for _, with := range []string{
+		`{"sample_size": 0}`,
+		`{"num_sample_values": "all"}`,
+		`{"similarity_metric": 2}`,
+	} {
+		_, errs = infer(inferencer, keyspace, with)
+		if len(errs) == 0 {
+			t.Errorf("expected error for %s", with)
+		}
+	}
+}
+
+func TestFunctions(t *testing.T) {
+	dir, er := ioutil.TempDir("", "file_functions")
+	if er != nil {
+		t.Fatalf("failed to create temp dir: %v", er)
+	}
+	defer os.RemoveAll(dir)
+
+	store, err := NewDatastore(dir)
+	if err != nil {
+		t.Fatalf("failed to create store: %v", err)
+	}
+
+	functions := store.(datastore.FunctionStore)
+	for _, f := range []*datastore.FunctionDefinition{
+		{Name: "inc", Parameters: []string{"n"}, Body: "(`n` + 1)"},
+		{Name: "dec", Parameters: []string{"n"}, Body: "(`n` - 1)"},
+		{Name: "inc", Parameters: []string{"m"}, Body: "(`m` + 2)"},
+	} {
+		err = functions.SetFunction(f)
+		if err != nil {
+			t.Fatalf("failed to set function: %v", err)
+		}
+	}
+
+	// Functions are persisted, and a function replaces its previous definition
+	store, err = NewDatastore(dir)
+	if err != nil {
+		t.Fatalf("failed to create store: %v", err)
+	}
+
+	functions = store.(datastore.FunctionStore)
+	defs, err := functions.Functions()
+	if err != nil || len(defs) != 2 || defs[0].Name != "dec" || defs[1].Body != "(`m` + 2)" {
+		t.Errorf("unexpected functions %v, err %v", defs, err)
+	}
+
+	for _, name := range []string{"inc", "dec"} {
+		err = functions.DeleteFunction(name)
+		if err != nil {
+			t.Fatalf("failed to delete function: %v", err)
+		}
+	}
+
+	defs, err = functions.Functions()
+	if err != nil || len(defs) != 0 {
+		t.Errorf("unexpected functions %v, err %v", defs, err)
+	}
+
+	if _, er := os.Stat(filepath.Join(dir, "functions.json")); !os.IsNotExist(er) {
+		t.Errorf("expected the functions file to be removed, got %v", er)
+	}
+}
+
+func fileKeyspace(t *testing.T, dir string) datastore.Keyspace {
+	store, err := NewDatastore(dir)
+	if err != nil {
+		t.Fatalf("failed to create store: %v", err)
+	}
+
+	namespace, err := store.NamespaceByName("default")
+	if err != nil {
+		t.Fatalf("failed to get namespace: %v", err)
+	}
+
+	keyspace, err := namespace.KeyspaceByName("people")
+	if err != nil {
+		t.Fatalf("failed to get keyspace: %v", err)
+	}
+
+	return keyspace
+}
+
+func parseExprs(t *testing.T, strs ...string) expression.Expressions {
+	exprs := make(expression.Expressions, len(strs))
+	for i, s := range strs {
+		expr, err := parser.Parse(s)
+		if err != nil {
+			t.Fatalf("failed to parse %s: %v", s, err)
+		}
+		exprs[i] = expr
+	}
+	return exprs
+}
+
+func checkScan(t *testing.T, indexer datastore.Indexer, name string, span *datastore.Span, expected ...string) {
+	checkIndexScan(t, indexer, name, span, false, expected...)
+}
+
+func checkDistinctScan(t *testing.T, indexer datastore.Indexer, name string, span *datastore.Span,
+	expected ...string) {
+	checkIndexScan(t, indexer, name, span, true, expected...)
+}
+
+func checkIndexScan(t *testing.T, indexer datastore.Indexer, name string, span *datastore.Span, distinct bool,
+	expected ...string) {
+	index, err := indexer.IndexByName(name)
+	if err != nil {
+		t.Fatalf("failed to get index %s: %v", name, err)
+	}
+
+	if span == nil {
+		span = &datastore.Span{}
+	}
+
+	conn := datastore.NewIndexConnection(&testingContext{t})
+	go index.Scan("", span, distinct, math.MaxInt64, datastore.UNBOUNDED, nil, conn)
+
+	var keys []string
+	for entry := range conn.EntryChannel() {
+		keys = append(keys, entry.PrimaryKey)
+	}
+
+	if fmt.Sprint(keys) != fmt.Sprint(expected) {
+		t.Errorf("index %s: expected %v, got %v", name, expected, keys)
+	}
+}
+
 type testingContext struct {
 	t *testing.T
 }
@@ -196,3 +663,33 @@ func (this *testingContext) Warning(wrn errors.Error) {
 func (this *testingContext) Fatal(fatal errors.Error) {
 	this.t.Logf("scan fatal: %v", fatal)
 }
+
+func infer(inferencer datastore.Inferencer, keyspace datastore.Keyspace, with string) (
+	[]interface{}, []errors.Error) {
+	context := &recordingContext{}
+	conn := datastore.NewValueConnection(context)
+	go inferencer.InferKeyspace(keyspace, value.NewValue([]byte(with)), conn)
+
+	var flavors []interface{}
+	for v := range conn.ValueChannel() {
+		flavors = v.Actual().([]interface{})
+	}
+
+	return flavors, context.errors
+}
+
+// Records the errors of an operation instead of logging them.
+type recordingContext struct {
+	errors []errors.Error
+}
+
+func (this *recordingContext) Error(err errors.Error) {
+	this.errors = append(this.errors, err)
+}
+
+func (this *recordingContext) Warning(wrn errors.Error) {
+}
+
+func (this *recordingContext) Fatal(fatal errors.Error) {
+	this.errors = append(this.errors, fatal)
+}
@@ -0,0 +1,32 @@
+//  Copyright (c) 2016 Couchbase, Inc.
+//  Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file
+//  except in compliance with the License. You may obtain a copy of the License at
+//    http://www.apache.org/licenses/LICENSE-2.0
+//  Unless required by applicable law or agreed to in writing, software distributed under the
+//  License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+//  either express or implied. See the License for the specific language governing permissions
+//  and limitations under the License.
+
+package datastore
+
+import (
+	"github.com/couchbase/query/errors"
+)
+
+// The definition of a function created with CREATE FUNCTION, as
+// persisted by a FunctionStore. The body is kept as N1QL text, and
+// is parsed again when the functions are loaded.
+type FunctionDefinition struct {
+	Name       string   `json:"name"`
+	Parameters []string `json:"parameters"`
+	Body       string   `json:"body"`
+}
+
+// FunctionStore is implemented by datastores that persist the
+// functions created with CREATE FUNCTION, so that they are loaded
+// again when the query service starts.
+type FunctionStore interface {
+	SetFunction(definition *FunctionDefinition) errors.Error // Persist a function
+	DeleteFunction(name string) errors.Error                 // Remove a persisted function
+	Functions() ([]*FunctionDefinition, errors.Error)        // All the persisted functions
+}
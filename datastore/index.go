@@ -20,10 +20,17 @@ import (
 
 type IndexType string
 
+// Index types. A full-text index has a single key, whose text it
+// analyzes with expression.SearchTerms. A scan takes the search
+// query as the low and high bound of its span, and returns the
+// documents containing every term of the query, in descending order
+// of relevance. The only entry key is the score, which the scan
+// attaches to the document for SEARCH_SCORE().
 const (
 	DEFAULT IndexType = "default" // default may vary per backend
 	VIEW    IndexType = "view"    // view index
 	GSI     IndexType = "gsi"     // global secondary index
+	FTS     IndexType = "fts"     // full-text search index
 )
 
 type Indexer interface {
@@ -43,6 +50,50 @@ type Indexer interface {
 	SetLogLevel(level logging.Level)                            // Set log level for in-process logging
 }
 
+// VersionedIndexer is implemented by indexers that version their index
+// metadata. The version changes whenever any query node creates, drops,
+// builds or alters an index of the indexer, and is current as of the
+// latest Refresh().
+type VersionedIndexer interface {
+	Indexer
+	MetadataVersion() uint64 // Version of the index metadata
+}
+
+// IndexerVersions holds the metadata versions of the indexers whose
+// indexes a plan was chosen from, as of the time they were read.
+type IndexerVersions map[VersionedIndexer]uint64
+
+// Records the version of the indexer, if it is versioned. Called
+// before reading its indexes.
+func (this IndexerVersions) Add(indexer Indexer) {
+	if vi, ok := indexer.(VersionedIndexer); ok {
+		if _, ok := this[vi]; !ok {
+			this[vi] = vi.MetadataVersion()
+		}
+	}
+}
+
+// Returns whether the metadata of any of the indexers has changed.
+func (this IndexerVersions) Changed() bool {
+	for indexer, version := range this {
+		if indexer.MetadataVersion() != version {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Reloads the indexers from their metadata.
+func (this IndexerVersions) Refresh() {
+	for indexer, _ := range this {
+		if err := indexer.Refresh(); err != nil {
+			logging.Errorp("Indexer refresh", logging.Pair{"keyspace", indexer.KeyspaceId()},
+				logging.Pair{"error", err})
+		}
+	}
+}
+
 type IndexState string
 
 const (
@@ -177,6 +228,19 @@ func GetScanCap() int64 {
 	return atomic.LoadInt64(&scanCap)
 }
 
+// Version of the index metadata, incremented whenever this node creates,
+// drops, builds or alters an index, or gathers statistics. Plans cached
+// under an earlier version are rebuilt.
+var indexVersion atomic.AlignedUint64
+
+func IndexesChanged() {
+	atomic.AddUint64(&indexVersion, 1)
+}
+
+func IndexVersion() uint64 {
+	return atomic.LoadUint64(&indexVersion)
+}
+
 func NewSizedIndexConnection(size int64, context Context) (*IndexConnection, errors.Error) {
 	if size <= 0 {
 		return nil, errors.NewIndexScanSizeError(size)
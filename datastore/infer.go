@@ -0,0 +1,523 @@
+//  Copyright (c) 2016 Couchbase, Inc.
+//  Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file
+//  except in compliance with the License. You may obtain a copy of the License at
+//    http://www.apache.org/licenses/LICENSE-2.0
+//  Unless required by applicable law or agreed to in writing, software distributed under the
+//  License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+//  either express or implied. See the License for the specific language governing permissions
+//  and limitations under the License.
+
+package datastore
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/couchbase/query/errors"
+	"github.com/couchbase/query/value"
+)
+
+// Options of the default inferencer, given in the WITH clause of
+// INFER. Options of other inferencers are ignored.
+const (
+	INFER_SAMPLE_SIZE       = "sample_size"       // Number of documents sampled
+	INFER_NUM_SAMPLE_VALUES = "num_sample_values" // Number of sample values kept for each field
+	INFER_SIMILARITY_METRIC = "similarity_metric" // Similarity of the fields of documents of the same flavor
+)
+
+const (
+	_DEF_SAMPLE_SIZE       = 1000
+	_DEF_NUM_SAMPLE_VALUES = 5
+	_DEF_SIMILARITY_METRIC = 0.6
+)
+
+const _SCHEMA = "http://json-schema.org/schema#"
+
+// Random documents are drawn until this many draws in a row return
+// documents already sampled.
+const _MAX_RANDOM_DUPLICATES = 32
+
+// The default schema inferencer. It only uses the Keyspace interface,
+// so it works with any datastore. Documents are sampled at random if
+// the keyspace is a RandomEntryProvider, and from the primary index
+// otherwise. The sampled documents are grouped into flavors of
+// similar documents, and the result is an array holding the
+// JSON-schema-like description of each flavor.
+type defaultInferencer struct {
+}
+
+func NewDefaultInferencer() Inferencer {
+	return &defaultInferencer{}
+}
+
+var _DEFAULT_INFERENCER = NewDefaultInferencer()
+
+// Inferencer by name, for datastores that only provide the default
+// inferencer.
+func DefaultInferencer(name InferenceType) (Inferencer, errors.Error) {
+	if name != INF_DEFAULT {
+		return nil, errors.NewOtherNotImplementedError(nil, "INFER USING "+string(name))
+	}
+
+	return _DEFAULT_INFERENCER, nil
+}
+
+func DefaultInferencers() ([]Inferencer, errors.Error) {
+	return []Inferencer{_DEFAULT_INFERENCER}, nil
+}
+
+func (this *defaultInferencer) Name() InferenceType {
+	return INF_DEFAULT
+}
+
+func (this *defaultInferencer) InferKeyspace(ks Keyspace, with value.Value, conn *ValueConnection) {
+	defer close(conn.ValueChannel())
+
+	options, err := newInferOptions(with)
+	if err != nil {
+		conn.Error(err)
+		return
+	}
+
+	var docs []value.Value
+	if random, ok := ks.(RandomEntryProvider); ok {
+		docs, err = sampleRandom(random, options.sampleSize, conn)
+	} else {
+		docs, err = samplePrimary(ks, options.sampleSize, conn)
+	}
+
+	if err != nil {
+		conn.Error(err)
+		return
+	}
+
+	if docs == nil {
+		return
+	}
+
+	if len(docs) == 0 {
+		conn.Error(errors.NewInferNoDocumentsError(ks.Name()))
+		return
+	}
+
+	flavors := inferFlavors(docs, options)
+	schemas := make([]interface{}, len(flavors))
+	for i, flavor := range flavors {
+		schemas[i] = flavor.schema(options)
+	}
+
+	select {
+	case conn.ValueChannel() <- value.NewValue(schemas):
+	case <-conn.StopChannel():
+	}
+}
+
+type inferOptions struct {
+	sampleSize       int
+	numSampleValues  int
+	similarityMetric float64
+}
+
+func newInferOptions(with value.Value) (*inferOptions, errors.Error) {
+	rv := &inferOptions{
+		sampleSize:       _DEF_SAMPLE_SIZE,
+		numSampleValues:  _DEF_NUM_SAMPLE_VALUES,
+		similarityMetric: _DEF_SIMILARITY_METRIC,
+	}
+
+	if with == nil {
+		return rv, nil
+	}
+
+	if with.Type() != value.OBJECT {
+		return nil, errors.NewInferInvalidOptionError("WITH", "must be an object.")
+	}
+
+	if v, ok := with.Field(INFER_SAMPLE_SIZE); ok {
+		n, ok := inferInt(v)
+		if !ok || n <= 0 {
+			return nil, errors.NewInferInvalidOptionError(INFER_SAMPLE_SIZE,
+				"must be a positive integer.")
+		}
+		rv.sampleSize = int(n)
+	}
+
+	if v, ok := with.Field(INFER_NUM_SAMPLE_VALUES); ok {
+		n, ok := inferInt(v)
+		if !ok || n < 0 {
+			return nil, errors.NewInferInvalidOptionError(INFER_NUM_SAMPLE_VALUES,
+				"must be a non-negative integer.")
+		}
+		rv.numSampleValues = int(n)
+	}
+
+	if v, ok := with.Field(INFER_SIMILARITY_METRIC); ok {
+		n := value.AsNumberValue(v)
+		if n == nil || n.Float64() < 0.0 || n.Float64() > 1.0 {
+			return nil, errors.NewInferInvalidOptionError(INFER_SIMILARITY_METRIC,
+				"must be a number between 0 and 1.")
+		}
+		rv.similarityMetric = n.Float64()
+	}
+
+	return rv, nil
+}
+
+func inferInt(v value.Value) (int64, bool) {
+	n := value.AsNumberValue(v)
+	if n == nil {
+		return 0, false
+	}
+
+	return value.IsInt(n)
+}
+
+// Draws random documents until sampleSize distinct ones are sampled,
+// or the draws keep returning documents already sampled. Returns nil
+// if the inferencer was stopped.
+func sampleRandom(random RandomEntryProvider, sampleSize int, conn *ValueConnection) (
+	[]value.Value, errors.Error) {
+	keys := make(map[string]bool, sampleSize)
+	docs := make([]value.Value, 0, sampleSize)
+
+	for duplicates := 0; len(docs) < sampleSize && duplicates < _MAX_RANDOM_DUPLICATES; {
+		if stopped(conn.StopChannel()) {
+			return nil, nil
+		}
+
+		key, doc, err := random.GetRandomEntry()
+		if err != nil {
+			return nil, err
+		}
+
+		if doc == nil || keys[key] {
+			duplicates++
+			continue
+		}
+
+		duplicates = 0
+		keys[key] = true
+		docs = append(docs, doc)
+	}
+
+	return docs, nil
+}
+
+// Scans the first sampleSize keys of an online primary index and
+// fetches their documents. Returns nil if the inferencer was stopped.
+func samplePrimary(ks Keyspace, sampleSize int, conn *ValueConnection) ([]value.Value, errors.Error) {
+	primary, err := inferPrimaryIndex(ks)
+	if err != nil {
+		return nil, err
+	}
+
+	indexConn := NewIndexConnection(conn)
+	defer stop(indexConn.StopChannel())
+
+	go primary.ScanEntries("", int64(sampleSize), UNBOUNDED, nil, indexConn)
+
+	keys := make([]string, 0, sampleSize)
+	var entry *IndexEntry
+	ok := true
+	for ok {
+		select {
+		case entry, ok = <-indexConn.EntryChannel():
+			if ok && len(keys) < sampleSize {
+				keys = append(keys, entry.PrimaryKey)
+			}
+		case <-conn.StopChannel():
+			return nil, nil
+		}
+	}
+
+	docs := make([]value.Value, 0, len(keys))
+	pairs, errs := ks.Fetch(keys)
+	for _, err := range errs {
+		if err.IsFatal() {
+			return nil, err
+		}
+	}
+
+	for _, pair := range pairs {
+		if pair.Value != nil {
+			docs = append(docs, pair.Value)
+		}
+	}
+
+	return docs, nil
+}
+
+func inferPrimaryIndex(ks Keyspace) (PrimaryIndex, errors.Error) {
+	indexers, err := ks.Indexers()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, indexer := range indexers {
+		primaries, err := indexer.PrimaryIndexes()
+		if err != nil {
+			return nil, err
+		}
+
+		for _, primary := range primaries {
+			state, _, err := primary.State()
+			if err != nil {
+				return nil, err
+			}
+
+			if state == ONLINE {
+				return primary, nil
+			}
+		}
+	}
+
+	return nil, errors.NewInferNoDocumentsError(
+		fmt.Sprintf("%s: no online primary index.", ks.Name()))
+}
+
+func stopped(stopChannel StopChannel) bool {
+	select {
+	case <-stopChannel:
+		return true
+	default:
+		return false
+	}
+}
+
+func stop(stopChannel StopChannel) {
+	select {
+	case stopChannel <- false:
+	default:
+	}
+}
+
+// A group of sampled documents with similar top-level fields.
+type inferFlavor struct {
+	fields    map[string]bool        // Top-level fields of the documents of the flavor
+	constants map[string]value.Value // Top-level fields with the same value in every document
+	root      *inferNode
+}
+
+// Documents are grouped with the first flavor whose fields are similar
+// enough to theirs. Similarity is the number of fields in common over
+// the number of fields in either. Non-object documents have a flavor
+// of their own.
+func inferFlavors(docs []value.Value, options *inferOptions) []*inferFlavor {
+	flavors := make([]*inferFlavor, 0, 4)
+
+	for _, doc := range docs {
+		fields := make(map[string]bool)
+		if doc.Type() == value.OBJECT {
+			for name, _ := range doc.Fields() {
+				fields[name] = true
+			}
+		}
+
+		var flavor *inferFlavor
+		for _, f := range flavors {
+			if (doc.Type() == value.OBJECT) == (f.constants != nil) &&
+				similarity(f.fields, fields) >= options.similarityMetric {
+				flavor = f
+				break
+			}
+		}
+
+		if flavor == nil {
+			flavor = &inferFlavor{
+				fields: make(map[string]bool),
+				root:   newInferNode(),
+			}
+			if doc.Type() == value.OBJECT {
+				flavor.constants = make(map[string]value.Value, len(fields))
+				for name, _ := range fields {
+					flavor.constants[name], _ = doc.Field(name)
+				}
+			}
+			flavors = append(flavors, flavor)
+		}
+
+		flavor.add(doc, fields, options)
+	}
+
+	sort.Stable(inferFlavorsByDocs(flavors))
+	return flavors
+}
+
+func similarity(fields1, fields2 map[string]bool) float64 {
+	if len(fields1) == 0 && len(fields2) == 0 {
+		return 1.0
+	}
+
+	common := 0
+	for name, _ := range fields2 {
+		if fields1[name] {
+			common++
+		}
+	}
+
+	return float64(common) / float64(len(fields1)+len(fields2)-common)
+}
+
+func (this *inferFlavor) add(doc value.Value, fields map[string]bool, options *inferOptions) {
+	for name, _ := range fields {
+		this.fields[name] = true
+	}
+
+	for name, constant := range this.constants {
+		v, ok := doc.Field(name)
+		if !ok || !constant.Equals(v).Truth() {
+			delete(this.constants, name)
+		}
+	}
+
+	this.root.add(doc, options)
+}
+
+func (this *inferFlavor) schema(options *inferOptions) map[string]interface{} {
+	rv := this.root.schema(0)
+	delete(rv, "%docs")
+	rv["$schema"] = _SCHEMA
+	rv["Flavor"] = this.description()
+	return rv
+}
+
+// The flavor of a group of documents is described by the top-level
+// fields that have the same scalar value in all of them, such as a
+// document type. A single document is not described.
+func (this *inferFlavor) description() string {
+	if this.root.docs < 2 {
+		return ""
+	}
+
+	names := make([]string, 0, len(this.constants))
+	for name, constant := range this.constants {
+		if constant.Type() != value.ARRAY && constant.Type() != value.OBJECT {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	terms := make([]string, len(names))
+	for i, name := range names {
+		bytes, _ := this.constants[name].MarshalJSON()
+		terms[i] = fmt.Sprintf("`%s` = %s", name, bytes)
+	}
+
+	return strings.Join(terms, ", ")
+}
+
+type inferFlavorsByDocs []*inferFlavor
+
+func (this inferFlavorsByDocs) Len() int           { return len(this) }
+func (this inferFlavorsByDocs) Less(i, j int) bool { return this[i].root.docs > this[j].root.docs }
+func (this inferFlavorsByDocs) Swap(i, j int)      { this[i], this[j] = this[j], this[i] }
+
+// The merged description of all the values found at one place of the
+// sampled documents: a field, or the elements of an array.
+type inferNode struct {
+	docs       int64                 // Number of values
+	types      map[value.Type]int64  // Number of values of each type
+	samples    value.Values          // Distinct scalar values, up to num_sample_values
+	properties map[string]*inferNode // Fields of the object values
+	items      *inferNode            // Elements of the array values
+}
+
+func newInferNode() *inferNode {
+	return &inferNode{
+		types: make(map[value.Type]int64, 1),
+	}
+}
+
+func (this *inferNode) add(v value.Value, options *inferOptions) {
+	this.docs++
+	this.types[v.Type()]++
+
+	switch v.Type() {
+	case value.OBJECT:
+		if this.properties == nil {
+			this.properties = make(map[string]*inferNode)
+		}
+		for name, field := range v.Fields() {
+			property, ok := this.properties[name]
+			if !ok {
+				property = newInferNode()
+				this.properties[name] = property
+			}
+			property.add(value.NewValue(field), options)
+		}
+	case value.ARRAY:
+		if this.items == nil {
+			this.items = newInferNode()
+		}
+		for _, item := range v.Actual().([]interface{}) {
+			this.items.add(value.NewValue(item), options)
+		}
+	default:
+		if len(this.samples) >= options.numSampleValues {
+			return
+		}
+		for _, sample := range this.samples {
+			if sample.Equals(v).Truth() {
+				return
+			}
+		}
+		this.samples = append(this.samples, v)
+	}
+}
+
+// The description of this node. Its frequency is relative to the
+// number of values of the enclosing object or array.
+func (this *inferNode) schema(parentDocs int64) map[string]interface{} {
+	rv := map[string]interface{}{
+		"#docs": this.docs,
+	}
+
+	if parentDocs > 0 {
+		rv["%docs"] = 100.0 * float64(this.docs) / float64(parentDocs)
+	}
+
+	names := make([]string, 0, len(this.types))
+	for t, _ := range this.types {
+		names = append(names, t.String())
+	}
+	sort.Strings(names)
+	if len(names) == 1 {
+		rv["type"] = names[0]
+	} else {
+		types := make([]interface{}, len(names))
+		for i, name := range names {
+			types[i] = name
+		}
+		rv["type"] = types
+	}
+
+	if len(this.samples) > 0 {
+		sort.Sort(inferSamples(this.samples))
+		samples := make([]interface{}, len(this.samples))
+		for i, sample := range this.samples {
+			samples[i] = sample.Actual()
+		}
+		rv["samples"] = samples
+	}
+
+	if this.properties != nil {
+		properties := make(map[string]interface{}, len(this.properties))
+		for name, property := range this.properties {
+			properties[name] = property.schema(this.types[value.OBJECT])
+		}
+		rv["properties"] = properties
+	}
+
+	if this.items != nil {
+		rv["items"] = this.items.schema(0)
+	}
+
+	return rv
+}
+
+type inferSamples value.Values
+
+func (this inferSamples) Len() int           { return len(this) }
+func (this inferSamples) Less(i, j int) bool { return this[i].Collate(this[j]) < 0 }
+func (this inferSamples) Swap(i, j int)      { this[i], this[j] = this[j], this[i] }
@@ -19,8 +19,10 @@ package mock
 
 import (
 	"fmt"
+	"sort"
 	"strconv"
 	"strings"
+	"sync"
 
 	"github.com/couchbase/query/datastore"
 	"github.com/couchbase/query/errors"
@@ -38,10 +40,12 @@ const (
 
 // store is the root for the mock-based Store.
 type store struct {
+	sync.RWMutex
 	path           string
 	namespaces     map[string]*namespace
 	namespaceNames []string
 	params         map[string]int
+	statistics     map[string]*datastore.KeyspaceStatistics
 }
 
 func (s *store) Id() string {
@@ -82,11 +86,41 @@ func (s *store) SetLogLevel(level logging.Level) {
 }
 
 func (s *store) Inferencer(name datastore.InferenceType) (datastore.Inferencer, errors.Error) {
-	return nil, errors.NewOtherNotImplementedError(nil, "INFER")
+	return datastore.DefaultInferencer(name)
 }
 
 func (s *store) Inferencers() ([]datastore.Inferencer, errors.Error) {
-	return nil, errors.NewOtherNotImplementedError(nil, "INFER")
+	return datastore.DefaultInferencers()
+}
+
+func (s *store) SetKeyspaceStatistics(stats *datastore.KeyspaceStatistics) errors.Error {
+	s.Lock()
+	defer s.Unlock()
+
+	if s.statistics == nil {
+		s.statistics = make(map[string]*datastore.KeyspaceStatistics)
+	}
+
+	s.statistics[stats.Namespace+"/"+stats.Keyspace] = stats
+	return nil
+}
+
+func (s *store) KeyspaceStatistics(namespace, keyspace string) (*datastore.KeyspaceStatistics, errors.Error) {
+	s.RLock()
+	defer s.RUnlock()
+	return s.statistics[namespace+"/"+keyspace], nil
+}
+
+func (s *store) AllKeyspaceStatistics() ([]*datastore.KeyspaceStatistics, errors.Error) {
+	s.RLock()
+	defer s.RUnlock()
+
+	rv := make([]*datastore.KeyspaceStatistics, 0, len(s.statistics))
+	for _, stats := range s.statistics {
+		rv = append(rv, stats)
+	}
+
+	return rv, nil
 }
 
 // namespace represents a mock-based Namespace.
@@ -130,12 +164,16 @@ func (p *namespace) KeyspaceByName(name string) (b datastore.Keyspace, e errors.
 	return
 }
 
-// keyspace is a mock-based keyspace.
+// keyspace is a mock-based keyspace. Its documents are generated, and
+// overlaid with the mutations of committed transactions.
 type keyspace struct {
-	namespace *namespace
-	name      string
-	nitems    int
-	mi        datastore.Indexer
+	sync.RWMutex
+	namespace  *namespace
+	name       string
+	nitems     int
+	mi         datastore.Indexer
+	commitLock sync.Mutex
+	mutations  map[string]value.Value // nil values are deleted documents
 }
 
 func (b *keyspace) NamespaceId() string {
@@ -151,7 +189,21 @@ func (b *keyspace) Name() string {
 }
 
 func (b *keyspace) Count() (int64, errors.Error) {
-	return int64(b.nitems), nil
+	b.RLock()
+	defer b.RUnlock()
+
+	count := int64(b.nitems)
+	for key, val := range b.mutations {
+		generated := b.generated(key)
+		switch {
+		case val == nil && generated:
+			count--
+		case val != nil && !generated:
+			count++
+		}
+	}
+
+	return count, nil
 }
 
 func (b *keyspace) Indexer(name datastore.IndexType) (datastore.Indexer, errors.Error) {
@@ -175,12 +227,14 @@ func (b *keyspace) Fetch(keys []string) ([]value.AnnotatedPair, []errors.Error)
 			continue
 		}
 
-		if item != nil {
-			item.SetAttachment("meta", map[string]interface{}{
-				"id": k,
-			})
+		if item == nil {
+			continue
 		}
 
+		item.SetAttachment("meta", map[string]interface{}{
+			"id": k,
+		})
+
 		rv = append(rv, value.AnnotatedPair{
 			Name:  k,
 			Value: item,
@@ -190,6 +244,17 @@ func (b *keyspace) Fetch(keys []string) ([]value.AnnotatedPair, []errors.Error)
 }
 
 func (b *keyspace) fetchOne(key string) (value.AnnotatedValue, errors.Error) {
+	b.RLock()
+	val, ok := b.mutations[key]
+	b.RUnlock()
+
+	if ok {
+		if val == nil {
+			return nil, nil
+		}
+		return value.NewAnnotatedValue(val.Copy()), nil
+	}
+
 	i, e := strconv.Atoi(key)
 	if e != nil {
 		return nil, errors.NewOtherKeyNotFoundError(e, fmt.Sprintf("no mock item: %v", key))
@@ -201,7 +266,7 @@ func (b *keyspace) fetchOne(key string) (value.AnnotatedValue, errors.Error) {
 // generate a mock document - used by fetchOne to mock a document in the keyspace
 func genItem(i int, nitems int) (value.AnnotatedValue, errors.Error) {
 	if i < 0 || i >= nitems {
-		return nil, errors.NewOtherDatastoreError(nil,
+		return nil, errors.NewOtherKeyNotFoundError(nil,
 			fmt.Sprintf("item out of mock range: %v [0,%v)", i, nitems))
 	}
 	id := strconv.Itoa(i)
@@ -230,6 +295,68 @@ func (b *keyspace) Delete(deletes []string) ([]string, errors.Error) {
 	return nil, errors.NewOtherNotImplementedError(nil, "for Mock datastore")
 }
 
+// Whether the key is one of the generated documents.
+func (b *keyspace) generated(key string) bool {
+	i, e := strconv.Atoi(key)
+	return e == nil && i >= 0 && i < b.nitems && strconv.Itoa(i) == key
+}
+
+// Whether a generated document has been deleted.
+func (b *keyspace) deleted(key string) bool {
+	b.RLock()
+	defer b.RUnlock()
+	val, ok := b.mutations[key]
+	return ok && val == nil
+}
+
+// Keys of the documents inserted in addition to the generated ones, in
+// sorted order.
+func (b *keyspace) insertedKeys() []string {
+	b.RLock()
+	defer b.RUnlock()
+
+	var rv []string
+	for key, val := range b.mutations {
+		if val != nil && !b.generated(key) {
+			rv = append(rv, key)
+		}
+	}
+
+	sort.Strings(rv)
+	return rv
+}
+
+func (b *keyspace) BeginCommit() {
+	b.commitLock.Lock()
+}
+
+func (b *keyspace) ApplyMutations(mutations []datastore.Mutation) errors.Error {
+	b.Lock()
+	defer b.Unlock()
+
+	if b.mutations == nil {
+		b.mutations = make(map[string]value.Value, len(mutations))
+	}
+
+	for _, m := range mutations {
+		if m.Value == nil {
+			if b.generated(m.Key) {
+				b.mutations[m.Key] = nil
+			} else {
+				delete(b.mutations, m.Key)
+			}
+		} else {
+			b.mutations[m.Key] = m.Value.Copy()
+		}
+	}
+
+	return nil
+}
+
+func (b *keyspace) EndCommit() {
+	b.commitLock.Unlock()
+}
+
 func (b *keyspace) Release() {
 }
 
@@ -461,9 +588,15 @@ func (pi *primaryIndex) Scan(requestId string, span *datastore.Span, distinct bo
 		limit = int64(pi.keyspace.nitems)
 	}
 
+	lowBound, highBound := low, high
+
 	for i := 0; i < pi.keyspace.nitems && int64(i) < limit; i++ {
 		id := strconv.Itoa(i)
 
+		if pi.keyspace.deleted(id) {
+			continue
+		}
+
 		if low != "" &&
 			(id < low ||
 				(id == low && (span.Range.Inclusion&datastore.LOW == 0))) {
@@ -481,6 +614,16 @@ func (pi *primaryIndex) Scan(requestId string, span *datastore.Span, distinct bo
 		entry := datastore.IndexEntry{PrimaryKey: id}
 		conn.EntryChannel() <- &entry
 	}
+
+	for _, id := range pi.keyspace.insertedKeys() {
+		if (lowBound != "" && (id < lowBound || (id == lowBound && (span.Range.Inclusion&datastore.LOW == 0)))) ||
+			(highBound != "" && (id > highBound || (id == highBound && (span.Range.Inclusion&datastore.HIGH == 0)))) {
+			continue
+		}
+
+		entry := datastore.IndexEntry{PrimaryKey: id}
+		conn.EntryChannel() <- &entry
+	}
 }
 
 func (pi *primaryIndex) ScanEntries(requestId string, limit int64, cons datastore.ScanConsistency,
@@ -492,7 +635,15 @@ func (pi *primaryIndex) ScanEntries(requestId string, limit int64, cons datastor
 	}
 
 	for i := 0; i < pi.keyspace.nitems && int64(i) < limit; i++ {
-		entry := datastore.IndexEntry{PrimaryKey: strconv.Itoa(i)}
+		id := strconv.Itoa(i)
+		if !pi.keyspace.deleted(id) {
+			entry := datastore.IndexEntry{PrimaryKey: id}
+			conn.EntryChannel() <- &entry
+		}
+	}
+
+	for _, id := range pi.keyspace.insertedKeys() {
+		entry := datastore.IndexEntry{PrimaryKey: id}
 		conn.EntryChannel() <- &entry
 	}
 }
@@ -193,6 +193,150 @@ func TestMockIndex(t *testing.T) {
 	items, err = doIndexScan(t, b, span)
 }
 
+func TestMockTransaction(t *testing.T) {
+	s, err := NewDatastore("mock:items=10")
+	if err != nil {
+		t.Fatalf("failed to create store: %v", err)
+	}
+
+	p, _ := s.NamespaceByName("p0")
+	b, _ := p.KeyspaceByName("b0")
+
+	// Mutations are staged until commit
+	tx, err := datastore.NewTransaction(nil)
+	if err != nil {
+		t.Fatalf("failed to start transaction: %v", err)
+	}
+
+	tb := tx.Keyspace(b)
+	if _, err = tb.Delete([]string{"5"}); err != nil {
+		t.Fatalf("unexpected error in delete: %v", err)
+	}
+
+	if _, err = tb.Insert([]value.Pair{{Name: "x", Value: value.NewValue(map[string]interface{}{"id": "x"})}}); err != nil {
+		t.Fatalf("unexpected error in insert: %v", err)
+	}
+
+	if _, err = tb.Insert([]value.Pair{{Name: "3", Value: value.NewValue(map[string]interface{}{"id": "3"})}}); err == nil {
+		t.Fatalf("expected error inserting existing key")
+	}
+
+	if vs, _ := b.Fetch([]string{"5"}); len(vs) != 1 {
+		t.Fatalf("expected item 5 before commit")
+	}
+
+	if vs, _ := tb.Fetch([]string{"5", "x"}); len(vs) != 1 || vs[0].Name != "x" {
+		t.Fatalf("expected only item x within transaction")
+	}
+
+	if err = tx.Commit(); err != nil {
+		t.Fatalf("unexpected error in commit: %v", err)
+	}
+
+	if vs, errs := b.Fetch([]string{"5"}); errs != nil || len(vs) != 0 {
+		t.Fatalf("expected item 5 to be deleted")
+	}
+
+	if vs, errs := b.Fetch([]string{"x"}); errs != nil || len(vs) != 1 {
+		t.Fatalf("expected item x")
+	}
+
+	span := &datastore.Span{Range: datastore.Range{Inclusion: datastore.BOTH,
+		Low: []value.Value{value.NewValue("0")}, High: []value.Value{value.NewValue("z")}}}
+	items, err := doIndexScan(t, b, span)
+	if err != nil || len(items) != 10 || items[9].PrimaryKey != "x" {
+		t.Fatalf("unexpected items in scan: %v", items)
+	}
+
+	// The first committer wins
+	tx1, _ := datastore.NewTransaction(nil)
+	tx2, _ := datastore.NewTransaction(nil)
+	for i, tx := range []*datastore.Transaction{tx1, tx2} {
+		pair := value.Pair{Name: "3", Value: value.NewValue(map[string]interface{}{"i": i})}
+		if _, err = tx.Keyspace(b).Update([]value.Pair{pair}); err != nil {
+			t.Fatalf("unexpected error in update: %v", err)
+		}
+	}
+
+	if err = tx2.Commit(); err != nil {
+		t.Fatalf("unexpected error in commit: %v", err)
+	}
+
+	if err = tx1.Commit(); err == nil {
+		t.Fatalf("expected conflict in commit")
+	}
+
+	vs, _ := b.Fetch([]string{"3"})
+	if i, _ := vs[0].Value.Field("i"); i.Actual() != int64(1) {
+		t.Fatalf("expected the committed update, got %v", i)
+	}
+}
+
+// A keyspace that fails to apply the mutations of a transaction
+type failingKeyspace struct {
+	datastore.TransactionalKeyspace
+}
+
+func (this *failingKeyspace) ApplyMutations(mutations []datastore.Mutation) errors.Error {
+	return errors.NewError(nil, "apply failed")
+}
+
+func TestMockTransactionUndo(t *testing.T) {
+	s, err := NewDatastore("mock:keyspaces=2,items=10")
+	if err != nil {
+		t.Fatalf("failed to create store: %v", err)
+	}
+
+	p, _ := s.NamespaceByName("p0")
+	b0, _ := p.KeyspaceByName("b0")
+	b1, _ := p.KeyspaceByName("b1")
+	f1 := &failingKeyspace{b1.(datastore.TransactionalKeyspace)}
+
+	tx, _ := datastore.NewTransaction(nil)
+	pair := value.Pair{Name: "3", Value: value.NewValue(map[string]interface{}{"i": 1})}
+	for _, b := range []datastore.Keyspace{b0, f1} {
+		if _, err = tx.Keyspace(b).Update([]value.Pair{pair}); err != nil {
+			t.Fatalf("unexpected error in update: %v", err)
+		}
+	}
+
+	if _, err = tx.Keyspace(b0).Delete([]string{"4"}); err != nil {
+		t.Fatalf("unexpected error in delete: %v", err)
+	}
+
+	// The writes to b0 are applied before b1 fails, and are undone
+	if err = tx.Commit(); err == nil {
+		t.Fatalf("expected error in commit")
+	}
+
+	vs, _ := b0.Fetch([]string{"3", "4"})
+	if len(vs) != 2 {
+		t.Fatalf("expected items 3 and 4 to be restored, got %v", vs)
+	}
+
+	if id, _ := vs[0].Value.Field("id"); id.Actual() != "3" {
+		t.Fatalf("expected item 3 to be restored, got %v", vs[0].Value)
+	}
+}
+
+// Transactions are bound to the credentials that started them
+func TestMockTransactionCredentials(t *testing.T) {
+	tx, _ := datastore.NewTransaction(datastore.Credentials{"alice": "pw"})
+	defer tx.Rollback()
+
+	if _, err := datastore.GetTransaction(tx.Id(), datastore.Credentials{"alice": "pw"}); err != nil {
+		t.Fatalf("unexpected error getting transaction: %v", err)
+	}
+
+	if _, err := datastore.GetTransaction(tx.Id(), datastore.Credentials{"bob": "pw"}); err == nil {
+		t.Fatalf("expected error getting transaction with other credentials")
+	}
+
+	if _, err := datastore.GetTransaction(tx.Id(), nil); err == nil {
+		t.Fatalf("expected error getting transaction without credentials")
+	}
+}
+
 type testingContext struct {
 	t *testing.T
 }
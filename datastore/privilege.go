@@ -9,16 +9,48 @@
 
 package datastore
 
-import ()
+import (
+	"fmt"
+)
 
 type Privilege int
 
 const (
-	PRIV_READ  Privilege = 1
-	PRIV_WRITE Privilege = 2
-	PRIV_DDL   Privilege = 3
+	PRIV_READ     Privilege = 1
+	PRIV_WRITE    Privilege = 2
+	PRIV_DDL      Privilege = 3
+	PRIV_FUNCTION Privilege = 4 // Create and drop user-defined functions
+	PRIV_SECURITY Privilege = 5 // Grant and revoke roles
 )
 
+func (this Privilege) String() string {
+	switch this {
+	case PRIV_READ:
+		return "read"
+	case PRIV_WRITE:
+		return "write"
+	case PRIV_DDL:
+		return "ddl"
+	case PRIV_FUNCTION:
+		return "function"
+	case PRIV_SECURITY:
+		return "security"
+	default:
+		return fmt.Sprintf("%d", int(this))
+	}
+}
+
+/*
+User-defined functions do not belong to a keyspace. Their privileges
+are requested under this key instead of "namespace:keyspace".
+*/
+const FUNCTIONS_PRIVILEGE_KEY = "#functions"
+
+/*
+Granting and revoking roles does not involve a keyspace either.
+*/
+const SECURITY_PRIVILEGE_KEY = "#security"
+
 /*
 Type Privileges maps string of the form "namespace:keyspace" to
 privileges.
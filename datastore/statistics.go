@@ -0,0 +1,65 @@
+//  Copyright (c) 2016 Couchbase, Inc.
+//  Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file
+//  except in compliance with the License. You may obtain a copy of the License at
+//    http://www.apache.org/licenses/LICENSE-2.0
+//  Unless required by applicable law or agreed to in writing, software distributed under the
+//  License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+//  either express or implied. See the License for the specific language governing permissions
+//  and limitations under the License.
+
+package datastore
+
+import (
+	"github.com/couchbase/query/errors"
+)
+
+// Statistics of a keyspace gathered by ANALYZE from a sample of its
+// documents. Fields are identified by their expressions, as written
+// in index keys.
+type KeyspaceStatistics struct {
+	Namespace string             `json:"namespace"`
+	Keyspace  string             `json:"keyspace"`
+	Documents int64              `json:"documents"` // Number of documents in the keyspace
+	Sampled   int64              `json:"sampled"`   // Number of documents sampled
+	Updated   string             `json:"updated"`   // Time of the ANALYZE, in RFC 3339 format
+	Fields    []*FieldStatistics `json:"fields"`
+}
+
+// Statistics of one field over the sampled documents.
+type FieldStatistics struct {
+	Field     string          `json:"field"`
+	Count     int64           `json:"count"`    // Number of sampled documents where the field is present
+	Nulls     int64           `json:"nulls"`    // Number of sampled documents where the field is null
+	Distinct  int64           `json:"distinct"` // Estimated number of distinct values in the keyspace
+	Min       interface{}     `json:"min,omitempty"`
+	Max       interface{}     `json:"max,omitempty"`
+	Histogram []*HistogramBin `json:"histogram,omitempty"`
+}
+
+// A bin of an equi-depth histogram of the non-null values of a
+// field. The bin holds the values above the maximum of the previous
+// bin, up to and including its own maximum.
+type HistogramBin struct {
+	Max      interface{} `json:"max"`
+	Count    int64       `json:"count"`
+	Distinct int64       `json:"distinct"`
+}
+
+// Field returns the statistics of a field, or nil.
+func (this *KeyspaceStatistics) Field(field string) *FieldStatistics {
+	for _, f := range this.Fields {
+		if f.Field == field {
+			return f
+		}
+	}
+
+	return nil
+}
+
+// Statistician is implemented by datastores that persist the
+// statistics gathered by ANALYZE.
+type Statistician interface {
+	SetKeyspaceStatistics(stats *KeyspaceStatistics) errors.Error                      // Persist the statistics of a keyspace
+	KeyspaceStatistics(namespace, keyspace string) (*KeyspaceStatistics, errors.Error) // Statistics of a keyspace, or nil
+	AllKeyspaceStatistics() ([]*KeyspaceStatistics, errors.Error)                      // Statistics of all the analyzed keyspaces
+}
@@ -27,6 +27,10 @@ const KEYSPACE_NAME_DUAL = "dual"
 const KEYSPACE_NAME_PREPAREDS = "prepareds"
 const KEYSPACE_NAME_REQUESTS = "completed_requests"
 const KEYSPACE_NAME_ACTIVE = "active_requests"
+const KEYSPACE_NAME_STATISTICS = "statistics"
+const KEYSPACE_NAME_FUNCTIONS = "functions"
+const KEYSPACE_NAME_PLAN_CACHE = "plan_cache"
+const KEYSPACE_NAME_USER_INFO = "user_info"
 
 type store struct {
 	actualStore              datastore.Datastore
@@ -83,11 +87,11 @@ func (s *store) SetLogLevel(level logging.Level) {
 }
 
 func (s *store) Inferencer(name datastore.InferenceType) (datastore.Inferencer, errors.Error) {
-	return nil, errors.NewOtherNotImplementedError(nil, "INFER")
+	return datastore.DefaultInferencer(name)
 }
 
 func (s *store) Inferencers() ([]datastore.Inferencer, errors.Error) {
-	return nil, errors.NewOtherNotImplementedError(nil, "INFER")
+	return datastore.DefaultInferencers()
 }
 
 func NewDatastore(actualStore datastore.Datastore) (datastore.Datastore, errors.Error) {
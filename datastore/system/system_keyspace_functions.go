@@ -0,0 +1,200 @@
+//  Copyright (c) 2016 Couchbase, Inc.
+//  Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file
+//  except in compliance with the License. You may obtain a copy of the License at
+//    http://www.apache.org/licenses/LICENSE-2.0
+//  Unless required by applicable law or agreed to in writing, software distributed under the
+//  License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+//  either express or implied. See the License for the specific language governing permissions
+//  and limitations under the License.
+
+package system
+
+import (
+	"github.com/couchbase/query/datastore"
+	"github.com/couchbase/query/errors"
+	"github.com/couchbase/query/expression"
+	"github.com/couchbase/query/timestamp"
+	"github.com/couchbase/query/value"
+)
+
+type functionsKeyspace struct {
+	namespace *namespace
+	name      string
+	indexer   datastore.Indexer
+}
+
+func (b *functionsKeyspace) Release() {
+}
+
+func (b *functionsKeyspace) NamespaceId() string {
+	return b.namespace.Id()
+}
+
+func (b *functionsKeyspace) Id() string {
+	return b.Name()
+}
+
+func (b *functionsKeyspace) Name() string {
+	return b.name
+}
+
+func (b *functionsKeyspace) Count() (int64, errors.Error) {
+	return int64(expression.CountUserFunctions()), nil
+}
+
+func (b *functionsKeyspace) Indexer(name datastore.IndexType) (datastore.Indexer, errors.Error) {
+	return b.indexer, nil
+}
+
+func (b *functionsKeyspace) Indexers() ([]datastore.Indexer, errors.Error) {
+	return []datastore.Indexer{b.indexer}, nil
+}
+
+func (b *functionsKeyspace) Fetch(keys []string) ([]value.AnnotatedPair, []errors.Error) {
+	var errs []errors.Error
+	rv := make([]value.AnnotatedPair, 0, len(keys))
+
+	for _, key := range keys {
+		definition, ok := expression.GetUserFunction(key)
+		if !ok {
+			continue
+		}
+
+		parameters := make([]interface{}, len(definition.Parameters()))
+		for i, p := range definition.Parameters() {
+			parameters[i] = p
+		}
+
+		item := value.NewAnnotatedValue(map[string]interface{}{
+			"name":       definition.Name(),
+			"parameters": parameters,
+			"body":       definition.Body().String(),
+		})
+		item.SetAttachment("meta", map[string]interface{}{
+			"id": key,
+		})
+		rv = append(rv, value.AnnotatedPair{
+			Name:  key,
+			Value: item,
+		})
+	}
+
+	return rv, errs
+}
+
+func (b *functionsKeyspace) Insert(inserts []value.Pair) ([]value.Pair, errors.Error) {
+	return nil, errors.NewSystemNotImplementedError(nil, "")
+}
+
+func (b *functionsKeyspace) Update(updates []value.Pair) ([]value.Pair, errors.Error) {
+	return nil, errors.NewSystemNotImplementedError(nil, "")
+}
+
+func (b *functionsKeyspace) Upsert(upserts []value.Pair) ([]value.Pair, errors.Error) {
+	return nil, errors.NewSystemNotImplementedError(nil, "")
+}
+
+// Functions are dropped with DROP FUNCTION, which checks privileges.
+func (b *functionsKeyspace) Delete(deletes []string) ([]string, errors.Error) {
+	return nil, errors.NewSystemNotImplementedError(nil, "")
+}
+
+func newFunctionsKeyspace(p *namespace) (*functionsKeyspace, errors.Error) {
+	b := new(functionsKeyspace)
+	b.namespace = p
+	b.name = KEYSPACE_NAME_FUNCTIONS
+
+	primary := &functionsIndex{name: "#primary", keyspace: b}
+	b.indexer = newSystemIndexer(b, primary)
+
+	return b, nil
+}
+
+type functionsIndex struct {
+	name     string
+	keyspace *functionsKeyspace
+}
+
+func (pi *functionsIndex) KeyspaceId() string {
+	return pi.keyspace.Id()
+}
+
+func (pi *functionsIndex) Id() string {
+	return pi.Name()
+}
+
+func (pi *functionsIndex) Name() string {
+	return pi.name
+}
+
+func (pi *functionsIndex) Type() datastore.IndexType {
+	return datastore.DEFAULT
+}
+
+func (pi *functionsIndex) SeekKey() expression.Expressions {
+	return nil
+}
+
+func (pi *functionsIndex) RangeKey() expression.Expressions {
+	return nil
+}
+
+func (pi *functionsIndex) Condition() expression.Expression {
+	return nil
+}
+
+func (pi *functionsIndex) IsPrimary() bool {
+	return true
+}
+
+func (pi *functionsIndex) State() (state datastore.IndexState, msg string, err errors.Error) {
+	return datastore.ONLINE, "", nil
+}
+
+func (pi *functionsIndex) Statistics(requestId string, span *datastore.Span) (
+	datastore.Statistics, errors.Error) {
+	return nil, nil
+}
+
+func (pi *functionsIndex) Drop(requestId string) errors.Error {
+	return errors.NewSystemIdxNoDropError(nil, "")
+}
+
+func (pi *functionsIndex) Scan(requestId string, span *datastore.Span, distinct bool, limit int64,
+	cons datastore.ScanConsistency, vector timestamp.Vector, conn *datastore.IndexConnection) {
+	defer close(conn.EntryChannel())
+
+	// Range spans, as used by covering scans, return every entry
+	if len(span.Seek) == 0 {
+		pi.scanEntries(limit, conn)
+		return
+	}
+
+	name, ok := span.Seek[0].Actual().(string)
+	if !ok {
+		return
+	}
+
+	if definition, ok := expression.GetUserFunction(name); ok {
+		entry := datastore.IndexEntry{PrimaryKey: definition.Name()}
+		conn.EntryChannel() <- &entry
+	}
+}
+
+func (pi *functionsIndex) ScanEntries(requestId string, limit int64, cons datastore.ScanConsistency,
+	vector timestamp.Vector, conn *datastore.IndexConnection) {
+	defer close(conn.EntryChannel())
+
+	pi.scanEntries(limit, conn)
+}
+
+func (pi *functionsIndex) scanEntries(limit int64, conn *datastore.IndexConnection) {
+	for i, name := range expression.NameUserFunctions() {
+		if limit > 0 && int64(i) >= limit {
+			break
+		}
+
+		entry := datastore.IndexEntry{PrimaryKey: name}
+		conn.EntryChannel() <- &entry
+	}
+}
@@ -0,0 +1,221 @@
+//  Copyright (c) 2016 Couchbase, Inc.
+//  Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file
+//  except in compliance with the License. You may obtain a copy of the License at
+//    http://www.apache.org/licenses/LICENSE-2.0
+//  Unless required by applicable law or agreed to in writing, software distributed under the
+//  License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+//  either express or implied. See the License for the specific language governing permissions
+//  and limitations under the License.
+
+package system
+
+import (
+	"fmt"
+
+	"github.com/couchbase/query/datastore"
+	"github.com/couchbase/query/errors"
+	"github.com/couchbase/query/expression"
+	"github.com/couchbase/query/plan"
+	"github.com/couchbase/query/timestamp"
+	"github.com/couchbase/query/value"
+)
+
+type planCacheKeyspace struct {
+	namespace *namespace
+	name      string
+	indexer   datastore.Indexer
+}
+
+func (b *planCacheKeyspace) Release() {
+}
+
+func (b *planCacheKeyspace) NamespaceId() string {
+	return b.namespace.Id()
+}
+
+func (b *planCacheKeyspace) Id() string {
+	return b.Name()
+}
+
+func (b *planCacheKeyspace) Name() string {
+	return b.name
+}
+
+func (b *planCacheKeyspace) Count() (int64, errors.Error) {
+	return int64(plan.CountCachedPlans()), nil
+}
+
+func (b *planCacheKeyspace) Indexer(name datastore.IndexType) (datastore.Indexer, errors.Error) {
+	return b.indexer, nil
+}
+
+func (b *planCacheKeyspace) Indexers() ([]datastore.Indexer, errors.Error) {
+	return []datastore.Indexer{b.indexer}, nil
+}
+
+func (b *planCacheKeyspace) Fetch(keys []string) ([]value.AnnotatedPair, []errors.Error) {
+	var errs []errors.Error
+	rv := make([]value.AnnotatedPair, 0, len(keys))
+
+	for _, key := range keys {
+		plan.CachedPlanDo(key, func(entry *plan.PlanCacheEntry) {
+			item := value.NewAnnotatedValue(map[string]interface{}{
+				"id":        key,
+				"statement": entry.Statement,
+				"namespace": entry.Namespace,
+				"uses":      entry.Uses,
+				"added":     entry.Added.String(),
+				"stale":     entry.Stale(),
+			})
+			if entry.Uses > 0 {
+				item.SetField("lastUse", entry.LastUse.String())
+			}
+			item.SetAttachment("meta", map[string]interface{}{
+				"id": key,
+			})
+			rv = append(rv, value.AnnotatedPair{
+				Name:  key,
+				Value: item,
+			})
+		})
+	}
+	return rv, errs
+}
+
+func (b *planCacheKeyspace) Insert(inserts []value.Pair) ([]value.Pair, errors.Error) {
+	// FIXME
+	return nil, errors.NewSystemNotImplementedError(nil, "")
+}
+
+func (b *planCacheKeyspace) Update(updates []value.Pair) ([]value.Pair, errors.Error) {
+	// FIXME
+	return nil, errors.NewSystemNotImplementedError(nil, "")
+}
+
+func (b *planCacheKeyspace) Upsert(upserts []value.Pair) ([]value.Pair, errors.Error) {
+	// FIXME
+	return nil, errors.NewSystemNotImplementedError(nil, "")
+}
+
+func (b *planCacheKeyspace) Delete(deletes []string) ([]string, errors.Error) {
+	deleted := make([]string, 0, len(deletes))
+	for _, key := range deletes {
+		if plan.DeleteCachedPlan(key) {
+			deleted = append(deleted, key)
+		}
+	}
+	return deleted, nil
+}
+
+func newPlanCacheKeyspace(p *namespace) (*planCacheKeyspace, errors.Error) {
+	b := new(planCacheKeyspace)
+	b.namespace = p
+	b.name = KEYSPACE_NAME_PLAN_CACHE
+
+	primary := &planCacheIndex{name: "#primary", keyspace: b}
+	b.indexer = newSystemIndexer(b, primary)
+
+	return b, nil
+}
+
+type planCacheIndex struct {
+	name     string
+	keyspace *planCacheKeyspace
+}
+
+func (pi *planCacheIndex) KeyspaceId() string {
+	return pi.keyspace.Id()
+}
+
+func (pi *planCacheIndex) Id() string {
+	return pi.Name()
+}
+
+func (pi *planCacheIndex) Name() string {
+	return pi.name
+}
+
+func (pi *planCacheIndex) Type() datastore.IndexType {
+	return datastore.DEFAULT
+}
+
+func (pi *planCacheIndex) SeekKey() expression.Expressions {
+	return nil
+}
+
+func (pi *planCacheIndex) RangeKey() expression.Expressions {
+	return nil
+}
+
+func (pi *planCacheIndex) Condition() expression.Expression {
+	return nil
+}
+
+func (pi *planCacheIndex) IsPrimary() bool {
+	return true
+}
+
+func (pi *planCacheIndex) State() (state datastore.IndexState, msg string, err errors.Error) {
+	return datastore.ONLINE, "", nil
+}
+
+func (pi *planCacheIndex) Statistics(requestId string, span *datastore.Span) (
+	datastore.Statistics, errors.Error) {
+	return nil, nil
+}
+
+func (pi *planCacheIndex) Drop(requestId string) errors.Error {
+	return errors.NewSystemIdxNoDropError(nil, "")
+}
+
+func (pi *planCacheIndex) Scan(requestId string, span *datastore.Span, distinct bool, limit int64,
+	cons datastore.ScanConsistency, vector timestamp.Vector, conn *datastore.IndexConnection) {
+	defer close(conn.EntryChannel())
+
+	// Range spans, as used by covering scans, return every entry
+	if len(span.Seek) == 0 {
+		pi.scanEntries(limit, conn)
+		return
+	}
+
+	val := ""
+
+	a := span.Seek[0].Actual()
+	switch a := a.(type) {
+	case string:
+		val = a
+	default:
+		conn.Error(errors.NewSystemDatastoreError(nil, fmt.Sprintf("Invalid seek value %v of type %T.", a, a)))
+		return
+	}
+
+	found := false
+	plan.CachedPlanDo(val, func(entry *plan.PlanCacheEntry) {
+		found = true
+	})
+
+	if found {
+		entry := datastore.IndexEntry{PrimaryKey: val}
+		conn.EntryChannel() <- &entry
+	}
+}
+
+func (pi *planCacheIndex) ScanEntries(requestId string, limit int64, cons datastore.ScanConsistency,
+	vector timestamp.Vector, conn *datastore.IndexConnection) {
+	defer close(conn.EntryChannel())
+
+	pi.scanEntries(limit, conn)
+}
+
+func (pi *planCacheIndex) scanEntries(limit int64, conn *datastore.IndexConnection) {
+	var n int64
+	plan.CachedPlansForEach(func(id string, entry *plan.PlanCacheEntry) {
+		if limit > 0 && n >= limit {
+			return
+		}
+
+		n++
+		indexEntry := datastore.IndexEntry{PrimaryKey: id}
+		conn.EntryChannel() <- &indexEntry
+	})
+}
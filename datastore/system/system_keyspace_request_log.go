@@ -86,6 +86,12 @@ func (b *requestLogKeyspace) Fetch(keys []string) ([]value.AnnotatedPair, []erro
 			if entry.PhaseOperators != nil {
 				item.SetField("PhaseOperators", entry.PhaseOperators)
 			}
+			if entry.PeakMemory > 0 {
+				item.SetField("PeakMemory", entry.PeakMemory)
+			}
+			if entry.Profile != nil {
+				item.SetField("Profile", entry.Profile)
+			}
 			item.SetAttachment("meta", map[string]interface{}{
 				"id": key,
 			})
@@ -0,0 +1,266 @@
+//  Copyright (c) 2016 Couchbase, Inc.
+//  Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file
+//  except in compliance with the License. You may obtain a copy of the License at
+//    http://www.apache.org/licenses/LICENSE-2.0
+//  Unless required by applicable law or agreed to in writing, software distributed under the
+//  License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+//  either express or implied. See the License for the specific language governing permissions
+//  and limitations under the License.
+
+package system
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/couchbase/query/datastore"
+	"github.com/couchbase/query/errors"
+	"github.com/couchbase/query/expression"
+	"github.com/couchbase/query/timestamp"
+	"github.com/couchbase/query/value"
+)
+
+type statisticsKeyspace struct {
+	namespace *namespace
+	name      string
+	indexer   datastore.Indexer
+}
+
+func (b *statisticsKeyspace) Release() {
+}
+
+func (b *statisticsKeyspace) NamespaceId() string {
+	return b.namespace.Id()
+}
+
+func (b *statisticsKeyspace) Id() string {
+	return b.Name()
+}
+
+func (b *statisticsKeyspace) Name() string {
+	return b.name
+}
+
+// The statistics of all the keyspaces analyzed in the actual
+// datastore, if it persists statistics.
+func (b *statisticsKeyspace) allStatistics() ([]*datastore.KeyspaceStatistics, errors.Error) {
+	statistician, ok := b.namespace.store.actualStore.(datastore.Statistician)
+	if !ok {
+		return nil, nil
+	}
+
+	return statistician.AllKeyspaceStatistics()
+}
+
+func (b *statisticsKeyspace) Count() (int64, errors.Error) {
+	all, err := b.allStatistics()
+	if err != nil {
+		return 0, errors.NewSystemDatastoreError(err, "")
+	}
+
+	return int64(len(all)), nil
+}
+
+func (b *statisticsKeyspace) Indexer(name datastore.IndexType) (datastore.Indexer, errors.Error) {
+	return b.indexer, nil
+}
+
+func (b *statisticsKeyspace) Indexers() ([]datastore.Indexer, errors.Error) {
+	return []datastore.Indexer{b.indexer}, nil
+}
+
+func (b *statisticsKeyspace) Fetch(keys []string) ([]value.AnnotatedPair, []errors.Error) {
+	var errs []errors.Error
+	rv := make([]value.AnnotatedPair, 0, len(keys))
+	for _, k := range keys {
+		item, e := b.fetchOne(k)
+
+		if e != nil {
+			if errs == nil {
+				errs = make([]errors.Error, 0, 1)
+			}
+			errs = append(errs, e)
+			continue
+		}
+
+		if item != nil {
+			item.SetAttachment("meta", map[string]interface{}{
+				"id": k,
+			})
+		}
+
+		rv = append(rv, value.AnnotatedPair{
+			Name:  k,
+			Value: item,
+		})
+	}
+
+	return rv, errs
+}
+
+func (b *statisticsKeyspace) fetchOne(key string) (value.AnnotatedValue, errors.Error) {
+	ids := strings.SplitN(key, "/", 2)
+	if len(ids) != 2 {
+		return nil, errors.NewSystemDatastoreError(nil, "Key Not Found "+key)
+	}
+
+	statistician, ok := b.namespace.store.actualStore.(datastore.Statistician)
+	if !ok {
+		return nil, errors.NewSystemDatastoreError(nil, "Key Not Found "+key)
+	}
+
+	stats, err := statistician.KeyspaceStatistics(ids[0], ids[1])
+	if err != nil {
+		return nil, err
+	}
+
+	if stats == nil {
+		return nil, errors.NewSystemDatastoreError(nil, "Key Not Found "+key)
+	}
+
+	bytes, er := json.Marshal(stats)
+	if er != nil {
+		return nil, errors.NewSystemDatastoreError(er, "")
+	}
+
+	return value.NewAnnotatedValue(value.NewValue(bytes)), nil
+}
+
+func (b *statisticsKeyspace) Insert(inserts []value.Pair) ([]value.Pair, errors.Error) {
+	return nil, errors.NewSystemNotImplementedError(nil, "")
+}
+
+func (b *statisticsKeyspace) Update(updates []value.Pair) ([]value.Pair, errors.Error) {
+	return nil, errors.NewSystemNotImplementedError(nil, "")
+}
+
+func (b *statisticsKeyspace) Upsert(upserts []value.Pair) ([]value.Pair, errors.Error) {
+	return nil, errors.NewSystemNotImplementedError(nil, "")
+}
+
+func (b *statisticsKeyspace) Delete(deletes []string) ([]string, errors.Error) {
+	return nil, errors.NewSystemNotImplementedError(nil, "")
+}
+
+func newStatisticsKeyspace(p *namespace) (*statisticsKeyspace, errors.Error) {
+	b := new(statisticsKeyspace)
+	b.namespace = p
+	b.name = KEYSPACE_NAME_STATISTICS
+
+	primary := &statisticsIndex{name: "#primary", keyspace: b}
+	b.indexer = newSystemIndexer(b, primary)
+
+	return b, nil
+}
+
+type statisticsIndex struct {
+	name     string
+	keyspace *statisticsKeyspace
+}
+
+func (pi *statisticsIndex) KeyspaceId() string {
+	return pi.keyspace.Id()
+}
+
+func (pi *statisticsIndex) Id() string {
+	return pi.Name()
+}
+
+func (pi *statisticsIndex) Name() string {
+	return pi.name
+}
+
+func (pi *statisticsIndex) Type() datastore.IndexType {
+	return datastore.DEFAULT
+}
+
+func (pi *statisticsIndex) SeekKey() expression.Expressions {
+	return nil
+}
+
+func (pi *statisticsIndex) RangeKey() expression.Expressions {
+	return nil
+}
+
+func (pi *statisticsIndex) Condition() expression.Expression {
+	return nil
+}
+
+func (pi *statisticsIndex) IsPrimary() bool {
+	return true
+}
+
+func (pi *statisticsIndex) State() (state datastore.IndexState, msg string, err errors.Error) {
+	return datastore.ONLINE, "", nil
+}
+
+func (pi *statisticsIndex) Statistics(requestId string, span *datastore.Span) (
+	datastore.Statistics, errors.Error) {
+	return nil, nil
+}
+
+func (pi *statisticsIndex) Drop(requestId string) errors.Error {
+	return errors.NewSystemIdxNoDropError(nil, "")
+}
+
+func (pi *statisticsIndex) Scan(requestId string, span *datastore.Span, distinct bool, limit int64,
+	cons datastore.ScanConsistency, vector timestamp.Vector, conn *datastore.IndexConnection) {
+	defer close(conn.EntryChannel())
+
+	// Range spans, as used by covering scans, return every entry
+	if len(span.Seek) == 0 {
+		pi.scanEntries(limit, conn)
+		return
+	}
+
+	val := ""
+
+	a := span.Seek[0].Actual()
+	switch a := a.(type) {
+	case string:
+		val = a
+	default:
+		conn.Error(errors.NewSystemDatastoreError(nil, fmt.Sprintf("Invalid seek value %v of type %T.", a, a)))
+		return
+	}
+
+	all, err := pi.keyspace.allStatistics()
+	if err != nil {
+		conn.Error(errors.NewSystemDatastoreError(err, ""))
+		return
+	}
+
+	for _, stats := range all {
+		key := stats.Namespace + "/" + stats.Keyspace
+		if key == val {
+			entry := datastore.IndexEntry{PrimaryKey: key}
+			conn.EntryChannel() <- &entry
+			return
+		}
+	}
+}
+
+func (pi *statisticsIndex) ScanEntries(requestId string, limit int64, cons datastore.ScanConsistency,
+	vector timestamp.Vector, conn *datastore.IndexConnection) {
+	defer close(conn.EntryChannel())
+
+	pi.scanEntries(limit, conn)
+}
+
+func (pi *statisticsIndex) scanEntries(limit int64, conn *datastore.IndexConnection) {
+	all, err := pi.keyspace.allStatistics()
+	if err != nil {
+		conn.Error(errors.NewSystemDatastoreError(err, ""))
+		return
+	}
+
+	for i, stats := range all {
+		if limit > 0 && int64(i) >= limit {
+			break
+		}
+
+		entry := datastore.IndexEntry{PrimaryKey: stats.Namespace + "/" + stats.Keyspace}
+		conn.EntryChannel() <- &entry
+	}
+}
@@ -0,0 +1,250 @@
+//  Copyright (c) 2016 Couchbase, Inc.
+//  Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file
+//  except in compliance with the License. You may obtain a copy of the License at
+//    http://www.apache.org/licenses/LICENSE-2.0
+//  Unless required by applicable law or agreed to in writing, software distributed under the
+//  License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+//  either express or implied. See the License for the specific language governing permissions
+//  and limitations under the License.
+
+package system
+
+import (
+	"fmt"
+
+	"github.com/couchbase/query/auth"
+	"github.com/couchbase/query/datastore"
+	"github.com/couchbase/query/errors"
+	"github.com/couchbase/query/expression"
+	"github.com/couchbase/query/timestamp"
+	"github.com/couchbase/query/value"
+)
+
+// The users of the credential store, and the roles granted to them
+type userInfoKeyspace struct {
+	namespace *namespace
+	name      string
+	indexer   datastore.Indexer
+}
+
+func (b *userInfoKeyspace) Release() {
+}
+
+func (b *userInfoKeyspace) NamespaceId() string {
+	return b.namespace.Id()
+}
+
+func (b *userInfoKeyspace) Id() string {
+	return b.Name()
+}
+
+func (b *userInfoKeyspace) Name() string {
+	return b.name
+}
+
+func (b *userInfoKeyspace) Count() (int64, errors.Error) {
+	users, err := credentialStoreUsers()
+	return int64(len(users)), err
+}
+
+func (b *userInfoKeyspace) Indexer(name datastore.IndexType) (datastore.Indexer, errors.Error) {
+	return b.indexer, nil
+}
+
+func (b *userInfoKeyspace) Indexers() ([]datastore.Indexer, errors.Error) {
+	return []datastore.Indexer{b.indexer}, nil
+}
+
+func (b *userInfoKeyspace) Fetch(keys []string) ([]value.AnnotatedPair, []errors.Error) {
+	var errs []errors.Error
+	rv := make([]value.AnnotatedPair, 0, len(keys))
+
+	store := auth.GetCredentialStore()
+	if store == nil {
+		return rv, errs
+	}
+
+	for _, key := range keys {
+		user, err := store.User(key)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+
+		roles := make([]interface{}, len(user.Roles))
+		for i, role := range user.Roles {
+			roles[i] = roleValue(role)
+		}
+
+		item := value.NewAnnotatedValue(map[string]interface{}{
+			"id":    user.Id,
+			"roles": roles,
+		})
+		if user.Name != "" {
+			item.SetField("name", user.Name)
+		}
+		item.SetAttachment("meta", map[string]interface{}{
+			"id": key,
+		})
+		rv = append(rv, value.AnnotatedPair{
+			Name:  key,
+			Value: item,
+		})
+	}
+	return rv, errs
+}
+
+func roleValue(role auth.Role) map[string]interface{} {
+	rv := map[string]interface{}{"role": role.Name}
+	if role.Keyspace != "" {
+		rv["keyspace"] = role.Keyspace
+	}
+	return rv
+}
+
+// Returns the users of the credential store, if one is configured.
+func credentialStoreUsers() ([]*auth.User, errors.Error) {
+	store := auth.GetCredentialStore()
+	if store == nil {
+		return nil, nil
+	}
+
+	return store.Users()
+}
+
+func (b *userInfoKeyspace) Insert(inserts []value.Pair) ([]value.Pair, errors.Error) {
+	// FIXME
+	return nil, errors.NewSystemNotImplementedError(nil, "")
+}
+
+func (b *userInfoKeyspace) Update(updates []value.Pair) ([]value.Pair, errors.Error) {
+	// FIXME
+	return nil, errors.NewSystemNotImplementedError(nil, "")
+}
+
+func (b *userInfoKeyspace) Upsert(upserts []value.Pair) ([]value.Pair, errors.Error) {
+	// FIXME
+	return nil, errors.NewSystemNotImplementedError(nil, "")
+}
+
+func (b *userInfoKeyspace) Delete(deletes []string) ([]string, errors.Error) {
+	// FIXME
+	return nil, errors.NewSystemNotImplementedError(nil, "")
+}
+
+func newUserInfoKeyspace(p *namespace) (*userInfoKeyspace, errors.Error) {
+	b := new(userInfoKeyspace)
+	b.namespace = p
+	b.name = KEYSPACE_NAME_USER_INFO
+
+	primary := &userInfoIndex{name: "#primary", keyspace: b}
+	b.indexer = newSystemIndexer(b, primary)
+
+	return b, nil
+}
+
+type userInfoIndex struct {
+	name     string
+	keyspace *userInfoKeyspace
+}
+
+func (pi *userInfoIndex) KeyspaceId() string {
+	return pi.keyspace.Id()
+}
+
+func (pi *userInfoIndex) Id() string {
+	return pi.Name()
+}
+
+func (pi *userInfoIndex) Name() string {
+	return pi.name
+}
+
+func (pi *userInfoIndex) Type() datastore.IndexType {
+	return datastore.DEFAULT
+}
+
+func (pi *userInfoIndex) SeekKey() expression.Expressions {
+	return nil
+}
+
+func (pi *userInfoIndex) RangeKey() expression.Expressions {
+	return nil
+}
+
+func (pi *userInfoIndex) Condition() expression.Expression {
+	return nil
+}
+
+func (pi *userInfoIndex) IsPrimary() bool {
+	return true
+}
+
+func (pi *userInfoIndex) State() (state datastore.IndexState, msg string, err errors.Error) {
+	return datastore.ONLINE, "", nil
+}
+
+func (pi *userInfoIndex) Statistics(requestId string, span *datastore.Span) (
+	datastore.Statistics, errors.Error) {
+	return nil, nil
+}
+
+func (pi *userInfoIndex) Drop(requestId string) errors.Error {
+	return errors.NewSystemIdxNoDropError(nil, "")
+}
+
+func (pi *userInfoIndex) Scan(requestId string, span *datastore.Span, distinct bool, limit int64,
+	cons datastore.ScanConsistency, vector timestamp.Vector, conn *datastore.IndexConnection) {
+	defer close(conn.EntryChannel())
+
+	// Range spans, as used by covering scans, return every entry
+	if len(span.Seek) == 0 {
+		pi.scanEntries(limit, conn)
+		return
+	}
+
+	val := ""
+
+	a := span.Seek[0].Actual()
+	switch a := a.(type) {
+	case string:
+		val = a
+	default:
+		conn.Error(errors.NewSystemDatastoreError(nil, fmt.Sprintf("Invalid seek value %v of type %T.", a, a)))
+		return
+	}
+
+	store := auth.GetCredentialStore()
+	if store == nil {
+		return
+	}
+
+	if _, err := store.User(val); err == nil {
+		entry := datastore.IndexEntry{PrimaryKey: val}
+		conn.EntryChannel() <- &entry
+	}
+}
+
+func (pi *userInfoIndex) ScanEntries(requestId string, limit int64, cons datastore.ScanConsistency,
+	vector timestamp.Vector, conn *datastore.IndexConnection) {
+	defer close(conn.EntryChannel())
+
+	pi.scanEntries(limit, conn)
+}
+
+func (pi *userInfoIndex) scanEntries(limit int64, conn *datastore.IndexConnection) {
+	users, err := credentialStoreUsers()
+	if err != nil {
+		conn.Error(err)
+		return
+	}
+
+	for i, user := range users {
+		if limit > 0 && int64(i) >= limit {
+			break
+		}
+
+		entry := datastore.IndexEntry{PrimaryKey: user.Id}
+		conn.EntryChannel() <- &entry
+	}
+}
@@ -126,5 +126,29 @@ func (p *namespace) loadKeyspaces() (e errors.Error) {
 	}
 	p.keyspaces[actives.Name()] = actives
 
+	stats, e := newStatisticsKeyspace(p)
+	if e != nil {
+		return e
+	}
+	p.keyspaces[stats.Name()] = stats
+
+	functions, e := newFunctionsKeyspace(p)
+	if e != nil {
+		return e
+	}
+	p.keyspaces[functions.Name()] = functions
+
+	plans, e := newPlanCacheKeyspace(p)
+	if e != nil {
+		return e
+	}
+	p.keyspaces[plans.Name()] = plans
+
+	users, e := newUserInfoKeyspace(p)
+	if e != nil {
+		return e
+	}
+	p.keyspaces[users.Name()] = users
+
 	return nil
 }
@@ -0,0 +1,569 @@
+//  Copyright (c) 2016 Couchbase, Inc.
+//  Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file
+//  except in compliance with the License. You may obtain a copy of the License at
+//    http://www.apache.org/licenses/LICENSE-2.0
+//  Unless required by applicable law or agreed to in writing, software distributed under the
+//  License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+//  either express or implied. See the License for the specific language governing permissions
+//  and limitations under the License.
+
+package datastore
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	atomic "github.com/couchbase/go-couchbase/platform"
+	"github.com/couchbase/query/errors"
+	"github.com/couchbase/query/logging"
+	"github.com/couchbase/query/util"
+	"github.com/couchbase/query/value"
+)
+
+// Transactions that are idle for longer than the timeout are rolled
+// back. Zero or negative disables the timeout.
+var transactionTimeout = atomic.AlignedInt64(2 * time.Minute)
+
+func SetTransactionTimeout(timeout time.Duration) {
+	atomic.StoreInt64(&transactionTimeout, int64(timeout))
+}
+
+func GetTransactionTimeout() time.Duration {
+	return time.Duration(atomic.LoadInt64(&transactionTimeout))
+}
+
+// A mutation staged by a transaction. A nil Value deletes the document.
+type Mutation struct {
+	Key   string
+	Value value.Value
+}
+
+// TransactionalKeyspace is implemented by keyspaces that can apply the
+// mutations of a transaction atomically. Between BeginCommit() and
+// EndCommit(), the keyspace accepts no other mutations, so that the
+// transaction can check its reads and apply its writes without
+// interference. Applying mutations must be repeatable, so that a
+// commit that fails can restore the documents it wrote.
+type TransactionalKeyspace interface {
+	Keyspace
+	BeginCommit()                                     // Block other mutations of this keyspace
+	ApplyMutations(mutations []Mutation) errors.Error // Apply the mutations of a committing transaction
+	EndCommit()                                       // Allow other mutations of this keyspace
+}
+
+// A transaction stages its writes, and applies them at COMMIT.
+//
+// Reads see the transaction's own writes, and otherwise the committed
+// value of each document as of its first read by the transaction, so
+// that repeated reads are stable. Scans of the keyspace indexes are
+// recorded with the keys they returned.
+//
+// At COMMIT, the read set is validated: every document read by the
+// transaction must still have the value it read, and every recorded
+// scan, when repeated, must find no other documents within the range
+// it scanned. Otherwise, another writer has changed what the
+// transaction saw, and the whole transaction is rolled back. Writes to
+// other documents do not conflict. The first committer wins.
+//
+// A transaction can only be used by the credentials that started it.
+type Transaction struct {
+	sync.Mutex
+	id          string
+	credentials Credentials
+	lastUse     time.Time
+	done        bool
+	keyspaces   map[string]*transactionKeyspace
+}
+
+var _TRANSACTIONS = struct {
+	sync.Mutex
+	transactions map[string]*Transaction
+}{
+	transactions: make(map[string]*Transaction),
+}
+
+// Starts a new transaction on behalf of the credentials.
+func NewTransaction(credentials Credentials) (*Transaction, errors.Error) {
+	id, err := util.UUID()
+	if err != nil {
+		return nil, errors.NewError(err, "Unable to generate a transaction id.")
+	}
+
+	rv := &Transaction{
+		id:          id,
+		credentials: make(Credentials, len(credentials)),
+		lastUse:     time.Now(),
+		keyspaces:   make(map[string]*transactionKeyspace),
+	}
+
+	for user, password := range credentials {
+		rv.credentials[user] = password
+	}
+
+	_TRANSACTIONS.Lock()
+	defer _TRANSACTIONS.Unlock()
+
+	expireTransactions()
+	_TRANSACTIONS.transactions[id] = rv
+	return rv, nil
+}
+
+// Returns the active transaction with the given id, if it was started
+// by the same credentials.
+func GetTransaction(id string, credentials Credentials) (*Transaction, errors.Error) {
+	_TRANSACTIONS.Lock()
+	defer _TRANSACTIONS.Unlock()
+
+	expireTransactions()
+	rv, ok := _TRANSACTIONS.transactions[id]
+	if !ok {
+		return nil, errors.NewTransactionNotFoundError(id)
+	}
+
+	if !sameCredentials(rv.credentials, credentials) {
+		return nil, errors.NewTransactionCredentialsError(id)
+	}
+
+	rv.Lock()
+	rv.lastUse = time.Now()
+	rv.Unlock()
+	return rv, nil
+}
+
+// Number of active transactions.
+func CountTransactions() int {
+	_TRANSACTIONS.Lock()
+	defer _TRANSACTIONS.Unlock()
+
+	expireTransactions()
+	return len(_TRANSACTIONS.transactions)
+}
+
+// Rolls back idle transactions. Called with _TRANSACTIONS locked.
+func expireTransactions() {
+	timeout := GetTransactionTimeout()
+	for id, transaction := range _TRANSACTIONS.transactions {
+		transaction.Lock()
+		if transaction.done || (timeout > 0 && time.Since(transaction.lastUse) > timeout) {
+			transaction.done = true
+			delete(_TRANSACTIONS.transactions, id)
+		}
+		transaction.Unlock()
+	}
+}
+
+func sameCredentials(credentials, other Credentials) bool {
+	if len(credentials) != len(other) {
+		return false
+	}
+
+	for user, password := range credentials {
+		if p, ok := other[user]; !ok || p != password {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Called without the transaction locked, as expireTransactions() locks
+// the registry before the transaction.
+func removeTransaction(id string) {
+	_TRANSACTIONS.Lock()
+	defer _TRANSACTIONS.Unlock()
+	delete(_TRANSACTIONS.transactions, id)
+}
+
+func (this *Transaction) Id() string {
+	return this.id
+}
+
+// Returns a view of the keyspace within this transaction. Its reads
+// see the transaction's writes, and its writes are staged until
+// COMMIT.
+func (this *Transaction) Keyspace(keyspace Keyspace) Keyspace {
+	this.Lock()
+	defer this.Unlock()
+
+	return this.keyspace(keyspace)
+}
+
+// Called with the transaction locked.
+func (this *Transaction) keyspace(keyspace Keyspace) *transactionKeyspace {
+	name := keyspace.NamespaceId() + ":" + keyspace.Name()
+	rv, ok := this.keyspaces[name]
+	if !ok {
+		rv = &transactionKeyspace{
+			Keyspace:    keyspace,
+			transaction: this,
+			reads:       make(map[string]value.Value),
+			writes:      make(map[string]value.Value),
+		}
+		this.keyspaces[name] = rv
+	}
+
+	return rv
+}
+
+// Applies the writes of the transaction, unless another writer has
+// changed what the transaction read. Either way, the transaction
+// ends. If a keyspace fails to apply its writes, the keyspaces already
+// written are restored to the values read by the transaction, which
+// validation has found current, so that no writes are applied.
+func (this *Transaction) Commit() errors.Error {
+	removeTransaction(this.id)
+
+	this.Lock()
+	defer this.Unlock()
+
+	if this.done {
+		return errors.NewTransactionNotFoundError(this.id)
+	}
+
+	this.done = true
+
+	// Lock the keyspaces read or written in a fixed order, to avoid
+	// deadlock between concurrent commits
+	names := make([]string, 0, len(this.keyspaces))
+	for name, tk := range this.keyspaces {
+		if _, ok := tk.Keyspace.(TransactionalKeyspace); ok {
+			names = append(names, name)
+		}
+	}
+
+	sort.Strings(names)
+	committers := make([]TransactionalKeyspace, len(names))
+	for i, name := range names {
+		committers[i] = this.keyspaces[name].Keyspace.(TransactionalKeyspace)
+		committers[i].BeginCommit()
+		defer committers[i].EndCommit()
+	}
+
+	for _, name := range names {
+		err := this.keyspaces[name].validate()
+		if err != nil {
+			return err
+		}
+	}
+
+	applied := make([]int, 0, len(names))
+	for i, name := range names {
+		if len(this.keyspaces[name].writes) == 0 {
+			continue
+		}
+
+		// The keyspace may have applied some of the mutations
+		applied = append(applied, i)
+		err := committers[i].ApplyMutations(this.keyspaces[name].mutations())
+		if err != nil {
+			this.undo(names, committers, applied)
+			return errors.NewTransactionCommitError(err, committers[i].Name())
+		}
+	}
+
+	return nil
+}
+
+// Restores the keyspaces written by a failed commit, most recent
+// first.
+func (this *Transaction) undo(names []string, committers []TransactionalKeyspace, applied []int) {
+	for j := len(applied) - 1; j >= 0; j-- {
+		i := applied[j]
+		err := committers[i].ApplyMutations(this.keyspaces[names[i]].undoMutations())
+		if err != nil {
+			logging.Errorp("Transaction.undo", logging.Pair{"transaction", this.id},
+				logging.Pair{"keyspace", committers[i].Name()}, logging.Pair{"error", err})
+		}
+	}
+}
+
+// Discards the writes of the transaction, and ends it.
+func (this *Transaction) Rollback() {
+	removeTransaction(this.id)
+
+	this.Lock()
+	defer this.Unlock()
+
+	this.done = true
+	this.keyspaces = make(map[string]*transactionKeyspace)
+}
+
+// transactionKeyspace overlays a keyspace with the reads and writes
+// of a transaction. Writes are only possible on keyspaces that
+// implement TransactionalKeyspace.
+type transactionKeyspace struct {
+	Keyspace
+	transaction *Transaction
+	reads       map[string]value.Value // Committed value first read; nil if missing
+	writes      map[string]value.Value // Staged value; nil if deleted
+	scans       []*transactionScan     // Index scans, repeated at commit
+	counted     bool                   // Whether the transaction counted the keyspace
+	count       int64                  // Committed count of the keyspace
+}
+
+func (this *transactionKeyspace) Count() (int64, errors.Error) {
+	this.transaction.Lock()
+	defer this.transaction.Unlock()
+
+	if this.transaction.done {
+		return 0, errors.NewTransactionNotFoundError(this.transaction.id)
+	}
+
+	count, err := this.Keyspace.Count()
+	if err != nil {
+		return count, err
+	}
+
+	if !this.counted {
+		this.counted = true
+		this.count = count
+	}
+
+	for key, val := range this.writes {
+		read := this.reads[key]
+		switch {
+		case val == nil && read != nil:
+			count--
+		case val != nil && read == nil:
+			count++
+		}
+	}
+
+	return count, nil
+}
+
+func (this *transactionKeyspace) Fetch(keys []string) ([]value.AnnotatedPair, []errors.Error) {
+	this.transaction.Lock()
+	defer this.transaction.Unlock()
+
+	errs := this.read(keys)
+	rv := make([]value.AnnotatedPair, 0, len(keys))
+	for _, key := range keys {
+		val := this.current(key)
+		if val == nil {
+			continue
+		}
+
+		av := value.NewAnnotatedValue(val.CopyForUpdate())
+		av.SetAttachment("meta", map[string]interface{}{"id": key})
+		rv = append(rv, value.AnnotatedPair{Name: key, Value: av})
+	}
+
+	return rv, errs
+}
+
+const (
+	_INSERT = iota
+	_UPDATE
+	_UPSERT
+)
+
+func (this *transactionKeyspace) Insert(inserts []value.Pair) ([]value.Pair, errors.Error) {
+	return this.stage(_INSERT, inserts)
+}
+
+func (this *transactionKeyspace) Update(updates []value.Pair) ([]value.Pair, errors.Error) {
+	return this.stage(_UPDATE, updates)
+}
+
+func (this *transactionKeyspace) Upsert(upserts []value.Pair) ([]value.Pair, errors.Error) {
+	return this.stage(_UPSERT, upserts)
+}
+
+func (this *transactionKeyspace) Delete(deletes []string) ([]string, errors.Error) {
+	if _, ok := this.Keyspace.(TransactionalKeyspace); !ok {
+		return nil, errors.NewTransactionNotSupportedError(this.Name())
+	}
+
+	this.transaction.Lock()
+	defer this.transaction.Unlock()
+
+	errs := this.read(deletes)
+	if len(errs) > 0 {
+		return nil, errs[0]
+	}
+
+	rv := make([]string, 0, len(deletes))
+	for _, key := range deletes {
+		if this.current(key) != nil {
+			this.writes[key] = nil
+			rv = append(rv, key)
+		}
+	}
+
+	return rv, nil
+}
+
+// Stages the pairs, and returns the staged ones. As with the
+// datastores, INSERT fails on existing keys, and UPDATE skips missing
+// keys.
+func (this *transactionKeyspace) stage(op int, pairs []value.Pair) ([]value.Pair, errors.Error) {
+	if _, ok := this.Keyspace.(TransactionalKeyspace); !ok {
+		return nil, errors.NewTransactionNotSupportedError(this.Name())
+	}
+
+	this.transaction.Lock()
+	defer this.transaction.Unlock()
+
+	keys := make([]string, len(pairs))
+	for i, pair := range pairs {
+		keys[i] = pair.Name
+	}
+
+	errs := this.read(keys)
+	if len(errs) > 0 {
+		return nil, errs[0]
+	}
+
+	var err errors.Error
+	rv := make([]value.Pair, 0, len(pairs))
+	for _, pair := range pairs {
+		switch op {
+		case _INSERT:
+			if this.current(pair.Name) != nil {
+				err = errors.NewTransactionKeyExistsError(pair.Name, this.Name())
+				continue
+			}
+		case _UPDATE:
+			if this.current(pair.Name) == nil {
+				continue
+			}
+		}
+
+		this.writes[pair.Name] = pair.Value.CopyForUpdate()
+		rv = append(rv, pair)
+	}
+
+	return rv, err
+}
+
+// Reads the committed values of the keys not yet seen by the
+// transaction. Called with the transaction locked.
+func (this *transactionKeyspace) read(keys []string) []errors.Error {
+	if this.transaction.done {
+		return []errors.Error{errors.NewTransactionNotFoundError(this.transaction.id)}
+	}
+
+	unread := make([]string, 0, len(keys))
+	for _, key := range keys {
+		if _, ok := this.reads[key]; !ok {
+			unread = append(unread, key)
+		}
+	}
+
+	if len(unread) == 0 {
+		return nil
+	}
+
+	pairs, fetchErrs := this.Keyspace.Fetch(unread)
+	for _, pair := range pairs {
+		this.reads[pair.Name] = pair.Value
+	}
+
+	// Some datastores report missing keys as errors
+	var errs []errors.Error
+	for _, err := range fetchErrs {
+		if err.Code() != errors.KEY_NOT_FOUND {
+			errs = append(errs, err)
+		}
+	}
+
+	// Only remember keys as missing if the fetch was complete
+	if len(errs) == 0 {
+		for _, key := range unread {
+			if _, ok := this.reads[key]; !ok {
+				this.reads[key] = nil
+			}
+		}
+	}
+
+	return errs
+}
+
+// The value of the key as seen by the transaction, or nil if missing.
+// Called with the transaction locked.
+func (this *transactionKeyspace) current(key string) value.Value {
+	if val, ok := this.writes[key]; ok {
+		return val
+	}
+
+	return this.reads[key]
+}
+
+// Validates the read set of the transaction: the documents read by
+// the transaction must still have the values it read, the keyspace
+// must have the count it read, and the recorded scans must find no
+// other documents. Writes are always preceded by reads. Called during
+// commit.
+func (this *transactionKeyspace) validate() errors.Error {
+	keys := make([]string, 0, len(this.reads))
+	for key, _ := range this.reads {
+		keys = append(keys, key)
+	}
+
+	pairs, errs := this.Keyspace.Fetch(keys)
+	for _, err := range errs {
+		if err.Code() != errors.KEY_NOT_FOUND {
+			return errors.NewTransactionCommitError(err, this.Name())
+		}
+	}
+
+	committed := make(map[string]value.Value, len(pairs))
+	for _, pair := range pairs {
+		committed[pair.Name] = pair.Value
+	}
+
+	for _, key := range keys {
+		read, now := this.reads[key], committed[key]
+		if (read == nil) != (now == nil) ||
+			(read != nil && !read.Equals(now).Truth()) {
+			return errors.NewTransactionConflictError(key, this.Name())
+		}
+	}
+
+	if this.counted {
+		count, err := this.Keyspace.Count()
+		if err != nil {
+			return errors.NewTransactionCommitError(err, this.Name())
+		}
+
+		if count != this.count {
+			return errors.NewTransactionPhantomError(this.Name())
+		}
+	}
+
+	for _, scan := range this.scans {
+		if err := scan.validate(this); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// The staged writes, in key order.
+func (this *transactionKeyspace) mutations() []Mutation {
+	return this.keyMutations(this.writes)
+}
+
+// The mutations that restore the written keys to their committed
+// values, in key order.
+func (this *transactionKeyspace) undoMutations() []Mutation {
+	return this.keyMutations(this.reads)
+}
+
+func (this *transactionKeyspace) keyMutations(values map[string]value.Value) []Mutation {
+	keys := make([]string, 0, len(this.writes))
+	for key, _ := range this.writes {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+	rv := make([]Mutation, len(keys))
+	for i, key := range keys {
+		rv[i] = Mutation{Key: key, Value: values[key]}
+	}
+
+	return rv
+}
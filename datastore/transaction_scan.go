@@ -0,0 +1,159 @@
+//  Copyright (c) 2016 Couchbase, Inc.
+//  Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file
+//  except in compliance with the License. You may obtain a copy of the License at
+//    http://www.apache.org/licenses/LICENSE-2.0
+//  Unless required by applicable law or agreed to in writing, software distributed under the
+//  License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+//  either express or implied. See the License for the specific language governing permissions
+//  and limitations under the License.
+
+package datastore
+
+import (
+	"math"
+
+	"github.com/couchbase/query/errors"
+)
+
+// An index scan made within a transaction. At commit, the scan is
+// repeated, and must return the same keys; otherwise, another writer
+// has added or removed documents within the scanned range.
+type transactionScan struct {
+	index Index
+	span  *Span           // nil for a scan of all the entries of a primary index
+	keys  map[string]bool // Keys returned by the scan
+	last  *IndexEntry     // Last entry returned, if the scan stopped early
+}
+
+// Returns the keys written by the transaction to the keyspace, and
+// whether each exists within the transaction. Index scans within the
+// transaction skip the written keys, as the index only sees their
+// committed values, and return the existing ones after the entries of
+// the index.
+func (this *Transaction) Staged(keyspace Keyspace) (map[string]bool, errors.Error) {
+	this.Lock()
+	defer this.Unlock()
+
+	if this.done {
+		return nil, errors.NewTransactionNotFoundError(this.id)
+	}
+
+	tk := this.keyspace(keyspace)
+	rv := make(map[string]bool, len(tk.writes))
+	for key, val := range tk.writes {
+		rv[key] = val != nil
+	}
+
+	return rv, nil
+}
+
+// Records the keys returned by a scan of the index within the
+// transaction. A nil span is a scan of all the entries of a primary
+// index. last is the last entry returned, if the scan stopped before
+// the end of its span, and nil otherwise.
+func (this *Transaction) RecordScan(keyspace Keyspace, index Index, span *Span,
+	keys []string, last *IndexEntry) errors.Error {
+	this.Lock()
+	defer this.Unlock()
+
+	if this.done {
+		return errors.NewTransactionNotFoundError(this.id)
+	}
+
+	scan := &transactionScan{
+		index: index,
+		span:  span,
+		keys:  make(map[string]bool, len(keys)),
+		last:  last,
+	}
+
+	for _, key := range keys {
+		scan.keys[key] = true
+	}
+
+	tk := this.keyspace(keyspace)
+	tk.scans = append(tk.scans, scan)
+	return nil
+}
+
+// Repeats the scan, up to its last entry if it stopped early. Keys
+// written by the transaction are validated as reads, and are ignored
+// here. Called during commit.
+func (this *transactionScan) validate(tk *transactionKeyspace) errors.Error {
+	context := &scanContext{}
+	conn := NewIndexConnection(context)
+	defer func() {
+		select {
+		case conn.StopChannel() <- false:
+		default:
+		}
+	}()
+
+	if this.span == nil {
+		go this.index.(PrimaryIndex).ScanEntries("", math.MaxInt64, SCAN_PLUS, nil, conn)
+	} else {
+		go this.index.Scan("", this.span, false, math.MaxInt64, SCAN_PLUS, nil, conn)
+	}
+
+	found := make(map[string]bool, len(this.keys))
+	complete := true
+	for entry := range conn.EntryChannel() {
+		if _, ok := tk.writes[entry.PrimaryKey]; !ok {
+			if !this.keys[entry.PrimaryKey] {
+				return errors.NewTransactionPhantomError(tk.Name())
+			}
+
+			found[entry.PrimaryKey] = true
+		}
+
+		if this.last != nil && sameEntry(entry, this.last) {
+			complete = false
+			break
+		}
+	}
+
+	// The index reports errors before closing the connection
+	if complete && context.err != nil {
+		return errors.NewTransactionCommitError(context.err, tk.Name())
+	}
+
+	for key, _ := range this.keys {
+		if _, ok := tk.writes[key]; !ok && !found[key] {
+			return errors.NewTransactionPhantomError(tk.Name())
+		}
+	}
+
+	return nil
+}
+
+func sameEntry(entry, other *IndexEntry) bool {
+	if entry.PrimaryKey != other.PrimaryKey || len(entry.EntryKey) != len(other.EntryKey) {
+		return false
+	}
+
+	for i, key := range entry.EntryKey {
+		if key.Collate(other.EntryKey[i]) != 0 {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Receives the errors of the scans repeated at commit.
+type scanContext struct {
+	err errors.Error
+}
+
+func (this *scanContext) Fatal(err errors.Error) {
+	this.Error(err)
+}
+
+func (this *scanContext) Error(err errors.Error) {
+	if this.err == nil {
+		this.err = err
+	}
+}
+
+func (this *scanContext) Warning(wrn errors.Error) {
+}
@@ -105,3 +105,10 @@ func NewAdminNotSSLEnabledError() Error {
 	return &err{level: EXCEPTION, ICode: ADMIN_SSL_NOT_ENABLED, IKey: "admin.service.ssl_cert",
 		InternalMsg: "server is not ssl enabled", InternalCaller: CallerN(1)}
 }
+
+const ADMIN_AUDIT_ERROR = 2150
+
+func NewAdminAuditError(e error, msg string) Error {
+	return &err{level: EXCEPTION, ICode: ADMIN_AUDIT_ERROR, IKey: "admin.audit", ICause: e,
+		InternalMsg: "Error writing audit trail " + msg, InternalCaller: CallerN(1)}
+}
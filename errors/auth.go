@@ -0,0 +1,41 @@
+//  Copyright (c) 2016 Couchbase, Inc.
+//  Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file
+//  except in compliance with the License. You may obtain a copy of the License at
+//    http://www.apache.org/licenses/LICENSE-2.0
+//  Unless required by applicable law or agreed to in writing, software distributed under the
+//  License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+//  either express or implied. See the License for the specific language governing permissions
+//  and limitations under the License.
+
+package errors
+
+import (
+	"fmt"
+)
+
+// Engine-level users and roles error codes
+func NewNoCredentialStoreError() Error {
+	return &err{level: EXCEPTION, ICode: 10100, IKey: "auth.no_credential_store",
+		InternalMsg: "No credential store is configured for users and roles.", InternalCaller: CallerN(1)}
+}
+
+func NewCredentialStoreError(e error, msg string) Error {
+	return &err{level: EXCEPTION, ICode: 10110, IKey: "auth.credential_store_error", ICause: e,
+		InternalMsg: "Error accessing credential store " + msg, InternalCaller: CallerN(1)}
+}
+
+func NewUserNotFoundError(user string) Error {
+	return &err{level: EXCEPTION, ICode: 10120, IKey: "auth.user_not_found",
+		InternalMsg: fmt.Sprintf("User %s is not defined.", user), InternalCaller: CallerN(1)}
+}
+
+func NewInvalidRoleError(role string) Error {
+	return &err{level: EXCEPTION, ICode: 10130, IKey: "auth.invalid_role",
+		InternalMsg: fmt.Sprintf("Role %s is not defined.", role), InternalCaller: CallerN(1)}
+}
+
+func NewRoleKeyspaceError(role string) Error {
+	return &err{level: EXCEPTION, ICode: 10140, IKey: "auth.role_keyspace",
+		InternalMsg:    fmt.Sprintf("Role %s applies to all keyspaces and cannot be granted ON a keyspace.", role),
+		InternalCaller: CallerN(1)}
+}
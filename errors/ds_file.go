@@ -58,8 +58,10 @@ func NewFileKeyspaceNotDirError(e error, msg string) Error {
 		InternalMsg: "Keyspace path must be a directory " + msg, InternalCaller: CallerN(1)}
 }
 
+const FILE_INDEX_NOT_FOUND = 15009
+
 func NewFileIdxNotFound(e error, msg string) Error {
-	return &err{level: EXCEPTION, ICode: 15009, IKey: "datastore.file.idx_not_found", ICause: e,
+	return &err{level: EXCEPTION, ICode: FILE_INDEX_NOT_FOUND, IKey: "datastore.file.idx_not_found", ICause: e,
 		InternalMsg: "Index not found " + msg, InternalCaller: CallerN(1)}
 }
 
@@ -72,3 +74,13 @@ func NewFilePrimaryIdxNoDropError(e error, msg string) Error {
 	return &err{level: EXCEPTION, ICode: 15011, IKey: "datastore.file.primary_idx_no_drop", ICause: e,
 		InternalMsg: "Primary Index cannot be dropped " + msg, InternalCaller: CallerN(1)}
 }
+
+func NewFileIdxExists(e error, msg string) Error {
+	return &err{level: EXCEPTION, ICode: 15012, IKey: "datastore.file.idx_exists", ICause: e,
+		InternalMsg: "Index already exists " + msg, InternalCaller: CallerN(1)}
+}
+
+func NewFileIdxMetadataError(e error, msg string) Error {
+	return &err{level: EXCEPTION, ICode: 15013, IKey: "datastore.file.idx_metadata", ICause: e,
+		InternalMsg: "Invalid index metadata " + msg, InternalCaller: CallerN(1)}
+}
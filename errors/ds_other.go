@@ -55,8 +55,10 @@ func NewOtherNotSupportedError(e error, msg string) Error {
 		InternalMsg: "Not supported for this datastore " + msg, InternalCaller: CallerN(1)}
 }
 
+const KEY_NOT_FOUND = 16007
+
 func NewOtherKeyNotFoundError(e error, msg string) Error {
-	return &err{level: EXCEPTION, ICode: 16007, IKey: "datastore.other.key_not_found", ICause: e,
+	return &err{level: EXCEPTION, ICode: KEY_NOT_FOUND, IKey: "datastore.other.key_not_found", ICause: e,
 		InternalMsg: "Key not found " + msg, InternalCaller: CallerN(1)}
 }
 
@@ -64,3 +66,13 @@ func NewInferencerNotFoundError(e error, msg string) Error {
 	return &err{level: EXCEPTION, ICode: 16020, IKey: "datastore.other.inferencer_not_found", ICause: e,
 		InternalMsg: "Inferencer not found " + msg, InternalCaller: CallerN(1)}
 }
+
+func NewInferInvalidOptionError(option string, msg string) Error {
+	return &err{level: EXCEPTION, ICode: 16021, IKey: "datastore.other.infer_invalid_option",
+		InternalMsg: fmt.Sprintf("Invalid INFER option %s: %s", option, msg), InternalCaller: CallerN(1)}
+}
+
+func NewInferNoDocumentsError(msg string) Error {
+	return &err{level: EXCEPTION, ICode: 16022, IKey: "datastore.other.infer_no_documents",
+		InternalMsg: "No documents could be sampled for INFER " + msg, InternalCaller: CallerN(1)}
+}
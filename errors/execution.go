@@ -118,3 +118,63 @@ func NewScanVectorTooManyScannedBuckets(buckets []string) Error {
 		InternalMsg: fmt.Sprintf("The scan_vector parameter should not be used for queries accessing more than one keyspace. "+
 			"Use scan_vectors instead. Keyspaces: %v", buckets), InternalCaller: CallerN(1)}
 }
+
+func NewWithRecursionLimitError(alias string, limit int) Error {
+	return &err{level: EXCEPTION, ICode: 5200, IKey: "execution.with_recursion_limit",
+		InternalMsg:    fmt.Sprintf("Recursive WITH alias %s exceeded the limit of %d iterations.", alias, limit),
+		InternalCaller: CallerN(1)}
+}
+
+func NewSortSpillError(e error) Error {
+	return &err{level: EXCEPTION, ICode: 5210, IKey: "execution.sort_spill_error", ICause: e,
+		InternalMsg: "Error spilling ORDER BY results to disk.", InternalCaller: CallerN(1)}
+}
+
+func NewGroupSpillError(e error) Error {
+	return &err{level: EXCEPTION, ICode: 5220, IKey: "execution.group_spill_error", ICause: e,
+		InternalMsg: "Error spilling GROUP BY results to disk.", InternalCaller: CallerN(1)}
+}
+
+func NewAnalyzeError(e error, keyspace string) Error {
+	return &err{level: EXCEPTION, ICode: 5230, IKey: "execution.analyze_error", ICause: e,
+		InternalMsg: fmt.Sprintf("Error analyzing keyspace %s.", keyspace), InternalCaller: CallerN(1)}
+}
+
+func NewFunctionExistsError(name string) Error {
+	return &err{level: EXCEPTION, ICode: 5240, IKey: "execution.function_exists",
+		InternalMsg: fmt.Sprintf("Function %s already exists.", name), InternalCaller: CallerN(1)}
+}
+
+func NewFunctionNotFoundError(name string) Error {
+	return &err{level: EXCEPTION, ICode: 5250, IKey: "execution.function_not_found",
+		InternalMsg: fmt.Sprintf("Function %s is not defined.", name), InternalCaller: CallerN(1)}
+}
+
+func NewFunctionArgumentsError(name string, expected, actual int) Error {
+	return &err{level: EXCEPTION, ICode: 5260, IKey: "execution.function_arguments",
+		InternalMsg:    fmt.Sprintf("Function %s takes %d arguments, but was called with %d.", name, expected, actual),
+		InternalCaller: CallerN(1)}
+}
+
+func NewFunctionRecursionError(name string, limit int) Error {
+	return &err{level: EXCEPTION, ICode: 5270, IKey: "execution.function_recursion_limit",
+		InternalMsg:    fmt.Sprintf("Function %s exceeded the limit of %d nested function calls.", name, limit),
+		InternalCaller: CallerN(1)}
+}
+
+func NewDocumentMutationError(e error, op, key string) Error {
+	return &err{level: EXCEPTION, ICode: 5280, IKey: "execution.document_mutation_error", ICause: e,
+		InternalMsg: fmt.Sprintf("Failed to %s document %s.", op, key), InternalCaller: CallerN(1)}
+}
+
+func NewMemoryQuotaExceededError(quota int64) Error {
+	return &err{level: EXCEPTION, ICode: 5290, IKey: "execution.memory_quota_exceeded",
+		InternalMsg:    fmt.Sprintf("Request exceeded its memory quota of %d bytes.", quota),
+		InternalCaller: CallerN(1)}
+}
+
+func NewNodeQuotaExceededError(quota int64) Error {
+	return &err{level: EXCEPTION, ICode: 5300, IKey: "execution.node_quota_exceeded",
+		InternalMsg:    fmt.Sprintf("Requests on this node exceeded the memory quota of %d bytes.", quota),
+		InternalCaller: CallerN(1)}
+}
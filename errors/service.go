@@ -98,3 +98,8 @@ func NewServiceErrorDecodeNil() Error {
 	return &err{level: EXCEPTION, ICode: 1160, IKey: "service.io.request.type",
 		InternalMsg: "Failed to decode nil value.", InternalCaller: CallerN(1)}
 }
+
+func NewServiceErrorWorkloadSaturated(class string) Error {
+	return &err{level: EXCEPTION, ICode: 1170, IKey: "service.workload.saturated",
+		InternalMsg: fmt.Sprintf("Workload class %s is saturated. Retry the request later.", class), InternalCaller: CallerN(1)}
+}
@@ -69,6 +69,9 @@ const (
 	NO_SUCH_ALIAS       = 141
 	NO_SUCH_ALIAS_MSG   = "Alias does not exist "
 
+	INVALID_OUTPUT_FORMAT     = 142
+	INVALID_OUTPUT_FORMAT_MSG = "Invalid output format; use one of json, table, csv or vertical. Input value : "
+
 	//Generic Errors (170 - 199)
 	OPERATION_TIMEOUT     = 170
 	OPERATION_TIMEOUT_MSG = "Operation timed out. Check query service url "
@@ -193,6 +196,10 @@ func NewShellErrorNoSuchAlias(msg string) Error {
 
 }
 
+func NewShellErrorInvalidOutputFormat(msg string) Error {
+	return &err{level: EXCEPTION, ICode: INVALID_OUTPUT_FORMAT, IKey: "shell.invalid.output.format", InternalMsg: INVALID_OUTPUT_FORMAT_MSG + msg, InternalCaller: CallerN(1)}
+}
+
 //Generic Errors
 
 func NewShellErrorOperationTimeout(msg string) Error {
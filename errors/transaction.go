@@ -0,0 +1,71 @@
+//  Copyright (c) 2016 Couchbase, Inc.
+//  Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file
+//  except in compliance with the License. You may obtain a copy of the License at
+//    http://www.apache.org/licenses/LICENSE-2.0
+//  Unless required by applicable law or agreed to in writing, software distributed under the
+//  License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+//  either express or implied. See the License for the specific language governing permissions
+//  and limitations under the License.
+
+package errors
+
+import (
+	"fmt"
+)
+
+// Transaction errors - errors that are created by transactions and the
+// transaction statements
+
+func NewTransactionNotFoundError(id string) Error {
+	return &err{level: EXCEPTION, ICode: 17000, IKey: "transaction.not_found",
+		InternalMsg: fmt.Sprintf("Transaction %s is not active; it may have expired.", id), InternalCaller: CallerN(1)}
+}
+
+func NewTransactionCredentialsError(id string) Error {
+	return &err{level: EXCEPTION, ICode: 17005, IKey: "transaction.credentials",
+		InternalMsg: fmt.Sprintf("Transaction %s was started by other credentials.", id), InternalCaller: CallerN(1)}
+}
+
+func NewNoTransactionError(stmt string) Error {
+	return &err{level: EXCEPTION, ICode: 17010, IKey: "transaction.none",
+		InternalMsg: fmt.Sprintf("%s requires an active transaction.", stmt), InternalCaller: CallerN(1)}
+}
+
+func NewTransactionInProgressError(id string) Error {
+	return &err{level: EXCEPTION, ICode: 17020, IKey: "transaction.in_progress",
+		InternalMsg: fmt.Sprintf("Transaction %s is already in progress.", id), InternalCaller: CallerN(1)}
+}
+
+func NewTransactionNotSupportedError(keyspace string) Error {
+	return &err{level: EXCEPTION, ICode: 17030, IKey: "transaction.not_supported",
+		InternalMsg:    fmt.Sprintf("Keyspace %s does not support writes within a transaction.", keyspace),
+		InternalCaller: CallerN(1)}
+}
+
+func NewTransactionKeyExistsError(key, keyspace string) Error {
+	return &err{level: EXCEPTION, ICode: 17040, IKey: "transaction.key_exists",
+		InternalMsg: fmt.Sprintf("Key %s already exists in keyspace %s.", key, keyspace), InternalCaller: CallerN(1)}
+}
+
+func NewTransactionConflictError(key, keyspace string) Error {
+	return &err{level: EXCEPTION, ICode: 17050, IKey: "transaction.conflict",
+		InternalMsg: fmt.Sprintf("Key %s in keyspace %s was changed by another writer; "+
+			"the transaction has been rolled back.", key, keyspace), InternalCaller: CallerN(1)}
+}
+
+func NewTransactionPhantomError(keyspace string) Error {
+	return &err{level: EXCEPTION, ICode: 17055, IKey: "transaction.phantom",
+		InternalMsg: fmt.Sprintf("Documents were added to or removed from keyspace %s within the range "+
+			"read by the transaction; the transaction has been rolled back.", keyspace), InternalCaller: CallerN(1)}
+}
+
+func NewTransactionCommitError(e error, keyspace string) Error {
+	return &err{level: EXCEPTION, ICode: 17060, IKey: "transaction.commit_error", ICause: e,
+		InternalMsg: fmt.Sprintf("Error committing transaction to keyspace %s.", keyspace), InternalCaller: CallerN(1)}
+}
+
+func NewTransactionIndexJoinError(alias, op string) Error {
+	return &err{level: EXCEPTION, ICode: 17070, IKey: "transaction.index_" + op,
+		InternalMsg:    fmt.Sprintf("Index %s on term %s is not supported within a transaction.", op, alias),
+		InternalCaller: CallerN(1)}
+}
@@ -0,0 +1,374 @@
+//  Copyright (c) 2016 Couchbase, Inc.
+//  Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file
+//  except in compliance with the License. You may obtain a copy of the License at
+//    http://www.apache.org/licenses/LICENSE-2.0
+//  Unless required by applicable law or agreed to in writing, software distributed under the
+//  License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+//  either express or implied. See the License for the specific language governing permissions
+//  and limitations under the License.
+
+package execution
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"sort"
+	"time"
+
+	"github.com/couchbase/query/datastore"
+	"github.com/couchbase/query/errors"
+	"github.com/couchbase/query/expression"
+	"github.com/couchbase/query/plan"
+	"github.com/couchbase/query/value"
+)
+
+const (
+	_ANALYZE_SAMPLE_SIZE    = 1000 // Default number of documents sampled
+	_ANALYZE_HISTOGRAM_BINS = 16   // Default number of histogram bins per field
+)
+
+// Samples the documents of a keyspace, gathers statistics on their
+// fields, and persists the statistics through the datastore.
+type AnalyzeKeyspace struct {
+	base
+	plan *plan.AnalyzeKeyspace
+}
+
+func NewAnalyzeKeyspace(plan *plan.AnalyzeKeyspace) *AnalyzeKeyspace {
+	rv := &AnalyzeKeyspace{
+		base: newBase(),
+		plan: plan,
+	}
+
+	rv.output = rv
+	return rv
+}
+
+func (this *AnalyzeKeyspace) Accept(visitor Visitor) (interface{}, error) {
+	return visitor.VisitAnalyzeKeyspace(this)
+}
+
+func (this *AnalyzeKeyspace) Copy() Operator {
+	return &AnalyzeKeyspace{this.base.copy(), this.plan}
+}
+
+func (this *AnalyzeKeyspace) RunOnce(context *Context, parent value.Value) {
+	this.once.Do(func() {
+		defer context.Recover()       // Recover from any panic
+		defer close(this.itemChannel) // Broadcast that I have stopped
+		defer this.notify()           // Notify that I have stopped
+
+		if context.Readonly() {
+			return
+		}
+
+		timer := time.Now()
+		addTime := func() {
+			t := time.Since(timer) - this.chanTime
+			context.AddPhaseTime("analyze", t)
+			this.plan.AddTime(t)
+		}
+		defer addTime()
+
+		statistician, ok := context.Datastore().(datastore.Statistician)
+		if !ok {
+			context.Error(errors.NewOtherNotImplementedError(nil, "ANALYZE"))
+			return
+		}
+
+		keyspace := this.plan.Keyspace()
+		sampleSize, bins := this.options()
+
+		keys, documents, err := this.sampleKeys(context, keyspace, sampleSize)
+		if err != nil || keys == nil {
+			if err != nil {
+				context.Error(errors.NewAnalyzeError(err, keyspace.Name()))
+			}
+			return
+		}
+
+		docs, err := this.fetch(keyspace, keys)
+		if err != nil {
+			context.Error(errors.NewAnalyzeError(err, keyspace.Name()))
+			return
+		}
+
+		fields := this.plan.Node().Fields()
+		if len(fields) == 0 {
+			fields = topLevelFields(docs)
+		}
+
+		stats := &datastore.KeyspaceStatistics{
+			Namespace: keyspace.NamespaceId(),
+			Keyspace:  keyspace.Name(),
+			Documents: documents,
+			Sampled:   int64(len(docs)),
+			Updated:   time.Now().Format(time.RFC3339),
+			Fields:    make([]*datastore.FieldStatistics, 0, len(fields)),
+		}
+
+		for _, field := range fields {
+			fs, err := analyzeField(field, docs, documents, bins, context)
+			if err != nil {
+				context.Error(errors.NewAnalyzeError(err, keyspace.Name()))
+				return
+			}
+
+			stats.Fields = append(stats.Fields, fs)
+		}
+
+		err = statistician.SetKeyspaceStatistics(stats)
+		datastore.IndexesChanged()
+		if err != nil {
+			context.Error(errors.NewAnalyzeError(err, keyspace.Name()))
+		}
+	})
+}
+
+// The sample size and number of histogram bins, from the WITH clause
+// if given there.
+func (this *AnalyzeKeyspace) options() (sampleSize, bins int) {
+	sampleSize = _ANALYZE_SAMPLE_SIZE
+	bins = _ANALYZE_HISTOGRAM_BINS
+
+	with := this.plan.Node().With()
+	if with == nil {
+		return
+	}
+
+	if v, ok := with.Field("sample_size"); ok && v.Type() == value.NUMBER {
+		if n := int(value.AsNumberValue(v).Int64()); n > 0 {
+			sampleSize = n
+		}
+	}
+
+	if v, ok := with.Field("histogram_bins"); ok && v.Type() == value.NUMBER {
+		if n := int(value.AsNumberValue(v).Int64()); n > 0 {
+			bins = n
+		}
+	}
+
+	return
+}
+
+// Scans the primary index of the keyspace and keeps a uniform random
+// sample of its keys. Also returns the number of keys scanned. The
+// returned keys are nil if the operator was stopped.
+func (this *AnalyzeKeyspace) sampleKeys(context *Context, keyspace datastore.Keyspace,
+	sampleSize int) ([]string, int64, error) {
+	primary, err := onlinePrimaryIndex(keyspace)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	conn := datastore.NewIndexConnection(context)
+	defer notifyConn(conn.StopChannel())
+
+	scanVector := context.ScanVectorSource().ScanVector(keyspace.NamespaceId(), keyspace.Name())
+	go primary.ScanEntries(context.RequestId(), math.MaxInt64,
+		context.ScanConsistency(), scanVector, conn)
+
+	random := rand.New(rand.NewSource(time.Now().UnixNano()))
+	keys := make([]string, 0, sampleSize)
+	scanned := int64(0)
+
+	var entry *datastore.IndexEntry
+	ok := true
+	for ok {
+		select {
+		case entry, ok = <-conn.EntryChannel():
+			if ok {
+				// Reservoir sampling
+				scanned++
+				if len(keys) < sampleSize {
+					keys = append(keys, entry.PrimaryKey)
+				} else if r := random.Int63n(scanned); r < int64(sampleSize) {
+					keys[r] = entry.PrimaryKey
+				}
+			}
+		case <-this.stopChannel:
+			return nil, 0, nil
+		}
+	}
+
+	return keys, scanned, nil
+}
+
+func (this *AnalyzeKeyspace) fetch(keyspace datastore.Keyspace, keys []string) (
+	[]value.AnnotatedValue, error) {
+	docs := make([]value.AnnotatedValue, 0, len(keys))
+	batch := PipelineBatchSize()
+
+	for i := 0; i < len(keys); i += batch {
+		j := i + batch
+		if j > len(keys) {
+			j = len(keys)
+		}
+
+		pairs, errs := keyspace.Fetch(keys[i:j])
+		for _, err := range errs {
+			if err.IsFatal() {
+				return nil, err
+			}
+		}
+
+		for _, pair := range pairs {
+			if pair.Value != nil {
+				docs = append(docs, pair.Value)
+			}
+		}
+	}
+
+	return docs, nil
+}
+
+func onlinePrimaryIndex(keyspace datastore.Keyspace) (datastore.PrimaryIndex, error) {
+	indexers, err := keyspace.Indexers()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, indexer := range indexers {
+		primaries, err := indexer.PrimaryIndexes()
+		if err != nil {
+			return nil, err
+		}
+
+		for _, primary := range primaries {
+			state, _, err := primary.State()
+			if err != nil {
+				return nil, err
+			}
+
+			if state == datastore.ONLINE {
+				return primary, nil
+			}
+		}
+	}
+
+	return nil, fmt.Errorf("No online primary index on keyspace %s.", keyspace.Name())
+}
+
+// The top-level fields of the sampled documents, in name order.
+func topLevelFields(docs []value.AnnotatedValue) expression.Expressions {
+	names := make(map[string]bool)
+	for _, doc := range docs {
+		if doc.Type() != value.OBJECT {
+			continue
+		}
+
+		for name := range doc.Fields() {
+			names[name] = true
+		}
+	}
+
+	sorted := make([]string, 0, len(names))
+	for name := range names {
+		sorted = append(sorted, name)
+	}
+	sort.Strings(sorted)
+
+	fields := make(expression.Expressions, len(sorted))
+	for i, name := range sorted {
+		fields[i] = expression.NewIdentifier(name)
+	}
+
+	return fields
+}
+
+func analyzeField(field expression.Expression, docs []value.AnnotatedValue, documents int64,
+	bins int, context *Context) (*datastore.FieldStatistics, error) {
+	fs := &datastore.FieldStatistics{
+		Field: field.String(),
+	}
+
+	vals := make(collatedValues, 0, len(docs))
+	for _, doc := range docs {
+		v, err := field.Evaluate(doc, context)
+		if err != nil {
+			return nil, err
+		}
+
+		switch v.Type() {
+		case value.MISSING:
+			continue
+		case value.NULL:
+			fs.Nulls++
+		default:
+			vals = append(vals, v)
+		}
+
+		fs.Count++
+	}
+
+	if len(vals) == 0 {
+		return fs, nil
+	}
+
+	sort.Sort(vals)
+	fs.Min = vals[0].Actual()
+	fs.Max = vals[len(vals)-1].Actual()
+
+	// Equi-depth histogram; equal values never straddle two bins
+	depth := (len(vals) + bins - 1) / bins
+	fs.Histogram = make([]*datastore.HistogramBin, 0, bins)
+	bin := &datastore.HistogramBin{}
+	distinct, singletons := int64(0), int64(0)
+
+	for i := 0; i < len(vals); {
+		j := i + 1
+		for j < len(vals) && vals[j].Collate(vals[i]) == 0 {
+			j++
+		}
+
+		distinct++
+		if j-i == 1 {
+			singletons++
+		}
+
+		bin.Count += int64(j - i)
+		bin.Distinct++
+		if bin.Count >= int64(depth) || j == len(vals) {
+			bin.Max = vals[i].Actual()
+			fs.Histogram = append(fs.Histogram, bin)
+			bin = &datastore.HistogramBin{}
+		}
+
+		i = j
+	}
+
+	fs.Distinct = estimateDistinct(int64(len(vals)), distinct, singletons, int64(len(docs)), documents)
+	return fs, nil
+}
+
+// Estimates the number of distinct values in the keyspace from a
+// sample of n values with d distinct values, f1 of which occur once,
+// using the Duj1 estimator of Haas et al.
+func estimateDistinct(n, d, f1, sampled, documents int64) int64 {
+	if sampled == 0 || sampled >= documents {
+		return d
+	}
+
+	total := float64(n) * float64(documents) / float64(sampled)
+	est := float64(n) * float64(d) / (float64(n) - float64(f1) + float64(f1)*float64(n)/total)
+	if est > total {
+		est = total
+	}
+
+	return int64(est + 0.5)
+}
+
+type collatedValues []value.Value
+
+func (this collatedValues) Len() int {
+	return len(this)
+}
+
+func (this collatedValues) Less(i, j int) bool {
+	return this[i].Collate(this[j]) < 0
+}
+
+func (this collatedValues) Swap(i, j int) {
+	this[i], this[j] = this[j], this[i]
+}
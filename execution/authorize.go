@@ -12,6 +12,7 @@ package execution
 import (
 	"time"
 
+	"github.com/couchbase/query/auth"
 	"github.com/couchbase/query/datastore"
 	"github.com/couchbase/query/plan"
 	"github.com/couchbase/query/value"
@@ -57,8 +58,17 @@ func (this *Authorize) RunOnce(context *Context, parent value.Value) {
 
 		timer := time.Now()
 
-		ds := datastore.GetDatastore()
-		if ds != nil {
+		context.SetPrivileges(this.plan.Privileges())
+
+		// Engine-level roles take over from the datastore when
+		// a credential store is configured
+		if store := auth.GetCredentialStore(); store != nil {
+			err := auth.Authorize(store, this.plan.Privileges(), context.Credentials())
+			if err != nil {
+				context.Fatal(err)
+				return
+			}
+		} else if ds := datastore.GetDatastore(); ds != nil {
 			err := ds.Authorize(this.plan.Privileges(), context.Credentials())
 			if err != nil {
 				context.Fatal(err)
@@ -77,6 +87,10 @@ func (this *Authorize) RunOnce(context *Context, parent value.Value) {
 
 		go this.child.RunOnce(context, parent)
 
+		// Time spent waiting for the children is not kernel time
+		wait := time.Now()
+		defer func() { this.chanTime += time.Since(wait) }()
+
 		for {
 			select {
 			case <-this.childChannel: // Never closed
@@ -16,6 +16,7 @@ import (
 	"time"
 
 	atomic "github.com/couchbase/go-couchbase/platform"
+	"github.com/couchbase/query/algebra"
 	"github.com/couchbase/query/errors"
 	"github.com/couchbase/query/value"
 )
@@ -27,10 +28,28 @@ type base struct {
 	output      Operator
 	stop        Operator
 	parent      Parent
-	once        sync.Once
+	once        runOnce
 	batch       []value.AnnotatedValue
 	duration    time.Duration
 	chanTime    time.Duration
+	servTime    time.Duration
+	itemsIn     int64
+	itemsOut    int64
+	stats       *opStats // Nil unless the request is profiled
+}
+
+// Runs the body of an operator exactly once, and notes when it
+// started, for the operator statistics.
+type runOnce struct {
+	sync.Once
+	start time.Time
+}
+
+func (this *runOnce) Do(f func()) {
+	this.Once.Do(func() {
+		this.start = time.Now()
+		f()
+	})
 }
 
 const _ITEM_CAP = 512
@@ -117,6 +136,7 @@ func (this *base) copy() base {
 		input:       this.input,
 		output:      this.output,
 		parent:      this.parent,
+		stats:       this.stats,
 	}
 }
 
@@ -135,6 +155,7 @@ func (this *base) sendItem(item value.AnnotatedValue) bool {
 
 	select {
 	case this.output.ItemChannel() <- item:
+		this.itemsOut++
 		return true
 	case <-this.stopChannel: // Never closed
 		return false
@@ -178,14 +199,15 @@ func (this *base) runConsumer(cons consumer, context *Context, parent value.Valu
 
 			select {
 			case item, ok = <-this.input.ItemChannel():
+				this.chanTime += time.Since(t)
 				if ok {
+					this.itemsIn++
 					ok = cons.processItem(item, context)
 				}
 			case <-this.stopChannel: // Never closed
 				this.chanTime += time.Since(t)
 				break loop
 			}
-			this.chanTime += time.Since(t)
 		}
 
 		this.notifyStop()
@@ -209,10 +231,41 @@ func (this *base) readonly() bool {
 
 // Unblock all dependencies.
 func (this *base) notify() {
+	this.addStats()
 	this.notifyStop()
 	this.notifyParent()
 }
 
+// Used by the builder to profile the operator. Operators built from
+// the same plan operator share their statistics.
+func (this *base) setStats(stats *opStats) {
+	if this.stats == nil {
+		this.stats = stats
+	}
+}
+
+// Adds the counts and times of this operator to its statistics, once
+// it has stopped. Kernel time is the time the operator ran, other than
+// the time it waited on the datastore or on other operators.
+func (this *base) addStats() {
+	stats := this.stats
+	if stats == nil {
+		return
+	}
+
+	this.stats = nil
+	kernTime := time.Since(this.once.start) - this.chanTime - this.servTime
+	if kernTime < 0 || this.once.start.IsZero() {
+		kernTime = 0
+	}
+
+	go_atomic.AddInt64(&stats.itemsIn, this.itemsIn)
+	go_atomic.AddInt64(&stats.itemsOut, this.itemsOut)
+	go_atomic.AddInt64(&stats.kernTime, int64(kernTime))
+	go_atomic.AddInt64(&stats.servTime, int64(this.servTime))
+	go_atomic.AddInt64(&stats.waitTime, int64(this.chanTime))
+}
+
 // Notify parent, if any.
 func (this *base) notifyParent() {
 	parent := this.parent
@@ -321,3 +374,54 @@ func (this *base) requireKey(item value.AnnotatedValue, context *Context) (strin
 		return "", false
 	}
 }
+
+// Makes the images of a mutated document visible to RETURNING as OLD
+// and NEW. They are added to the parent scope of the item, so that
+// RETURNING * does not project them.
+func withImages(item value.AnnotatedValue, old, new value.Value) value.AnnotatedValue {
+	images := make(map[string]interface{}, 2)
+	if old != nil {
+		images[algebra.RETURNING_OLD] = old
+	}
+
+	if new != nil {
+		images[algebra.RETURNING_NEW] = new
+	}
+
+	var parent value.Value
+	if sv, ok := item.GetValue().(*value.ScopeValue); ok {
+		parent = sv.Parent()
+	}
+
+	fields, _ := item.Actual().(map[string]interface{})
+	rv := value.NewAnnotatedValue(value.NewScopeValue(fields, value.NewScopeValue(images, parent)))
+	rv.SetAnnotations(item)
+	return rv
+}
+
+// Reports each document of a batch that the keyspace failed to
+// mutate, with the keyspace error as the cause. If the whole batch
+// failed, the keyspace error is reported once. Documents skipped
+// without an error, such as ones already deleted, are not reported.
+func reportFailures(op string, keys []string, done map[string]bool, e errors.Error, context *Context) {
+	if e == nil {
+		return
+	}
+
+	if len(done) == 0 {
+		context.Error(e)
+		return
+	}
+
+	failed := false
+	for _, key := range keys {
+		if !done[key] {
+			context.Error(errors.NewDocumentMutationError(e, op, key))
+			failed = true
+		}
+	}
+
+	if !failed {
+		context.Error(e)
+	}
+}
@@ -26,7 +26,7 @@ func Build(plan plan.Operator, context *Context) (Operator, error) {
 		m = make(map[scannedIndex]bool)
 	}
 	builder := &builder{context, m}
-	x, err := plan.Accept(builder)
+	ex, err := builder.build(plan)
 
 	if err != nil {
 		return nil, err
@@ -40,10 +40,36 @@ func Build(plan plan.Operator, context *Context) (Operator, error) {
 		return nil, errors.NewScanVectorTooManyScannedBuckets(scannedIndexArr)
 	}
 
+	return ex, nil
+}
+
+// Builds the operator for a plan operator and its children. When the
+// request is profiled, the operator collects its statistics for the
+// plan operator.
+func (this *builder) build(op plan.Operator) (Operator, error) {
+	x, err := op.Accept(this)
+	if err != nil {
+		return nil, err
+	}
+
 	ex := x.(Operator)
+	if profiler := this.context.Profiler(); profiler != nil {
+		if prepared, ok := op.(*plan.Prepared); ok {
+			op = prepared.Operator
+		}
+
+		if p, ok := ex.(profiled); ok {
+			p.setStats(profiler.operatorStats(op))
+		}
+	}
+
 	return ex, nil
 }
 
+type profiled interface {
+	setStats(stats *opStats)
+}
+
 type scannedIndex struct {
 	namespace string
 	keyspace  string
@@ -112,12 +138,12 @@ func (this *builder) VisitIntersectScan(plan *plan.IntersectScan) (interface{},
 	scans := _INDEX_SCAN_POOL.Get()
 
 	for _, p := range plan.Scans() {
-		s, e := p.Accept(this)
+		s, e := this.build(p)
 		if e != nil {
 			return nil, e
 		}
 
-		scans = append(scans, s.(Operator))
+		scans = append(scans, s)
 	}
 
 	return NewIntersectScan(scans), nil
@@ -127,24 +153,28 @@ func (this *builder) VisitUnionScan(plan *plan.UnionScan) (interface{}, error) {
 	scans := _INDEX_SCAN_POOL.Get()
 
 	for _, p := range plan.Scans() {
-		s, e := p.Accept(this)
+		s, e := this.build(p)
 		if e != nil {
 			return nil, e
 		}
 
-		scans = append(scans, s.(Operator))
+		scans = append(scans, s)
 	}
 
 	return NewUnionScan(scans), nil
 }
 
 func (this *builder) VisitDistinctScan(plan *plan.DistinctScan) (interface{}, error) {
-	scan, err := plan.Scan().Accept(this)
+	scan, err := this.build(plan.Scan())
 	if err != nil {
 		return nil, err
 	}
 
-	return NewDistinctScan(scan.(Operator)), nil
+	return NewDistinctScan(scan), nil
+}
+
+func (this *builder) VisitExpressionScan(plan *plan.ExpressionScan) (interface{}, error) {
+	return NewExpressionScan(plan), nil
 }
 
 // Fetch
@@ -166,6 +196,14 @@ func (this *builder) VisitIndexJoin(plan *plan.IndexJoin) (interface{}, error) {
 	return NewIndexJoin(plan), nil
 }
 
+func (this *builder) VisitHashJoin(plan *plan.HashJoin) (interface{}, error) {
+	return NewHashJoin(plan), nil
+}
+
+func (this *builder) VisitNLJoin(plan *plan.NLJoin) (interface{}, error) {
+	return NewNLJoin(plan), nil
+}
+
 func (this *builder) VisitNest(plan *plan.Nest) (interface{}, error) {
 	return NewNest(plan), nil
 }
@@ -201,6 +239,11 @@ func (this *builder) VisitFinalGroup(plan *plan.FinalGroup) (interface{}, error)
 	return NewFinalGroup(plan), nil
 }
 
+// Window
+func (this *builder) VisitWindow(plan *plan.Window) (interface{}, error) {
+	return NewWindow(plan), nil
+}
+
 // Project
 func (this *builder) VisitInitialProject(plan *plan.InitialProject) (interface{}, error) {
 	return NewInitialProject(plan), nil
@@ -224,43 +267,43 @@ func (this *builder) VisitUnionAll(plan *plan.UnionAll) (interface{}, error) {
 	children := _UNION_POOL.Get()
 
 	for _, child := range plan.Children() {
-		c, e := child.Accept(this)
+		c, e := this.build(child)
 		if e != nil {
 			return nil, e
 		}
 
-		children = append(children, c.(Operator))
+		children = append(children, c)
 	}
 
 	return NewUnionAll(children...), nil
 }
 
 func (this *builder) VisitIntersectAll(plan *plan.IntersectAll) (interface{}, error) {
-	first, e := plan.First().Accept(this)
+	first, e := this.build(plan.First())
 	if e != nil {
 		return nil, e
 	}
 
-	second, e := plan.Second().Accept(this)
+	second, e := this.build(plan.Second())
 	if e != nil {
 		return nil, e
 	}
 
-	return NewIntersectAll(first.(Operator), second.(Operator)), nil
+	return NewIntersectAll(first, second), nil
 }
 
 func (this *builder) VisitExceptAll(plan *plan.ExceptAll) (interface{}, error) {
-	first, e := plan.First().Accept(this)
+	first, e := this.build(plan.First())
 	if e != nil {
 		return nil, e
 	}
 
-	second, e := plan.Second().Accept(this)
+	second, e := this.build(plan.Second())
 	if e != nil {
 		return nil, e
 	}
 
-	return NewExceptAll(first.(Operator), second.(Operator)), nil
+	return NewExceptAll(first, second), nil
 }
 
 // Order
@@ -318,27 +361,27 @@ func (this *builder) VisitMerge(plan *plan.Merge) (interface{}, error) {
 	var update, delete, insert Operator
 
 	if plan.Update() != nil {
-		op, e := plan.Update().Accept(this)
+		op, e := this.build(plan.Update())
 		if e != nil {
 			return nil, e
 		}
-		update = op.(Operator)
+		update = op
 	}
 
 	if plan.Delete() != nil {
-		op, e := plan.Delete().Accept(this)
+		op, e := this.build(plan.Delete())
 		if e != nil {
 			return nil, e
 		}
-		delete = op.(Operator)
+		delete = op
 	}
 
 	if plan.Insert() != nil {
-		op, e := plan.Insert().Accept(this)
+		op, e := this.build(plan.Insert())
 		if e != nil {
 			return nil, e
 		}
-		insert = op.(Operator)
+		insert = op
 	}
 
 	return NewMerge(plan, update, delete, insert), nil
@@ -351,17 +394,27 @@ func (this *builder) VisitAlias(plan *plan.Alias) (interface{}, error) {
 
 // Authorize
 func (this *builder) VisitAuthorize(plan *plan.Authorize) (interface{}, error) {
-	child, err := plan.Child().Accept(this)
+	child, err := this.build(plan.Child())
 	if err != nil {
 		return nil, err
 	}
 
-	return NewAuthorize(plan, child.(Operator)), nil
+	return NewAuthorize(plan, child), nil
+}
+
+// With
+func (this *builder) VisitWith(plan *plan.With) (interface{}, error) {
+	child, err := this.build(plan.Child())
+	if err != nil {
+		return nil, err
+	}
+
+	return NewWith(plan, child), nil
 }
 
 // Parallel
 func (this *builder) VisitParallel(plan *plan.Parallel) (interface{}, error) {
-	child, err := plan.Child().Accept(this)
+	child, err := this.build(plan.Child())
 	if err != nil {
 		return nil, err
 	}
@@ -371,7 +424,7 @@ func (this *builder) VisitParallel(plan *plan.Parallel) (interface{}, error) {
 	if maxParallelism == 1 {
 		return child, nil
 	} else {
-		return NewParallel(plan, child.(Operator)), nil
+		return NewParallel(plan, child), nil
 	}
 }
 
@@ -380,12 +433,12 @@ func (this *builder) VisitSequence(plan *plan.Sequence) (interface{}, error) {
 	children := _SEQUENCE_POOL.Get()
 
 	for _, pchild := range plan.Children() {
-		child, err := pchild.Accept(this)
+		child, err := this.build(pchild)
 		if err != nil {
 			return nil, err
 		}
 
-		children = append(children, child.(Operator))
+		children = append(children, child)
 	}
 
 	return NewSequence(children...), nil
@@ -445,3 +498,39 @@ func (this *builder) VisitExplain(plan *plan.Explain) (interface{}, error) {
 func (this *builder) VisitInferKeyspace(plan *plan.InferKeyspace) (interface{}, error) {
 	return NewInferKeyspace(plan), nil
 }
+
+// Analyze
+func (this *builder) VisitAnalyzeKeyspace(plan *plan.AnalyzeKeyspace) (interface{}, error) {
+	return NewAnalyzeKeyspace(plan), nil
+}
+
+// Function DDL
+func (this *builder) VisitCreateFunction(plan *plan.CreateFunction) (interface{}, error) {
+	return NewCreateFunction(plan), nil
+}
+
+func (this *builder) VisitDropFunction(plan *plan.DropFunction) (interface{}, error) {
+	return NewDropFunction(plan), nil
+}
+
+// Transactions
+func (this *builder) VisitStartTransaction(plan *plan.StartTransaction) (interface{}, error) {
+	return NewStartTransaction(plan), nil
+}
+
+func (this *builder) VisitCommitTransaction(plan *plan.CommitTransaction) (interface{}, error) {
+	return NewCommitTransaction(plan), nil
+}
+
+func (this *builder) VisitRollbackTransaction(plan *plan.RollbackTransaction) (interface{}, error) {
+	return NewRollbackTransaction(plan), nil
+}
+
+// Roles
+func (this *builder) VisitGrantRole(plan *plan.GrantRole) (interface{}, error) {
+	return NewGrantRole(plan), nil
+}
+
+func (this *builder) VisitRevokeRole(plan *plan.RevokeRole) (interface{}, error) {
+	return NewRevokeRole(plan), nil
+}
@@ -17,6 +17,7 @@ import (
 type Collect struct {
 	base
 	values []interface{}
+	memory memoryTracker
 }
 
 const _COLLECT_CAP = 64
@@ -43,6 +44,7 @@ func (this *Collect) Copy() Operator {
 }
 
 func (this *Collect) RunOnce(context *Context, parent value.Value) {
+	defer this.memory.release(context)
 	this.runConsumer(this, context, parent)
 }
 
@@ -54,7 +56,7 @@ func (this *Collect) processItem(item value.AnnotatedValue, context *Context) bo
 	}
 
 	this.values = append(this.values, item.Actual())
-	return true
+	return this.memory.track(item, context)
 }
 
 func (this *Collect) ValuesOnce() value.Value {
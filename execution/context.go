@@ -16,6 +16,7 @@ import (
 	"sync"
 	"time"
 
+	atomic "github.com/couchbase/go-couchbase/platform"
 	"github.com/couchbase/query/algebra"
 	"github.com/couchbase/query/datastore"
 	"github.com/couchbase/query/errors"
@@ -59,6 +60,11 @@ type Output interface {
 	MutationCount() uint64
 	SortCount() uint64
 	SetSortCount(i uint64)
+	AddSortSpill(size uint64)
+	AddGroupSpill(size uint64)
+	SetPeakMemory(size uint64)
+	SetPrivileges(privileges datastore.Privileges)
+	SetProfiler(profiler *Profiler)
 	AddPhaseOperator(p Phases)
 	AddPhaseCount(p Phases, c uint64)
 	FmtPhaseCounts() map[string]interface{}
@@ -69,6 +75,12 @@ type Output interface {
 }
 
 type Context struct {
+	sortMemory       atomic.AlignedInt64 // Memory used by ORDER BY; must be aligned
+	groupMemory      atomic.AlignedInt64 // Memory used by GROUP BY; must be aligned
+	memory           atomic.AlignedInt64 // Memory held by operators; must be aligned
+	peakMemory       atomic.AlignedInt64 // Peak of memory; must be aligned
+	memoryQuota      int64
+	peakMutex        sync.Mutex
 	requestId        string
 	datastore        datastore.Datastore
 	systemstore      datastore.Datastore
@@ -84,6 +96,8 @@ type Context struct {
 	output           Output
 	subplans         *subqueryMap
 	subresults       *subqueryMap
+	transaction      *datastore.Transaction
+	profiler         *Profiler
 	mutex            sync.RWMutex
 }
 
@@ -107,6 +121,7 @@ func NewContext(requestId string, datastore, systemstore datastore.Datastore,
 		output:           output,
 		subplans:         nil,
 		subresults:       nil,
+		memoryQuota:      GetMemoryQuota(),
 	}
 
 	if rv.maxParallelism <= 0 || rv.maxParallelism > runtime.NumCPU() {
@@ -172,6 +187,41 @@ func (this *Context) ScanVectorSource() timestamp.ScanVectorSource {
 	return this.scanVectorSource
 }
 
+// Sets the transaction within which the request runs.
+func (this *Context) SetTransaction(transaction *datastore.Transaction) {
+	this.transaction = transaction
+}
+
+func (this *Context) Transaction() *datastore.Transaction {
+	return this.transaction
+}
+
+// Sets the profiler that collects the operator statistics of the
+// request. Operators built afterwards are profiled.
+func (this *Context) SetProfiler(profiler *Profiler) {
+	this.profiler = profiler
+	this.output.SetProfiler(profiler)
+}
+
+func (this *Context) Profiler() *Profiler {
+	return this.profiler
+}
+
+// Returns the keyspace to use for fetches and mutations. Within a
+// transaction, they see and stage the transaction's writes.
+func (this *Context) Keyspace(keyspace datastore.Keyspace) datastore.Keyspace {
+	if this.transaction == nil {
+		return keyspace
+	}
+
+	return this.transaction.Keyspace(keyspace)
+}
+
+// Records the privileges checked for the request, for auditing
+func (this *Context) SetPrivileges(privileges datastore.Privileges) {
+	this.output.SetPrivileges(privileges)
+}
+
 func (this *Context) AddMutationCount(i uint64) {
 	this.output.AddMutationCount(i)
 }
@@ -188,6 +238,80 @@ func (this *Context) SortCount() uint64 {
 	return this.output.SortCount()
 }
 
+func (this *Context) AddSortSpill(size uint64) {
+	this.output.AddSortSpill(size)
+}
+
+func (this *Context) AddGroupSpill(size uint64) {
+	this.output.AddGroupSpill(size)
+}
+
+// Adds to the memory used by ORDER BY, and returns the total
+func (this *Context) addSortMemory(size int64) int64 {
+	return atomic.AddInt64(&this.sortMemory, size)
+}
+
+// Adds to the memory used by GROUP BY, and returns the total
+func (this *Context) addGroupMemory(size int64) int64 {
+	return atomic.AddInt64(&this.groupMemory, size)
+}
+
+// Sets the memory quota of the request, overriding the default.
+// Zero or negative disables the quota.
+func (this *Context) SetMemoryQuota(size int64) {
+	if size < 0 {
+		size = 0
+	}
+	this.memoryQuota = size
+}
+
+func (this *Context) MemoryQuota() int64 {
+	return this.memoryQuota
+}
+
+func (this *Context) PeakMemory() int64 {
+	return atomic.LoadInt64(&this.peakMemory)
+}
+
+// Accounts for memory held by an operator. If the request or the node
+// exceeds its memory quota, the request is aborted and false is
+// returned.
+func (this *Context) TrackMemory(size int64) bool {
+	m := atomic.AddInt64(&this.memory, size)
+	n := atomic.AddInt64(&nodeMemory, size)
+	if m > atomic.LoadInt64(&this.peakMemory) {
+		this.setPeakMemory(m)
+	}
+
+	if quota := this.memoryQuota; quota > 0 && m > quota {
+		this.Fatal(errors.NewMemoryQuotaExceededError(quota))
+		return false
+	}
+
+	if quota := GetNodeQuota(); quota > 0 && n > quota {
+		this.Fatal(errors.NewNodeQuotaExceededError(quota))
+		return false
+	}
+
+	return true
+}
+
+// Releases memory previously accounted for with TrackMemory.
+func (this *Context) ReleaseMemory(size int64) {
+	atomic.AddInt64(&this.memory, -size)
+	atomic.AddInt64(&nodeMemory, -size)
+}
+
+func (this *Context) setPeakMemory(size int64) {
+	this.peakMutex.Lock()
+	defer this.peakMutex.Unlock()
+
+	if size > atomic.LoadInt64(&this.peakMemory) {
+		atomic.StoreInt64(&this.peakMemory, size)
+		this.output.SetPeakMemory(uint64(size))
+	}
+}
+
 func (this *Context) AddPhaseOperator(p Phases) {
 	this.output.AddPhaseOperator(p)
 }
@@ -232,7 +356,11 @@ func (this *Context) EvaluateSubquery(query *algebra.Select, parent value.Value)
 
 	if !planFound {
 		var err error
-		subplan, err = planner.Build(query, this.datastore, this.systemstore, this.namespace, true)
+		if this.transaction != nil {
+			subplan, err = planner.BuildTransaction(query, this.datastore, this.systemstore, this.namespace, true)
+		} else {
+			subplan, err = planner.Build(query, this.datastore, this.systemstore, this.namespace, true)
+		}
 		if err != nil {
 			return nil, err
 		}
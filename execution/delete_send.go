@@ -69,6 +69,8 @@ func (this *SendDelete) beforeItems(context *Context, parent value.Value) bool {
 	}
 
 	switch l := limit.Actual().(type) {
+	case int64:
+		this.limit = l
 	case float64:
 		this.limit = int64(l)
 	default:
@@ -93,7 +95,9 @@ func (this *SendDelete) flushBatch(context *Context) bool {
 	keys := _STRING_POOL.Get()
 	defer _STRING_POOL.Put(keys)
 
-	for _, item := range this.batch {
+	olds := make([]value.Value, len(this.batch))
+
+	for i, item := range this.batch {
 		dv, ok := item.Field(this.plan.Alias())
 		if !ok {
 			context.Error(errors.NewDeleteAliasMissingError(this.plan.Alias()))
@@ -112,25 +116,35 @@ func (this *SendDelete) flushBatch(context *Context) bool {
 		}
 
 		keys = append(keys, key)
+		olds[i] = av
 	}
 
 	timer := time.Now()
 
-	deleted_keys, e := this.plan.Keyspace().Delete(keys)
+	deleted_keys, e := context.Keyspace(this.plan.Keyspace()).Delete(keys)
 
 	t := time.Since(timer)
+	this.servTime += t
 	context.AddPhaseTime("delete", t)
 	this.plan.AddTime(t)
 
 	// Update mutation count with number of deleted docs:
 	context.AddMutationCount(uint64(len(deleted_keys)))
 
-	if e != nil {
-		context.Error(e)
+	deleted := make(map[string]bool, len(deleted_keys))
+	for _, key := range deleted_keys {
+		deleted[key] = true
 	}
 
-	for _, item := range this.batch {
-		if !this.sendItem(item) {
+	reportFailures("delete", keys, deleted, e, context)
+
+	// Only the deleted documents are returned
+	for i, item := range this.batch {
+		if !deleted[keys[i]] {
+			continue
+		}
+
+		if !this.sendItem(withImages(item, olds[i], nil)) {
 			return false
 		}
 	}
@@ -22,6 +22,7 @@ type Distinct struct {
 	set     *value.Set
 	plan    *plan.Distinct
 	collect bool
+	memory  memoryTracker
 }
 
 const _DISTINCT_CAP = 1024
@@ -50,6 +51,7 @@ func (this *Distinct) Copy() Operator {
 }
 
 func (this *Distinct) RunOnce(context *Context, parent value.Value) {
+	defer this.memory.release(context)
 	this.runConsumer(this, context, parent)
 }
 
@@ -59,8 +61,13 @@ func (this *Distinct) processItem(item value.AnnotatedValue, context *Context) b
 		p = item
 	}
 
+	n := this.set.Len()
 	this.set.Put(p.(value.Value), item)
-	return true
+	if this.set.Len() == n {
+		return true
+	}
+
+	return this.memory.track(item, context)
 }
 
 func (this *Distinct) afterItems(context *Context) {
@@ -10,6 +10,8 @@
 package execution
 
 import (
+	"time"
+
 	"github.com/couchbase/query/errors"
 	"github.com/couchbase/query/plan"
 	"github.com/couchbase/query/value"
@@ -17,13 +19,15 @@ import (
 
 type Explain struct {
 	base
-	plan plan.Operator
+	plan         *plan.Explain
+	childChannel StopChannel
 }
 
-func NewExplain(plan plan.Operator) *Explain {
+func NewExplain(plan *plan.Explain) *Explain {
 	rv := &Explain{
-		base: newBase(),
-		plan: plan,
+		base:         newBase(),
+		plan:         plan,
+		childChannel: make(StopChannel, 1),
 	}
 
 	rv.output = rv
@@ -35,7 +39,11 @@ func (this *Explain) Accept(visitor Visitor) (interface{}, error) {
 }
 
 func (this *Explain) Copy() Operator {
-	return &Explain{this.base.copy(), this.plan}
+	return &Explain{
+		base:         this.base.copy(),
+		plan:         this.plan,
+		childChannel: make(StopChannel, 1),
+	}
 }
 
 func (this *Explain) RunOnce(context *Context, parent value.Value) {
@@ -44,6 +52,11 @@ func (this *Explain) RunOnce(context *Context, parent value.Value) {
 		defer close(this.itemChannel) // Broadcast that I have stopped
 		defer this.notify()           // Notify that I have stopped
 
+		if this.plan.Analyze() {
+			this.analyze(context, parent)
+			return
+		}
+
 		bytes, err := this.plan.MarshalJSON()
 		if err != nil {
 			context.Fatal(errors.NewExplainError(err, "EXPLAIN: Error marshaling JSON."))
@@ -55,3 +68,57 @@ func (this *Explain) RunOnce(context *Context, parent value.Value) {
 
 	})
 }
+
+// Executes the statement, discarding its results, and sends its plan
+// with the statistics of each operator.
+func (this *Explain) analyze(context *Context, parent value.Value) {
+	profiler := NewProfiler(this.plan.Operator())
+	context.SetProfiler(profiler)
+
+	pipeline, err := Build(this.plan.Operator(), context)
+	if err != nil {
+		context.Fatal(errors.NewExplainError(err, "EXPLAIN ANALYZE: Error building the plan."))
+		return
+	}
+
+	timer := time.Now()
+
+	sequence := NewSequence(pipeline, NewDiscard())
+	sequence.SetParent(this)
+	go sequence.RunOnce(context, parent)
+
+	stopped := false
+loop:
+	for {
+		select {
+		case <-this.childChannel: // Never closed
+			// Wait for the statement
+			break loop
+		case <-this.stopChannel: // Never closed
+			stopped = true
+			notifyChildren(sequence)
+		}
+	}
+
+	if stopped {
+		return
+	}
+
+	executionTime := time.Since(timer)
+	profile, err := profiler.Profile()
+	if err != nil {
+		context.Fatal(errors.NewExplainError(err, "EXPLAIN ANALYZE: Error marshaling JSON."))
+		return
+	}
+
+	this.sendItem(value.NewAnnotatedValue(map[string]interface{}{
+		"plan":          profile,
+		"text":          this.plan.Text(),
+		"analyze":       true,
+		"executionTime": executionTime.String(),
+	}))
+}
+
+func (this *Explain) ChildChannel() StopChannel {
+	return this.childChannel
+}
@@ -113,9 +113,10 @@ func (this *Fetch) flushBatch(context *Context) bool {
 	timer := time.Now()
 
 	// Fetch
-	pairs, errs := this.plan.Keyspace().Fetch(keys)
+	pairs, errs := context.Keyspace(this.plan.Keyspace()).Fetch(keys)
 
 	t := time.Since(timer)
+	this.servTime += t
 	context.AddPhaseTime("fetch", t)
 	this.plan.AddTime(t)
 
@@ -178,6 +179,9 @@ func (this *Fetch) flushBatch(context *Context) bool {
 		}
 
 		item := batchMap[key]
+		if score := item.GetAttachment("score"); score != nil {
+			fv.SetAttachment("score", score)
+		}
 		item.SetField(this.plan.Term().Alias(), fv)
 
 		if !this.sendItem(item) {
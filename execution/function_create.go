@@ -0,0 +1,77 @@
+//  Copyright (c) 2016 Couchbase, Inc.
+//  Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file
+//  except in compliance with the License. You may obtain a copy of the License at
+//    http://www.apache.org/licenses/LICENSE-2.0
+//  Unless required by applicable law or agreed to in writing, software distributed under the
+//  License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+//  either express or implied. See the License for the specific language governing permissions
+//  and limitations under the License.
+
+package execution
+
+import (
+	"github.com/couchbase/query/datastore"
+	"github.com/couchbase/query/expression"
+	"github.com/couchbase/query/plan"
+	"github.com/couchbase/query/value"
+)
+
+// Adds a user-defined function to the catalog, and persists it where
+// the datastore supports it.
+type CreateFunction struct {
+	base
+	plan *plan.CreateFunction
+}
+
+func NewCreateFunction(plan *plan.CreateFunction) *CreateFunction {
+	rv := &CreateFunction{
+		base: newBase(),
+		plan: plan,
+	}
+
+	rv.output = rv
+	return rv
+}
+
+func (this *CreateFunction) Accept(visitor Visitor) (interface{}, error) {
+	return visitor.VisitCreateFunction(this)
+}
+
+func (this *CreateFunction) Copy() Operator {
+	return &CreateFunction{this.base.copy(), this.plan}
+}
+
+func (this *CreateFunction) RunOnce(context *Context, parent value.Value) {
+	this.once.Do(func() {
+		defer context.Recover()       // Recover from any panic
+		defer close(this.itemChannel) // Broadcast that I have stopped
+		defer this.notify()           // Notify that I have stopped
+
+		if context.Readonly() {
+			return
+		}
+
+		node := this.plan.Node()
+		definition := expression.NewUserFunctionDefinition(node.Name(), node.Parameters(), node.Body())
+		err := expression.AddUserFunction(definition)
+		if err != nil {
+			context.Error(err)
+			return
+		}
+
+		functions, ok := context.Datastore().(datastore.FunctionStore)
+		if !ok {
+			return
+		}
+
+		err = functions.SetFunction(&datastore.FunctionDefinition{
+			Name:       definition.Name(),
+			Parameters: definition.Parameters(),
+			Body:       definition.Body().String(),
+		})
+		if err != nil {
+			expression.DeleteUserFunction(definition.Name())
+			context.Error(err)
+		}
+	})
+}
@@ -0,0 +1,72 @@
+//  Copyright (c) 2016 Couchbase, Inc.
+//  Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file
+//  except in compliance with the License. You may obtain a copy of the License at
+//    http://www.apache.org/licenses/LICENSE-2.0
+//  Unless required by applicable law or agreed to in writing, software distributed under the
+//  License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+//  either express or implied. See the License for the specific language governing permissions
+//  and limitations under the License.
+
+package execution
+
+import (
+	"github.com/couchbase/query/datastore"
+	"github.com/couchbase/query/expression"
+	"github.com/couchbase/query/plan"
+	"github.com/couchbase/query/value"
+)
+
+// Removes a user-defined function from the catalog, and from the
+// datastore where it is persisted.
+type DropFunction struct {
+	base
+	plan *plan.DropFunction
+}
+
+func NewDropFunction(plan *plan.DropFunction) *DropFunction {
+	rv := &DropFunction{
+		base: newBase(),
+		plan: plan,
+	}
+
+	rv.output = rv
+	return rv
+}
+
+func (this *DropFunction) Accept(visitor Visitor) (interface{}, error) {
+	return visitor.VisitDropFunction(this)
+}
+
+func (this *DropFunction) Copy() Operator {
+	return &DropFunction{this.base.copy(), this.plan}
+}
+
+func (this *DropFunction) RunOnce(context *Context, parent value.Value) {
+	this.once.Do(func() {
+		defer context.Recover()       // Recover from any panic
+		defer close(this.itemChannel) // Broadcast that I have stopped
+		defer this.notify()           // Notify that I have stopped
+
+		if context.Readonly() {
+			return
+		}
+
+		definition, _ := expression.GetUserFunction(this.plan.Node().Name())
+		err := expression.DeleteUserFunction(this.plan.Node().Name())
+		if err != nil {
+			context.Error(err)
+			return
+		}
+
+		functions, ok := context.Datastore().(datastore.FunctionStore)
+		if !ok {
+			return
+		}
+
+		err = functions.DeleteFunction(definition.Name())
+		if err != nil {
+			expression.AddUserFunction(definition)
+			context.Error(err)
+		}
+	})
+}
@@ -17,10 +17,16 @@ import (
 	"github.com/couchbase/query/value"
 )
 
+// Final grouping. With a GROUP BY memory budget, groups are sent on
+// as soon as they are computed, rather than kept until all the input
+// has been read.
 type FinalGroup struct {
 	base
 	plan   *plan.FinalGroup
 	groups map[string]value.AnnotatedValue
+	stream bool
+	sent   bool
+	memory memoryTracker
 }
 
 func NewFinalGroup(plan *plan.FinalGroup) *FinalGroup {
@@ -47,9 +53,15 @@ func (this *FinalGroup) Copy() Operator {
 }
 
 func (this *FinalGroup) RunOnce(context *Context, parent value.Value) {
+	defer this.memory.release(context)
 	this.runConsumer(this, context, parent)
 }
 
+func (this *FinalGroup) beforeItems(context *Context, parent value.Value) bool {
+	this.stream = GetGroupMemory() > 0
+	return true
+}
+
 func (this *FinalGroup) processItem(item value.AnnotatedValue, context *Context) bool {
 	// Generate the group key
 	var gk string
@@ -87,7 +99,13 @@ func (this *FinalGroup) processItem(item value.AnnotatedValue, context *Context)
 			aggregates[agg.String()] = v
 		}
 
-		return true
+		if this.stream {
+			delete(this.groups, gk)
+			this.sent = true
+			return this.sendItem(gv)
+		}
+
+		return this.memory.track(gv, context)
 	default:
 		context.Fatal(errors.NewInvalidValueError(fmt.Sprintf(
 			"Invalid or missing aggregates of type %T.", aggregates)))
@@ -103,7 +121,7 @@ func (this *FinalGroup) afterItems(context *Context) {
 	}
 
 	// Mo matching inputs, so send default values
-	if len(this.groups) == 0 {
+	if len(this.groups) == 0 && !this.sent {
 		av := value.NewAnnotatedValue(nil)
 		aggregates := make(map[string]value.Value, len(this.plan.Aggregates()))
 		av.SetAttachment("aggregates", aggregates)
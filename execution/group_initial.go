@@ -17,11 +17,16 @@ import (
 	"github.com/couchbase/query/value"
 )
 
-// Grouping of input data.
+// Grouping of input data. Past the GROUP BY memory budget, the
+// groups are sent on early and grouping starts over, as the groups
+// are cumulated again by IntermediateGroup.
 type InitialGroup struct {
 	base
 	plan   *plan.InitialGroup
 	groups map[string]value.AnnotatedValue
+	sizes  map[string]int64
+	size   int64
+	memory memoryTracker
 }
 
 func NewInitialGroup(plan *plan.InitialGroup) *InitialGroup {
@@ -48,6 +53,8 @@ func (this *InitialGroup) Copy() Operator {
 }
 
 func (this *InitialGroup) RunOnce(context *Context, parent value.Value) {
+	defer this.releaseSize(context)
+	defer this.memory.release(context)
 	this.runConsumer(this, context, parent)
 }
 
@@ -74,6 +81,10 @@ func (this *InitialGroup) processItem(item value.AnnotatedValue, context *Contex
 		for _, agg := range this.plan.Aggregates() {
 			aggregates[agg.String()] = agg.Default()
 		}
+
+		if !this.memory.track(gv, context) {
+			return false
+		}
 	}
 
 	// Cumulate aggregates
@@ -94,13 +105,58 @@ func (this *InitialGroup) processItem(item value.AnnotatedValue, context *Contex
 		aggregates[agg.String()] = v
 	}
 
-	return true
+	return this.account(gk, gv, context)
 }
 
 func (this *InitialGroup) afterItems(context *Context) {
+	this.sendGroups()
+}
+
+func (this *InitialGroup) sendGroups() bool {
 	for _, av := range this.groups {
 		if !this.sendItem(av) {
-			return
+			return false
 		}
 	}
+
+	return true
+}
+
+// Re-estimates the size of a group once it is cumulated, as its
+// aggregates may grow, and sends the groups on once the request
+// exceeds its memory budget.
+func (this *InitialGroup) account(gk string, gv value.AnnotatedValue, context *Context) bool {
+	budget := GetGroupMemory()
+	if budget <= 0 {
+		return true
+	}
+
+	if this.sizes == nil {
+		this.sizes = make(map[string]int64)
+	}
+
+	size := groupSize(gv)
+	delta := size - this.sizes[gk]
+	this.sizes[gk] = size
+	this.size += delta
+	if context.addGroupMemory(delta) <= budget {
+		return true
+	}
+
+	if !this.sendGroups() {
+		return false
+	}
+
+	this.groups = make(map[string]value.AnnotatedValue)
+	this.releaseSize(context)
+	this.memory.release(context)
+	return true
+}
+
+func (this *InitialGroup) releaseSize(context *Context) {
+	this.sizes = nil
+	if this.size != 0 {
+		context.addGroupMemory(-this.size)
+		this.size = 0
+	}
 }
@@ -17,11 +17,18 @@ import (
 	"github.com/couchbase/query/value"
 )
 
-// Grouping of groups. Recursable.
+// Grouping of groups. Recursable. Groups are partitioned to disk
+// past the GROUP BY memory budget, and each partition is grouped
+// separately once all the input has been read.
 type IntermediateGroup struct {
 	base
-	plan   *plan.IntermediateGroup
-	groups map[string]value.AnnotatedValue
+	plan       *plan.IntermediateGroup
+	groups     map[string]value.AnnotatedValue
+	sizes      map[string]int64
+	parent     value.Value
+	size       int64
+	partitions *groupPartitions
+	memory     memoryTracker
 }
 
 func NewIntermediateGroup(plan *plan.IntermediateGroup) *IntermediateGroup {
@@ -48,10 +55,46 @@ func (this *IntermediateGroup) Copy() Operator {
 }
 
 func (this *IntermediateGroup) RunOnce(context *Context, parent value.Value) {
+	defer this.releasePartitions(context)
+	defer this.memory.release(context)
 	this.runConsumer(this, context, parent)
 }
 
+func (this *IntermediateGroup) beforeItems(context *Context, parent value.Value) bool {
+	this.parent = parent
+	return true
+}
+
 func (this *IntermediateGroup) processItem(item value.AnnotatedValue, context *Context) bool {
+	gk, ok := this.cumulate(item, context)
+	if !ok {
+		return false
+	}
+
+	budget := GetGroupMemory()
+	if budget <= 0 {
+		return true
+	}
+
+	if this.sizes == nil {
+		this.sizes = make(map[string]int64)
+	}
+
+	// Re-estimate the group, as its aggregates may grow, and spill
+	// the groups once the request exceeds its memory budget
+	size := groupSize(this.groups[gk])
+	delta := size - this.sizes[gk]
+	this.sizes[gk] = size
+	this.size += delta
+	if context.addGroupMemory(delta) > budget {
+		return this.spill(context)
+	}
+
+	return true
+}
+
+// Cumulates the item into its group. Returns the group key.
+func (this *IntermediateGroup) cumulate(item value.AnnotatedValue, context *Context) (string, bool) {
 	// Generate the group key
 	var gk string
 	if len(this.plan.Keys()) > 0 {
@@ -59,7 +102,7 @@ func (this *IntermediateGroup) processItem(item value.AnnotatedValue, context *C
 		gk, e = groupKey(item, this.plan.Keys(), context)
 		if e != nil {
 			context.Fatal(errors.NewEvaluationError(e, "GROUP key"))
-			return false
+			return gk, false
 		}
 	}
 
@@ -68,7 +111,7 @@ func (this *IntermediateGroup) processItem(item value.AnnotatedValue, context *C
 	if gv == nil {
 		gv = item
 		this.groups[gk] = gv
-		return true
+		return gk, this.memory.track(gv, context)
 	}
 
 	// Cumulate aggregates
@@ -76,14 +119,14 @@ func (this *IntermediateGroup) processItem(item value.AnnotatedValue, context *C
 	if !ok {
 		context.Fatal(errors.NewInvalidValueError(
 			fmt.Sprintf("Invalid partial aggregates %v of type %T", part, part)))
-		return false
+		return gk, false
 	}
 
 	cumulative := gv.GetAttachment("aggregates").(map[string]value.Value)
 	if !ok {
 		context.Fatal(errors.NewInvalidValueError(
 			fmt.Sprintf("Invalid cumulative aggregates %v of type %T", cumulative, cumulative)))
-		return false
+		return gk, false
 	}
 
 	for _, agg := range this.plan.Aggregates() {
@@ -92,19 +135,96 @@ func (this *IntermediateGroup) processItem(item value.AnnotatedValue, context *C
 		if e != nil {
 			context.Fatal(errors.NewGroupUpdateError(
 				e, "Error updating intermediate GROUP value."))
-			return false
+			return gk, false
 		}
 
 		cumulative[a] = v
 	}
 
-	return true
+	return gk, true
 }
 
 func (this *IntermediateGroup) afterItems(context *Context) {
+	if this.partitions == nil {
+		this.sendGroups()
+		return
+	}
+
+	if len(this.groups) > 0 && !this.spill(context) {
+		return
+	}
+
+	// Group each partition in turn
+	for p := 0; p < _GROUP_PARTITIONS; p++ {
+		this.groups = make(map[string]value.AnnotatedValue)
+
+		ok := true
+		err := this.partitions.read(p, this.parent, func(item value.AnnotatedValue) bool {
+			_, ok = this.cumulate(item, context)
+			return ok
+		})
+
+		if err != nil {
+			context.Fatal(errors.NewGroupSpillError(err))
+			return
+		}
+
+		if !ok || !this.sendGroups() {
+			return
+		}
+
+		this.memory.release(context)
+	}
+}
+
+func (this *IntermediateGroup) sendGroups() bool {
 	for _, av := range this.groups {
 		if !this.sendItem(av) {
-			return
+			return false
 		}
 	}
+
+	return true
+}
+
+// Writes the groups to their partitions on disk.
+func (this *IntermediateGroup) spill(context *Context) bool {
+	var err error
+	if this.partitions == nil {
+		this.partitions, err = newGroupPartitions()
+		if err != nil {
+			context.Fatal(errors.NewGroupSpillError(err))
+			return false
+		}
+	}
+
+	size := int64(0)
+	for gk, av := range this.groups {
+		n, err := this.partitions.write(gk, av)
+		size += n
+		if err != nil {
+			context.Fatal(errors.NewGroupSpillError(err))
+			return false
+		}
+	}
+
+	context.AddGroupSpill(uint64(size))
+	this.groups = make(map[string]value.AnnotatedValue)
+	this.sizes = nil
+	context.addGroupMemory(-this.size)
+	this.size = 0
+	this.memory.release(context)
+	return true
+}
+
+func (this *IntermediateGroup) releasePartitions(context *Context) {
+	if this.partitions != nil {
+		this.partitions.close()
+		this.partitions = nil
+	}
+
+	if this.size != 0 {
+		context.addGroupMemory(-this.size)
+		this.size = 0
+	}
 }
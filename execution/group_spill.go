@@ -0,0 +1,153 @@
+//  Copyright (c) 2016 Couchbase, Inc.
+//  Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file
+//  except in compliance with the License. You may obtain a copy of the License at
+//    http://www.apache.org/licenses/LICENSE-2.0
+//  Unless required by applicable law or agreed to in writing, software distributed under the
+//  License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+//  either express or implied. See the License for the specific language governing permissions
+//  and limitations under the License.
+
+package execution
+
+import (
+	"bufio"
+	"hash/fnv"
+	"io"
+	"io/ioutil"
+	"os"
+
+	atomic "github.com/couchbase/go-couchbase/platform"
+	"github.com/couchbase/query/value"
+)
+
+// Memory budget, in bytes, for the GROUP BY operators of a request.
+// Once exceeded, initial groups are sent on early and intermediate
+// groups are partitioned to temporary files, which are aggregated
+// one at a time when all the input has been read. Zero or negative
+// disables spilling.
+var groupMemory atomic.AlignedInt64
+
+func SetGroupMemory(size int64) {
+	if size < 0 {
+		size = 0
+	}
+	atomic.StoreInt64(&groupMemory, size)
+}
+
+func GetGroupMemory() int64 {
+	return atomic.LoadInt64(&groupMemory)
+}
+
+const _GROUP_PARTITIONS = 16
+
+// Estimates the size of a group, including its aggregates, which
+// are attachments and so are not sized with the group value.
+func groupSize(gv value.AnnotatedValue) int64 {
+	size := value.EstimateSize(gv)
+	aggregates, ok := gv.GetAttachment("aggregates").(map[string]value.Value)
+	if ok {
+		for a, v := range aggregates {
+			size += int64(len(a)) + value.EstimateSize(v)
+		}
+	}
+
+	return size
+}
+
+// Groups spilled to temporary files, partitioned by group key so
+// that all the partial groups of a key are in the same partition.
+type groupPartitions struct {
+	files   []*os.File
+	writers []*bufio.Writer
+}
+
+func newGroupPartitions() (*groupPartitions, error) {
+	rv := &groupPartitions{
+		files:   make([]*os.File, 0, _GROUP_PARTITIONS),
+		writers: make([]*bufio.Writer, 0, _GROUP_PARTITIONS),
+	}
+
+	for i := 0; i < _GROUP_PARTITIONS; i++ {
+		file, err := ioutil.TempFile("", "cbq-group-")
+		if err != nil {
+			rv.close()
+			return nil, err
+		}
+
+		rv.files = append(rv.files, file)
+		rv.writers = append(rv.writers, bufio.NewWriter(file))
+	}
+
+	return rv, nil
+}
+
+// Writes the group to its partition. Returns the number of bytes
+// written.
+func (this *groupPartitions) write(gk string, item value.AnnotatedValue) (int64, error) {
+	bytes, err := encodeSpillItem(item)
+	if err != nil {
+		return 0, err
+	}
+
+	h := fnv.New32a()
+	h.Write([]byte(gk))
+	p := h.Sum32() % uint32(len(this.writers))
+
+	bytes = append(bytes, '\n')
+	n, err := this.writers[p].Write(bytes)
+	return int64(n), err
+}
+
+// Reads the groups of a partition. Decoded groups are scoped by the
+// parent value, as they were before being spilled.
+func (this *groupPartitions) read(p int, parent value.Value,
+	fn func(item value.AnnotatedValue) bool) error {
+	err := this.writers[p].Flush()
+	if err != nil {
+		return err
+	}
+
+	file := this.files[p]
+	_, err = file.Seek(0, 0)
+	if err != nil {
+		return err
+	}
+
+	reader := bufio.NewReader(file)
+	for {
+		bytes, err := reader.ReadBytes('\n')
+		if err == io.EOF && len(bytes) == 0 {
+			return nil
+		} else if err != nil && err != io.EOF {
+			return err
+		}
+
+		item, err := decodeSpillItem(bytes)
+		if err != nil {
+			return err
+		}
+
+		if parent != nil {
+			if fields, ok := item.GetValue().Actual().(map[string]interface{}); ok {
+				av := value.NewAnnotatedValue(value.NewScopeValue(fields, parent))
+				av.SetAnnotations(item)
+				item = av
+			}
+		}
+
+		if !fn(item) {
+			return nil
+		}
+	}
+}
+
+func (this *groupPartitions) close() {
+	for _, file := range this.files {
+		name := file.Name()
+		file.Close()
+		os.Remove(name)
+	}
+
+	this.files = nil
+	this.writers = nil
+}
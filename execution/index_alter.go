@@ -10,6 +10,7 @@
 package execution
 
 import (
+	"github.com/couchbase/query/datastore"
 	"github.com/couchbase/query/plan"
 	"github.com/couchbase/query/value"
 )
@@ -48,5 +49,6 @@ func (this *AlterIndex) RunOnce(context *Context, parent value.Value) {
 		}
 
 		// Actually alter index
+		datastore.IndexesChanged()
 	})
 }
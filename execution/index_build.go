@@ -10,6 +10,7 @@
 package execution
 
 import (
+	"github.com/couchbase/query/datastore"
 	"github.com/couchbase/query/plan"
 	"github.com/couchbase/query/value"
 )
@@ -63,6 +64,7 @@ func (this *BuildIndexes) RunOnce(context *Context, parent value.Value) {
 		}
 
 		err = indexer.BuildIndexes(context.RequestId(), node.Names()...)
+		datastore.IndexesChanged()
 		if err != nil {
 			context.Error(err)
 		}
@@ -10,6 +10,7 @@
 package execution
 
 import (
+	"github.com/couchbase/query/datastore"
 	"github.com/couchbase/query/plan"
 	"github.com/couchbase/query/value"
 )
@@ -57,6 +58,7 @@ func (this *CreateIndex) RunOnce(context *Context, parent value.Value) {
 
 		_, err = indexer.CreateIndex(context.RequestId(), node.Name(), node.SeekKeys(),
 			node.RangeKeys(), node.Where(), node.With())
+		datastore.IndexesChanged()
 		if err != nil {
 			context.Error(err)
 		}
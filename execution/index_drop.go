@@ -10,6 +10,7 @@
 package execution
 
 import (
+	"github.com/couchbase/query/datastore"
 	"github.com/couchbase/query/plan"
 	"github.com/couchbase/query/value"
 )
@@ -49,6 +50,7 @@ func (this *DropIndex) RunOnce(context *Context, parent value.Value) {
 
 		// Actually drop index
 		err := this.plan.Index().Drop(context.RequestId())
+		datastore.IndexesChanged()
 		if err != nil {
 			context.Error(err)
 		}
@@ -10,6 +10,7 @@
 package execution
 
 import (
+	"github.com/couchbase/query/datastore"
 	"github.com/couchbase/query/plan"
 	"github.com/couchbase/query/value"
 )
@@ -56,6 +57,7 @@ func (this *CreatePrimaryIndex) RunOnce(context *Context, parent value.Value) {
 		}
 
 		_, err = indexer.CreatePrimaryIndex(context.RequestId(), node.Name(), node.With())
+		datastore.IndexesChanged()
 		if err != nil {
 			context.Error(err)
 		}
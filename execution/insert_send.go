@@ -69,6 +69,8 @@ func (this *SendInsert) beforeItems(context *Context, parent value.Value) bool {
 	}
 
 	switch l := limit.Actual().(type) {
+	case int64:
+		this.limit = l
 	case float64:
 		this.limit = int64(l)
 	default:
@@ -150,23 +152,34 @@ func (this *SendInsert) flushBatch(context *Context) bool {
 
 	dpairs = dpairs[0:i]
 
+	keys := _STRING_POOL.Get()
+	defer _STRING_POOL.Put(keys)
+
+	for _, dp := range dpairs {
+		keys = append(keys, dp.Name)
+	}
+
 	timer := time.Now()
 
 	// Perform the actual INSERT
 	var er errors.Error
-	dpairs, er = this.plan.Keyspace().Insert(dpairs)
+	dpairs, er = context.Keyspace(this.plan.Keyspace()).Insert(dpairs)
 
 	t := time.Since(timer)
+	this.servTime += t
 	context.AddPhaseTime("insert", t)
 	this.plan.AddTime(t)
 
 	// Update mutation count with number of inserted docs
 	context.AddMutationCount(uint64(len(dpairs)))
 
-	if er != nil {
-		context.Error(er)
+	done := make(map[string]bool, len(dpairs))
+	for _, dp := range dpairs {
+		done[dp.Name] = true
 	}
 
+	reportFailures("insert", keys, done, er, context)
+
 	// Capture the inserted keys in case there is a RETURNING clause
 	for _, dp := range dpairs {
 		dv := value.NewAnnotatedValue(dp.Value)
@@ -174,7 +187,7 @@ func (this *SendInsert) flushBatch(context *Context) bool {
 		av := value.NewAnnotatedValue(make(map[string]interface{}))
 		av.SetAnnotations(dv)
 		av.SetField(this.plan.Alias(), dv)
-		if !this.sendItem(av) {
+		if !this.sendItem(withImages(av, nil, dv)) {
 			return false
 		}
 	}
@@ -10,6 +10,8 @@
 package execution
 
 import (
+	"time"
+
 	"github.com/couchbase/query/value"
 )
 
@@ -58,6 +60,8 @@ func (this *IntersectAll) beforeItems(context *Context, parent value.Value) bool
 	sequence.SetParent(this)
 	go sequence.RunOnce(context, parent)
 
+	// Time spent waiting for the second input is not kernel time
+	wait := time.Now()
 	stopped := false
 loop:
 	for {
@@ -71,6 +75,7 @@ loop:
 			notifyChildren(sequence)
 		}
 	}
+	this.chanTime += time.Since(wait)
 
 	if stopped {
 		return false
@@ -0,0 +1,158 @@
+//  Copyright (c) 2016 Couchbase, Inc.
+//  Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file
+//  except in compliance with the License. You may obtain a copy of the License at
+//    http://www.apache.org/licenses/LICENSE-2.0
+//  Unless required by applicable law or agreed to in writing, software distributed under the
+//  License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+//  either express or implied. See the License for the specific language governing permissions
+//  and limitations under the License.
+
+package execution
+
+import (
+	"time"
+
+	"github.com/couchbase/query/errors"
+	"github.com/couchbase/query/expression"
+	"github.com/couchbase/query/plan"
+	"github.com/couchbase/query/value"
+)
+
+// Hash join for ANSI joins with equality predicates. Builds a hash
+// table of the right source on the build expressions, and probes it
+// with each left item on the probe expressions.
+type HashJoin struct {
+	base
+	plan    *plan.HashJoin
+	parent  value.Value
+	right   value.Values
+	matched []bool
+	memory  memoryTracker
+	table   map[string][]int
+}
+
+func NewHashJoin(plan *plan.HashJoin) *HashJoin {
+	rv := &HashJoin{
+		base: newBase(),
+		plan: plan,
+	}
+
+	rv.output = rv
+	return rv
+}
+
+func (this *HashJoin) Accept(visitor Visitor) (interface{}, error) {
+	return visitor.VisitHashJoin(this)
+}
+
+func (this *HashJoin) Copy() Operator {
+	return &HashJoin{
+		base: this.base.copy(),
+		plan: this.plan,
+	}
+}
+
+func (this *HashJoin) RunOnce(context *Context, parent value.Value) {
+	defer this.memory.release(context)
+	this.runConsumer(this, context, parent)
+	t := this.duration - this.chanTime
+	context.AddPhaseTime("join", t)
+	this.plan.AddTime(t)
+}
+
+func (this *HashJoin) beforeItems(context *Context, parent value.Value) bool {
+	timer := time.Now()
+	defer func() { this.duration += time.Since(timer) }()
+
+	var ok bool
+	this.parent = parent
+	this.right, ok = collectJoinRight(this.plan.Child(), this.plan.Alias(), &this.memory, context, parent)
+	if !ok {
+		return false
+	}
+
+	this.matched = make([]bool, len(this.right))
+	this.table = make(map[string][]int, len(this.right))
+
+	// Build
+	for i, right := range this.right {
+		item := value.NewScopeValue(map[string]interface{}{this.plan.Alias(): right}, parent)
+		key, ok, e := hashJoinKey(this.plan.BuildExprs(), item, context)
+		if e != nil {
+			context.Error(errors.NewEvaluationError(e, "JOIN build"))
+			return false
+		}
+
+		if ok {
+			this.table[key] = append(this.table[key], i)
+		}
+	}
+
+	return true
+}
+
+func (this *HashJoin) processItem(item value.AnnotatedValue, context *Context) bool {
+	// Probe
+	key, ok, e := hashJoinKey(this.plan.ProbeExprs(), item, context)
+	if e != nil {
+		context.Error(errors.NewEvaluationError(e, "JOIN probe"))
+		return false
+	}
+
+	found := false
+	if ok {
+		for _, i := range this.table[key] {
+			av, match, ok := joinItems(item, this.right[i], this.plan.Alias(), this.plan.Onclause(), context)
+			if !ok {
+				return false
+			}
+
+			if !match {
+				continue
+			}
+
+			found = true
+			this.matched[i] = true
+			if !this.sendItem(av) {
+				return false
+			}
+		}
+	}
+
+	return found || !this.plan.Outer() || this.sendItem(item)
+}
+
+func (this *HashJoin) afterItems(context *Context) {
+	if this.plan.RightOuter() {
+		this.sendUnmatched(this.right, this.matched, this.plan.Alias(), this.parent)
+	}
+
+	this.right = nil
+	this.matched = nil
+	this.table = nil
+}
+
+// Returns the hash key of the expressions. An item whose key has a
+// MISSING or NULL value cannot satisfy an equality predicate.
+func hashJoinKey(exprs expression.Expressions, item value.Value, context *Context) (string, bool, error) {
+	vals := make([]interface{}, len(exprs))
+	for i, expr := range exprs {
+		v, e := expr.Evaluate(item, context)
+		if e != nil {
+			return "", false, e
+		}
+
+		if v.Type() <= value.NULL {
+			return "", false, nil
+		}
+
+		vals[i] = v
+	}
+
+	bytes, e := value.NewValue(vals).MarshalJSON()
+	if e != nil {
+		return "", false, e
+	}
+
+	return string(bytes), true, nil
+}
@@ -85,8 +85,10 @@ func (this *IndexJoin) processItem(item value.AnnotatedValue, context *Context)
 			default:
 			}
 
+			serv := time.Now()
 			select {
 			case entry, ok = <-conn.EntryChannel():
+				this.servTime += time.Since(serv)
 				t := time.Now()
 
 				if ok {
@@ -153,7 +155,9 @@ func (this *IndexJoin) fetch(entry *datastore.IndexEntry, context *Context) (
 	keys := []string{entry.PrimaryKey}
 
 	// Fetch
-	pairs, errs := this.plan.Keyspace().Fetch(keys)
+	timer := time.Now()
+	pairs, errs := context.Keyspace(this.plan.Keyspace()).Fetch(keys)
+	this.servTime += time.Since(timer)
 
 	fetchOk := true
 	for _, err := range errs {
@@ -0,0 +1,98 @@
+//  Copyright (c) 2016 Couchbase, Inc.
+//  Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file
+//  except in compliance with the License. You may obtain a copy of the License at
+//    http://www.apache.org/licenses/LICENSE-2.0
+//  Unless required by applicable law or agreed to in writing, software distributed under the
+//  License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+//  either express or implied. See the License for the specific language governing permissions
+//  and limitations under the License.
+
+package execution
+
+import (
+	"time"
+
+	"github.com/couchbase/query/plan"
+	"github.com/couchbase/query/value"
+)
+
+// Nested-loop join for ANSI joins without equality predicates.
+type NLJoin struct {
+	base
+	plan    *plan.NLJoin
+	parent  value.Value
+	right   value.Values
+	matched []bool
+	memory  memoryTracker
+}
+
+func NewNLJoin(plan *plan.NLJoin) *NLJoin {
+	rv := &NLJoin{
+		base: newBase(),
+		plan: plan,
+	}
+
+	rv.output = rv
+	return rv
+}
+
+func (this *NLJoin) Accept(visitor Visitor) (interface{}, error) {
+	return visitor.VisitNLJoin(this)
+}
+
+func (this *NLJoin) Copy() Operator {
+	return &NLJoin{
+		base: this.base.copy(),
+		plan: this.plan,
+	}
+}
+
+func (this *NLJoin) RunOnce(context *Context, parent value.Value) {
+	defer this.memory.release(context)
+	this.runConsumer(this, context, parent)
+	t := this.duration - this.chanTime
+	context.AddPhaseTime("join", t)
+	this.plan.AddTime(t)
+}
+
+func (this *NLJoin) beforeItems(context *Context, parent value.Value) bool {
+	timer := time.Now()
+	defer func() { this.duration += time.Since(timer) }()
+
+	var ok bool
+	this.parent = parent
+	this.right, ok = collectJoinRight(this.plan.Child(), this.plan.Alias(), &this.memory, context, parent)
+	this.matched = make([]bool, len(this.right))
+	return ok
+}
+
+func (this *NLJoin) processItem(item value.AnnotatedValue, context *Context) bool {
+	found := false
+	for i, right := range this.right {
+		av, match, ok := joinItems(item, right, this.plan.Alias(), this.plan.Onclause(), context)
+		if !ok {
+			return false
+		}
+
+		if !match {
+			continue
+		}
+
+		found = true
+		this.matched[i] = true
+		if !this.sendItem(av) {
+			return false
+		}
+	}
+
+	return found || !this.plan.Outer() || this.sendItem(item)
+}
+
+func (this *NLJoin) afterItems(context *Context) {
+	if this.plan.RightOuter() {
+		this.sendUnmatched(this.right, this.matched, this.plan.Alias(), this.parent)
+	}
+
+	this.right = nil
+	this.matched = nil
+}
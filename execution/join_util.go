@@ -0,0 +1,89 @@
+//  Copyright (c) 2016 Couchbase, Inc.
+//  Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file
+//  except in compliance with the License. You may obtain a copy of the License at
+//    http://www.apache.org/licenses/LICENSE-2.0
+//  Unless required by applicable law or agreed to in writing, software distributed under the
+//  License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+//  either express or implied. See the License for the specific language governing permissions
+//  and limitations under the License.
+
+package execution
+
+import (
+	"github.com/couchbase/query/errors"
+	"github.com/couchbase/query/expression"
+	"github.com/couchbase/query/plan"
+	"github.com/couchbase/query/value"
+)
+
+// Runs the plan of the right source of an ANSI join and returns the
+// right source objects, which are held by the join until it is done.
+func collectJoinRight(op plan.Operator, alias string, memory *memoryTracker, context *Context,
+	parent value.Value) (value.Values, bool) {
+	pipeline, err := Build(op, context)
+	if err != nil {
+		context.Error(errors.NewEvaluationError(err, "JOIN"))
+		return nil, false
+	}
+
+	collect := NewCollect()
+	sequence := NewSequence(pipeline, collect)
+	sequence.RunOnce(context, parent)
+
+	// Await completion
+	ok := true
+	for ok {
+		_, ok = <-collect.Output().ItemChannel()
+	}
+
+	items := collect.ValuesOnce().Actual().([]interface{})
+	rv := make(value.Values, 0, len(items))
+	for _, item := range items {
+		right, ok := value.NewValue(item).Field(alias)
+		if !ok {
+			continue
+		}
+
+		if !memory.track(right, context) {
+			return nil, false
+		}
+
+		rv = append(rv, right)
+	}
+
+	return rv, true
+}
+
+// Attaches a right source object to a left item and evaluates the
+// ON clause on the result.
+func joinItems(item value.AnnotatedValue, right value.Value, alias string,
+	onclause expression.Expression, context *Context) (value.AnnotatedValue, bool, bool) {
+	av := value.NewAnnotatedValue(item.Copy())
+	av.SetField(alias, right)
+
+	on, err := onclause.Evaluate(av, context)
+	if err != nil {
+		context.Error(errors.NewEvaluationError(err, "JOIN ON"))
+		return nil, false, false
+	}
+
+	return av, on.Truth(), true
+}
+
+// Sends the right source objects without a match, for RIGHT OUTER
+// joins. The left source is missing from these items.
+func (this *base) sendUnmatched(right value.Values, matched []bool, alias string,
+	parent value.Value) bool {
+	for i, r := range right {
+		if matched[i] {
+			continue
+		}
+
+		av := value.NewAnnotatedValue(value.NewScopeValue(map[string]interface{}{alias: r}, parent))
+		if !this.sendItem(av) {
+			return false
+		}
+	}
+
+	return true
+}
@@ -55,6 +55,9 @@ func (this *Limit) beforeItems(context *Context, parent value.Value) bool {
 
 	actual := val.Actual()
 	switch actual := actual.(type) {
+	case int64:
+		this.limit = actual
+		return true
 	case float64:
 		if math.Trunc(actual) == actual {
 			this.limit = int64(actual)
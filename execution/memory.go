@@ -0,0 +1,79 @@
+//  Copyright (c) 2014 Couchbase, Inc.
+//  Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file
+//  except in compliance with the License. You may obtain a copy of the License at
+//    http://www.apache.org/licenses/LICENSE-2.0
+//  Unless required by applicable law or agreed to in writing, software distributed under the
+//  License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+//  either express or implied. See the License for the specific language governing permissions
+//  and limitations under the License.
+
+package execution
+
+import (
+	atomic "github.com/couchbase/go-couchbase/platform"
+	"github.com/couchbase/query/value"
+)
+
+// Default memory quota, in bytes, for the values held by the
+// execution operators of a request. Requests can set their own quota
+// with the memory_quota parameter. Zero or negative disables the
+// quota.
+var memoryQuota atomic.AlignedInt64
+
+func SetMemoryQuota(size int64) {
+	if size < 0 {
+		size = 0
+	}
+	atomic.StoreInt64(&memoryQuota, size)
+}
+
+func GetMemoryQuota() int64 {
+	return atomic.LoadInt64(&memoryQuota)
+}
+
+// Memory quota, in bytes, for the values held by the execution
+// operators of all the requests running on this node. Zero or
+// negative disables the quota.
+var nodeQuota atomic.AlignedInt64
+
+func SetNodeQuota(size int64) {
+	if size < 0 {
+		size = 0
+	}
+	atomic.StoreInt64(&nodeQuota, size)
+}
+
+func GetNodeQuota() int64 {
+	return atomic.LoadInt64(&nodeQuota)
+}
+
+// Memory held by the execution operators of all the requests running
+// on this node.
+var nodeMemory atomic.AlignedInt64
+
+func NodeMemory() int64 {
+	return atomic.LoadInt64(&nodeMemory)
+}
+
+// Accounts for the values held by an operator, so that they can be
+// released together once the operator is done with them.
+type memoryTracker struct {
+	size int64
+}
+
+// Adds the size of the item to the memory held by the operator.
+// Returns false if the request exceeds its quota, in which case the
+// request has been aborted.
+func (this *memoryTracker) track(item value.Value, context *Context) bool {
+	size := value.EstimateSize(item)
+	this.size += size
+	return context.TrackMemory(size)
+}
+
+// Releases all the memory held by the operator.
+func (this *memoryTracker) release(context *Context) {
+	if this.size != 0 {
+		context.ReleaseMemory(this.size)
+		this.size = 0
+	}
+}
@@ -131,6 +131,7 @@ func (this *Merge) RunOnce(context *Context, parent value.Value) {
 		}
 
 		// Wait for all children
+		wait := time.Now()
 		n := len(children)
 		for n > 0 {
 			select {
@@ -138,6 +139,7 @@ func (this *Merge) RunOnce(context *Context, parent value.Value) {
 				n--
 			}
 		}
+		this.chanTime += time.Since(wait)
 	})
 }
 
@@ -185,9 +187,11 @@ func (this *Merge) processMatch(item value.AnnotatedValue,
 	timer := time.Now()
 
 	ok = true
-	bvs, errs := this.plan.Keyspace().Fetch([]string{k})
+	bvs, errs := context.Keyspace(this.plan.Keyspace()).Fetch([]string{k})
 
-	this.duration += time.Since(timer)
+	fetchTime := time.Since(timer)
+	this.duration += fetchTime
+	this.servTime += fetchTime
 
 	for _, err := range errs {
 		context.Error(err)
@@ -85,9 +85,11 @@ func (this *Nest) processItem(item value.AnnotatedValue, context *Context) bool
 	timer := time.Now()
 
 	// Fetch
-	pairs, errs := this.plan.Keyspace().Fetch(keys)
+	pairs, errs := context.Keyspace(this.plan.Keyspace()).Fetch(keys)
 
-	this.duration += time.Since(timer)
+	fetchTime := time.Since(timer)
+	this.duration += fetchTime
+	this.servTime += fetchTime
 
 	fetchOk := true
 	for _, err := range errs {
@@ -86,8 +86,10 @@ func (this *IndexNest) processItem(item value.AnnotatedValue, context *Context)
 			default:
 			}
 
+			serv := time.Now()
 			select {
 			case entry, ok = <-conn.EntryChannel():
+				this.servTime += time.Since(serv)
 				if ok {
 					entries = append(entries, entry)
 				}
@@ -174,7 +176,9 @@ func (this *IndexNest) fetch(entries []*datastore.IndexEntry, context *Context)
 	}
 
 	// Fetch
-	pairs, errs := this.plan.Keyspace().Fetch(keys)
+	timer := time.Now()
+	pairs, errs := context.Keyspace(this.plan.Keyspace()).Fetch(keys)
+	this.servTime += time.Since(timer)
 
 	fetchOk := true
 	for _, err := range errs {
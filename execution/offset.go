@@ -55,6 +55,9 @@ func (this *Offset) beforeItems(context *Context, parent value.Value) bool {
 
 	actual := val.Actual()
 	switch actual := actual.(type) {
+	case int64:
+		this.offset = actual
+		return true
 	case float64:
 		if math.Trunc(actual) == actual {
 			this.offset = int64(actual)
@@ -10,6 +10,7 @@
 package execution
 
 import (
+	"container/heap"
 	"time"
 
 	"github.com/couchbase/query/errors"
@@ -24,6 +25,9 @@ type Order struct {
 	values  value.AnnotatedValues
 	context *Context
 	terms   []string
+	size    int64
+	runs    []*sortRun
+	memory  memoryTracker
 }
 
 const _ORDER_CAP = 1024
@@ -55,6 +59,8 @@ func (this *Order) Copy() Operator {
 
 func (this *Order) RunOnce(context *Context, parent value.Value) {
 	defer this.releaseValues()
+	defer this.releaseRuns(context)
+	defer this.memory.release(context)
 	context.AddPhaseOperator(SORT)
 	this.runConsumer(this, context, parent)
 }
@@ -68,6 +74,70 @@ func (this *Order) processItem(item value.AnnotatedValue, context *Context) bool
 	}
 
 	this.values = append(this.values, item)
+	if !this.memory.track(item, context) {
+		return false
+	}
+
+	budget := GetSortMemory()
+	if budget <= 0 {
+		return true
+	}
+
+	// Spill a sorted run once the request exceeds its memory budget
+	size := value.EstimateSize(item)
+	this.size += size
+	if context.addSortMemory(size) > budget {
+		return this.spill(context)
+	}
+
+	return true
+}
+
+// Sorts the items in memory and writes them to a temporary file.
+func (this *Order) spill(context *Context) bool {
+	if this.terms == nil {
+		this.setupTerms(context)
+	}
+
+	// Evaluate the sort keys, which are kept with the spilled items
+	for _, av := range this.values {
+		for i, term := range this.plan.Terms() {
+			if _, ok := av.GetAttachment(this.terms[i]).(value.Value); ok {
+				continue
+			}
+
+			ev, e := term.Expression().Evaluate(av, context)
+			if e != nil {
+				context.Error(errors.NewEvaluationError(e, "ORDER BY"))
+				return false
+			}
+
+			av.SetAttachment(this.terms[i], ev)
+		}
+	}
+
+	timer := time.Now()
+	sort.Sort(this)
+	run, size, err := newSortRun(this.values)
+	t := time.Since(timer)
+	context.AddPhaseTime("sort", t)
+	this.plan.AddTime(t)
+
+	if err != nil {
+		context.Fatal(errors.NewSortSpillError(err))
+		return false
+	}
+
+	this.runs = append(this.runs, run)
+	context.AddSortSpill(uint64(size))
+	context.AddPhaseCount(SORT, uint64(this.Len()))
+	context.SetSortCount(context.SortCount() + uint64(this.Len()))
+
+	this.releaseValues()
+	this.values = _ORDER_POOL.Get()
+	context.addSortMemory(-this.size)
+	this.size = 0
+	this.memory.release(context)
 	return true
 }
 
@@ -87,6 +157,16 @@ func (this *Order) afterItems(context *Context) {
 	}()
 
 	this.setupTerms(context)
+
+	if len(this.runs) > 0 {
+		if len(this.values) > 0 && !this.spill(context) {
+			return
+		}
+
+		this.mergeRuns(context)
+		return
+	}
+
 	timer := time.Now()
 	sort.Sort(this)
 	t := time.Since(timer)
@@ -103,6 +183,58 @@ func (this *Order) afterItems(context *Context) {
 	}
 }
 
+// Merges the spilled runs and sends their items.
+func (this *Order) mergeRuns(context *Context) {
+	runs := &sortRuns{
+		runs: make([]*sortRun, 0, len(this.runs)),
+		less: this.lessThan,
+	}
+
+	for _, run := range this.runs {
+		ok, err := run.next()
+		if err != nil {
+			context.Fatal(errors.NewSortSpillError(err))
+			return
+		}
+
+		if ok {
+			runs.runs = append(runs.runs, run)
+		}
+	}
+
+	heap.Init(runs)
+	for runs.Len() > 0 {
+		run := runs.runs[0]
+		if !this.sendItem(run.item) {
+			return
+		}
+
+		ok, err := run.next()
+		if err != nil {
+			context.Fatal(errors.NewSortSpillError(err))
+			return
+		}
+
+		if ok {
+			heap.Fix(runs, 0)
+		} else {
+			heap.Pop(runs)
+		}
+	}
+}
+
+func (this *Order) releaseRuns(context *Context) {
+	for _, run := range this.runs {
+		run.close()
+	}
+
+	this.runs = nil
+	if this.size != 0 {
+		context.addSortMemory(-this.size)
+		this.size = 0
+	}
+}
+
 func (this *Order) releaseValues() {
 	_ORDER_POOL.Put(this.values)
 	this.values = nil
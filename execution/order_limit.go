@@ -100,6 +100,7 @@ func (this *OrderLimit) Copy() Operator {
 
 func (this *OrderLimit) RunOnce(context *Context, parent value.Value) {
 	defer this.releaseValues()
+	defer this.memory.release(context)
 	context.AddPhaseOperator(SORT)
 	this.runConsumer(this, context, parent)
 }
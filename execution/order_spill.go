@@ -0,0 +1,309 @@
+//  Copyright (c) 2016 Couchbase, Inc.
+//  Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file
+//  except in compliance with the License. You may obtain a copy of the License at
+//    http://www.apache.org/licenses/LICENSE-2.0
+//  Unless required by applicable law or agreed to in writing, software distributed under the
+//  License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+//  either express or implied. See the License for the specific language governing permissions
+//  and limitations under the License.
+
+package execution
+
+import (
+	"bufio"
+	"container/heap"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"os"
+
+	atomic "github.com/couchbase/go-couchbase/platform"
+	"github.com/couchbase/query/value"
+)
+
+// Memory budget, in bytes, for the ORDER BY operators of a request.
+// Once exceeded, sorted runs are spilled to temporary files and
+// merged when all the input has been read. Zero or negative
+// disables spilling.
+var sortMemory atomic.AlignedInt64
+
+func SetSortMemory(size int64) {
+	if size < 0 {
+		size = 0
+	}
+	atomic.StoreInt64(&sortMemory, size)
+}
+
+func GetSortMemory() int64 {
+	return atomic.LoadInt64(&sortMemory)
+}
+
+// The encoding of a spilled item. Attachments that are values, such
+// as the projection and the sort keys, are kept, as are object
+// attachments, such as META(), and the partial aggregates of GROUP
+// BY. Other attachments are consumed before ORDER BY and are
+// dropped.
+type spillItem struct {
+	Value       json.RawMessage                   `json:"v"`
+	Attachments map[string]*spillValue            `json:"a,omitempty"`
+	Objects     map[string]interface{}            `json:"o,omitempty"`
+	Aggregates  map[string]map[string]*spillValue `json:"g,omitempty"`
+	Covers      map[string]*spillValue            `json:"c,omitempty"`
+}
+
+// The encoding of an attachment, a cover or a partial aggregate.
+// MISSING marshals as null, so it is recorded. The zero and null
+// defaults of the aggregates are compared by identity, and DISTINCT
+// aggregates keep their values in a set attachment, so both are
+// recorded.
+type spillValue struct {
+	Value    json.RawMessage   `json:"v"`
+	Missing  bool              `json:"m,omitempty"`
+	Zero     bool              `json:"z,omitempty"`
+	Distinct bool              `json:"d,omitempty"`
+	Set      []json.RawMessage `json:"s,omitempty"`
+}
+
+func encodeSpillValue(v value.Value) (*spillValue, error) {
+	var err error
+	sv := &spillValue{
+		Missing: v.Type() == value.MISSING,
+		Zero:    v == value.ZERO_VALUE,
+	}
+
+	sv.Value, err = v.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+
+	av, ok := v.(value.AnnotatedValue)
+	if !ok {
+		return sv, nil
+	}
+
+	set, ok := av.GetAttachment("set").(*value.Set)
+	if !ok {
+		return sv, nil
+	}
+
+	sv.Distinct = true
+	sv.Set = make([]json.RawMessage, 0, set.Len())
+	for _, item := range set.Values() {
+		bytes, err := item.MarshalJSON()
+		if err != nil {
+			return nil, err
+		}
+		sv.Set = append(sv.Set, bytes)
+	}
+
+	return sv, nil
+}
+
+func decodeSpillValue(sv *spillValue) value.Value {
+	var v value.Value
+	if sv.Missing {
+		v = value.MISSING_VALUE
+	} else if sv.Zero {
+		v = value.ZERO_VALUE
+	} else if string(sv.Value) == "null" {
+		v = value.NULL_VALUE
+	} else {
+		v = value.NewValue([]byte(sv.Value))
+	}
+
+	if !sv.Distinct {
+		return v
+	}
+
+	av := value.NewAnnotatedValue(v)
+	set := value.NewSet(len(sv.Set))
+	for _, item := range sv.Set {
+		set.Add(value.NewValue([]byte(item)))
+	}
+	av.SetAttachment("set", set)
+	return av
+}
+
+func encodeSpillItem(item value.AnnotatedValue) ([]byte, error) {
+	var err error
+	si := &spillItem{}
+
+	si.Value, err = item.GetValue().MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+
+	for k, a := range item.Attachments() {
+		switch a := a.(type) {
+		case value.Value:
+			if si.Attachments == nil {
+				si.Attachments = make(map[string]*spillValue, len(item.Attachments()))
+			}
+			si.Attachments[k], err = encodeSpillValue(a)
+			if err != nil {
+				return nil, err
+			}
+		case map[string]interface{}:
+			if si.Objects == nil {
+				si.Objects = make(map[string]interface{}, 1)
+			}
+			si.Objects[k] = a
+		case map[string]value.Value:
+			if si.Aggregates == nil {
+				si.Aggregates = make(map[string]map[string]*spillValue, 1)
+			}
+			aggs := make(map[string]*spillValue, len(a))
+			for name, v := range a {
+				aggs[name], err = encodeSpillValue(v)
+				if err != nil {
+					return nil, err
+				}
+			}
+			si.Aggregates[k] = aggs
+		}
+	}
+
+	for k, c := range item.Covers() {
+		if si.Covers == nil {
+			si.Covers = make(map[string]*spillValue, len(item.Covers()))
+		}
+		si.Covers[k], err = encodeSpillValue(c)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return json.Marshal(si)
+}
+
+func decodeSpillItem(bytes []byte) (value.AnnotatedValue, error) {
+	si := &spillItem{}
+	err := json.Unmarshal(bytes, si)
+	if err != nil {
+		return nil, err
+	}
+
+	av := value.NewAnnotatedValue(value.NewValue([]byte(si.Value)))
+	for k, a := range si.Attachments {
+		av.SetAttachment(k, decodeSpillValue(a))
+	}
+
+	for k, o := range si.Objects {
+		av.SetAttachment(k, o)
+	}
+
+	for k, g := range si.Aggregates {
+		aggs := make(map[string]value.Value, len(g))
+		for name, sv := range g {
+			aggs[name] = decodeSpillValue(sv)
+		}
+		av.SetAttachment(k, aggs)
+	}
+
+	for k, c := range si.Covers {
+		av.SetCover(k, decodeSpillValue(c))
+	}
+
+	return av, nil
+}
+
+// A sorted run spilled to a temporary file, one item per line.
+type sortRun struct {
+	file   *os.File
+	reader *bufio.Reader
+	item   value.AnnotatedValue
+}
+
+// Writes the sorted items to a temporary file. Returns the run and
+// the number of bytes written.
+func newSortRun(items value.AnnotatedValues) (*sortRun, int64, error) {
+	file, err := ioutil.TempFile("", "cbq-sort-")
+	if err != nil {
+		return nil, 0, err
+	}
+
+	run := &sortRun{file: file}
+	writer := bufio.NewWriter(file)
+	size := int64(0)
+	for _, item := range items {
+		bytes, err := encodeSpillItem(item)
+		if err != nil {
+			run.close()
+			return nil, 0, err
+		}
+
+		bytes = append(bytes, '\n')
+		n, err := writer.Write(bytes)
+		size += int64(n)
+		if err != nil {
+			run.close()
+			return nil, 0, err
+		}
+	}
+
+	err = writer.Flush()
+	if err == nil {
+		_, err = file.Seek(0, 0)
+	}
+
+	if err != nil {
+		run.close()
+		return nil, 0, err
+	}
+
+	run.reader = bufio.NewReader(file)
+	return run, size, nil
+}
+
+// Reads the next item of the run. Returns false at the end of the
+// run.
+func (this *sortRun) next() (bool, error) {
+	bytes, err := this.reader.ReadBytes('\n')
+	if err == io.EOF && len(bytes) == 0 {
+		this.item = nil
+		return false, nil
+	} else if err != nil && err != io.EOF {
+		return false, err
+	}
+
+	this.item, err = decodeSpillItem(bytes)
+	return err == nil, err
+}
+
+func (this *sortRun) close() {
+	name := this.file.Name()
+	this.file.Close()
+	os.Remove(name)
+}
+
+// Heap of sorted runs, ordered by their current items, for the k-way
+// merge of the runs.
+type sortRuns struct {
+	runs []*sortRun
+	less func(v1, v2 value.AnnotatedValue) bool
+}
+
+func (this *sortRuns) Len() int {
+	return len(this.runs)
+}
+
+func (this *sortRuns) Less(i, j int) bool {
+	return this.less(this.runs[i].item, this.runs[j].item)
+}
+
+func (this *sortRuns) Swap(i, j int) {
+	this.runs[i], this.runs[j] = this.runs[j], this.runs[i]
+}
+
+func (this *sortRuns) Push(x interface{}) {
+	this.runs = append(this.runs, x.(*sortRun))
+}
+
+func (this *sortRuns) Pop() interface{} {
+	n := len(this.runs)
+	run := this.runs[n-1]
+	this.runs = this.runs[:n-1]
+	return run
+}
+
+var _ heap.Interface = (*sortRuns)(nil)
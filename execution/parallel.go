@@ -11,6 +11,7 @@ package execution
 
 import (
 	"runtime"
+	"time"
 
 	"github.com/couchbase/query/plan"
 	"github.com/couchbase/query/util"
@@ -67,6 +68,8 @@ func (this *Parallel) RunOnce(context *Context, parent value.Value) {
 		children[0] = this.child
 		go this.runChild(children[0], context, parent)
 
+		// Time spent waiting for the children is not kernel time
+		wait := time.Now()
 		for n > 0 {
 			select {
 			case <-this.childChannel: // Never closed
@@ -77,6 +80,7 @@ func (this *Parallel) RunOnce(context *Context, parent value.Value) {
 				notifyChildren(children...)
 			}
 		}
+		this.chanTime += time.Since(wait)
 	})
 }
 
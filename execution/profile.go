@@ -0,0 +1,180 @@
+//  Copyright (c) 2014 Couchbase, Inc.
+//  Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file
+//  except in compliance with the License. You may obtain a copy of the License at
+//    http://www.apache.org/licenses/LICENSE-2.0
+//  Unless required by applicable law or agreed to in writing, software distributed under the
+//  License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+//  either express or implied. See the License for the specific language governing permissions
+//  and limitations under the License.
+
+package execution
+
+import (
+	"bytes"
+	"encoding/json"
+	"sync"
+	go_atomic "sync/atomic"
+	"time"
+
+	"github.com/couchbase/query/plan"
+)
+
+// The statistics of a plan operator, summed over the execution
+// operators built from it, such as the copies run by Parallel.
+type opStats struct {
+	itemsIn  int64
+	itemsOut int64
+	kernTime int64
+	servTime int64
+	waitTime int64
+}
+
+func (this *opStats) fields() map[string]interface{} {
+	return map[string]interface{}{
+		"#itemsIn":  go_atomic.LoadInt64(&this.itemsIn),
+		"#itemsOut": go_atomic.LoadInt64(&this.itemsOut),
+		"kernTime":  time.Duration(go_atomic.LoadInt64(&this.kernTime)).String(),
+		"servTime":  time.Duration(go_atomic.LoadInt64(&this.servTime)).String(),
+		"waitTime":  time.Duration(go_atomic.LoadInt64(&this.waitTime)).String(),
+	}
+}
+
+// Profiler collects the statistics of the operators of a plan, for
+// EXPLAIN ANALYZE and the profile request parameter.
+type Profiler struct {
+	sync.Mutex
+	root  plan.Operator
+	stats map[plan.Operator]*opStats
+}
+
+func NewProfiler(root plan.Operator) *Profiler {
+	return &Profiler{
+		root:  root,
+		stats: make(map[plan.Operator]*opStats),
+	}
+}
+
+// Returns the plan, in its JSON form, with the statistics of each
+// operator that has run added to the operator as #stats.
+func (this *Profiler) Profile() (map[string]interface{}, error) {
+	return this.annotate(this.root)
+}
+
+func (this *Profiler) operatorStats(op plan.Operator) *opStats {
+	this.Lock()
+	defer this.Unlock()
+
+	stats, ok := this.stats[op]
+	if !ok {
+		stats = &opStats{}
+		this.stats[op] = stats
+	}
+
+	return stats
+}
+
+func (this *Profiler) annotate(op plan.Operator) (map[string]interface{}, error) {
+	if prepared, ok := op.(*plan.Prepared); ok {
+		op = prepared.Operator
+	}
+
+	body, err := json.Marshal(op)
+	if err != nil {
+		return nil, err
+	}
+
+	r, err := unmarshalPlan(body)
+	if err != nil {
+		return nil, err
+	}
+
+	annotate := func(name string, child plan.Operator) error {
+		c, err := this.annotate(child)
+		if err == nil {
+			r[name] = c
+		}
+		return err
+	}
+
+	annotateList := func(name string, children []plan.Operator) error {
+		rv := make([]interface{}, len(children))
+		for i, child := range children {
+			c, err := this.annotate(child)
+			if err != nil {
+				return err
+			}
+			rv[i] = c
+		}
+
+		r[name] = rv
+		return nil
+	}
+
+	switch op := op.(type) {
+	case *plan.Explain:
+		err = annotate("plan", op.Operator())
+	case *plan.Sequence:
+		err = annotateList("~children", op.Children())
+	case *plan.Parallel:
+		err = annotate("~child", op.Child())
+	case *plan.UnionAll:
+		err = annotateList("children", op.Children())
+	case *plan.IntersectAll:
+		err = annotate("first", op.First())
+		if err == nil {
+			err = annotate("second", op.Second())
+		}
+	case *plan.ExceptAll:
+		err = annotate("first", op.First())
+		if err == nil {
+			err = annotate("second", op.Second())
+		}
+	case *plan.IntersectScan:
+		err = annotateList("scans", op.Scans())
+	case *plan.UnionScan:
+		err = annotateList("scans", op.Scans())
+	case *plan.DistinctScan:
+		err = annotate("scan", op.Scan())
+	case *plan.Authorize:
+		err = annotate("child", op.Child())
+	case *plan.With:
+		err = annotate("child", op.Child())
+	case *plan.HashJoin:
+		err = annotate("child", op.Child())
+	case *plan.NLJoin:
+		err = annotate("child", op.Child())
+	case *plan.Merge:
+		if op.Update() != nil {
+			err = annotate("update", op.Update())
+		}
+		if err == nil && op.Delete() != nil {
+			err = annotate("delete", op.Delete())
+		}
+		if err == nil && op.Insert() != nil {
+			err = annotate("insert", op.Insert())
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	this.Lock()
+	stats := this.stats[op]
+	this.Unlock()
+
+	if stats != nil {
+		r["#stats"] = stats.fields()
+	}
+
+	return r, nil
+}
+
+// Numbers in the plan, such as index positions, are kept as they are.
+func unmarshalPlan(body []byte) (map[string]interface{}, error) {
+	var r map[string]interface{}
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+	err := decoder.Decode(&r)
+	return r, err
+}
@@ -0,0 +1,79 @@
+//  Copyright (c) 2016 Couchbase, Inc.
+//  Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file
+//  except in compliance with the License. You may obtain a copy of the License at
+//    http://www.apache.org/licenses/LICENSE-2.0
+//  Unless required by applicable law or agreed to in writing, software distributed under the
+//  License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+//  either express or implied. See the License for the specific language governing permissions
+//  and limitations under the License.
+
+package execution
+
+import (
+	"github.com/couchbase/query/algebra"
+	"github.com/couchbase/query/auth"
+	"github.com/couchbase/query/errors"
+	"github.com/couchbase/query/plan"
+	"github.com/couchbase/query/value"
+)
+
+// Grants roles to users in the credential store.
+type GrantRole struct {
+	base
+	plan *plan.GrantRole
+}
+
+func NewGrantRole(plan *plan.GrantRole) *GrantRole {
+	rv := &GrantRole{
+		base: newBase(),
+		plan: plan,
+	}
+
+	rv.output = rv
+	return rv
+}
+
+func (this *GrantRole) Accept(visitor Visitor) (interface{}, error) {
+	return visitor.VisitGrantRole(this)
+}
+
+func (this *GrantRole) Copy() Operator {
+	return &GrantRole{this.base.copy(), this.plan}
+}
+
+func (this *GrantRole) RunOnce(context *Context, parent value.Value) {
+	this.once.Do(func() {
+		defer context.Recover()       // Recover from any panic
+		defer close(this.itemChannel) // Broadcast that I have stopped
+		defer this.notify()           // Notify that I have stopped
+
+		if context.Readonly() {
+			return
+		}
+
+		store := auth.GetCredentialStore()
+		if store == nil {
+			context.Error(errors.NewNoCredentialStoreError())
+			return
+		}
+
+		node := this.plan.Node()
+		roles, err := auth.NewRoles(node.Roles(), roleKeyspaces(node.Keyspaces()))
+		if err == nil {
+			err = store.GrantRoles(node.Users(), roles)
+		}
+
+		if err != nil {
+			context.Error(err)
+		}
+	})
+}
+
+func roleKeyspaces(keyspaces []*algebra.KeyspaceRef) []string {
+	rv := make([]string, len(keyspaces))
+	for i, ks := range keyspaces {
+		rv[i] = ks.Namespace() + ":" + ks.Keyspace()
+	}
+
+	return rv
+}
@@ -0,0 +1,69 @@
+//  Copyright (c) 2016 Couchbase, Inc.
+//  Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file
+//  except in compliance with the License. You may obtain a copy of the License at
+//    http://www.apache.org/licenses/LICENSE-2.0
+//  Unless required by applicable law or agreed to in writing, software distributed under the
+//  License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+//  either express or implied. See the License for the specific language governing permissions
+//  and limitations under the License.
+
+package execution
+
+import (
+	"github.com/couchbase/query/auth"
+	"github.com/couchbase/query/errors"
+	"github.com/couchbase/query/plan"
+	"github.com/couchbase/query/value"
+)
+
+// Revokes roles from users in the credential store.
+type RevokeRole struct {
+	base
+	plan *plan.RevokeRole
+}
+
+func NewRevokeRole(plan *plan.RevokeRole) *RevokeRole {
+	rv := &RevokeRole{
+		base: newBase(),
+		plan: plan,
+	}
+
+	rv.output = rv
+	return rv
+}
+
+func (this *RevokeRole) Accept(visitor Visitor) (interface{}, error) {
+	return visitor.VisitRevokeRole(this)
+}
+
+func (this *RevokeRole) Copy() Operator {
+	return &RevokeRole{this.base.copy(), this.plan}
+}
+
+func (this *RevokeRole) RunOnce(context *Context, parent value.Value) {
+	this.once.Do(func() {
+		defer context.Recover()       // Recover from any panic
+		defer close(this.itemChannel) // Broadcast that I have stopped
+		defer this.notify()           // Notify that I have stopped
+
+		if context.Readonly() {
+			return
+		}
+
+		store := auth.GetCredentialStore()
+		if store == nil {
+			context.Error(errors.NewNoCredentialStoreError())
+			return
+		}
+
+		node := this.plan.Node()
+		roles, err := auth.NewRoles(node.Roles(), roleKeyspaces(node.Keyspaces()))
+		if err == nil {
+			err = store.RevokeRoles(node.Users(), roles)
+		}
+
+		if err != nil {
+			context.Error(err)
+		}
+	})
+}
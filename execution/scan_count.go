@@ -47,7 +47,9 @@ func (this *CountScan) RunOnce(context *Context, parent value.Value) {
 
 		timer := time.Now()
 
-		count, e := this.plan.Keyspace().Count()
+		serv := time.Now()
+		count, e := context.Keyspace(this.plan.Keyspace()).Count()
+		this.servTime += time.Since(serv)
 
 		t := time.Since(timer)
 		context.AddPhaseTime("count", t)
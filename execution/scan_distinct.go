@@ -11,6 +11,7 @@ package execution
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/couchbase/query/errors"
 	"github.com/couchbase/query/util"
@@ -74,12 +75,16 @@ func (this *DistinctScan) RunOnce(context *Context, parent value.Value) {
 			default:
 			}
 
+			wait := time.Now()
 			select {
 			case item, ok = <-this.scan.ItemChannel():
+				this.chanTime += time.Since(wait)
 				if ok {
+					this.itemsIn++
 					ok = this.processKey(item, context)
 				}
 			case <-this.childChannel:
+				this.chanTime += time.Since(wait)
 				n--
 			case <-this.stopChannel:
 				break loop
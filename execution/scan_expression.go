@@ -0,0 +1,73 @@
+//  Copyright (c) 2016 Couchbase, Inc.
+//  Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file
+//  except in compliance with the License. You may obtain a copy of the License at
+//    http://www.apache.org/licenses/LICENSE-2.0
+//  Unless required by applicable law or agreed to in writing, software distributed under the
+//  License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+//  either express or implied. See the License for the specific language governing permissions
+//  and limitations under the License.
+
+package execution
+
+import (
+	"github.com/couchbase/query/errors"
+	"github.com/couchbase/query/plan"
+	"github.com/couchbase/query/value"
+)
+
+// ExpressionScan is used for expression terms in FROM, such as
+// references to common table expressions.
+type ExpressionScan struct {
+	base
+	plan *plan.ExpressionScan
+}
+
+func NewExpressionScan(plan *plan.ExpressionScan) *ExpressionScan {
+	rv := &ExpressionScan{
+		base: newBase(),
+		plan: plan,
+	}
+
+	rv.output = rv
+	return rv
+}
+
+func (this *ExpressionScan) Accept(visitor Visitor) (interface{}, error) {
+	return visitor.VisitExpressionScan(this)
+}
+
+func (this *ExpressionScan) Copy() Operator {
+	return &ExpressionScan{this.base.copy(), this.plan}
+}
+
+func (this *ExpressionScan) RunOnce(context *Context, parent value.Value) {
+	this.once.Do(func() {
+		defer context.Recover()       // Recover from any panic
+		defer close(this.itemChannel) // Broadcast that I have stopped
+		defer this.notify()           // Notify that I have stopped
+
+		ev, err := this.plan.FromExpr().Evaluate(parent, context)
+		if err != nil {
+			context.Error(errors.NewEvaluationError(err, "FROM expression"))
+			return
+		}
+
+		actuals := ev.Actual()
+		switch actuals.(type) {
+		case []interface{}:
+		case nil:
+			actuals = []interface{}(nil)
+		default:
+			actuals = []interface{}{actuals}
+		}
+
+		acts := actuals.([]interface{})
+		for _, act := range acts {
+			av := value.NewAnnotatedValue(value.NewScopeValue(
+				map[string]interface{}{this.plan.Alias(): act}, parent))
+			if !this.sendItem(av) {
+				return
+			}
+		}
+	})
+}
@@ -55,6 +55,28 @@ func (this *IndexScan) RunOnce(context *Context, parent value.Value) {
 		defer close(this.itemChannel) // Broadcast that I have stopped
 		defer this.notify()           // Notify that I have stopped
 
+		// within a transaction, the scans are repeated at commit
+		var ts *transactionScan
+		if context.Transaction() != nil {
+			term := this.plan.Term()
+			namespace, err := context.Datastore().NamespaceByName(term.Namespace())
+			if err != nil {
+				context.Error(err)
+				return
+			}
+
+			keyspace, err := namespace.KeyspaceByName(term.Keyspace())
+			if err != nil {
+				context.Error(err)
+				return
+			}
+
+			ts, _ = newTransactionScan(context, keyspace)
+			if ts == nil {
+				return
+			}
+		}
+
 		spans := this.plan.Spans()
 		n := len(spans)
 		this.childChannel = make(StopChannel, n)
@@ -62,13 +84,19 @@ func (this *IndexScan) RunOnce(context *Context, parent value.Value) {
 		defer _INDEX_SCAN_POOL.Put(children)
 
 		for i, span := range spans {
-			children = append(children, newSpanScan(this, span))
+			children = append(children, newSpanScan(this, span, ts))
 			go children[i].RunOnce(context, parent)
 		}
 
+		// Time spent waiting for the children is not kernel time
+		wait := time.Now()
+		defer func() { this.chanTime += time.Since(wait) }()
+
+		stopped := false
 		for n > 0 {
 			select {
 			case <-this.stopChannel:
+				stopped = true
 				this.notifyStop()
 				notifyChildren(children...)
 			default:
@@ -79,10 +107,23 @@ func (this *IndexScan) RunOnce(context *Context, parent value.Value) {
 				// Wait for all children
 				n--
 			case <-this.stopChannel: // Never closed
+				stopped = true
 				this.notifyStop()
 				notifyChildren(children...)
 			}
 		}
+
+		// the index does not see the documents written by the transaction
+		if ts != nil && !stopped {
+			for _, key := range ts.existing() {
+				cv := value.NewScopeValue(make(map[string]interface{}), parent)
+				av := value.NewAnnotatedValue(cv)
+				av.SetAttachment("meta", map[string]interface{}{"id": key})
+				if !this.sendItem(av) {
+					return
+				}
+			}
+		}
 	})
 }
 
@@ -94,17 +135,23 @@ type spanScan struct {
 	base
 	plan *plan.IndexScan
 	span *plan.Span
+	ts   *transactionScan
 }
 
-func newSpanScan(parent *IndexScan, span *plan.Span) *spanScan {
+func newSpanScan(parent *IndexScan, span *plan.Span, ts *transactionScan) *spanScan {
 	rv := &spanScan{
 		base: newRedirectBase(),
 		plan: parent.plan,
 		span: span,
 	}
 
+	if ts != nil {
+		rv.ts = ts.copy()
+	}
+
 	rv.parent = parent
 	rv.output = parent.output
+	rv.stats = parent.stats
 	return rv
 }
 
@@ -113,7 +160,7 @@ func (this *spanScan) Accept(visitor Visitor) (interface{}, error) {
 }
 
 func (this *spanScan) Copy() Operator {
-	return &spanScan{this.base.copy(), this.plan, this.span}
+	return &spanScan{this.base.copy(), this.plan, this.span, nil}
 }
 
 func (this *spanScan) RunOnce(context *Context, parent value.Value) {
@@ -122,6 +169,17 @@ func (this *spanScan) RunOnce(context *Context, parent value.Value) {
 		defer close(this.itemChannel) // Broadcast that I have stopped
 		defer this.notify()           // Notify that I have stopped
 
+		dspan, err := evalSpan(this.span, context)
+		if err != nil {
+			context.Error(errors.NewEvaluationError(err, "span"))
+			return
+		}
+
+		complete := false
+		if this.ts != nil {
+			defer func() { this.ts.record(context, this.plan.Index(), dspan, complete) }()
+		}
+
 		conn := datastore.NewIndexConnection(context)
 		defer notifyConn(conn.StopChannel()) // Notify index that I have stopped
 
@@ -134,12 +192,15 @@ func (this *spanScan) RunOnce(context *Context, parent value.Value) {
 		}
 		defer addTime()
 
-		go this.scan(context, conn)
+		go this.scan(context, conn, dspan)
 
 		var entry *datastore.IndexEntry
 		ok := true
 		var docs uint64 = 0
 
+		// Full-text index entries carry the score of the document
+		search := this.plan.Index().Type() == datastore.FTS
+
 		var countDocs = func() {
 			if docs > 0 {
 				context.AddPhaseCount(INDEX_SCAN, docs)
@@ -154,9 +215,15 @@ func (this *spanScan) RunOnce(context *Context, parent value.Value) {
 			default:
 			}
 
+			serv := time.Now()
 			select {
 			case entry, ok = <-conn.EntryChannel():
+				this.servTime += time.Since(serv)
 				if ok {
+					if this.ts != nil && this.ts.skip(entry) {
+						continue
+					}
+
 					cv := value.NewScopeValue(make(map[string]interface{}), parent)
 					av := value.NewAnnotatedValue(cv)
 
@@ -164,6 +231,11 @@ func (this *spanScan) RunOnce(context *Context, parent value.Value) {
 					meta := map[string]interface{}{"id": entry.PrimaryKey}
 					av.SetAttachment("meta", meta)
 
+					// For downstream SEARCH_SCORE()
+					if search && len(entry.EntryKey) > 0 {
+						av.SetAttachment("score", entry.EntryKey[0])
+					}
+
 					covers := this.plan.Covers()
 					if len(covers) > 0 {
 						for c, v := range this.plan.FilterCovers() {
@@ -188,6 +260,8 @@ func (this *spanScan) RunOnce(context *Context, parent value.Value) {
 						context.AddPhaseCount(INDEX_SCAN, docs)
 						docs = 0
 					}
+				} else {
+					complete = true
 				}
 
 			case <-this.stopChannel:
@@ -197,22 +271,15 @@ func (this *spanScan) RunOnce(context *Context, parent value.Value) {
 	})
 }
 
-func (this *spanScan) scan(context *Context, conn *datastore.IndexConnection) {
+func (this *spanScan) scan(context *Context, conn *datastore.IndexConnection, dspan *datastore.Span) {
 	defer context.Recover() // Recover from any panic
 
-	dspan, err := evalSpan(this.span, context)
-	if err != nil {
-		context.Error(errors.NewEvaluationError(err, "span"))
-		close(conn.EntryChannel())
-		return
-	}
-
 	limit := int64(math.MaxInt64)
 	if this.plan.Limit() != nil {
 		if context.ScanConsistency() == datastore.UNBOUNDED || this.plan.Covers() != nil {
 			lv, err := this.plan.Limit().Evaluate(nil, context)
 			if err == nil && lv.Type() == value.NUMBER {
-				limit = int64(lv.Actual().(float64))
+				limit = value.AsNumberValue(lv).Int64()
 			}
 		}
 	}
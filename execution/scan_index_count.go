@@ -62,7 +62,9 @@ func (this *IndexCountScan) RunOnce(context *Context, parent value.Value) {
 				context.Error(errors.NewEvaluationError(err, "span"))
 				return
 			}
+			serv := time.Now()
 			subcount, err := this.plan.Index().Count(dspan, context.ScanConsistency(), scanVector)
+			this.servTime += time.Since(serv)
 			if err != nil {
 				context.Error(errors.NewEvaluationError(err, "Count()"))
 				return
@@ -11,6 +11,7 @@ package execution
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/couchbase/query/errors"
 	"github.com/couchbase/query/value"
@@ -93,12 +94,16 @@ func (this *IntersectScan) RunOnce(context *Context, parent value.Value) {
 			default:
 			}
 
+			wait := time.Now()
 			select {
 			case item, ok = <-channel.ItemChannel():
+				this.chanTime += time.Since(wait)
 				if ok {
+					this.itemsIn++
 					ok = this.processKey(item, context)
 				}
 			case <-this.childChannel:
+				this.chanTime += time.Since(wait)
 				if n == len(this.scans) {
 					this.notifyScans()
 				}
@@ -55,6 +55,17 @@ func (this *PrimaryScan) RunOnce(context *Context, parent value.Value) {
 }
 
 func (this *PrimaryScan) scanPrimary(context *Context, parent value.Value) {
+	// within a transaction, the scan is repeated at commit
+	ts, ok := newTransactionScan(context, this.plan.Keyspace())
+	if !ok {
+		return
+	}
+
+	complete := false
+	if ts != nil {
+		defer func() { ts.record(context, this.plan.Index(), nil, complete) }()
+	}
+
 	conn := this.newIndexConnection(context)
 	defer notifyConn(conn.StopChannel()) // Notify index that I have stopped
 
@@ -71,7 +82,6 @@ func (this *PrimaryScan) scanPrimary(context *Context, parent value.Value) {
 
 	var entry, lastEntry *datastore.IndexEntry
 
-	ok := true
 	nitems := 0
 
 	var docs uint64 = 0
@@ -88,20 +98,28 @@ func (this *PrimaryScan) scanPrimary(context *Context, parent value.Value) {
 		default:
 		}
 
+		serv := time.Now()
 		select {
 		case entry, ok = <-conn.EntryChannel():
+			this.servTime += time.Since(serv)
 			if ok {
+				lastEntry = entry
+				nitems++
+				if ts != nil && ts.skip(entry) {
+					continue
+				}
+
 				cv := value.NewScopeValue(make(map[string]interface{}), parent)
 				av := value.NewAnnotatedValue(cv)
 				av.SetAttachment("meta", map[string]interface{}{"id": entry.PrimaryKey})
 				ok = this.sendItem(av)
-				lastEntry = entry
-				nitems++
 				docs++
 				if docs > _PHASE_UPDATE_COUNT {
 					context.AddPhaseCount(PRIMARY_SCAN, docs)
 					docs = 0
 				}
+			} else {
+				complete = !conn.Timeout()
 			}
 
 		case <-this.stopChannel:
@@ -120,12 +138,26 @@ func (this *PrimaryScan) scanPrimary(context *Context, parent value.Value) {
 		}
 		// do chunked scans; nitems gives the chunk size, and lastEntry the starting point
 		for lastEntry != nil {
-			lastEntry = this.scanPrimaryChunk(context, parent, nitems, lastEntry)
+			lastEntry = this.scanPrimaryChunk(context, parent, nitems, lastEntry, ts)
+		}
+		complete = true
+	}
+
+	// the index does not see the documents written by the transaction
+	if ts != nil && complete {
+		for _, key := range ts.existing() {
+			cv := value.NewScopeValue(make(map[string]interface{}), parent)
+			av := value.NewAnnotatedValue(cv)
+			av.SetAttachment("meta", map[string]interface{}{"id": key})
+			if !this.sendItem(av) {
+				return
+			}
 		}
 	}
 }
 
-func (this *PrimaryScan) scanPrimaryChunk(context *Context, parent value.Value, chunkSize int, indexEntry *datastore.IndexEntry) *datastore.IndexEntry {
+func (this *PrimaryScan) scanPrimaryChunk(context *Context, parent value.Value, chunkSize int,
+	indexEntry *datastore.IndexEntry, ts *transactionScan) *datastore.IndexEntry {
 	conn, _ := datastore.NewSizedIndexConnection(int64(chunkSize), context)
 	conn.SetPrimary()
 	defer notifyConn(conn.StopChannel()) // Notify index that I have stopped
@@ -153,15 +185,21 @@ func (this *PrimaryScan) scanPrimaryChunk(context *Context, parent value.Value,
 		default:
 		}
 
+		serv := time.Now()
 		select {
 		case entry, ok = <-conn.EntryChannel():
+			this.servTime += time.Since(serv)
 			if ok {
+				lastEntry = entry
+				nitems++
+				if ts != nil && ts.skip(entry) {
+					continue
+				}
+
 				cv := value.NewScopeValue(make(map[string]interface{}), parent)
 				av := value.NewAnnotatedValue(cv)
 				av.SetAttachment("meta", map[string]interface{}{"id": entry.PrimaryKey})
 				ok = this.sendItem(av)
-				lastEntry = entry
-				nitems++
 			}
 
 		case <-this.stopChannel:
@@ -180,7 +218,7 @@ func (this *PrimaryScan) scanEntries(context *Context, conn *datastore.IndexConn
 	if this.plan.Limit() != nil {
 		lv, err := this.plan.Limit().Evaluate(nil, context)
 		if err == nil && lv.Type() == value.NUMBER {
-			limit = int64(lv.Actual().(float64))
+			limit = value.AsNumberValue(lv).Int64()
 		}
 	}
 
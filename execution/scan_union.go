@@ -11,6 +11,7 @@ package execution
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/couchbase/query/errors"
 	"github.com/couchbase/query/value"
@@ -41,8 +42,8 @@ func (this *UnionScan) Accept(visitor Visitor) (interface{}, error) {
 func (this *UnionScan) Copy() Operator {
 	scans := _INDEX_SCAN_POOL.Get()
 
-	for i, s := range this.scans {
-		scans[i] = s.Copy()
+	for _, s := range this.scans {
+		scans = append(scans, s.Copy())
 	}
 
 	return &UnionScan{
@@ -89,12 +90,16 @@ func (this *UnionScan) RunOnce(context *Context, parent value.Value) {
 			default:
 			}
 
+			wait := time.Now()
 			select {
 			case item, ok = <-channel.ItemChannel():
+				this.chanTime += time.Since(wait)
 				if ok {
+					this.itemsIn++
 					ok = this.processKey(item, context)
 				}
 			case <-this.childChannel:
+				this.chanTime += time.Since(wait)
 				n--
 			case <-this.stopChannel:
 				break loop
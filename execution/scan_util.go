@@ -10,6 +10,8 @@
 package execution
 
 import (
+	"sort"
+
 	"github.com/couchbase/query/datastore"
 	"github.com/couchbase/query/errors"
 	"github.com/couchbase/query/expression"
@@ -45,3 +47,82 @@ func notifyConn(stopchannel datastore.StopChannel) {
 var _INDEX_SCAN_POOL = NewOperatorPool(16)
 var _INDEX_COUNT_POOL = util.NewStringIntPool(1024)
 var _INDEX_VALUE_POOL = value.NewStringAnnotatedPool(1024)
+
+// An index scan within a transaction. The index does not see the
+// writes of the transaction, so the scan skips the written keys, and
+// returns the existing ones after the entries of the index. The keys
+// returned by the index are recorded in the transaction, which
+// repeats the scan at commit.
+type transactionScan struct {
+	transaction *datastore.Transaction
+	keyspace    datastore.Keyspace
+	staged      map[string]bool
+	keys        []string
+	last        *datastore.IndexEntry
+}
+
+// Returns nil outside of a transaction.
+func newTransactionScan(context *Context, keyspace datastore.Keyspace) (*transactionScan, bool) {
+	transaction := context.Transaction()
+	if transaction == nil {
+		return nil, true
+	}
+
+	staged, err := transaction.Staged(keyspace)
+	if err != nil {
+		context.Error(err)
+		return nil, false
+	}
+
+	return &transactionScan{
+		transaction: transaction,
+		keyspace:    keyspace,
+		staged:      staged,
+	}, true
+}
+
+func (this *transactionScan) copy() *transactionScan {
+	return &transactionScan{
+		transaction: this.transaction,
+		keyspace:    this.keyspace,
+		staged:      this.staged,
+	}
+}
+
+// Notes the entry, and returns whether the scan skips it.
+func (this *transactionScan) skip(entry *datastore.IndexEntry) bool {
+	this.keys = append(this.keys, entry.PrimaryKey)
+	this.last = entry
+	_, ok := this.staged[entry.PrimaryKey]
+	return ok
+}
+
+// Records the scan in the transaction. A nil span is a scan of all the
+// entries of a primary index. A scan that stopped before returning any
+// entries depends on none.
+func (this *transactionScan) record(context *Context, index datastore.Index, span *datastore.Span, complete bool) {
+	last := this.last
+	if complete {
+		last = nil
+	} else if last == nil {
+		return
+	}
+
+	err := this.transaction.RecordScan(this.keyspace, index, span, this.keys, last)
+	if err != nil {
+		context.Error(err)
+	}
+}
+
+// The keys written by the transaction that exist within it, in order.
+func (this *transactionScan) existing() []string {
+	rv := make([]string, 0, len(this.staged))
+	for key, exists := range this.staged {
+		if exists {
+			rv = append(rv, key)
+		}
+	}
+
+	sort.Strings(rv)
+	return rv
+}
@@ -10,6 +10,8 @@
 package execution
 
 import (
+	"time"
+
 	"github.com/couchbase/query/value"
 )
 
@@ -80,6 +82,10 @@ func (this *Sequence) RunOnce(context *Context, parent value.Value) {
 		// Run last child
 		go last_child.RunOnce(context, parent)
 
+		// Time spent waiting for the children is not kernel time
+		wait := time.Now()
+		defer func() { this.chanTime += time.Since(wait) }()
+
 		for {
 			select {
 			case <-this.childChannel: // Never closed
@@ -10,6 +10,8 @@
 package execution
 
 import (
+	"time"
+
 	"github.com/couchbase/query/value"
 )
 
@@ -39,9 +41,19 @@ func (this *Stream) RunOnce(context *Context, parent value.Value) {
 }
 
 func (this *Stream) processItem(item value.AnnotatedValue, context *Context) bool {
-	return context.Result(item)
+	// Waiting for the client to take the result is not kernel time
+	wait := time.Now()
+	ok := context.Result(item)
+	this.chanTime += time.Since(wait)
+	if ok {
+		this.itemsOut++
+	}
+	return ok
 }
 
 func (this *Stream) afterItems(context *Context) {
+	// The statistics are complete before the request sees the end
+	// of the results
+	this.addStats()
 	context.CloseResults()
 }
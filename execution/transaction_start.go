@@ -0,0 +1,63 @@
+//  Copyright (c) 2016 Couchbase, Inc.
+//  Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file
+//  except in compliance with the License. You may obtain a copy of the License at
+//    http://www.apache.org/licenses/LICENSE-2.0
+//  Unless required by applicable law or agreed to in writing, software distributed under the
+//  License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+//  either express or implied. See the License for the specific language governing permissions
+//  and limitations under the License.
+
+package execution
+
+import (
+	"github.com/couchbase/query/datastore"
+	"github.com/couchbase/query/errors"
+	"github.com/couchbase/query/plan"
+	"github.com/couchbase/query/value"
+)
+
+// Starts a transaction, and returns its id. Later requests that carry
+// the id run within the transaction.
+type StartTransaction struct {
+	base
+	plan *plan.StartTransaction
+}
+
+func NewStartTransaction(plan *plan.StartTransaction) *StartTransaction {
+	rv := &StartTransaction{
+		base: newBase(),
+		plan: plan,
+	}
+
+	rv.output = rv
+	return rv
+}
+
+func (this *StartTransaction) Accept(visitor Visitor) (interface{}, error) {
+	return visitor.VisitStartTransaction(this)
+}
+
+func (this *StartTransaction) Copy() Operator {
+	return &StartTransaction{this.base.copy(), this.plan}
+}
+
+func (this *StartTransaction) RunOnce(context *Context, parent value.Value) {
+	this.once.Do(func() {
+		defer context.Recover()       // Recover from any panic
+		defer close(this.itemChannel) // Broadcast that I have stopped
+		defer this.notify()           // Notify that I have stopped
+
+		if transaction := context.Transaction(); transaction != nil {
+			context.Error(errors.NewTransactionInProgressError(transaction.Id()))
+			return
+		}
+
+		transaction, err := datastore.NewTransaction(context.Credentials())
+		if err != nil {
+			context.Error(err)
+			return
+		}
+
+		this.sendItem(value.NewAnnotatedValue(map[string]interface{}{"txid": transaction.Id()}))
+	})
+}
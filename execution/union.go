@@ -10,6 +10,8 @@
 package execution
 
 import (
+	"time"
+
 	"github.com/couchbase/query/value"
 )
 
@@ -70,6 +72,8 @@ func (this *UnionAll) RunOnce(context *Context, parent value.Value) {
 			go child.RunOnce(context, parent)
 		}
 
+		// Time spent waiting for the children is not kernel time
+		wait := time.Now()
 		for n > 0 {
 			select {
 			case <-this.childChannel: // Never closed
@@ -80,6 +84,7 @@ func (this *UnionAll) RunOnce(context *Context, parent value.Value) {
 				notifyChildren(this.children...)
 			}
 		}
+		this.chanTime += time.Since(wait)
 
 		context.SetSortCount(0)
 	})
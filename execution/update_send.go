@@ -70,6 +70,8 @@ func (this *SendUpdate) beforeItems(context *Context, parent value.Value) bool {
 	}
 
 	switch l := limit.Actual().(type) {
+	case int64:
+		this.limit = l
 	case float64:
 		this.limit = int64(l)
 	default:
@@ -94,6 +96,11 @@ func (this *SendUpdate) flushBatch(context *Context) bool {
 	pairs := _UPDATE_POOL.Get()
 	defer _UPDATE_POOL.Put(pairs)
 
+	keys := _STRING_POOL.Get()
+	defer _STRING_POOL.Put(keys)
+
+	olds := make([]value.Value, len(this.batch))
+
 	for i, item := range this.batch {
 		uv, ok := item.Field(this.plan.Alias())
 		if !ok {
@@ -114,6 +121,7 @@ func (this *SendUpdate) flushBatch(context *Context) bool {
 
 		pairs = pairs[0 : i+1]
 		pairs[i].Name = key
+		keys = append(keys, key)
 
 		clone := item.GetAttachment("clone")
 		switch clone := clone.(type) {
@@ -128,6 +136,7 @@ func (this *SendUpdate) flushBatch(context *Context) bool {
 			cav.SetAnnotations(av)
 			pairs[i].Value = cav
 			item.SetField(this.plan.Alias(), cav)
+			olds[i] = av
 		default:
 			context.Error(errors.NewInvalidValueError(fmt.Sprintf(
 				"Invalid UPDATE value of type %T.", clone)))
@@ -137,21 +146,31 @@ func (this *SendUpdate) flushBatch(context *Context) bool {
 
 	timer := time.Now()
 
-	pairs, e := this.plan.Keyspace().Update(pairs)
+	pairs, e := context.Keyspace(this.plan.Keyspace()).Update(pairs)
 
 	t := time.Since(timer)
+	this.servTime += t
 	context.AddPhaseTime("update", t)
 	this.plan.AddTime(t)
 
 	// Update mutation count with number of updated docs
 	context.AddMutationCount(uint64(len(pairs)))
 
-	if e != nil {
-		context.Error(e)
+	updated := make(map[string]bool, len(pairs))
+	for _, pair := range pairs {
+		updated[pair.Name] = true
 	}
 
-	for _, item := range this.batch {
-		if !this.sendItem(item) {
+	reportFailures("update", keys, updated, e, context)
+
+	// Only the updated documents are returned
+	for i, item := range this.batch {
+		if !updated[keys[i]] {
+			continue
+		}
+
+		nv, _ := item.Field(this.plan.Alias())
+		if !this.sendItem(withImages(item, olds[i], nv)) {
 			return false
 		}
 	}
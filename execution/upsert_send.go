@@ -120,23 +120,42 @@ func (this *SendUpsert) flushBatch(context *Context) bool {
 
 	dpairs = dpairs[0:i]
 
+	keys := _STRING_POOL.Get()
+	defer _STRING_POOL.Put(keys)
+
+	for _, dp := range dpairs {
+		keys = append(keys, dp.Name)
+	}
+
+	var olds map[string]value.Value
+	if this.plan.FetchOld() && len(keys) > 0 {
+		olds = this.fetchOld(keys, context)
+		if olds == nil {
+			return false
+		}
+	}
+
 	timer := time.Now()
 
 	// Perform the actual UPSERT
 	var er errors.Error
-	dpairs, er = this.plan.Keyspace().Upsert(dpairs)
+	dpairs, er = context.Keyspace(this.plan.Keyspace()).Upsert(dpairs)
 
 	t := time.Since(timer)
+	this.servTime += t
 	context.AddPhaseTime("upsert", t)
 	this.plan.AddTime(t)
 
 	// Update mutation count with number of upserted docs
 	context.AddMutationCount(uint64(len(dpairs)))
 
-	if er != nil {
-		context.Error(er)
+	done := make(map[string]bool, len(dpairs))
+	for _, dp := range dpairs {
+		done[dp.Name] = true
 	}
 
+	reportFailures("upsert", keys, done, er, context)
+
 	// Capture the upserted keys in case there is a RETURNING clause
 	for _, dp := range dpairs {
 		dv := value.NewAnnotatedValue(dp.Value)
@@ -144,7 +163,7 @@ func (this *SendUpsert) flushBatch(context *Context) bool {
 		av := value.NewAnnotatedValue(make(map[string]interface{}))
 		av.SetAnnotations(dv)
 		av.SetField(this.plan.Alias(), dv)
-		if !this.sendItem(av) {
+		if !this.sendItem(withImages(av, olds[dp.Name], dv)) {
 			return false
 		}
 	}
@@ -152,6 +171,29 @@ func (this *SendUpsert) flushBatch(context *Context) bool {
 	return true
 }
 
+// Fetches the documents about to be replaced, for RETURNING OLD.
+// Returns nil on error.
+func (this *SendUpsert) fetchOld(keys []string, context *Context) map[string]value.Value {
+	timer := time.Now()
+	pairs, errs := context.Keyspace(this.plan.Keyspace()).Fetch(keys)
+	this.servTime += time.Since(timer)
+
+	// Some datastores report missing keys as errors
+	for _, err := range errs {
+		if err.Code() != errors.KEY_NOT_FOUND {
+			context.Error(err)
+			return nil
+		}
+	}
+
+	rv := make(map[string]value.Value, len(pairs))
+	for _, pair := range pairs {
+		rv[pair.Name] = pair.Value
+	}
+
+	return rv
+}
+
 func (this *SendUpsert) readonly() bool {
 	return false
 }
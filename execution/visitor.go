@@ -22,6 +22,7 @@ type Visitor interface {
 	VisitIntersectScan(op *IntersectScan) (interface{}, error)
 	VisitUnionScan(op *UnionScan) (interface{}, error)
 	VisitDistinctScan(op *DistinctScan) (interface{}, error)
+	VisitExpressionScan(op *ExpressionScan) (interface{}, error)
 
 	// Fetch
 	VisitFetch(op *Fetch) (interface{}, error)
@@ -29,6 +30,8 @@ type Visitor interface {
 
 	// Join
 	VisitJoin(op *Join) (interface{}, error)
+	VisitHashJoin(op *HashJoin) (interface{}, error)
+	VisitNLJoin(op *NLJoin) (interface{}, error)
 	VisitIndexJoin(op *IndexJoin) (interface{}, error)
 	VisitNest(op *Nest) (interface{}, error)
 	VisitIndexNest(op *IndexNest) (interface{}, error)
@@ -45,6 +48,9 @@ type Visitor interface {
 	VisitIntermediateGroup(op *IntermediateGroup) (interface{}, error)
 	VisitFinalGroup(op *FinalGroup) (interface{}, error)
 
+	// Window
+	VisitWindow(op *Window) (interface{}, error)
+
 	// Project
 	VisitInitialProject(op *InitialProject) (interface{}, error)
 	VisitFinalProject(op *FinalProject) (interface{}, error)
@@ -86,6 +92,7 @@ type Visitor interface {
 	// Framework
 	VisitAlias(op *Alias) (interface{}, error)
 	VisitAuthorize(op *Authorize) (interface{}, error)
+	VisitWith(op *With) (interface{}, error)
 	VisitParallel(op *Parallel) (interface{}, error)
 	VisitSequence(op *Sequence) (interface{}, error)
 	VisitDiscard(op *Discard) (interface{}, error)
@@ -108,4 +115,20 @@ type Visitor interface {
 
 	// Infer
 	VisitInferKeyspace(op *InferKeyspace) (interface{}, error)
+
+	// Analyze
+	VisitAnalyzeKeyspace(op *AnalyzeKeyspace) (interface{}, error)
+
+	// Function DDL
+	VisitCreateFunction(op *CreateFunction) (interface{}, error)
+	VisitDropFunction(op *DropFunction) (interface{}, error)
+
+	// Transactions
+	VisitStartTransaction(op *StartTransaction) (interface{}, error)
+	VisitCommitTransaction(op *CommitTransaction) (interface{}, error)
+	VisitRollbackTransaction(op *RollbackTransaction) (interface{}, error)
+
+	// Roles
+	VisitGrantRole(op *GrantRole) (interface{}, error)
+	VisitRevokeRole(op *RevokeRole) (interface{}, error)
 }
@@ -0,0 +1,246 @@
+//  Copyright (c) 2016 Couchbase, Inc.
+//  Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file
+//  except in compliance with the License. You may obtain a copy of the License at
+//    http://www.apache.org/licenses/LICENSE-2.0
+//  Unless required by applicable law or agreed to in writing, software distributed under the
+//  License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+//  either express or implied. See the License for the specific language governing permissions
+//  and limitations under the License.
+
+package execution
+
+import (
+	"time"
+
+	"github.com/couchbase/query/algebra"
+	"github.com/couchbase/query/errors"
+	"github.com/couchbase/query/plan"
+	"github.com/couchbase/query/sort"
+	"github.com/couchbase/query/value"
+)
+
+// Evaluation of window functions. Collects its input, then sorts
+// and partitions it once for each distinct PARTITION BY and ORDER BY
+// of the window functions.
+type Window struct {
+	base
+	plan   *plan.Window
+	values value.AnnotatedValues
+	memory memoryTracker
+}
+
+var _WINDOW_POOL = value.NewAnnotatedPool(_ORDER_CAP)
+
+func NewWindow(plan *plan.Window) *Window {
+	rv := &Window{
+		base:   newBase(),
+		plan:   plan,
+		values: _WINDOW_POOL.Get(),
+	}
+
+	rv.output = rv
+	return rv
+}
+
+func (this *Window) Accept(visitor Visitor) (interface{}, error) {
+	return visitor.VisitWindow(this)
+}
+
+func (this *Window) Copy() Operator {
+	return &Window{
+		base:   this.base.copy(),
+		plan:   this.plan,
+		values: _WINDOW_POOL.Get(),
+	}
+}
+
+func (this *Window) RunOnce(context *Context, parent value.Value) {
+	defer this.releaseValues()
+	defer this.memory.release(context)
+	this.runConsumer(this, context, parent)
+}
+
+func (this *Window) processItem(item value.AnnotatedValue, context *Context) bool {
+	if len(this.values) == cap(this.values) {
+		values := make(value.AnnotatedValues, len(this.values), len(this.values)<<1)
+		copy(values, this.values)
+		this.releaseValues()
+		this.values = values
+	}
+
+	this.values = append(this.values, item)
+	return this.memory.track(item, context)
+}
+
+func (this *Window) afterItems(context *Context) {
+	defer this.releaseValues()
+
+	timer := time.Now()
+
+	for _, av := range this.values {
+		av.SetAttachment("windows", make(map[string]value.Value, len(this.plan.Functions())))
+	}
+
+	// Window functions with the same PARTITION BY and ORDER BY
+	// share one sort of the input
+	keys := make([]string, 0, len(this.plan.Functions()))
+	windows := make(map[string]algebra.WindowFunctions, len(this.plan.Functions()))
+	for _, fn := range this.plan.Functions() {
+		key := fn.Window().SortKey()
+		if _, ok := windows[key]; !ok {
+			keys = append(keys, key)
+		}
+		windows[key] = append(windows[key], fn)
+	}
+
+	for _, key := range keys {
+		if !this.computeWindows(windows[key], context) {
+			return
+		}
+	}
+
+	this.plan.AddTime(time.Since(timer))
+
+	for _, av := range this.values {
+		if !this.sendItem(av) {
+			return
+		}
+	}
+}
+
+func (this *Window) computeWindows(fns algebra.WindowFunctions, context *Context) bool {
+	window := fns[0].Window()
+	sorter, e := newWindowSorter(window, this.values, context)
+	if e != nil {
+		context.Error(errors.NewEvaluationError(e, "window"))
+		return false
+	}
+
+	sort.Sort(sorter)
+
+	for start := 0; start < len(sorter.items); {
+		end := start + 1
+		for end < len(sorter.items) && sorter.samePartition(start, end) {
+			end++
+		}
+
+		var keys []value.Values
+		if window.OrderBy() != nil {
+			keys = sorter.orderKeys[start:end]
+		}
+
+		partition := algebra.NewWindowPartition(window, sorter.items[start:end], keys)
+		for _, fn := range fns {
+			results, e := fn.ComputeWindow(partition.ForWindow(fn.Window()), context)
+			if e != nil {
+				context.Error(errors.NewEvaluationError(e, "window"))
+				return false
+			}
+
+			name := fn.String()
+			for i, result := range results {
+				windows := partition.Item(i).GetAttachment("windows").(map[string]value.Value)
+				windows[name] = result
+			}
+		}
+
+		start = end
+	}
+
+	return true
+}
+
+func (this *Window) releaseValues() {
+	_WINDOW_POOL.Put(this.values)
+	this.values = nil
+}
+
+// Sorts the input of a window by its PARTITION BY and ORDER BY.
+type windowSorter struct {
+	window        *algebra.WindowTerm
+	items         value.AnnotatedValues
+	partitionKeys []value.Values
+	orderKeys     []value.Values
+}
+
+func newWindowSorter(window *algebra.WindowTerm, values value.AnnotatedValues,
+	context *Context) (*windowSorter, error) {
+	rv := &windowSorter{
+		window:        window,
+		items:         make(value.AnnotatedValues, len(values)),
+		partitionKeys: make([]value.Values, len(values)),
+		orderKeys:     make([]value.Values, len(values)),
+	}
+
+	copy(rv.items, values)
+
+	var terms algebra.SortTerms
+	if window.OrderBy() != nil {
+		terms = window.OrderBy().Terms()
+	}
+
+	for i, item := range rv.items {
+		rv.partitionKeys[i] = make(value.Values, len(window.PartitionBy()))
+		for j, expr := range window.PartitionBy() {
+			v, e := expr.Evaluate(item, context)
+			if e != nil {
+				return nil, e
+			}
+			rv.partitionKeys[i][j] = v
+		}
+
+		rv.orderKeys[i] = make(value.Values, len(terms))
+		for j, term := range terms {
+			v, e := term.Expression().Evaluate(item, context)
+			if e != nil {
+				return nil, e
+			}
+			rv.orderKeys[i][j] = v
+		}
+	}
+
+	return rv, nil
+}
+
+func (this *windowSorter) samePartition(i, j int) bool {
+	for k, key := range this.partitionKeys[i] {
+		if key.Collate(this.partitionKeys[j][k]) != 0 {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (this *windowSorter) Len() int {
+	return len(this.items)
+}
+
+func (this *windowSorter) Less(i, j int) bool {
+	for k, key := range this.partitionKeys[i] {
+		c := key.Collate(this.partitionKeys[j][k])
+		if c != 0 {
+			return c < 0
+		}
+	}
+
+	terms := this.window.OrderBy()
+	for k, key := range this.orderKeys[i] {
+		c := key.Collate(this.orderKeys[j][k])
+		if c == 0 {
+			continue
+		} else if terms.Terms()[k].Descending() {
+			return c > 0
+		} else {
+			return c < 0
+		}
+	}
+
+	return false
+}
+
+func (this *windowSorter) Swap(i, j int) {
+	this.items[i], this.items[j] = this.items[j], this.items[i]
+	this.partitionKeys[i], this.partitionKeys[j] = this.partitionKeys[j], this.partitionKeys[i]
+	this.orderKeys[i], this.orderKeys[j] = this.orderKeys[j], this.orderKeys[i]
+}
@@ -0,0 +1,197 @@
+//  Copyright (c) 2016 Couchbase, Inc.
+//  Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file
+//  except in compliance with the License. You may obtain a copy of the License at
+//    http://www.apache.org/licenses/LICENSE-2.0
+//  Unless required by applicable law or agreed to in writing, software distributed under the
+//  License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+//  either express or implied. See the License for the specific language governing permissions
+//  and limitations under the License.
+
+package execution
+
+import (
+	"time"
+
+	"github.com/couchbase/query/errors"
+	"github.com/couchbase/query/plan"
+	"github.com/couchbase/query/value"
+)
+
+// Maximum number of evaluations of the recursive query of a
+// recursive common table expression.
+const _WITH_RECURSION_LIMIT = 1000
+
+// Evaluates the common table expressions of a WITH clause, in order,
+// and binds their aliases to their results. The child is then run
+// within the scope of the aliases.
+type With struct {
+	base
+	plan         *plan.With
+	child        Operator
+	childChannel StopChannel
+	memory       memoryTracker
+}
+
+func NewWith(plan *plan.With, child Operator) *With {
+	rv := &With{
+		base:         newBase(),
+		plan:         plan,
+		child:        child,
+		childChannel: make(StopChannel, 1),
+	}
+
+	rv.output = rv
+	return rv
+}
+
+func (this *With) Accept(visitor Visitor) (interface{}, error) {
+	return visitor.VisitWith(this)
+}
+
+func (this *With) Copy() Operator {
+	return &With{
+		base:         this.base.copy(),
+		plan:         this.plan,
+		child:        this.child.Copy(),
+		childChannel: make(StopChannel, 1),
+	}
+}
+
+func (this *With) RunOnce(context *Context, parent value.Value) {
+	this.once.Do(func() {
+		defer context.Recover()       // Recover from any panic
+		defer close(this.itemChannel) // Broadcast that I have stopped
+		defer this.notify()           // Notify that I have stopped
+		defer this.memory.release(context)
+
+		timer := time.Now()
+
+		scope := value.NewScopeValue(make(map[string]interface{}, len(this.plan.Bindings())), parent)
+		for _, b := range this.plan.Bindings() {
+			var results value.Value
+			var ok bool
+			if b.Recursive() {
+				results, ok = this.evaluateRecursive(b, context, scope)
+			} else {
+				results, ok = evaluateWith(b.Query(), &this.memory, context, scope)
+			}
+
+			if !ok {
+				return
+			}
+
+			scope.SetField(b.Alias(), results)
+		}
+
+		this.plan.AddTime(time.Since(timer))
+
+		this.child.SetInput(this.input)
+		this.child.SetOutput(this.output)
+		this.child.SetStop(nil)
+		this.child.SetParent(this)
+
+		go this.child.RunOnce(context, scope)
+
+		// Time spent waiting for the children is not kernel time
+		wait := time.Now()
+		defer func() { this.chanTime += time.Since(wait) }()
+
+		for {
+			select {
+			case <-this.childChannel: // Never closed
+				// Wait for child
+				return
+			case <-this.stopChannel: // Never closed
+				this.notifyStop()
+				notifyChildren(this.child)
+			}
+		}
+	})
+}
+
+func (this *With) ChildChannel() StopChannel {
+	return this.childChannel
+}
+
+// Evaluates the anchor of a recursive common table expression, then
+// evaluates its step with the alias bound to the previous results,
+// until the step returns no new results.
+func (this *With) evaluateRecursive(b *plan.WithBinding, context *Context,
+	parent *value.ScopeValue) (value.Value, bool) {
+	results, ok := evaluateWith(b.Query(), &this.memory, context, parent)
+	if !ok {
+		return nil, false
+	}
+
+	var seen *value.Set
+	all := results.Actual().([]interface{})
+	if b.Distinct() {
+		seen = value.NewSet(len(all))
+		all = distinctWith(seen, all)
+	}
+
+	working := all
+	for i := 0; len(working) > 0; i++ {
+		if i == _WITH_RECURSION_LIMIT {
+			context.Fatal(errors.NewWithRecursionLimitError(b.Alias(), _WITH_RECURSION_LIMIT))
+			return nil, false
+		}
+
+		scope := value.NewScopeValue(map[string]interface{}{b.Alias(): working}, parent)
+		results, ok = evaluateWith(b.Step(), &this.memory, context, scope)
+		if !ok {
+			return nil, false
+		}
+
+		working = results.Actual().([]interface{})
+		if seen != nil {
+			working = distinctWith(seen, working)
+		}
+
+		all = append(all, working...)
+	}
+
+	return value.NewValue(all), true
+}
+
+// Runs the plan of a common table expression and collects its
+// results, which are held by the WITH until it is done.
+func evaluateWith(op plan.Operator, memory *memoryTracker, context *Context,
+	parent value.Value) (value.Value, bool) {
+	pipeline, err := Build(op, context)
+	if err != nil {
+		context.Fatal(errors.NewEvaluationError(err, "WITH"))
+		return nil, false
+	}
+
+	collect := NewCollect()
+	sequence := NewSequence(pipeline, collect)
+	sequence.RunOnce(context, parent)
+
+	// Await completion
+	ok := true
+	for ok {
+		_, ok = <-collect.Output().ItemChannel()
+	}
+
+	results := collect.ValuesOnce()
+	if !memory.track(results, context) {
+		return nil, false
+	}
+
+	return results, true
+}
+
+// Returns the items that are not in seen, and adds them to seen.
+func distinctWith(seen *value.Set, items []interface{}) []interface{} {
+	rv := make([]interface{}, 0, len(items))
+	for _, item := range items {
+		v := value.NewValue(item)
+		if !seen.Has(v) {
+			seen.Add(v)
+			rv = append(rv, item)
+		}
+	}
+
+	return rv
+}
@@ -65,14 +65,14 @@ missing value. If not a number and not missing return a NULL value.
 */
 func (this *Div) Apply(context Context, first, second value.Value) (value.Value, error) {
 	if second.Type() == value.NUMBER {
-		s := second.Actual().(float64)
-		if s == 0.0 {
+		s := value.AsNumberValue(second)
+		if s.Float64() == 0.0 {
 			return value.NULL_VALUE, nil
 		}
 
 		if first.Type() == value.NUMBER {
-			d := first.Actual().(float64) / s
-			return value.NewValue(d), nil
+			d := value.AsNumberValue(first).Div(s)
+			return d, nil
 		}
 	}
 
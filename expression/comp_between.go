@@ -80,12 +80,10 @@ func (this *Between) Apply(context Context, item, low, high value.Value) (value.
 		return highCmp, nil
 	}
 
-	switch lowActual := lowCmp.Actual().(type) {
-	case float64:
-		switch highActual := highCmp.Actual().(type) {
-		case float64:
-			return value.NewValue(lowActual >= 0 && highActual <= 0), nil
-		}
+	if lowCmp.Type() == value.NUMBER && highCmp.Type() == value.NUMBER {
+		lowActual := value.AsNumberValue(lowCmp).Float64()
+		highActual := value.AsNumberValue(highCmp).Float64()
+		return value.NewValue(lowActual >= 0 && highActual <= 0), nil
 	}
 
 	return value.NULL_VALUE, nil
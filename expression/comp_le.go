@@ -72,9 +72,8 @@ func (this *LE) FilterCovers(covers map[string]value.Value) map[string]value.Val
 
 func (this *LE) Apply(context Context, first, second value.Value) (value.Value, error) {
 	cmp := first.Compare(second)
-	switch actual := cmp.Actual().(type) {
-	case float64:
-		return value.NewValue(actual <= 0), nil
+	if cmp.Type() == value.NUMBER {
+		return value.NewValue(value.AsNumberValue(cmp).Float64() <= 0), nil
 	}
 
 	return cmp, nil
@@ -24,18 +24,22 @@ type Formalizer struct {
 	keyspace    string
 	allowed     *value.ScopeValue
 	identifiers *value.ScopeValue
+	withs       map[string]bool
 }
 
 func NewFormalizer(keyspace string, parent *Formalizer) *Formalizer {
 	var pv value.Value
+	var withs map[string]bool
 	if parent != nil {
 		pv = parent.allowed
+		withs = parent.withs
 	}
 
 	rv := &Formalizer{
 		keyspace:    keyspace,
 		allowed:     value.NewScopeValue(make(map[string]interface{}), pv),
 		identifiers: value.NewScopeValue(make(map[string]interface{}, 64), nil),
+		withs:       withs,
 	}
 
 	if keyspace != "" {
@@ -163,12 +167,17 @@ func (this *Formalizer) VisitIdentifier(expr *Identifier) (interface{}, error) {
 }
 
 /*
-Formalize META() functions defined on indexes.
+Formalize META() functions defined on indexes, and SEARCH_SCORE()
+functions, which read the score of the keyspace document.
 */
 func (this *Formalizer) VisitFunction(expr Function) (interface{}, error) {
-	meta, ok := expr.(*Meta)
-	if ok && len(meta.Operands()) == 0 && this.keyspace != "" {
-		return NewMeta(NewIdentifier(this.keyspace)), nil
+	if len(expr.Operands()) == 0 && this.keyspace != "" {
+		switch expr.(type) {
+		case *Meta:
+			return NewMeta(NewIdentifier(this.keyspace)), nil
+		case *SearchScore:
+			return NewSearchScore(NewIdentifier(this.keyspace)), nil
+		}
 	}
 
 	return expr, expr.MapChildren(this.mapper)
@@ -224,9 +233,44 @@ func (this *Formalizer) Copy() *Formalizer {
 	f := NewFormalizer(this.keyspace, nil)
 	f.allowed = this.allowed.Copy().(*value.ScopeValue)
 	f.identifiers = this.identifiers.Copy().(*value.ScopeValue)
+	f.withs = this.withs
 	return f
 }
 
+/*
+Add a WITH alias, ie. the name of a common table expression. WITH
+aliases are visible in all nested scopes, and references to them
+do not make subqueries correlated.
+*/
+func (this *Formalizer) SetWith(alias string) {
+	withs := make(map[string]bool, len(this.withs)+1)
+	for w, _ := range this.withs {
+		withs[w] = true
+	}
+
+	withs[alias] = true
+	this.withs = withs
+	this.allowed.SetField(alias, value.TRUE_VALUE)
+}
+
+func (this *Formalizer) IsWith(alias string) bool {
+	return this.withs[alias]
+}
+
+/*
+Returns a formalizer that only allows the WITH aliases. It is used
+for scopes that cannot reference their parent, such as subqueries
+in FROM.
+*/
+func (this *Formalizer) WithScope() *Formalizer {
+	rv := NewFormalizer("", nil)
+	for w, _ := range this.withs {
+		rv.SetWith(w)
+	}
+
+	return rv
+}
+
 func (this *Formalizer) SetKeyspace(keyspace string) {
 	this.keyspace = keyspace
 
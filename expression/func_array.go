@@ -161,13 +161,13 @@ func (this *ArrayAvg) Apply(context Context, arg value.Value) (value.Value, erro
 		return value.NULL_VALUE, nil
 	}
 
-	sum := 0.0
+	sum := value.ZERO_NUMBER
 	count := 0
 	aa := arg.Actual().([]interface{})
 	for _, a := range aa {
 		v := value.NewValue(a)
 		if v.Type() == value.NUMBER {
-			sum += v.Actual().(float64)
+			sum = sum.Add(value.AsNumberValue(v))
 			count++
 		}
 	}
@@ -175,7 +175,7 @@ func (this *ArrayAvg) Apply(context Context, arg value.Value) (value.Value, erro
 	if count == 0 {
 		return value.NULL_VALUE, nil
 	} else {
-		return value.NewValue(sum / float64(count)), nil
+		return sum.Div(value.AsNumberValue(value.NewValue(count))), nil
 	}
 }
 
@@ -601,7 +601,7 @@ func (this *ArrayFlatten) Apply(context Context, first, second value.Value) (val
 	}
 
 	arr := first.Actual().([]interface{})
-	fdepth := second.Actual().(float64)
+	fdepth := value.AsNumberValue(second).Float64()
 
 	// Second parameter must be an integer.
 	if math.Trunc(fdepth) != fdepth {
@@ -793,7 +793,7 @@ func (this *ArrayInsert) Apply(context Context, first, second, third value.Value
 	}
 
 	/* the position needs to be an integer */
-	f := second.Actual().(float64)
+	f := value.AsNumberValue(second).Float64()
 	if math.Trunc(f) != f {
 		return value.NULL_VALUE, nil
 	}
@@ -1421,9 +1421,9 @@ func (this *ArrayRange) Apply(context Context, args ...value.Value) (value.Value
 		return value.NULL_VALUE, nil
 	}
 
-	start := startv.Actual().(float64)
-	end := endv.Actual().(float64)
-	step := stepv.Actual().(float64)
+	start := value.AsNumberValue(startv).Float64()
+	end := value.AsNumberValue(endv).Float64()
+	step := value.AsNumberValue(stepv).Float64()
 
 	if step == 0.0 ||
 		start == end ||
@@ -1611,7 +1611,7 @@ func (this *ArrayRepeat) Apply(context Context, first, second value.Value) (valu
 		return value.NULL_VALUE, nil
 	}
 
-	sf := second.Actual().(float64)
+	sf := value.AsNumberValue(second).Float64()
 	if sf < 0 || sf != math.Trunc(sf) {
 		return value.NULL_VALUE, nil
 	}
@@ -2061,16 +2061,16 @@ func (this *ArraySum) Apply(context Context, arg value.Value) (value.Value, erro
 		return value.NULL_VALUE, nil
 	}
 
-	sum := 0.0
+	sum := value.ZERO_NUMBER
 	aa := arg.Actual().([]interface{})
 	for _, a := range aa {
 		v := value.NewValue(a)
 		if v.Type() == value.NUMBER {
-			sum += v.Actual().(float64)
+			sum = sum.Add(value.AsNumberValue(v))
 		}
 	}
 
-	return value.NewValue(sum), nil
+	return sum, nil
 }
 
 /*
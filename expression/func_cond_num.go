@@ -79,9 +79,9 @@ func (this *IfInf) Apply(context Context, args ...value.Value) (value.Value, err
 			return value.NULL_VALUE, nil
 		}
 
-		f := a.Actual().(float64)
+		f := value.AsNumberValue(a).Float64()
 		if !math.IsInf(f, 0) {
-			return value.NewValue(f), nil
+			return value.AsNumberValue(a), nil
 		}
 	}
 
@@ -173,9 +173,9 @@ func (this *IfNaN) Apply(context Context, args ...value.Value) (value.Value, err
 			return value.NULL_VALUE, nil
 		}
 
-		f := a.Actual().(float64)
+		f := value.AsNumberValue(a).Float64()
 		if !math.IsNaN(f) {
-			return value.NewValue(f), nil
+			return value.AsNumberValue(a), nil
 		}
 	}
 
@@ -273,9 +273,9 @@ func (this *IfNaNOrInf) Apply(context Context, args ...value.Value) (value.Value
 			return value.NULL_VALUE, nil
 		}
 
-		f := a.Actual().(float64)
+		f := value.AsNumberValue(a).Float64()
 		if !math.IsInf(f, 0) && !math.IsNaN(f) {
-			return value.NewValue(f), nil
+			return value.AsNumberValue(a), nil
 		}
 	}
 
@@ -192,25 +192,27 @@ func (this *ClockStr) Constructor() FunctionConstructor { return NewClockStr }
 ///////////////////////////////////////////////////
 
 /*
-This represents the Date function DATE_ADD_MILLIS(expr,n,part).
+This represents the Date function DATE_ADD_MILLIS(expr,n,part [, tz]).
 It performs date arithmetic. n and part are used to define an
 interval or duration, which is then added (or subtracted) to
-the UNIX timestamp, returning the result. Type DateAddMillis
-is a struct that implements TernaryFunctionBase since it has
-3 input arguments.
+the UNIX timestamp, returning the result. Days and larger parts
+are added to the wall clock of the named time zone tz, or of the
+local time zone, so that they are correct across daylight saving
+time changes. Type DateAddMillis is a struct that implements
+FunctionBase.
 */
 type DateAddMillis struct {
-	TernaryFunctionBase
+	FunctionBase
 }
 
 /*
-The function NewDateAddMillis calls NewTernaryFunctionBase to
-create a function named DATE_ADD_MILLIS with the three
+The function NewDateAddMillis calls NewFunctionBase to
+create a function named DATE_ADD_MILLIS with the
 expressions as input.
 */
-func NewDateAddMillis(first, second, third Expression) Function {
+func NewDateAddMillis(operands ...Expression) Function {
 	rv := &DateAddMillis{
-		*NewTernaryFunctionBase("date_add_millis", first, second, third),
+		*NewFunctionBase("date_add_millis", operands...),
 	}
 
 	rv.expr = rv
@@ -231,11 +233,11 @@ It returns a value type NUMBER.
 func (this *DateAddMillis) Type() value.Type { return value.NUMBER }
 
 /*
-Calls the Eval method for ternary functions and passes in the
+Calls the Eval method for the receiver and passes in the
 receiver, current item and current context.
 */
 func (this *DateAddMillis) Evaluate(item value.Value, context Context) (value.Value, error) {
-	return this.TernaryEval(this, item, context)
+	return this.Eval(this, item, context)
 }
 
 /*
@@ -246,24 +248,37 @@ Actual for these values to convert into valid Go type and cast date,n to
 float64(N1QL valid number type) and part to string. If n is a floating
 point value return null value. Call the dateAdd method to a add n to
 the time obtained by converting the date to time using the millisToTime
-method. Convert the result back using timeToMillis and then return it in
-value format.
+method, in the time zone if one is given. Convert the result back using
+timeToMillis and then return it in value format.
 */
-func (this *DateAddMillis) Apply(context Context, date, n, part value.Value) (value.Value, error) {
-	if date.Type() == value.MISSING || n.Type() == value.MISSING || part.Type() == value.MISSING {
+func (this *DateAddMillis) Apply(context Context, args ...value.Value) (value.Value, error) {
+	date, n, part := args[0], args[1], args[2]
+	zv := _LOCAL_ZONE_VALUE
+	if len(args) > 3 {
+		zv = args[3]
+	}
+
+	if date.Type() == value.MISSING || n.Type() == value.MISSING || part.Type() == value.MISSING ||
+		zv.Type() == value.MISSING {
 		return value.MISSING_VALUE, nil
-	} else if date.Type() != value.NUMBER || n.Type() != value.NUMBER || part.Type() != value.STRING {
+	} else if date.Type() != value.NUMBER || n.Type() != value.NUMBER || part.Type() != value.STRING ||
+		zv.Type() != value.STRING {
+		return value.NULL_VALUE, nil
+	}
+
+	loc, err := time.LoadLocation(zv.Actual().(string))
+	if err != nil {
 		return value.NULL_VALUE, nil
 	}
 
-	da := date.Actual().(float64)
-	na := n.Actual().(float64)
+	da := value.AsNumberValue(date).Float64()
+	na := value.AsNumberValue(n).Float64()
 	if na != math.Trunc(na) {
 		return value.NULL_VALUE, nil
 	}
 
 	pa := part.Actual().(string)
-	t, err := dateAdd(millisToTime(da), int(na), pa)
+	t, err := dateAdd(millisToTime(da).In(loc), int(na), pa)
 	if err != nil {
 		return value.NULL_VALUE, nil
 	}
@@ -272,14 +287,21 @@ func (this *DateAddMillis) Apply(context Context, date, n, part value.Value) (va
 }
 
 /*
-The constructor returns a NewDateAddMillis with the three operands
-cast to a Function as the FunctionConstructor.
+Minimum input arguments required for the defined function
+is 3.
 */
-func (this *DateAddMillis) Constructor() FunctionConstructor {
-	return func(operands ...Expression) Function {
-		return NewDateAddMillis(operands[0], operands[1], operands[2])
-	}
-}
+func (this *DateAddMillis) MinArgs() int { return 3 }
+
+/*
+Maximum input arguments allowable for the defined function
+is 4.
+*/
+func (this *DateAddMillis) MaxArgs() int { return 4 }
+
+/*
+Returns NewDateAddMillis as FunctionConstructor.
+*/
+func (this *DateAddMillis) Constructor() FunctionConstructor { return NewDateAddMillis }
 
 ///////////////////////////////////////////////////
 //
@@ -288,25 +310,27 @@ func (this *DateAddMillis) Constructor() FunctionConstructor {
 ///////////////////////////////////////////////////
 
 /*
-This represents the Date function DATE_ADD_STR(expr,n,part).
+This represents the Date function DATE_ADD_STR(expr,n,part [, tz]).
 It performs date arithmetic. n and part are used to define an
 interval or duration, which is then added to the date string
-in a supported format, returning the result. Type DateAddStr
-is a struct that implements TernaryFunctionBase since it has
-3 input arguments.
+in a supported format, returning the result. If the named time
+zone tz is given, the date is converted to it before the
+interval is added, so that days and larger parts follow its
+daylight saving time changes. Type DateAddStr is a struct that
+implements FunctionBase.
 */
 type DateAddStr struct {
-	TernaryFunctionBase
+	FunctionBase
 }
 
 /*
-The function NewDateAddStr calls NewTernaryFunctionBase to
-create a function named DATE_ADD_STR with the three
+The function NewDateAddStr calls NewFunctionBase to
+create a function named DATE_ADD_STR with the
 expressions as input.
 */
-func NewDateAddStr(first, second, third Expression) Function {
+func NewDateAddStr(operands ...Expression) Function {
 	rv := &DateAddStr{
-		*NewTernaryFunctionBase("date_add_str", first, second, third),
+		*NewFunctionBase("date_add_str", operands...),
 	}
 
 	rv.expr = rv
@@ -327,11 +351,11 @@ It returns a value type STRING.
 func (this *DateAddStr) Type() value.Type { return value.STRING }
 
 /*
-Calls the Eval method for ternary functions and passes in the
+Calls the Eval method for the receiver and passes in the
 receiver, current item and current context.
 */
 func (this *DateAddStr) Evaluate(item value.Value, context Context) (value.Value, error) {
-	return this.TernaryEval(this, item, context)
+	return this.Eval(this, item, context)
 }
 
 /*
@@ -341,12 +365,21 @@ number or if date and part arent strings then return a null value. Call
 Actual for these values to convert into valid Go type and cast n to
 float64(N1QL valid number type) and date,part to string. If n is a floating
 point value return null value. Call the dateAdd method to a add n to
-the time obtained. Return it in value format.
+the time obtained, in the time zone if one is given. Return it in value
+format.
 */
-func (this *DateAddStr) Apply(context Context, date, n, part value.Value) (value.Value, error) {
-	if date.Type() == value.MISSING || n.Type() == value.MISSING || part.Type() == value.MISSING {
+func (this *DateAddStr) Apply(context Context, args ...value.Value) (value.Value, error) {
+	date, n, part := args[0], args[1], args[2]
+	var zv value.Value
+	if len(args) > 3 {
+		zv = args[3]
+	}
+
+	if date.Type() == value.MISSING || n.Type() == value.MISSING || part.Type() == value.MISSING ||
+		(zv != nil && zv.Type() == value.MISSING) {
 		return value.MISSING_VALUE, nil
-	} else if date.Type() != value.STRING || n.Type() != value.NUMBER || part.Type() != value.STRING {
+	} else if date.Type() != value.STRING || n.Type() != value.NUMBER || part.Type() != value.STRING ||
+		(zv != nil && zv.Type() != value.STRING) {
 		return value.NULL_VALUE, nil
 	}
 
@@ -356,7 +389,15 @@ func (this *DateAddStr) Apply(context Context, date, n, part value.Value) (value
 		return value.NULL_VALUE, nil
 	}
 
-	na := n.Actual().(float64)
+	if zv != nil {
+		loc, err := time.LoadLocation(zv.Actual().(string))
+		if err != nil {
+			return value.NULL_VALUE, nil
+		}
+		t = t.In(loc)
+	}
+
+	na := value.AsNumberValue(n).Float64()
 	if na != math.Trunc(na) {
 		return value.NULL_VALUE, nil
 	}
@@ -371,14 +412,21 @@ func (this *DateAddStr) Apply(context Context, date, n, part value.Value) (value
 }
 
 /*
-The constructor returns a NewDateAddStr with the three operands
-cast to a Function as the FunctionConstructor.
+Minimum input arguments required for the defined function
+is 3.
 */
-func (this *DateAddStr) Constructor() FunctionConstructor {
-	return func(operands ...Expression) Function {
-		return NewDateAddStr(operands[0], operands[1], operands[2])
-	}
-}
+func (this *DateAddStr) MinArgs() int { return 3 }
+
+/*
+Maximum input arguments allowable for the defined function
+is 4.
+*/
+func (this *DateAddStr) MaxArgs() int { return 4 }
+
+/*
+Returns NewDateAddStr as FunctionConstructor.
+*/
+func (this *DateAddStr) Constructor() FunctionConstructor { return NewDateAddStr }
 
 ///////////////////////////////////////////////////
 //
@@ -439,8 +487,8 @@ func (this *DateDiffMillis) Apply(context Context, date1, date2, part value.Valu
 		return value.NULL_VALUE, nil
 	}
 
-	da1 := date1.Actual().(float64)
-	da2 := date2.Actual().(float64)
+	da1 := value.AsNumberValue(date1).Float64()
+	da2 := value.AsNumberValue(date2).Float64()
 	pa := part.Actual().(string)
 	diff, err := dateDiff(millisToTime(da1), millisToTime(da2), pa)
 	if err != nil {
@@ -558,6 +606,89 @@ func (this *DateDiffStr) Constructor() FunctionConstructor {
 	}
 }
 
+///////////////////////////////////////////////////
+//
+// DateFormatStr
+//
+///////////////////////////////////////////////////
+
+/*
+This represents the Date function DATE_FORMAT_STR(expr, fmt).
+It converts the date string in a supported format to the
+format fmt, which is either a date in a supported format or
+a pattern of % directives. Type DateFormatStr is a struct that
+implements BinaryFunctionBase.
+*/
+type DateFormatStr struct {
+	BinaryFunctionBase
+}
+
+/*
+The function NewDateFormatStr calls NewBinaryFunctionBase to
+create a function named DATE_FORMAT_STR with the two
+expressions as input.
+*/
+func NewDateFormatStr(first, second Expression) Function {
+	rv := &DateFormatStr{
+		*NewBinaryFunctionBase("date_format_str", first, second),
+	}
+
+	rv.expr = rv
+	return rv
+}
+
+/*
+It calls the VisitFunction method by passing in the receiver to
+and returns the interface. It is a visitor pattern.
+*/
+func (this *DateFormatStr) Accept(visitor Visitor) (interface{}, error) {
+	return visitor.VisitFunction(this)
+}
+
+/*
+It returns a value type STRING.
+*/
+func (this *DateFormatStr) Type() value.Type { return value.STRING }
+
+/*
+Calls the Eval method for binary functions and passes in the
+receiver, current item and current context.
+*/
+func (this *DateFormatStr) Evaluate(item value.Value, context Context) (value.Value, error) {
+	return this.BinaryEval(this, item, context)
+}
+
+/*
+This method takes the date and the format as inputs. If either
+of these are missing then return a missing value, and if they
+are not strings then return a null value. Parse the date using
+strToTime, and return it formatted by timeToStr.
+*/
+func (this *DateFormatStr) Apply(context Context, first, second value.Value) (value.Value, error) {
+	if first.Type() == value.MISSING || second.Type() == value.MISSING {
+		return value.MISSING_VALUE, nil
+	} else if first.Type() != value.STRING || second.Type() != value.STRING {
+		return value.NULL_VALUE, nil
+	}
+
+	t, err := strToTime(first.Actual().(string))
+	if err != nil {
+		return value.NULL_VALUE, nil
+	}
+
+	return value.NewValue(timeToStr(t, second.Actual().(string))), nil
+}
+
+/*
+The constructor returns a NewDateFormatStr with the two operands
+cast to a Function as the FunctionConstructor.
+*/
+func (this *DateFormatStr) Constructor() FunctionConstructor {
+	return func(operands ...Expression) Function {
+		return NewDateFormatStr(operands[0], operands[1])
+	}
+}
+
 ///////////////////////////////////////////////////
 //
 // DatePartMillis
@@ -625,7 +756,7 @@ func (this *DatePartMillis) Apply(context Context, first, second value.Value) (v
 		return value.NULL_VALUE, nil
 	}
 
-	millis := first.Actual().(float64)
+	millis := value.AsNumberValue(first).Float64()
 	part := second.Actual().(string)
 	rv, err := datePart(millisToTime(millis), part)
 	if err != nil {
@@ -737,6 +868,255 @@ func (this *DatePartStr) Constructor() FunctionConstructor {
 	}
 }
 
+///////////////////////////////////////////////////
+//
+// DateRangeMillis
+//
+///////////////////////////////////////////////////
+
+/*
+This represents the Date function
+DATE_RANGE_MILLIS(start, end, part [, n [, tz ]]). It returns
+the array of UNIX timestamps from start, inclusive, to end,
+exclusive, in steps of n parts. n defaults to 1, and is negative
+for descending ranges. Steps of days and larger parts are taken
+on the wall clock of the named time zone tz, or of the local
+time zone. Type DateRangeMillis is a struct that implements
+FunctionBase.
+*/
+type DateRangeMillis struct {
+	FunctionBase
+}
+
+/*
+The function NewDateRangeMillis calls NewFunctionBase to
+create a function named DATE_RANGE_MILLIS with the
+expressions as input.
+*/
+func NewDateRangeMillis(operands ...Expression) Function {
+	rv := &DateRangeMillis{
+		*NewFunctionBase("date_range_millis", operands...),
+	}
+
+	rv.expr = rv
+	return rv
+}
+
+/*
+It calls the VisitFunction method by passing in the receiver to
+and returns the interface. It is a visitor pattern.
+*/
+func (this *DateRangeMillis) Accept(visitor Visitor) (interface{}, error) {
+	return visitor.VisitFunction(this)
+}
+
+/*
+It returns a value type ARRAY.
+*/
+func (this *DateRangeMillis) Type() value.Type { return value.ARRAY }
+
+/*
+Calls the Eval method for the receiver and passes in the
+receiver, current item and current context.
+*/
+func (this *DateRangeMillis) Evaluate(item value.Value, context Context) (value.Value, error) {
+	return this.Eval(this, item, context)
+}
+
+/*
+If any of the inputs are missing then return a missing value,
+and if they are not of the expected types, or n is not an
+integer, then return a null value. Otherwise return the dates
+generated by dateRange as UNIX timestamps.
+*/
+func (this *DateRangeMillis) Apply(context Context, args ...value.Value) (value.Value, error) {
+	startv, endv, part := args[0], args[1], args[2]
+	nv := value.ONE_VALUE
+	if len(args) > 3 {
+		nv = args[3]
+	}
+
+	zv := _LOCAL_ZONE_VALUE
+	if len(args) > 4 {
+		zv = args[4]
+	}
+
+	for _, arg := range []value.Value{startv, endv, part, nv, zv} {
+		if arg.Type() == value.MISSING {
+			return value.MISSING_VALUE, nil
+		}
+	}
+
+	if startv.Type() != value.NUMBER || endv.Type() != value.NUMBER || part.Type() != value.STRING ||
+		nv.Type() != value.NUMBER || zv.Type() != value.STRING {
+		return value.NULL_VALUE, nil
+	}
+
+	loc, err := time.LoadLocation(zv.Actual().(string))
+	if err != nil {
+		return value.NULL_VALUE, nil
+	}
+
+	n := value.AsNumberValue(nv).Float64()
+	if n != math.Trunc(n) {
+		return value.NULL_VALUE, nil
+	}
+
+	start := millisToTime(value.AsNumberValue(startv).Float64()).In(loc)
+	end := millisToTime(value.AsNumberValue(endv).Float64()).In(loc)
+	dates, err := dateRange(start, end, int(n), part.Actual().(string))
+	if err != nil {
+		return nil, err
+	} else if dates == nil {
+		return value.NULL_VALUE, nil
+	}
+
+	rv := make([]interface{}, len(dates))
+	for i, t := range dates {
+		rv[i] = timeToMillis(t)
+	}
+
+	return value.NewValue(rv), nil
+}
+
+/*
+Minimum input arguments required for the defined function
+is 3.
+*/
+func (this *DateRangeMillis) MinArgs() int { return 3 }
+
+/*
+Maximum input arguments allowable for the defined function
+is 5.
+*/
+func (this *DateRangeMillis) MaxArgs() int { return 5 }
+
+/*
+Returns NewDateRangeMillis as FunctionConstructor.
+*/
+func (this *DateRangeMillis) Constructor() FunctionConstructor { return NewDateRangeMillis }
+
+///////////////////////////////////////////////////
+//
+// DateRangeStr
+//
+///////////////////////////////////////////////////
+
+/*
+This represents the Date function
+DATE_RANGE_STR(start, end, part [, n ]). It returns the array
+of date strings from start, inclusive, to end, exclusive, in
+steps of n parts, in the format of start. n defaults to 1, and
+is negative for descending ranges. Type DateRangeStr is a
+struct that implements FunctionBase.
+*/
+type DateRangeStr struct {
+	FunctionBase
+}
+
+/*
+The function NewDateRangeStr calls NewFunctionBase to
+create a function named DATE_RANGE_STR with the
+expressions as input.
+*/
+func NewDateRangeStr(operands ...Expression) Function {
+	rv := &DateRangeStr{
+		*NewFunctionBase("date_range_str", operands...),
+	}
+
+	rv.expr = rv
+	return rv
+}
+
+/*
+It calls the VisitFunction method by passing in the receiver to
+and returns the interface. It is a visitor pattern.
+*/
+func (this *DateRangeStr) Accept(visitor Visitor) (interface{}, error) {
+	return visitor.VisitFunction(this)
+}
+
+/*
+It returns a value type ARRAY.
+*/
+func (this *DateRangeStr) Type() value.Type { return value.ARRAY }
+
+/*
+Calls the Eval method for the receiver and passes in the
+receiver, current item and current context.
+*/
+func (this *DateRangeStr) Evaluate(item value.Value, context Context) (value.Value, error) {
+	return this.Eval(this, item, context)
+}
+
+/*
+If any of the inputs are missing then return a missing value,
+and if they are not of the expected types, or n is not an
+integer, then return a null value. Otherwise return the dates
+generated by dateRange, formatted like start.
+*/
+func (this *DateRangeStr) Apply(context Context, args ...value.Value) (value.Value, error) {
+	startv, endv, part := args[0], args[1], args[2]
+	nv := value.ONE_VALUE
+	if len(args) > 3 {
+		nv = args[3]
+	}
+
+	if startv.Type() == value.MISSING || endv.Type() == value.MISSING ||
+		part.Type() == value.MISSING || nv.Type() == value.MISSING {
+		return value.MISSING_VALUE, nil
+	} else if startv.Type() != value.STRING || endv.Type() != value.STRING ||
+		part.Type() != value.STRING || nv.Type() != value.NUMBER {
+		return value.NULL_VALUE, nil
+	}
+
+	start, fmt, err := strToTimeFormat(startv.Actual().(string))
+	if err != nil {
+		return value.NULL_VALUE, nil
+	}
+
+	end, err := strToTime(endv.Actual().(string))
+	if err != nil {
+		return value.NULL_VALUE, nil
+	}
+
+	n := value.AsNumberValue(nv).Float64()
+	if n != math.Trunc(n) {
+		return value.NULL_VALUE, nil
+	}
+
+	dates, err := dateRange(start, end, int(n), part.Actual().(string))
+	if err != nil {
+		return nil, err
+	} else if dates == nil {
+		return value.NULL_VALUE, nil
+	}
+
+	rv := make([]interface{}, len(dates))
+	for i, t := range dates {
+		rv[i] = t.Format(fmt)
+	}
+
+	return value.NewValue(rv), nil
+}
+
+/*
+Minimum input arguments required for the defined function
+is 3.
+*/
+func (this *DateRangeStr) MinArgs() int { return 3 }
+
+/*
+Maximum input arguments allowable for the defined function
+is 4.
+*/
+func (this *DateRangeStr) MaxArgs() int { return 4 }
+
+/*
+Returns NewDateRangeStr as FunctionConstructor.
+*/
+func (this *DateRangeStr) Constructor() FunctionConstructor { return NewDateRangeStr }
+
 ///////////////////////////////////////////////////
 //
 // DateTruncMillis
@@ -804,7 +1184,7 @@ func (this *DateTruncMillis) Apply(context Context, first, second value.Value) (
 		return value.NULL_VALUE, nil
 	}
 
-	millis := first.Actual().(float64)
+	millis := value.AsNumberValue(first).Float64()
 	part := second.Actual().(string)
 	t := millisToTime(millis)
 
@@ -995,7 +1375,7 @@ func (this *MillisToStr) Apply(context Context, args ...value.Value) (value.Valu
 		return value.NULL_VALUE, nil
 	}
 
-	millis := ev.Actual().(float64)
+	millis := value.AsNumberValue(ev).Float64()
 	fmt := fv.Actual().(string)
 	t := millisToTime(millis)
 	return value.NewValue(timeToStr(t, fmt)), nil
@@ -1092,7 +1472,7 @@ func (this *MillisToUTC) Apply(context Context, args ...value.Value) (value.Valu
 		return value.NULL_VALUE, nil
 	}
 
-	millis := ev.Actual().(float64)
+	millis := value.AsNumberValue(ev).Float64()
 	fmt := fv.Actual().(string)
 	t := millisToTime(millis).UTC()
 	return value.NewValue(timeToStr(t, fmt)), nil
@@ -1194,7 +1574,7 @@ func (this *MillisToZoneName) Apply(context Context, args ...value.Value) (value
 		return value.NULL_VALUE, nil
 	}
 
-	millis := ev.Actual().(float64)
+	millis := value.AsNumberValue(ev).Float64()
 	tz := zv.Actual().(string)
 	loc, err := time.LoadLocation(tz)
 	if err != nil {
@@ -1397,22 +1777,23 @@ func (this *NowStr) Constructor() FunctionConstructor { return NewNowStr }
 ///////////////////////////////////////////////////
 
 /*
-This represents the Date function STR_TO_MILLIS(expr).
-It converts date in a supported format to UNIX milliseconds.
-It is of type struct that implements a UnaryFunctionBase.
+This represents the Date function STR_TO_MILLIS(expr [, fmt ]).
+It converts date in a supported format, or in the format fmt,
+to UNIX milliseconds. It is of type struct that implements
+FunctionBase.
 */
 type StrToMillis struct {
-	UnaryFunctionBase
+	FunctionBase
 }
 
 /*
-The function NewStrToMillis calls NewUnaryFunctionBase to
-create a function named STR_TO_MILLIS with the an
-expression as input.
+The function NewStrToMillis calls NewFunctionBase to
+create a function named STR_TO_MILLIS with the
+expressions as input.
 */
-func NewStrToMillis(operand Expression) Function {
+func NewStrToMillis(operands ...Expression) Function {
 	rv := &StrToMillis{
-		*NewUnaryFunctionBase("str_to_millis", operand),
+		*NewFunctionBase("str_to_millis", operands...),
 	}
 
 	rv.expr = rv
@@ -1433,11 +1814,11 @@ It returns value type number.
 func (this *StrToMillis) Type() value.Type { return value.NUMBER }
 
 /*
-Calls the Eval method for unary functions and passes in the
+Calls the Eval method for the receiver and passes in the
 receiver, current item and current context.
 */
 func (this *StrToMillis) Evaluate(item value.Value, context Context) (value.Value, error) {
-	return this.UnaryEval(this, item, context)
+	return this.Eval(this, item, context)
 }
 
 /*
@@ -1445,18 +1826,31 @@ This method takes in an input argument of type value, and returns a value that i
 a timestamp.  If the input argument type is missing, then return missing, and
 if it is not a string then return null value. Convert the value to a valid Go type
 using Actual and cast it to string. Convert it into a valid time format using
-strToTime. Use function timeToMillis to convert to milliseconds and then
-return that value.
+strToTime, or strToTimeWithFormat if a format is given. Use function timeToMillis
+to convert to milliseconds and then return that value.
 */
-func (this *StrToMillis) Apply(context Context, arg value.Value) (value.Value, error) {
-	if arg.Type() == value.MISSING {
+func (this *StrToMillis) Apply(context Context, args ...value.Value) (value.Value, error) {
+	arg := args[0]
+	var fv value.Value
+	if len(args) > 1 {
+		fv = args[1]
+	}
+
+	if arg.Type() == value.MISSING || (fv != nil && fv.Type() == value.MISSING) {
 		return value.MISSING_VALUE, nil
-	} else if arg.Type() != value.STRING {
+	} else if arg.Type() != value.STRING || (fv != nil && fv.Type() != value.STRING) {
 		return value.NULL_VALUE, nil
 	}
 
 	str := arg.Actual().(string)
-	t, err := strToTime(str)
+	var t time.Time
+	var err error
+	if fv == nil {
+		t, err = strToTime(str)
+	} else {
+		t, err = strToTimeWithFormat(str, fv.Actual().(string))
+	}
+
 	if err != nil {
 		return value.NULL_VALUE, nil
 	}
@@ -1465,14 +1859,21 @@ func (this *StrToMillis) Apply(context Context, arg value.Value) (value.Value, e
 }
 
 /*
-The constructor returns a NewStrToMillis with an operand cast to a
-Function as the FunctionConstructor.
+Minimum input arguments required for the defined function
+is 1.
 */
-func (this *StrToMillis) Constructor() FunctionConstructor {
-	return func(operands ...Expression) Function {
-		return NewStrToMillis(operands[0])
-	}
-}
+func (this *StrToMillis) MinArgs() int { return 1 }
+
+/*
+Maximum input arguments allowable for the defined function
+is 2.
+*/
+func (this *StrToMillis) MaxArgs() int { return 2 }
+
+/*
+Returns NewStrToMillis as FunctionConstructor.
+*/
+func (this *StrToMillis) Constructor() FunctionConstructor { return NewStrToMillis }
 
 ///////////////////////////////////////////////////
 //
@@ -1713,7 +2114,7 @@ func (this *DurationToStr) Apply(context Context, first value.Value) (value.Valu
 		return value.NULL_VALUE, nil
 	}
 
-	d := first.Actual().(float64)
+	d := value.AsNumberValue(first).Float64()
 	str := time.Duration(d).String()
 
 	return value.NewValue(str), nil
@@ -1811,6 +2212,181 @@ func (this *StrToDuration) Constructor() FunctionConstructor {
 	}
 }
 
+///////////////////////////////////////////////////
+//
+// WeekdayMillis
+//
+///////////////////////////////////////////////////
+
+/*
+This represents the Date function WEEKDAY_MILLIS(expr [, tz ]).
+It returns the name of the day of the week of the UNIX
+timestamp, in the named time zone tz or in the local time zone.
+Type WeekdayMillis is a struct that implements FunctionBase.
+*/
+type WeekdayMillis struct {
+	FunctionBase
+}
+
+/*
+The function NewWeekdayMillis calls NewFunctionBase to
+create a function named WEEKDAY_MILLIS with the
+expressions as input.
+*/
+func NewWeekdayMillis(operands ...Expression) Function {
+	rv := &WeekdayMillis{
+		*NewFunctionBase("weekday_millis", operands...),
+	}
+
+	rv.expr = rv
+	return rv
+}
+
+/*
+It calls the VisitFunction method by passing in the receiver to
+and returns the interface. It is a visitor pattern.
+*/
+func (this *WeekdayMillis) Accept(visitor Visitor) (interface{}, error) {
+	return visitor.VisitFunction(this)
+}
+
+/*
+It returns a value type STRING.
+*/
+func (this *WeekdayMillis) Type() value.Type { return value.STRING }
+
+/*
+Calls the Eval method for the receiver and passes in the
+receiver, current item and current context.
+*/
+func (this *WeekdayMillis) Evaluate(item value.Value, context Context) (value.Value, error) {
+	return this.Eval(this, item, context)
+}
+
+/*
+If either input is missing then return a missing value, and if
+the timestamp is not a number or the time zone is not a valid
+time zone name then return a null value.
+*/
+func (this *WeekdayMillis) Apply(context Context, args ...value.Value) (value.Value, error) {
+	ev := args[0]
+	zv := _LOCAL_ZONE_VALUE
+	if len(args) > 1 {
+		zv = args[1]
+	}
+
+	if ev.Type() == value.MISSING || zv.Type() == value.MISSING {
+		return value.MISSING_VALUE, nil
+	} else if ev.Type() != value.NUMBER || zv.Type() != value.STRING {
+		return value.NULL_VALUE, nil
+	}
+
+	loc, err := time.LoadLocation(zv.Actual().(string))
+	if err != nil {
+		return value.NULL_VALUE, nil
+	}
+
+	t := millisToTime(value.AsNumberValue(ev).Float64()).In(loc)
+	return value.NewValue(t.Weekday().String()), nil
+}
+
+/*
+Minimum input arguments required for the defined function
+is 1.
+*/
+func (this *WeekdayMillis) MinArgs() int { return 1 }
+
+/*
+Maximum input arguments allowable for the defined function
+is 2.
+*/
+func (this *WeekdayMillis) MaxArgs() int { return 2 }
+
+/*
+Returns NewWeekdayMillis as FunctionConstructor.
+*/
+func (this *WeekdayMillis) Constructor() FunctionConstructor { return NewWeekdayMillis }
+
+///////////////////////////////////////////////////
+//
+// WeekdayStr
+//
+///////////////////////////////////////////////////
+
+/*
+This represents the Date function WEEKDAY_STR(expr). It
+returns the name of the day of the week of the date string
+in a supported format. Type WeekdayStr is a struct that
+implements UnaryFunctionBase.
+*/
+type WeekdayStr struct {
+	UnaryFunctionBase
+}
+
+/*
+The function NewWeekdayStr calls NewUnaryFunctionBase to
+create a function named WEEKDAY_STR with the expression
+as input.
+*/
+func NewWeekdayStr(operand Expression) Function {
+	rv := &WeekdayStr{
+		*NewUnaryFunctionBase("weekday_str", operand),
+	}
+
+	rv.expr = rv
+	return rv
+}
+
+/*
+It calls the VisitFunction method by passing in the receiver to
+and returns the interface. It is a visitor pattern.
+*/
+func (this *WeekdayStr) Accept(visitor Visitor) (interface{}, error) {
+	return visitor.VisitFunction(this)
+}
+
+/*
+It returns a value type STRING.
+*/
+func (this *WeekdayStr) Type() value.Type { return value.STRING }
+
+/*
+Calls the Eval method for unary functions and passes in the
+receiver, current item and current context.
+*/
+func (this *WeekdayStr) Evaluate(item value.Value, context Context) (value.Value, error) {
+	return this.UnaryEval(this, item, context)
+}
+
+/*
+If the input is missing then return a missing value, and if it
+is not a string in a supported format then return a null value.
+*/
+func (this *WeekdayStr) Apply(context Context, arg value.Value) (value.Value, error) {
+	if arg.Type() == value.MISSING {
+		return value.MISSING_VALUE, nil
+	} else if arg.Type() != value.STRING {
+		return value.NULL_VALUE, nil
+	}
+
+	t, err := strToTime(arg.Actual().(string))
+	if err != nil {
+		return value.NULL_VALUE, nil
+	}
+
+	return value.NewValue(t.Weekday().String()), nil
+}
+
+/*
+The constructor returns a NewWeekdayStr with the operand
+cast to a Function as the FunctionConstructor.
+*/
+func (this *WeekdayStr) Constructor() FunctionConstructor {
+	return func(operands ...Expression) Function {
+		return NewWeekdayStr(operands[0])
+	}
+}
+
 /*
 Parse the input string using the defined formats for Date
 and return the time value it represents, and error. The
@@ -1849,13 +2425,40 @@ func strToTimeFormat(s string) (time.Time, string, error) {
 
 /*
 It returns a textual representation of the time value formatted
-according to the Format string.
+according to the Format string. The format is either a pattern
+of % directives, or a date in one of the supported formats.
 */
 func timeToStr(t time.Time, format string) string {
+	if strings.Contains(format, "%") {
+		return formatPattern(t, format)
+	}
+
 	_, fmt, _ := strToTimeFormat(format)
 	return t.Format(fmt)
 }
 
+/*
+Parse the input string in the given format, which is either a
+pattern of % directives, or a date in one of the supported
+formats.
+*/
+func strToTimeWithFormat(s, format string) (time.Time, error) {
+	if strings.Contains(format, "%") {
+		layout, err := patternLayout(format)
+		if err != nil {
+			return time.Time{}, err
+		}
+		return time.ParseInLocation(layout, s, time.Local)
+	}
+
+	_, layout, err := strToTimeFormat(format)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return time.ParseInLocation(layout, s, time.Local)
+}
+
 /*
 Convert input milliseconds to time format by multiplying
 with 10^6 and using the Unix method from the time package.
@@ -1901,6 +2504,16 @@ Represents a value of the default format.
 */
 var _DEFAULT_FMT_VALUE = value.NewValue(_DEFAULT_FORMAT)
 
+/*
+Represents the name of the local time zone.
+*/
+var _LOCAL_ZONE_VALUE = value.NewValue("Local")
+
+/*
+The maximum number of dates generated by the DATE_RANGE functions.
+*/
+const _MAX_DATE_RANGE = 100000
+
 /*
 This function returns the part of the time string that is
 depicted by part (for eg. the day, current quarter etc).
@@ -2002,6 +2615,72 @@ func dateAdd(t time.Time, n int, part string) (time.Time, error) {
 	}
 }
 
+/*
+Returns the times from start, inclusive, to end, exclusive, that
+are n parts apart. Each time is computed from start rather than
+from the previous time, and for steps of months or longer the day
+of the month is clamped to the last day of a shorter month, so
+that a monthly range from January 31 continues with February 29
+and March 31. Returns nil if n is 0, or an error if there are too
+many times.
+*/
+func dateRange(start, end time.Time, n int, part string) ([]time.Time, error) {
+	if n == 0 {
+		return nil, nil
+	}
+
+	rv := make([]time.Time, 0, 16)
+	for i := 0; ; i++ {
+		t, err := dateRangeAdd(start, i*n, part)
+		if err != nil {
+			return nil, nil
+		}
+
+		if (n > 0 && !t.Before(end)) || (n < 0 && !t.After(end)) {
+			return rv, nil
+		}
+
+		if len(rv) >= _MAX_DATE_RANGE {
+			return nil, fmt.Errorf("Date range exceeds %d dates.", _MAX_DATE_RANGE)
+		}
+
+		rv = append(rv, t)
+	}
+}
+
+/*
+Adds n parts to the time like dateAdd, but clamps the day of the
+month instead of overflowing into the next month.
+*/
+func dateRangeAdd(t time.Time, n int, part string) (time.Time, error) {
+	var months int
+	switch strings.ToLower(part) {
+	case "millennium":
+		months = n * 12000
+	case "century":
+		months = n * 1200
+	case "decade":
+		months = n * 120
+	case "year":
+		months = n * 12
+	case "quarter":
+		months = n * 3
+	case "month":
+		months = n
+	default:
+		return dateAdd(t, n, part)
+	}
+
+	year, month, day := t.Date()
+	first := time.Date(year, month+time.Month(months), 1,
+		t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), t.Location())
+	if last := first.AddDate(0, 1, -1).Day(); day > last {
+		day = last
+	}
+
+	return first.AddDate(0, 0, day-1), nil
+}
+
 /*
 Truncate out the part of the date string from the output and return the
 remaining time t.
@@ -2251,3 +2930,149 @@ be evenly divided by 400.
 func isLeapYear(year int) bool {
 	return year%400 == 0 || (year%4 == 0 && year%100 != 0)
 }
+
+/*
+Format the time according to a pattern of % directives. The
+supported directives are
+
+	%Y  year                       %y  year of the century, 00-99
+	%m  month, 01-12               %B  month name, %b abbreviated
+	%d  day of the month, 01-31    %e  day of the month, space padded
+	%H  hour, 00-23                %I  hour, 01-12
+	%M  minute, 00-59              %S  second, 00-59
+	%L  millisecond, 000-999       %p  AM or PM
+	%j  day of the year, 001-366   %q  quarter, 1-4
+	%A  weekday name, %a abbreviated
+	%u  ISO day of the week, 1-7 from Monday
+	%w  day of the week, 0-6 from Sunday
+	%U  week of the year, 01-53, as in DATE_PART "week"
+	%V  ISO week, 01-53            %G  ISO week-numbering year
+	%z  zone offset, e.g. -0700    %Z  zone abbreviation
+	%%  a literal %
+
+Other characters, and unknown directives, are copied as is.
+*/
+func formatPattern(t time.Time, pattern string) string {
+	buf := make([]byte, 0, 2*len(pattern))
+	for i := 0; i < len(pattern); i++ {
+		c := pattern[i]
+		if c != '%' || i == len(pattern)-1 {
+			buf = append(buf, c)
+			continue
+		}
+
+		i++
+		switch pattern[i] {
+		case 'Y':
+			buf = append(buf, fmt.Sprintf("%04d", t.Year())...)
+		case 'y':
+			buf = append(buf, fmt.Sprintf("%02d", t.Year()%100)...)
+		case 'm':
+			buf = append(buf, fmt.Sprintf("%02d", int(t.Month()))...)
+		case 'B':
+			buf = append(buf, t.Month().String()...)
+		case 'b':
+			buf = append(buf, t.Month().String()[:3]...)
+		case 'd':
+			buf = append(buf, fmt.Sprintf("%02d", t.Day())...)
+		case 'e':
+			buf = append(buf, fmt.Sprintf("%2d", t.Day())...)
+		case 'H':
+			buf = append(buf, fmt.Sprintf("%02d", t.Hour())...)
+		case 'I':
+			buf = append(buf, t.Format("03")...)
+		case 'M':
+			buf = append(buf, fmt.Sprintf("%02d", t.Minute())...)
+		case 'S':
+			buf = append(buf, fmt.Sprintf("%02d", t.Second())...)
+		case 'L':
+			buf = append(buf, fmt.Sprintf("%03d", t.Nanosecond()/int(time.Millisecond))...)
+		case 'p':
+			buf = append(buf, t.Format("PM")...)
+		case 'j':
+			buf = append(buf, fmt.Sprintf("%03d", t.YearDay())...)
+		case 'q':
+			buf = append(buf, fmt.Sprintf("%d", GetQuarter(t))...)
+		case 'A':
+			buf = append(buf, t.Weekday().String()...)
+		case 'a':
+			buf = append(buf, t.Weekday().String()[:3]...)
+		case 'u':
+			d, _ := datePart(t, "iso_dow")
+			buf = append(buf, fmt.Sprintf("%d", d)...)
+		case 'w':
+			buf = append(buf, fmt.Sprintf("%d", int(t.Weekday()))...)
+		case 'U':
+			w, _ := datePart(t, "week")
+			buf = append(buf, fmt.Sprintf("%02d", w)...)
+		case 'V':
+			_, w := t.ISOWeek()
+			buf = append(buf, fmt.Sprintf("%02d", w)...)
+		case 'G':
+			y, _ := t.ISOWeek()
+			buf = append(buf, fmt.Sprintf("%04d", y)...)
+		case 'z':
+			buf = append(buf, t.Format("-0700")...)
+		case 'Z':
+			buf = append(buf, t.Format("MST")...)
+		case '%':
+			buf = append(buf, '%')
+		default:
+			buf = append(buf, '%', pattern[i])
+		}
+	}
+
+	return string(buf)
+}
+
+/*
+Directives of format patterns that can be parsed, and the
+corresponding elements of Go time layouts. %L must follow a
+decimal point.
+*/
+var _PATTERN_LAYOUTS = map[byte]string{
+	'Y': "2006",
+	'y': "06",
+	'm': "01",
+	'B': "January",
+	'b': "Jan",
+	'd': "02",
+	'e': "_2",
+	'H': "15",
+	'I': "03",
+	'M': "04",
+	'S': "05",
+	'L': "000",
+	'p': "PM",
+	'j': "002",
+	'A': "Monday",
+	'a': "Mon",
+	'z': "-0700",
+	'Z': "MST",
+	'%': "%",
+}
+
+/*
+Convert a format pattern to a Go time layout, for parsing. The
+directives that cannot be parsed, such as weeks and quarters,
+are errors.
+*/
+func patternLayout(pattern string) (string, error) {
+	buf := make([]byte, 0, 2*len(pattern))
+	for i := 0; i < len(pattern); i++ {
+		c := pattern[i]
+		if c != '%' || i == len(pattern)-1 {
+			buf = append(buf, c)
+			continue
+		}
+
+		i++
+		layout, ok := _PATTERN_LAYOUTS[pattern[i]]
+		if !ok {
+			return "", fmt.Errorf("Unsupported date format directive %%%c for parsing.", pattern[i])
+		}
+		buf = append(buf, layout...)
+	}
+
+	return string(buf), nil
+}
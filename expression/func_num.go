@@ -80,7 +80,15 @@ func (this *Abs) Apply(context Context, arg value.Value) (value.Value, error) {
 		return value.NULL_VALUE, nil
 	}
 
-	return value.NewValue(math.Abs(arg.Actual().(float64))), nil
+	n := value.AsNumberValue(arg)
+	if i, ok := value.IsInt(n); ok && i != math.MinInt64 {
+		if i < 0 {
+			i = -i
+		}
+		return value.NewValue(i), nil
+	}
+
+	return value.NewValue(math.Abs(n.Float64())), nil
 }
 
 /*
@@ -157,7 +165,7 @@ func (this *Acos) Apply(context Context, arg value.Value) (value.Value, error) {
 		return value.NULL_VALUE, nil
 	}
 
-	return value.NewValue(math.Acos(arg.Actual().(float64))), nil
+	return value.NewValue(math.Acos(value.AsNumberValue(arg).Float64())), nil
 }
 
 /*
@@ -234,7 +242,7 @@ func (this *Asin) Apply(context Context, arg value.Value) (value.Value, error) {
 		return value.NULL_VALUE, nil
 	}
 
-	return value.NewValue(math.Asin(arg.Actual().(float64))), nil
+	return value.NewValue(math.Asin(value.AsNumberValue(arg).Float64())), nil
 }
 
 /*
@@ -311,7 +319,7 @@ func (this *Atan) Apply(context Context, arg value.Value) (value.Value, error) {
 		return value.NULL_VALUE, nil
 	}
 
-	return value.NewValue(math.Atan(arg.Actual().(float64))), nil
+	return value.NewValue(math.Atan(value.AsNumberValue(arg).Float64())), nil
 }
 
 /*
@@ -388,8 +396,8 @@ func (this *Atan2) Apply(context Context, first, second value.Value) (value.Valu
 	}
 
 	return value.NewValue(math.Atan2(
-		first.Actual().(float64),
-		second.Actual().(float64))), nil
+		value.AsNumberValue(first).Float64(),
+		value.AsNumberValue(second).Float64())), nil
 }
 
 /*
@@ -468,7 +476,12 @@ func (this *Ceil) Apply(context Context, arg value.Value) (value.Value, error) {
 		return value.NULL_VALUE, nil
 	}
 
-	return value.NewValue(math.Ceil(arg.Actual().(float64))), nil
+	n := value.AsNumberValue(arg)
+	if _, ok := value.IsInt(n); ok {
+		return n, nil
+	}
+
+	return value.NewValue(math.Ceil(n.Float64())), nil
 }
 
 /*
@@ -545,7 +558,7 @@ func (this *Cos) Apply(context Context, arg value.Value) (value.Value, error) {
 		return value.NULL_VALUE, nil
 	}
 
-	return value.NewValue(math.Cos(arg.Actual().(float64))), nil
+	return value.NewValue(math.Cos(value.AsNumberValue(arg).Float64())), nil
 }
 
 /*
@@ -622,7 +635,7 @@ func (this *Degrees) Apply(context Context, arg value.Value) (value.Value, error
 		return value.NULL_VALUE, nil
 	}
 
-	return value.NewValue(arg.Actual().(float64) * 180.0 / math.Pi), nil
+	return value.NewValue(value.AsNumberValue(arg).Float64() * 180.0 / math.Pi), nil
 }
 
 /*
@@ -771,7 +784,7 @@ func (this *Exp) Apply(context Context, arg value.Value) (value.Value, error) {
 		return value.NULL_VALUE, nil
 	}
 
-	return value.NewValue(math.Exp(arg.Actual().(float64))), nil
+	return value.NewValue(math.Exp(value.AsNumberValue(arg).Float64())), nil
 }
 
 /*
@@ -848,7 +861,7 @@ func (this *Ln) Apply(context Context, arg value.Value) (value.Value, error) {
 		return value.NULL_VALUE, nil
 	}
 
-	return value.NewValue(math.Log(arg.Actual().(float64))), nil
+	return value.NewValue(math.Log(value.AsNumberValue(arg).Float64())), nil
 }
 
 /*
@@ -926,7 +939,7 @@ func (this *Log) Apply(context Context, arg value.Value) (value.Value, error) {
 		return value.NULL_VALUE, nil
 	}
 
-	return value.NewValue(math.Log10(arg.Actual().(float64))), nil
+	return value.NewValue(math.Log10(value.AsNumberValue(arg).Float64())), nil
 }
 
 /*
@@ -1004,7 +1017,12 @@ func (this *Floor) Apply(context Context, arg value.Value) (value.Value, error)
 		return value.NULL_VALUE, nil
 	}
 
-	return value.NewValue(math.Floor(arg.Actual().(float64))), nil
+	n := value.AsNumberValue(arg)
+	if _, ok := value.IsInt(n); ok {
+		return n, nil
+	}
+
+	return value.NewValue(math.Floor(n.Float64())), nil
 }
 
 /*
@@ -1364,8 +1382,8 @@ func (this *Power) Apply(context Context, first, second value.Value) (value.Valu
 	}
 
 	return value.NewValue(math.Pow(
-		first.Actual().(float64),
-		second.Actual().(float64))), nil
+		value.AsNumberValue(first).Float64(),
+		value.AsNumberValue(second).Float64())), nil
 }
 
 /*
@@ -1442,7 +1460,7 @@ func (this *Radians) Apply(context Context, arg value.Value) (value.Value, error
 		return value.NULL_VALUE, nil
 	}
 
-	return value.NewValue(arg.Actual().(float64) * math.Pi / 180.0), nil
+	return value.NewValue(value.AsNumberValue(arg).Float64() * math.Pi / 180.0), nil
 }
 
 /*
@@ -1505,6 +1523,8 @@ func NewRandom(operands ...Expression) Function {
 		switch val := op.Value().Actual().(type) {
 		case float64:
 			rv.gen = rand.New(rand.NewSource(int64(val)))
+		case int64:
+			rv.gen = rand.New(rand.NewSource(val))
 		}
 	}
 
@@ -1558,12 +1578,12 @@ func (this *Random) Apply(context Context, args ...value.Value) (value.Value, er
 		return value.NULL_VALUE, nil
 	}
 
-	v := arg.Actual().(float64)
-	if v != math.Trunc(v) {
+	v, ok := value.IsInt(value.AsNumberValue(arg))
+	if !ok {
 		return value.NULL_VALUE, nil
 	}
 
-	gen := rand.New(rand.NewSource(int64(v)))
+	gen := rand.New(rand.NewSource(v))
 	return value.NewValue(gen.Float64()), nil
 }
 
@@ -1647,9 +1667,14 @@ func (this *Round) Apply(context Context, args ...value.Value) (value.Value, err
 		return value.NULL_VALUE, nil
 	}
 
-	v := arg.Actual().(float64)
+	n := value.AsNumberValue(arg)
+	_, isInt := value.IsInt(n)
+	v := n.Float64()
 
 	if len(this.operands) == 1 {
+		if isInt {
+			return n, nil
+		}
 		return value.NewValue(roundFloat(v, 0)), nil
 	}
 
@@ -1660,13 +1685,18 @@ func (this *Round) Apply(context Context, args ...value.Value) (value.Value, err
 	} else if prec.Type() != value.NUMBER {
 		return value.NULL_VALUE, nil
 	} else {
-		pf := prec.Actual().(float64)
+		pf := value.AsNumberValue(prec).Float64()
 		if pf != math.Trunc(pf) {
 			return value.NULL_VALUE, nil
 		}
 		p = int(pf)
 	}
 
+	// Integers are exact to any precision of zero or more
+	if isInt && p >= 0 {
+		return n, nil
+	}
+
 	return value.NewValue(roundFloat(v, p)), nil
 }
 
@@ -1751,7 +1781,7 @@ func (this *Sign) Apply(context Context, arg value.Value) (value.Value, error) {
 		return value.NULL_VALUE, nil
 	}
 
-	f := arg.Actual().(float64)
+	f := value.AsNumberValue(arg).Float64()
 	s := 0.0
 	if f < 0.0 {
 		s = -1.0
@@ -1836,7 +1866,7 @@ func (this *Sin) Apply(context Context, arg value.Value) (value.Value, error) {
 		return value.NULL_VALUE, nil
 	}
 
-	return value.NewValue(math.Sin(arg.Actual().(float64))), nil
+	return value.NewValue(math.Sin(value.AsNumberValue(arg).Float64())), nil
 }
 
 /*
@@ -1913,7 +1943,7 @@ func (this *Sqrt) Apply(context Context, arg value.Value) (value.Value, error) {
 		return value.NULL_VALUE, nil
 	}
 
-	return value.NewValue(math.Sqrt(arg.Actual().(float64))), nil
+	return value.NewValue(math.Sqrt(value.AsNumberValue(arg).Float64())), nil
 }
 
 /*
@@ -1990,7 +2020,7 @@ func (this *Tan) Apply(context Context, arg value.Value) (value.Value, error) {
 		return value.NULL_VALUE, nil
 	}
 
-	return value.NewValue(math.Tan(arg.Actual().(float64))), nil
+	return value.NewValue(math.Tan(value.AsNumberValue(arg).Float64())), nil
 }
 
 /*
@@ -2071,9 +2101,14 @@ func (this *Trunc) Apply(context Context, args ...value.Value) (value.Value, err
 		return value.NULL_VALUE, nil
 	}
 
-	v := arg.Actual().(float64)
+	n := value.AsNumberValue(arg)
+	_, isInt := value.IsInt(n)
+	v := n.Float64()
 
 	if len(this.operands) == 1 {
+		if isInt {
+			return n, nil
+		}
 		return value.NewValue(truncateFloat(v, 0)), nil
 	}
 
@@ -2084,13 +2119,18 @@ func (this *Trunc) Apply(context Context, args ...value.Value) (value.Value, err
 	} else if prec.Type() != value.NUMBER {
 		return value.NULL_VALUE, nil
 	} else {
-		pf := prec.Actual().(float64)
+		pf := value.AsNumberValue(prec).Float64()
 		if pf != math.Trunc(pf) {
 			return value.NULL_VALUE, nil
 		}
 		p = int(pf)
 	}
 
+	// Integers are exact to any precision of zero or more
+	if isInt && p >= 0 {
+		return n, nil
+	}
+
 	return value.NewValue(truncateFloat(v, p)), nil
 }
 
@@ -469,7 +469,7 @@ func (this *RegexpReplace) Apply(context Context, args ...value.Value) (value.Va
 		return value.NewValue(re.ReplaceAllLiteralString(f, r)), nil
 	}
 
-	nf := args[3].Actual().(float64)
+	nf := value.AsNumberValue(args[3]).Float64()
 	if nf != math.Trunc(nf) {
 		return value.NULL_VALUE, nil
 	}
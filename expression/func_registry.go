@@ -26,6 +26,20 @@ func GetFunction(name string) (Function, bool) {
 	return rv, ok
 }
 
+/*
+This method returns the names of all the registered functions,
+in lower case and in no particular order. It is used by clients
+such as the shell to offer function name completion.
+*/
+func FunctionNames() []string {
+	rv := make([]string, 0, len(_FUNCTIONS))
+	for name, _ := range _FUNCTIONS {
+		rv = append(rv, name)
+	}
+
+	return rv
+}
+
 /*
 The variable _FUNCTIONS represents a map from string to
 Function. Each string returns a pointer to that function.
@@ -91,21 +105,28 @@ var _FUNCTIONS = map[string]Function{
 	"date_add_str":        &DateAddStr{},
 	"date_diff_millis":    &DateDiffMillis{},
 	"date_diff_str":       &DateDiffStr{},
+	"date_format_str":     &DateFormatStr{},
 	"date_part_millis":    &DatePartMillis{},
 	"date_part_str":       &DatePartStr{},
+	"date_range_millis":   &DateRangeMillis{},
+	"date_range_str":      &DateRangeStr{},
 	"date_trunc_millis":   &DateTruncMillis{},
 	"date_trunc_str":      &DateTruncStr{},
 	"duration_to_str":     &DurationToStr{},
 	"millis":              &StrToMillis{},
 	"millis_to_str":       &MillisToStr{},
+	"millis_to_tz":        &MillisToZoneName{},
 	"millis_to_utc":       &MillisToUTC{},
 	"millis_to_zone_name": &MillisToZoneName{},
 	"now_millis":          &NowMillis{},
 	"now_str":             &NowStr{},
 	"str_to_duration":     &StrToDuration{},
 	"str_to_millis":       &StrToMillis{},
+	"str_to_tz":           &StrToZoneName{},
 	"str_to_utc":          &StrToUTC{},
 	"str_to_zone_name":    &StrToZoneName{},
+	"weekday_millis":      &WeekdayMillis{},
+	"weekday_str":         &WeekdayStr{},
 
 	// String
 	"contains":        &Contains{},
@@ -253,6 +274,10 @@ var _FUNCTIONS = map[string]Function{
 	"uuid":          &Uuid{},
 	"version":       &Version{},
 
+	// Full-text search
+	"search":       &Search{},
+	"search_score": &SearchScore{},
+
 	// Type checking
 	"is_array":   &IsArray{},
 	"is_atom":    &IsAtom{},
@@ -0,0 +1,189 @@
+//  Copyright (c) 2014 Couchbase, Inc.
+//  Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file
+//  except in compliance with the License. You may obtain a copy of the License at
+//    http://www.apache.org/licenses/LICENSE-2.0
+//  Unless required by applicable law or agreed to in writing, software distributed under the
+//  License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+//  either express or implied. See the License for the specific language governing permissions
+//  and limitations under the License.
+
+package expression
+
+import (
+	"github.com/couchbase/query/value"
+)
+
+///////////////////////////////////////////////////
+//
+// Search
+//
+///////////////////////////////////////////////////
+
+/*
+This represents the full-text search function SEARCH(expr, query).
+It returns true if the text of the expression contains every term
+of the query, after both are analyzed by SearchTerms. The planner
+answers it from a full-text index on the expression, if there is
+one. Type Search is a struct that implements BinaryFunctionBase.
+*/
+type Search struct {
+	BinaryFunctionBase
+}
+
+/*
+The function NewSearch calls NewBinaryFunctionBase to create a
+function named SEARCH with the two expressions as input.
+*/
+func NewSearch(first, second Expression) Function {
+	rv := &Search{
+		*NewBinaryFunctionBase("search", first, second),
+	}
+
+	rv.expr = rv
+	return rv
+}
+
+/*
+It calls the VisitFunction method by passing in the receiver to
+and returns the interface. It is a visitor pattern.
+*/
+func (this *Search) Accept(visitor Visitor) (interface{}, error) {
+	return visitor.VisitFunction(this)
+}
+
+/*
+It returns a value type BOOLEAN.
+*/
+func (this *Search) Type() value.Type { return value.BOOLEAN }
+
+/*
+Calls the Eval method for binary functions and passes in the
+receiver, current item and current context.
+*/
+func (this *Search) Evaluate(item value.Value, context Context) (value.Value, error) {
+	return this.BinaryEval(this, item, context)
+}
+
+/*
+If this expression is in the WHERE clause of a partial index, lists
+the Expressions that are implicitly covered.
+
+For boolean functions, simply list this expression.
+*/
+func (this *Search) FilterCovers(covers map[string]value.Value) map[string]value.Value {
+	covers[this.String()] = value.TRUE_VALUE
+	return covers
+}
+
+/*
+If either input is missing, return a missing value. If the text is
+not a string or an array, or the query is not a string, return a
+null value. A query without any terms matches nothing.
+*/
+func (this *Search) Apply(context Context, first, second value.Value) (value.Value, error) {
+	if first.Type() == value.MISSING || second.Type() == value.MISSING {
+		return value.MISSING_VALUE, nil
+	} else if (first.Type() != value.STRING && first.Type() != value.ARRAY) ||
+		second.Type() != value.STRING {
+		return value.NULL_VALUE, nil
+	}
+
+	query := SearchTerms(second)
+	if len(query) == 0 {
+		return value.FALSE_VALUE, nil
+	}
+
+	terms := make(map[string]bool, 64)
+	for _, term := range SearchTerms(first) {
+		terms[term] = true
+	}
+
+	for _, term := range query {
+		if !terms[term] {
+			return value.FALSE_VALUE, nil
+		}
+	}
+
+	return value.TRUE_VALUE, nil
+}
+
+/*
+The constructor returns a NewSearch with the two operands
+cast to a Function as the FunctionConstructor.
+*/
+func (this *Search) Constructor() FunctionConstructor {
+	return func(operands ...Expression) Function {
+		return NewSearch(operands[0], operands[1])
+	}
+}
+
+///////////////////////////////////////////////////
+//
+// SearchScore
+//
+///////////////////////////////////////////////////
+
+/*
+This represents the full-text search function SEARCH_SCORE([expr]).
+It returns the relevance score that a full-text index scan attached
+to the keyspace document, or null if the document was not found by
+a full-text index scan. Higher scores are more relevant.
+*/
+type SearchScore struct {
+	FunctionBase
+}
+
+func NewSearchScore(operands ...Expression) Function {
+	rv := &SearchScore{
+		*NewFunctionBase("search_score", operands...),
+	}
+
+	// Without an operand, the score is read from the current item
+	rv.volatile = len(operands) == 0
+	rv.expr = rv
+	return rv
+}
+
+func (this *SearchScore) Accept(visitor Visitor) (interface{}, error) {
+	return visitor.VisitFunction(this)
+}
+
+func (this *SearchScore) Type() value.Type { return value.NUMBER }
+
+func (this *SearchScore) Evaluate(item value.Value, context Context) (value.Value, error) {
+	val := item
+
+	if len(this.operands) > 0 {
+		arg, err := this.operands[0].Evaluate(item, context)
+		if err != nil {
+			return nil, err
+		}
+
+		val = arg
+	}
+
+	if val == nil || val.Type() == value.MISSING {
+		return value.MISSING_VALUE, nil
+	}
+
+	switch val := val.(type) {
+	case value.AnnotatedValue:
+		if score, ok := val.GetAttachment("score").(value.Value); ok {
+			return score, nil
+		}
+	}
+
+	return value.NULL_VALUE, nil
+}
+
+func (this *SearchScore) Indexable() bool {
+	return false
+}
+
+func (this *SearchScore) MinArgs() int { return 0 }
+
+func (this *SearchScore) MaxArgs() int { return 1 }
+
+func (this *SearchScore) Constructor() FunctionConstructor {
+	return NewSearchScore
+}
@@ -0,0 +1,99 @@
+//  Copyright (c) 2016 Couchbase, Inc.
+//  Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file
+//  except in compliance with the License. You may obtain a copy of the License at
+//    http://www.apache.org/licenses/LICENSE-2.0
+//  Unless required by applicable law or agreed to in writing, software distributed under the
+//  License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+//  either express or implied. See the License for the specific language governing permissions
+//  and limitations under the License.
+
+package expression
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/couchbase/query/value"
+)
+
+func TestStem(t *testing.T) {
+	stems := map[string]string{
+		"caresses":       "caress",
+		"ponies":         "poni",
+		"cats":           "cat",
+		"agreed":         "agre",
+		"plastered":      "plaster",
+		"motoring":       "motor",
+		"hopping":        "hop",
+		"filing":         "file",
+		"happy":          "happi",
+		"relational":     "relat",
+		"conditional":    "condit",
+		"hopefulness":    "hope",
+		"generalization": "gener",
+		"adjustment":     "adjust",
+		"controll":       "control",
+		"running":        "run",
+		"runs":           "run",
+		"databases":      "databas",
+		"sky":            "sky",
+		"10th":           "10th",
+		"café":           "café",
+	}
+
+	for word, expected := range stems {
+		if s := stem(word); s != expected {
+			t.Errorf("stem(%s): expected %s, got %s", word, expected, s)
+		}
+	}
+}
+
+func TestSearch(t *testing.T) {
+	terms := SearchTerms(value.NewValue([]interface{}{"The Quick-Brown fox", 42, []interface{}{"JUMPED over"}}))
+	if fmt.Sprint(terms) != "[quick brown fox jump over]" {
+		t.Errorf("Unexpected search terms %v", terms)
+	}
+
+	text := NewConstant("Running shoes for trail runners")
+	cases := []struct {
+		query    interface{}
+		expected value.Value
+	}{
+		{"run", value.TRUE_VALUE},
+		{"TRAIL shoe", value.TRUE_VALUE},
+		{"road shoe", value.FALSE_VALUE},
+		{"for the", value.FALSE_VALUE},
+		{42, value.NULL_VALUE},
+	}
+
+	for _, c := range cases {
+		rv, err := NewSearch(text, NewConstant(c.query)).Evaluate(nil, nil)
+		if err != nil {
+			t.Errorf("Unexpected error %v", err)
+		} else if rv.Collate(c.expected) != 0 {
+			t.Errorf("SEARCH(%v): expected %v, got %v", c.query, c.expected, rv)
+		}
+	}
+
+	rv, _ := NewSearch(NewConstant(value.MISSING_VALUE), NewConstant("run")).Evaluate(nil, nil)
+	if rv.Type() != value.MISSING {
+		t.Errorf("Expected MISSING, got %v", rv)
+	}
+}
+
+func TestSearchScore(t *testing.T) {
+	doc := value.NewAnnotatedValue(map[string]interface{}{"text": "run"})
+	score := NewSearchScore()
+	if rv, _ := score.Evaluate(doc, nil); rv.Type() != value.NULL {
+		t.Errorf("Expected NULL score, got %v", rv)
+	}
+
+	doc.SetAttachment("score", value.NewValue(1.5))
+	if rv, _ := score.Evaluate(doc, nil); rv.Actual() != 1.5 {
+		t.Errorf("Expected score 1.5, got %v", rv)
+	}
+
+	if score.Value() != nil {
+		t.Errorf("Expected SEARCH_SCORE() not to be constant")
+	}
+}
@@ -519,7 +519,7 @@ func (this *Repeat) Apply(context Context, first, second value.Value) (value.Val
 		return value.NULL_VALUE, nil
 	}
 
-	nf := second.Actual().(float64)
+	nf := value.AsNumberValue(second).Float64()
 	if nf < 0.0 || nf != math.Trunc(nf) {
 		return value.NULL_VALUE, nil
 	}
@@ -625,7 +625,7 @@ func (this *Replace) Apply(context Context, args ...value.Value) (value.Value, e
 	n := -1
 
 	if len(args) == 4 {
-		nf := args[3].Actual().(float64)
+		nf := value.AsNumberValue(args[3]).Float64()
 		if nf != math.Trunc(nf) {
 			return value.NULL_VALUE, nil
 		}
@@ -949,8 +949,7 @@ func (this *Substr) Apply(context Context, args ...value.Value) (value.Value, er
 		case value.MISSING:
 			return value.MISSING_VALUE, nil
 		case value.NUMBER:
-			vf := args[i].Actual().(float64)
-			if vf != math.Trunc(vf) {
+			if _, ok := value.IsInt(value.AsNumberValue(args[i])); !ok {
 				null = true
 			}
 		default:
@@ -963,7 +962,7 @@ func (this *Substr) Apply(context Context, args ...value.Value) (value.Value, er
 	}
 
 	str := args[0].Actual().(string)
-	pos := int(args[1].Actual().(float64))
+	pos := int(value.AsNumberValue(args[1]).Float64())
 
 	if pos < 0 {
 		pos = len(str) + pos
@@ -977,7 +976,7 @@ func (this *Substr) Apply(context Context, args ...value.Value) (value.Value, er
 		return value.NewValue(str[pos:]), nil
 	}
 
-	length := int(args[2].Actual().(float64))
+	length := int(value.AsNumberValue(args[2]).Float64())
 	if length < 0 || pos+length > len(str) {
 		return value.NULL_VALUE, nil
 	}
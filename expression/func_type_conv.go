@@ -274,6 +274,8 @@ func (this *ToBoolean) Apply(context Context, arg value.Value) (value.Value, err
 		switch a := arg.Actual().(type) {
 		case float64:
 			return value.NewValue(!math.IsNaN(a) && a != 0), nil
+		case int64:
+			return value.NewValue(a != 0), nil
 		case string:
 			return value.NewValue(len(a) > 0), nil
 		case []byte:
@@ -374,6 +376,11 @@ func (this *ToNumber) Apply(context Context, arg value.Value) (value.Value, erro
 				return value.ZERO_VALUE, nil
 			}
 		case string:
+			i, err := strconv.ParseInt(a, 10, 64)
+			if err == nil {
+				return value.NewValue(i), nil
+			}
+
 			f, err := strconv.ParseFloat(a, 64)
 			if err == nil {
 				return value.NewValue(f), nil
@@ -545,7 +552,12 @@ func (this *ToString) Apply(context Context, arg value.Value) (value.Value, erro
 	case value.BOOLEAN:
 		return value.NewValue(fmt.Sprint(arg.Actual())), nil
 	case value.NUMBER:
-		f := arg.Actual().(float64)
+		n := value.AsNumberValue(arg)
+		if i, ok := n.Actual().(int64); ok {
+			return value.NewValue(strconv.FormatInt(i, 10)), nil
+		}
+
+		f := n.Float64()
 		if f == -0 {
 			f = 0
 		}
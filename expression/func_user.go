@@ -0,0 +1,244 @@
+//  Copyright (c) 2016 Couchbase, Inc.
+//  Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file
+//  except in compliance with the License. You may obtain a copy of the License at
+//    http://www.apache.org/licenses/LICENSE-2.0
+//  Unless required by applicable law or agreed to in writing, software distributed under the
+//  License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+//  either express or implied. See the License for the specific language governing permissions
+//  and limitations under the License.
+
+package expression
+
+import (
+	"encoding/json"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/couchbase/query/errors"
+	"github.com/couchbase/query/value"
+)
+
+///////////////////////////////////////////////////
+//
+// UserFunction
+//
+///////////////////////////////////////////////////
+
+/*
+Maximum number of nested user-defined function calls, which bounds
+recursive functions.
+*/
+const MAX_USER_FUNCTION_DEPTH = 128
+
+/*
+This represents a call to a function defined with CREATE FUNCTION.
+The definition is looked up in the catalog each time the call is
+evaluated, so that it reflects the current definition. The body of
+the function is evaluated against an object of its parameters.
+*/
+type UserFunction struct {
+	FunctionBase
+}
+
+func NewUserFunction(name string, operands ...Expression) Function {
+	rv := &UserFunction{
+		*NewFunctionBase(strings.ToLower(name), operands...),
+	}
+
+	rv.volatile = true
+	rv.expr = rv
+	return rv
+}
+
+/*
+It calls the VisitFunction method by passing in the receiver to
+and returns the interface. It is a visitor pattern.
+*/
+func (this *UserFunction) Accept(visitor Visitor) (interface{}, error) {
+	return visitor.VisitFunction(this)
+}
+
+func (this *UserFunction) Type() value.Type { return value.JSON }
+
+func (this *UserFunction) Evaluate(item value.Value, context Context) (value.Value, error) {
+	return this.Eval(this, item, context)
+}
+
+/*
+Binds the arguments to the parameters of the function and evaluates
+its body. Nested calls are counted through the context passed to the
+body.
+*/
+func (this *UserFunction) Apply(context Context, args ...value.Value) (value.Value, error) {
+	definition, ok := GetUserFunction(this.Name())
+	if !ok {
+		return nil, errors.NewFunctionNotFoundError(this.Name())
+	}
+
+	parameters := definition.Parameters()
+	if len(args) != len(parameters) {
+		return nil, errors.NewFunctionArgumentsError(this.Name(), len(parameters), len(args))
+	}
+
+	depth := 0
+	if uc, ok := context.(*userFunctionContext); ok {
+		depth = uc.depth
+		context = uc.Context
+	}
+
+	if depth >= MAX_USER_FUNCTION_DEPTH {
+		return nil, errors.NewFunctionRecursionError(this.Name(), MAX_USER_FUNCTION_DEPTH)
+	}
+
+	scope := make(map[string]interface{}, len(parameters))
+	for i, p := range parameters {
+		scope[p] = args[i]
+	}
+
+	return definition.Body().Evaluate(value.NewScopeValue(scope, nil),
+		&userFunctionContext{context, depth + 1})
+}
+
+func (this *UserFunction) MinArgs() int { return len(this.operands) }
+
+func (this *UserFunction) MaxArgs() int { return len(this.operands) }
+
+func (this *UserFunction) Constructor() FunctionConstructor {
+	name := this.Name()
+	return func(operands ...Expression) Function {
+		return NewUserFunction(name, operands...)
+	}
+}
+
+/*
+The context of the body of a user-defined function, which records
+the depth of nested calls.
+*/
+type userFunctionContext struct {
+	Context
+	depth int
+}
+
+///////////////////////////////////////////////////
+//
+// UserFunctionDefinition
+//
+///////////////////////////////////////////////////
+
+/*
+The definition of a user-defined function: its name, the names of
+its parameters, and the expression of its body.
+*/
+type UserFunctionDefinition struct {
+	name       string
+	parameters []string
+	body       Expression
+}
+
+func NewUserFunctionDefinition(name string, parameters []string,
+	body Expression) *UserFunctionDefinition {
+	return &UserFunctionDefinition{
+		name:       strings.ToLower(name),
+		parameters: parameters,
+		body:       body,
+	}
+}
+
+func (this *UserFunctionDefinition) Name() string {
+	return this.name
+}
+
+func (this *UserFunctionDefinition) Parameters() []string {
+	return this.parameters
+}
+
+func (this *UserFunctionDefinition) Body() Expression {
+	return this.body
+}
+
+func (this *UserFunctionDefinition) MarshalJSON() ([]byte, error) {
+	r := map[string]interface{}{
+		"name":       this.name,
+		"parameters": this.parameters,
+		"body":       this.body.String(),
+	}
+
+	return json.Marshal(r)
+}
+
+/*
+The catalog of user-defined functions, by lower-case name. Where
+the datastore persists functions, the catalog is loaded from the
+datastore when the query service starts.
+*/
+var _USER_FUNCTIONS = struct {
+	sync.RWMutex
+	functions map[string]*UserFunctionDefinition
+}{
+	functions: make(map[string]*UserFunctionDefinition, 64),
+}
+
+func GetUserFunction(name string) (*UserFunctionDefinition, bool) {
+	_USER_FUNCTIONS.RLock()
+	defer _USER_FUNCTIONS.RUnlock()
+
+	rv, ok := _USER_FUNCTIONS.functions[strings.ToLower(name)]
+	return rv, ok
+}
+
+/*
+Adds a function to the catalog. Returns an error if a function of
+the same name exists.
+*/
+func AddUserFunction(definition *UserFunctionDefinition) errors.Error {
+	_USER_FUNCTIONS.Lock()
+	defer _USER_FUNCTIONS.Unlock()
+
+	if _, ok := _USER_FUNCTIONS.functions[definition.name]; ok {
+		return errors.NewFunctionExistsError(definition.name)
+	}
+
+	_USER_FUNCTIONS.functions[definition.name] = definition
+	return nil
+}
+
+/*
+Removes a function from the catalog. Returns an error if there is
+no function of that name.
+*/
+func DeleteUserFunction(name string) errors.Error {
+	_USER_FUNCTIONS.Lock()
+	defer _USER_FUNCTIONS.Unlock()
+
+	name = strings.ToLower(name)
+	if _, ok := _USER_FUNCTIONS.functions[name]; !ok {
+		return errors.NewFunctionNotFoundError(name)
+	}
+
+	delete(_USER_FUNCTIONS.functions, name)
+	return nil
+}
+
+func CountUserFunctions() int {
+	_USER_FUNCTIONS.RLock()
+	defer _USER_FUNCTIONS.RUnlock()
+
+	return len(_USER_FUNCTIONS.functions)
+}
+
+/*
+Returns the names of the user-defined functions, in sorted order.
+*/
+func NameUserFunctions() []string {
+	_USER_FUNCTIONS.RLock()
+	defer _USER_FUNCTIONS.RUnlock()
+
+	names := make([]string, 0, len(_USER_FUNCTIONS.functions))
+	for name := range _USER_FUNCTIONS.functions {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+	return names
+}
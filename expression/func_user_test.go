@@ -0,0 +1,45 @@
+//  Copyright (c) 2016 Couchbase, Inc.
+//  Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file
+//  except in compliance with the License. You may obtain a copy of the License at
+//    http://www.apache.org/licenses/LICENSE-2.0
+//  Unless required by applicable law or agreed to in writing, software distributed under the
+//  License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+//  either express or implied. See the License for the specific language governing permissions
+//  and limitations under the License.
+
+package expression
+
+import (
+	"testing"
+)
+
+func TestUserFunctionRecursion(t *testing.T) {
+	// loop(n) calls itself without end
+	body := NewUserFunction("loop", NewAdd(NewIdentifier("n"), NewConstant(1)))
+	err := AddUserFunction(NewUserFunctionDefinition("loop", []string{"n"}, body))
+	if err != nil {
+		t.Fatalf("Error %v adding function loop", err)
+	}
+	defer DeleteUserFunction("loop")
+
+	_, e := NewUserFunction("loop", NewConstant(1)).Evaluate(nil, nil)
+	if e == nil {
+		t.Errorf("Expected the recursion limit to be exceeded")
+	}
+
+	err = AddUserFunction(NewUserFunctionDefinition("LOOP", []string{"m"}, NewIdentifier("m")))
+	if err == nil {
+		t.Errorf("Expected an error redefining function loop")
+	}
+}
+
+func TestUserFunctionNotFound(t *testing.T) {
+	_, err := NewUserFunction("missing", NewConstant(1)).Evaluate(nil, nil)
+	if err == nil {
+		t.Errorf("Expected an error calling an undefined function")
+	}
+
+	if DeleteUserFunction("missing") == nil {
+		t.Errorf("Expected an error dropping an undefined function")
+	}
+}
@@ -67,6 +67,15 @@ type Function interface {
 	Constructor() FunctionConstructor
 }
 
+/*
+Functions whose text includes a trailing clause, such as the OVER
+clause of window functions, implement FunctionSuffix. The Stringer
+appends the suffix to the function call.
+*/
+type FunctionSuffix interface {
+	FunctionSuffix() string
+}
+
 /*
 FunctionConstructor enables dynamic construction of functions.
 It represents a function that takes input expressions as
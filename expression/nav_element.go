@@ -63,7 +63,7 @@ func (this *Element) Evaluate(item value.Value, context Context) (value.Value, e
 func (this *Element) Apply(context Context, first, second value.Value) (value.Value, error) {
 	switch second.Type() {
 	case value.NUMBER:
-		s := second.Actual().(float64)
+		s := value.AsNumberValue(second).Float64()
 		if s == math.Trunc(s) {
 			v, _ := first.Index(int(s))
 			return v, nil
@@ -102,7 +102,7 @@ func (this *Element) Set(item, val value.Value, context Context) bool {
 
 	switch second.Type() {
 	case value.NUMBER:
-		s := second.Actual().(float64)
+		s := value.AsNumberValue(second).Float64()
 		if s == math.Trunc(s) {
 			er := first.SetIndex(int(s), val)
 			return er == nil
@@ -10,8 +10,6 @@
 package expression
 
 import (
-	"math"
-
 	"github.com/couchbase/query/value"
 )
 
@@ -84,16 +82,16 @@ func (this *Slice) Apply(context Context, args ...value.Value) (rv value.Value,
 			return value.MISSING_VALUE, nil
 		}
 
-		ea, ok := end.Actual().(float64)
-		if !ok || ea != math.Trunc(ea) {
+		ea, ok := value.IsInt(value.AsNumberValue(end))
+		if !ok {
 			return value.NULL_VALUE, nil
 		}
 
 		ev = int(ea)
 	}
 
-	sa, ok := start.Actual().(float64)
-	if !ok || sa != math.Trunc(sa) {
+	sa, ok := value.IsInt(value.AsNumberValue(start))
+	if !ok {
 		return value.NULL_VALUE, nil
 	}
 
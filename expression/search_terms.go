@@ -0,0 +1,294 @@
+//  Copyright (c) 2014 Couchbase, Inc.
+//  Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file
+//  except in compliance with the License. You may obtain a copy of the License at
+//    http://www.apache.org/licenses/LICENSE-2.0
+//  Unless required by applicable law or agreed to in writing, software distributed under the
+//  License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+//  either express or implied. See the License for the specific language governing permissions
+//  and limitations under the License.
+
+package expression
+
+import (
+	"strings"
+	"unicode"
+
+	"github.com/couchbase/query/value"
+)
+
+/*
+Common English words that carry no meaning for full-text search.
+They are neither indexed nor searched for.
+*/
+var _STOP_WORDS = map[string]bool{
+	"a": true, "an": true, "and": true, "are": true, "as": true, "at": true,
+	"be": true, "but": true, "by": true, "for": true, "if": true, "in": true,
+	"into": true, "is": true, "it": true, "no": true, "not": true, "of": true,
+	"on": true, "or": true, "such": true, "that": true, "the": true, "their": true,
+	"then": true, "there": true, "these": true, "they": true, "this": true,
+	"to": true, "was": true, "will": true, "with": true,
+}
+
+/*
+SearchTerms analyzes text for full-text search. The strings in the
+value, including those nested in arrays, are split into words at
+characters that are neither letters nor digits. The words are
+lower-cased, stop words are dropped, and the rest are reduced to
+their stems, so that "Running" and "runs" both yield "run". The
+terms are returned in order of occurrence, with repetitions.
+*/
+func SearchTerms(val value.Value) []string {
+	return appendSearchTerms(nil, val)
+}
+
+func appendSearchTerms(terms []string, val value.Value) []string {
+	switch val.Type() {
+	case value.STRING:
+		words := strings.FieldsFunc(val.Actual().(string), func(r rune) bool {
+			return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+		})
+
+		for _, word := range words {
+			word = strings.ToLower(word)
+			if !_STOP_WORDS[word] {
+				terms = append(terms, stem(word))
+			}
+		}
+	case value.ARRAY:
+		for _, elem := range val.Actual().([]interface{}) {
+			terms = appendSearchTerms(terms, value.NewValue(elem))
+		}
+	}
+
+	return terms
+}
+
+/*
+Reduces an English word to its stem with the Porter stemming
+algorithm. Words that are not made of lower-case ASCII letters,
+and words of up to two letters, are returned unchanged.
+*/
+func stem(word string) string {
+	if len(word) <= 2 {
+		return word
+	}
+
+	for i := 0; i < len(word); i++ {
+		if word[i] < 'a' || word[i] > 'z' {
+			return word
+		}
+	}
+
+	w := []byte(word)
+	w = stemStep1ab(w)
+	w = stemStep1c(w)
+	w = stemSuffixes(w, _STEP2_SUFFIXES, 0)
+	w = stemSuffixes(w, _STEP3_SUFFIXES, 0)
+	w = stemStep4(w)
+	w = stemStep5(w)
+	return string(w)
+}
+
+func isConsonant(w []byte, i int) bool {
+	switch w[i] {
+	case 'a', 'e', 'i', 'o', 'u':
+		return false
+	case 'y':
+		return i == 0 || !isConsonant(w, i-1)
+	default:
+		return true
+	}
+}
+
+/*
+The number of vowel-consonant sequences in the word.
+*/
+func measure(w []byte) int {
+	n, i := 0, 0
+	for i < len(w) && isConsonant(w, i) {
+		i++
+	}
+
+	for i < len(w) {
+		for i < len(w) && !isConsonant(w, i) {
+			i++
+		}
+
+		if i >= len(w) {
+			break
+		}
+
+		for i < len(w) && isConsonant(w, i) {
+			i++
+		}
+
+		n++
+	}
+
+	return n
+}
+
+func hasVowel(w []byte) bool {
+	for i := range w {
+		if !isConsonant(w, i) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func endsDoubleConsonant(w []byte) bool {
+	n := len(w)
+	return n >= 2 && w[n-1] == w[n-2] && isConsonant(w, n-1)
+}
+
+/*
+The word ends consonant-vowel-consonant, where the last consonant
+is not w, x or y, as in "hop" or "fil".
+*/
+func endsCVC(w []byte) bool {
+	n := len(w)
+	if n < 3 || !isConsonant(w, n-3) || isConsonant(w, n-2) || !isConsonant(w, n-1) {
+		return false
+	}
+
+	return w[n-1] != 'w' && w[n-1] != 'x' && w[n-1] != 'y'
+}
+
+func hasSuffix(w []byte, suffix string) bool {
+	return len(w) >= len(suffix) && string(w[len(w)-len(suffix):]) == suffix
+}
+
+func replaceSuffix(w []byte, suffix, replacement string) []byte {
+	return append(w[:len(w)-len(suffix)], replacement...)
+}
+
+func stemStep1ab(w []byte) []byte {
+	switch {
+	case hasSuffix(w, "sses"), hasSuffix(w, "ies"):
+		w = w[:len(w)-2]
+	case hasSuffix(w, "ss"):
+	case hasSuffix(w, "s"):
+		w = w[:len(w)-1]
+	}
+
+	if hasSuffix(w, "eed") {
+		if measure(w[:len(w)-3]) > 0 {
+			w = w[:len(w)-1]
+		}
+		return w
+	}
+
+	var stem []byte
+	switch {
+	case hasSuffix(w, "ed"):
+		stem = w[:len(w)-2]
+	case hasSuffix(w, "ing"):
+		stem = w[:len(w)-3]
+	default:
+		return w
+	}
+
+	if !hasVowel(stem) {
+		return w
+	}
+
+	w = stem
+	switch {
+	case hasSuffix(w, "at"), hasSuffix(w, "bl"), hasSuffix(w, "iz"):
+		w = append(w, 'e')
+	case endsDoubleConsonant(w):
+		if c := w[len(w)-1]; c != 'l' && c != 's' && c != 'z' {
+			w = w[:len(w)-1]
+		}
+	case measure(w) == 1 && endsCVC(w):
+		w = append(w, 'e')
+	}
+
+	return w
+}
+
+func stemStep1c(w []byte) []byte {
+	if hasSuffix(w, "y") && hasVowel(w[:len(w)-1]) {
+		w[len(w)-1] = 'i'
+	}
+
+	return w
+}
+
+type suffixRule struct {
+	suffix      string
+	replacement string
+}
+
+var _STEP2_SUFFIXES = []suffixRule{
+	{"ational", "ate"}, {"tional", "tion"}, {"enci", "ence"}, {"anci", "ance"},
+	{"izer", "ize"}, {"bli", "ble"}, {"alli", "al"}, {"entli", "ent"},
+	{"eli", "e"}, {"ousli", "ous"}, {"ization", "ize"}, {"ation", "ate"},
+	{"ator", "ate"}, {"alism", "al"}, {"iveness", "ive"}, {"fulness", "ful"},
+	{"ousness", "ous"}, {"aliti", "al"}, {"iviti", "ive"}, {"biliti", "ble"},
+	{"logi", "log"},
+}
+
+var _STEP3_SUFFIXES = []suffixRule{
+	{"icate", "ic"}, {"ative", ""}, {"alize", "al"}, {"iciti", "ic"},
+	{"ical", "ic"}, {"ful", ""}, {"ness", ""},
+}
+
+/*
+Replaces the first matching suffix, if the rest of the word has
+a measure above the minimum.
+*/
+func stemSuffixes(w []byte, rules []suffixRule, min int) []byte {
+	for _, rule := range rules {
+		if hasSuffix(w, rule.suffix) {
+			if measure(w[:len(w)-len(rule.suffix)]) > min {
+				w = replaceSuffix(w, rule.suffix, rule.replacement)
+			}
+			return w
+		}
+	}
+
+	return w
+}
+
+var _STEP4_SUFFIXES = []string{
+	"ement", "ment", "ent", "ance", "ence", "able", "ible", "ant", "ism",
+	"ate", "iti", "ous", "ive", "ize", "ion", "al", "er", "ic", "ou",
+}
+
+func stemStep4(w []byte) []byte {
+	for _, suffix := range _STEP4_SUFFIXES {
+		if !hasSuffix(w, suffix) {
+			continue
+		}
+
+		stem := w[:len(w)-len(suffix)]
+		if suffix == "ion" && !hasSuffix(stem, "s") && !hasSuffix(stem, "t") {
+			return w
+		}
+
+		if measure(stem) > 1 {
+			w = stem
+		}
+		return w
+	}
+
+	return w
+}
+
+func stemStep5(w []byte) []byte {
+	if hasSuffix(w, "e") {
+		stem := w[:len(w)-1]
+		if m := measure(stem); m > 1 || (m == 1 && !endsCVC(stem)) {
+			w = stem
+		}
+	}
+
+	if hasSuffix(w, "ll") && measure(w) > 1 {
+		w = w[:len(w)-1]
+	}
+
+	return w
+}
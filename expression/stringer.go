@@ -584,6 +584,12 @@ func (this *Stringer) VisitFunction(expr Function) (interface{}, error) {
 	}
 
 	buf.WriteString(")")
+
+	if suffix, ok := expr.(FunctionSuffix); ok {
+		buf.WriteString(" ")
+		buf.WriteString(suffix.FunctionSuffix())
+	}
+
 	return buf.String(), nil
 }
 
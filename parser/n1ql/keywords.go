@@ -0,0 +1,48 @@
+//  Copyright (c) 2014 Couchbase, Inc.
+//  Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file
+//  except in compliance with the License. You may obtain a copy of the License at
+//    http://www.apache.org/licenses/LICENSE-2.0
+//  Unless required by applicable law or agreed to in writing, software distributed under the
+//  License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+//  either express or implied. See the License for the specific language governing permissions
+//  and limitations under the License.
+
+package n1ql
+
+// The N1QL keywords, in upper case, as matched by the lexer in
+// n1ql.nex. Add new keywords here as they are added to the lexer.
+var _KEYWORDS = []string{
+	"ALL", "ALTER", "ANALYZE", "AND", "ANY", "ARRAY", "AS", "ASC",
+	"BEGIN", "BETWEEN", "BINARY", "BOOLEAN", "BREAK", "BUCKET", "BUILD",
+	"BY", "CALL", "CASE", "CAST", "CLUSTER", "COLLATE", "COLLECTION",
+	"COMMIT", "CONNECT", "CONTINUE", "CORRELATE", "COVER", "CREATE",
+	"CURRENT", "DATABASE", "DATASET", "DATASTORE", "DECLARE", "DECREMENT",
+	"DELETE", "DERIVED", "DESC", "DESCRIBE", "DISTINCT", "DO", "DROP",
+	"EACH", "ELEMENT", "ELSE", "END", "EVERY", "EXCEPT", "EXCLUDE",
+	"EXECUTE", "EXISTS", "EXPLAIN", "FALSE", "FETCH", "FIRST", "FLATTEN",
+	"FOLLOWING", "FOR", "FORCE", "FROM", "FUNCTION", "GRANT", "GROUP",
+	"GSI", "HAVING", "IF", "IGNORE", "ILIKE", "IN", "INCLUDE",
+	"INCREMENT", "INDEX", "INFER", "INLINE", "INNER", "INSERT",
+	"INTERSECT", "INTO", "IS", "JOIN", "KEY", "KEYS", "KEYSPACE", "KNOWN",
+	"LAST", "LEFT", "LET", "LETTING", "LIKE", "LIMIT", "LSM", "MAP",
+	"MAPPING", "MATCHED", "MATERIALIZED", "MERGE", "MINUS", "MISSING",
+	"NAMESPACE", "NEST", "NOT", "NULL", "NUMBER", "OBJECT", "OFFSET",
+	"ON", "OPTION", "OR", "ORDER", "OUTER", "OVER", "PARSE", "PARTITION",
+	"PASSWORD", "PATH", "POOL", "PRECEDING", "PREPARE", "PRIMARY",
+	"PRIVATE", "PRIVILEGE", "PROCEDURE", "PUBLIC", "RANGE", "RAW",
+	"REALM", "RECURSIVE", "REDUCE", "RENAME", "RETURN", "RETURNING",
+	"REVOKE", "RIGHT", "ROLE", "ROLLBACK", "ROW", "ROWS", "SATISFIES",
+	"SCHEMA", "SELECT", "SELF", "SEMI", "SET", "SHOW", "SOME", "START",
+	"STATISTICS", "STRING", "SYSTEM", "THEN", "TO", "TRANSACTION",
+	"TRIGGER", "TRUE", "TRUNCATE", "UNBOUNDED", "UNDER", "UNION",
+	"UNIQUE", "UNKNOWN", "UNNEST", "UNSET", "UPDATE", "UPSERT", "USE",
+	"USER", "USING", "VALIDATE", "VALUE", "VALUED", "VALUES", "VIA",
+	"VIEW", "WHEN", "WHERE", "WHILE", "WITH", "WITHIN", "WORK", "XOR",
+}
+
+// Return the N1QL keywords, in upper case.
+func Keywords() []string {
+	rv := make([]string, len(_KEYWORDS))
+	copy(rv, _KEYWORDS)
+	return rv
+}
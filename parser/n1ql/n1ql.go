@@ -82,6 +82,8 @@ type lexer struct {
 	expr        expression.Expression
 	parsingStmt bool
 	text        string
+	function    string // Function being defined by CREATE FUNCTION
+	params      int    // Number of parameters of the function being defined
 }
 
 func newLexer(nex *Lexer) *lexer {
@@ -99,6 +101,15 @@ func (this *lexer) Remainder(offset int) string {
 	return strings.TrimLeft(this.text[offset:], " \t")
 }
 
+// The remainder after the given keyword, which the remainder starts with.
+func (this *lexer) RemainderAfter(offset int, keyword string) string {
+	rv := this.Remainder(offset)
+	if len(rv) >= len(keyword) && strings.EqualFold(rv[:len(keyword)], keyword) {
+		rv = strings.TrimLeft(rv[len(keyword):], " \t\n")
+	}
+	return rv
+}
+
 func (this *lexer) Error(s string) {
 	if len(this.nex.stack) > 0 {
 		s = s + " - at " + this.nex.Text()
@@ -125,3 +136,26 @@ func (this *lexer) nextParam() int {
 	this.posParam++
 	return this.posParam
 }
+
+func (this *lexer) setFunction(name string, params int) {
+	this.function = name
+	this.params = params
+}
+
+/*
+Returns the number of parameters of a user-defined function, which
+is either in the catalog or being defined by the statement.
+*/
+func (this *lexer) userFunction(name string) (int, bool) {
+	name = strings.ToLower(name)
+	if name == this.function {
+		return this.params, true
+	}
+
+	definition, ok := expression.GetUserFunction(name)
+	if !ok {
+		return 0, false
+	}
+
+	return len(definition.Parameters()), true
+}
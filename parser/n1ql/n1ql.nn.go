@@ -6214,6 +6214,242 @@ func NewLexerWithInit(in io.Reader, initFun func(*Lexer)) *Lexer {
 			},
 		}, []int{ /* Start-of-input transitions */ -1, -1, -1, -1, -1, -1, -1}, []int{ /* End-of-input transitions */ -1, -1, -1, -1, -1, -1, -1}, nil},
 
+		// [cC][uU][rR][rR][eE][nN][tT]
+		{[]bool{false, false, false, false, false, false, false, true}, []func(rune) int{ // Transitions
+			func(r rune) int {
+				switch r {
+				case 99:
+					return 1
+				case 67:
+					return 1
+				case 117:
+					return -1
+				case 85:
+					return -1
+				case 114:
+					return -1
+				case 82:
+					return -1
+				case 101:
+					return -1
+				case 69:
+					return -1
+				case 110:
+					return -1
+				case 78:
+					return -1
+				case 116:
+					return -1
+				case 84:
+					return -1
+				}
+				return -1
+			},
+			func(r rune) int {
+				switch r {
+				case 99:
+					return -1
+				case 67:
+					return -1
+				case 117:
+					return 2
+				case 85:
+					return 2
+				case 114:
+					return -1
+				case 82:
+					return -1
+				case 101:
+					return -1
+				case 69:
+					return -1
+				case 110:
+					return -1
+				case 78:
+					return -1
+				case 116:
+					return -1
+				case 84:
+					return -1
+				}
+				return -1
+			},
+			func(r rune) int {
+				switch r {
+				case 99:
+					return -1
+				case 67:
+					return -1
+				case 117:
+					return -1
+				case 85:
+					return -1
+				case 114:
+					return 3
+				case 82:
+					return 3
+				case 101:
+					return -1
+				case 69:
+					return -1
+				case 110:
+					return -1
+				case 78:
+					return -1
+				case 116:
+					return -1
+				case 84:
+					return -1
+				}
+				return -1
+			},
+			func(r rune) int {
+				switch r {
+				case 99:
+					return -1
+				case 67:
+					return -1
+				case 117:
+					return -1
+				case 85:
+					return -1
+				case 114:
+					return 4
+				case 82:
+					return 4
+				case 101:
+					return -1
+				case 69:
+					return -1
+				case 110:
+					return -1
+				case 78:
+					return -1
+				case 116:
+					return -1
+				case 84:
+					return -1
+				}
+				return -1
+			},
+			func(r rune) int {
+				switch r {
+				case 99:
+					return -1
+				case 67:
+					return -1
+				case 117:
+					return -1
+				case 85:
+					return -1
+				case 114:
+					return -1
+				case 82:
+					return -1
+				case 101:
+					return 5
+				case 69:
+					return 5
+				case 110:
+					return -1
+				case 78:
+					return -1
+				case 116:
+					return -1
+				case 84:
+					return -1
+				}
+				return -1
+			},
+			func(r rune) int {
+				switch r {
+				case 99:
+					return -1
+				case 67:
+					return -1
+				case 117:
+					return -1
+				case 85:
+					return -1
+				case 114:
+					return -1
+				case 82:
+					return -1
+				case 101:
+					return -1
+				case 69:
+					return -1
+				case 110:
+					return 6
+				case 78:
+					return 6
+				case 116:
+					return -1
+				case 84:
+					return -1
+				}
+				return -1
+			},
+			func(r rune) int {
+				switch r {
+				case 99:
+					return -1
+				case 67:
+					return -1
+				case 117:
+					return -1
+				case 85:
+					return -1
+				case 114:
+					return -1
+				case 82:
+					return -1
+				case 101:
+					return -1
+				case 69:
+					return -1
+				case 110:
+					return -1
+				case 78:
+					return -1
+				case 116:
+					return 7
+				case 84:
+					return 7
+				}
+				return -1
+			},
+			func(r rune) int {
+				switch r {
+				case 99:
+					return -1
+				case 67:
+					return -1
+				case 117:
+					return -1
+				case 85:
+					return -1
+				case 114:
+					return -1
+				case 82:
+					return -1
+				case 101:
+					return -1
+				case 69:
+					return -1
+				case 110:
+					return -1
+				case 78:
+					return -1
+				case 116:
+					return -1
+				case 84:
+					return -1
+				}
+				return -1
+			},
+		}, []int{ /* Start-of-input transitions */ -1, -1, -1, -1, -1, -1, -1, -1}, []int{ /* End-of-input transitions */ -1, -1, -1, -1, -1, -1, -1, -1}, nil},
+
 		// [dD][aA][tT][aA][bB][aA][sS][eE]
 		{[]bool{false, false, false, false, false, false, false, false, true}, []func(rune) int{ // Transitions
 			func(r rune) int {
@@ -11137,8 +11373,8 @@ func NewLexerWithInit(in io.Reader, initFun func(*Lexer)) *Lexer {
 			},
 		}, []int{ /* Start-of-input transitions */ -1, -1, -1, -1, -1, -1, -1, -1}, []int{ /* End-of-input transitions */ -1, -1, -1, -1, -1, -1, -1, -1}, nil},
 
-		// [fF][oO][rR]
-		{[]bool{false, false, false, true}, []func(rune) int{ // Transitions
+		// [fF][oO][lL][lL][oO][wW][iI][nN][gG]
+		{[]bool{false, false, false, false, false, false, false, false, false, true}, []func(rune) int{ // Transitions
 			func(r rune) int {
 				switch r {
 				case 102:
@@ -11149,44 +11385,26 @@ func NewLexerWithInit(in io.Reader, initFun func(*Lexer)) *Lexer {
 					return -1
 				case 79:
 					return -1
-				case 114:
+				case 108:
 					return -1
-				case 82:
+				case 76:
 					return -1
-				}
-				return -1
-			},
-			func(r rune) int {
-				switch r {
-				case 102:
+				case 119:
 					return -1
-				case 70:
+				case 87:
 					return -1
-				case 111:
-					return 2
-				case 79:
-					return 2
-				case 114:
+				case 105:
 					return -1
-				case 82:
+				case 73:
 					return -1
-				}
-				return -1
-			},
-			func(r rune) int {
-				switch r {
-				case 102:
+				case 110:
 					return -1
-				case 70:
+				case 78:
 					return -1
-				case 111:
+				case 103:
 					return -1
-				case 79:
+				case 71:
 					return -1
-				case 114:
-					return 3
-				case 82:
-					return 3
 				}
 				return -1
 			},
@@ -11197,41 +11415,28 @@ func NewLexerWithInit(in io.Reader, initFun func(*Lexer)) *Lexer {
 				case 70:
 					return -1
 				case 111:
-					return -1
+					return 2
 				case 79:
+					return 2
+				case 108:
 					return -1
-				case 114:
-					return -1
-				case 82:
+				case 76:
 					return -1
-				}
-				return -1
-			},
-		}, []int{ /* Start-of-input transitions */ -1, -1, -1, -1}, []int{ /* End-of-input transitions */ -1, -1, -1, -1}, nil},
-
-		// [fF][oO][rR][cC][eE]
-		{[]bool{false, false, false, false, false, true}, []func(rune) int{ // Transitions
-			func(r rune) int {
-				switch r {
-				case 102:
-					return 1
-				case 70:
-					return 1
-				case 114:
+				case 119:
 					return -1
-				case 82:
+				case 87:
 					return -1
-				case 99:
+				case 105:
 					return -1
-				case 101:
+				case 73:
 					return -1
-				case 69:
+				case 110:
 					return -1
-				case 111:
+				case 78:
 					return -1
-				case 79:
+				case 103:
 					return -1
-				case 67:
+				case 71:
 					return -1
 				}
 				return -1
@@ -11242,71 +11447,62 @@ func NewLexerWithInit(in io.Reader, initFun func(*Lexer)) *Lexer {
 					return -1
 				case 70:
 					return -1
-				case 114:
-					return -1
-				case 82:
-					return -1
-				case 99:
-					return -1
-				case 101:
-					return -1
-				case 69:
-					return -1
 				case 111:
-					return 2
-				case 79:
-					return 2
-				case 67:
-					return -1
-				}
-				return -1
-			},
-			func(r rune) int {
-				switch r {
-				case 102:
 					return -1
-				case 70:
+				case 79:
 					return -1
-				case 114:
+				case 108:
 					return 3
-				case 82:
+				case 76:
 					return 3
-				case 99:
+				case 119:
 					return -1
-				case 101:
+				case 87:
 					return -1
-				case 69:
+				case 105:
 					return -1
-				case 111:
+				case 73:
 					return -1
-				case 79:
+				case 110:
 					return -1
-				case 67:
+				case 78:
+					return -1
+				case 103:
+					return -1
+				case 71:
 					return -1
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
+				case 102:
+					return -1
+				case 70:
+					return -1
 				case 111:
 					return -1
 				case 79:
 					return -1
-				case 67:
+				case 108:
 					return 4
-				case 102:
+				case 76:
+					return 4
+				case 119:
 					return -1
-				case 70:
+				case 87:
 					return -1
-				case 114:
+				case 105:
 					return -1
-				case 82:
+				case 73:
 					return -1
-				case 99:
-					return 4
-				case 101:
+				case 110:
 					return -1
-				case 69:
+				case 78:
+					return -1
+				case 103:
+					return -1
+				case 71:
 					return -1
 				}
 				return -1
@@ -11317,92 +11513,95 @@ func NewLexerWithInit(in io.Reader, initFun func(*Lexer)) *Lexer {
 					return -1
 				case 70:
 					return -1
-				case 114:
+				case 111:
+					return 5
+				case 79:
+					return 5
+				case 108:
 					return -1
-				case 82:
+				case 76:
 					return -1
-				case 99:
+				case 119:
 					return -1
-				case 101:
-					return 5
-				case 69:
-					return 5
-				case 111:
+				case 87:
 					return -1
-				case 79:
+				case 105:
 					return -1
-				case 67:
+				case 73:
+					return -1
+				case 110:
+					return -1
+				case 78:
+					return -1
+				case 103:
+					return -1
+				case 71:
 					return -1
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
+				case 102:
+					return -1
+				case 70:
+					return -1
 				case 111:
 					return -1
 				case 79:
 					return -1
-				case 67:
+				case 108:
 					return -1
-				case 102:
+				case 76:
 					return -1
-				case 70:
+				case 119:
+					return 6
+				case 87:
+					return 6
+				case 105:
 					return -1
-				case 114:
+				case 73:
 					return -1
-				case 82:
+				case 110:
 					return -1
-				case 99:
+				case 78:
 					return -1
-				case 101:
+				case 103:
 					return -1
-				case 69:
+				case 71:
 					return -1
 				}
 				return -1
 			},
-		}, []int{ /* Start-of-input transitions */ -1, -1, -1, -1, -1, -1}, []int{ /* End-of-input transitions */ -1, -1, -1, -1, -1, -1}, nil},
-
-		// [fF][rR][oO][mM]
-		{[]bool{false, false, false, false, true}, []func(rune) int{ // Transitions
 			func(r rune) int {
 				switch r {
 				case 102:
-					return 1
-				case 70:
-					return 1
-				case 114:
 					return -1
-				case 82:
+				case 70:
 					return -1
 				case 111:
 					return -1
 				case 79:
 					return -1
-				case 109:
+				case 108:
 					return -1
-				case 77:
+				case 76:
 					return -1
-				}
-				return -1
-			},
-			func(r rune) int {
-				switch r {
-				case 102:
+				case 119:
 					return -1
-				case 70:
+				case 87:
 					return -1
-				case 114:
-					return 2
-				case 82:
-					return 2
-				case 111:
+				case 105:
+					return 7
+				case 73:
+					return 7
+				case 110:
 					return -1
-				case 79:
+				case 78:
 					return -1
-				case 109:
+				case 103:
 					return -1
-				case 77:
+				case 71:
 					return -1
 				}
 				return -1
@@ -11413,39 +11612,30 @@ func NewLexerWithInit(in io.Reader, initFun func(*Lexer)) *Lexer {
 					return -1
 				case 70:
 					return -1
-				case 114:
-					return -1
-				case 82:
-					return -1
 				case 111:
-					return 3
+					return -1
 				case 79:
-					return 3
-				case 109:
 					return -1
-				case 77:
+				case 108:
 					return -1
-				}
-				return -1
-			},
-			func(r rune) int {
-				switch r {
-				case 102:
+				case 76:
 					return -1
-				case 70:
+				case 119:
 					return -1
-				case 114:
+				case 87:
 					return -1
-				case 82:
+				case 105:
 					return -1
-				case 111:
+				case 73:
 					return -1
-				case 79:
+				case 110:
+					return 8
+				case 78:
+					return 8
+				case 103:
+					return -1
+				case 71:
 					return -1
-				case 109:
-					return 4
-				case 77:
-					return 4
 				}
 				return -1
 			},
@@ -11455,1292 +11645,1292 @@ func NewLexerWithInit(in io.Reader, initFun func(*Lexer)) *Lexer {
 					return -1
 				case 70:
 					return -1
-				case 114:
+				case 111:
 					return -1
-				case 82:
+				case 79:
 					return -1
-				case 111:
+				case 108:
 					return -1
-				case 79:
+				case 76:
 					return -1
-				case 109:
+				case 119:
 					return -1
-				case 77:
+				case 87:
+					return -1
+				case 105:
+					return -1
+				case 73:
+					return -1
+				case 110:
 					return -1
+				case 78:
+					return -1
+				case 103:
+					return 9
+				case 71:
+					return 9
 				}
 				return -1
 			},
-		}, []int{ /* Start-of-input transitions */ -1, -1, -1, -1, -1}, []int{ /* End-of-input transitions */ -1, -1, -1, -1, -1}, nil},
-
-		// [fF][uU][nN][cC][tT][iI][oO][nN]
-		{[]bool{false, false, false, false, false, false, false, false, true}, []func(rune) int{ // Transitions
 			func(r rune) int {
 				switch r {
 				case 102:
-					return 1
-				case 99:
 					return -1
-				case 105:
-					return -1
-				case 73:
+				case 70:
 					return -1
 				case 111:
 					return -1
-				case 117:
+				case 79:
 					return -1
-				case 85:
+				case 108:
 					return -1
-				case 78:
+				case 76:
 					return -1
-				case 79:
+				case 119:
 					return -1
-				case 67:
+				case 87:
 					return -1
-				case 116:
+				case 105:
+					return -1
+				case 73:
 					return -1
-				case 70:
-					return 1
 				case 110:
 					return -1
-				case 84:
+				case 78:
+					return -1
+				case 103:
+					return -1
+				case 71:
 					return -1
 				}
 				return -1
 			},
+		}, []int{ /* Start-of-input transitions */ -1, -1, -1, -1, -1, -1, -1, -1, -1, -1}, []int{ /* End-of-input transitions */ -1, -1, -1, -1, -1, -1, -1, -1, -1, -1}, nil},
+
+		// [fF][oO][rR]
+		{[]bool{false, false, false, true}, []func(rune) int{ // Transitions
 			func(r rune) int {
 				switch r {
-				case 117:
-					return 2
-				case 85:
-					return 2
-				case 78:
+				case 102:
+					return 1
+				case 70:
+					return 1
+				case 111:
 					return -1
 				case 79:
 					return -1
-				case 67:
+				case 114:
 					return -1
-				case 116:
+				case 82:
+					return -1
+				}
+				return -1
+			},
+			func(r rune) int {
+				switch r {
+				case 102:
 					return -1
 				case 70:
 					return -1
-				case 110:
+				case 111:
+					return 2
+				case 79:
+					return 2
+				case 114:
 					return -1
-				case 84:
+				case 82:
 					return -1
+				}
+				return -1
+			},
+			func(r rune) int {
+				switch r {
 				case 102:
 					return -1
-				case 99:
-					return -1
-				case 105:
-					return -1
-				case 73:
+				case 70:
 					return -1
 				case 111:
 					return -1
+				case 79:
+					return -1
+				case 114:
+					return 3
+				case 82:
+					return 3
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
-				case 70:
-					return -1
-				case 110:
-					return 3
-				case 84:
-					return -1
 				case 102:
 					return -1
-				case 99:
-					return -1
-				case 105:
-					return -1
-				case 73:
+				case 70:
 					return -1
 				case 111:
 					return -1
-				case 117:
-					return -1
-				case 85:
-					return -1
-				case 78:
-					return 3
 				case 79:
 					return -1
-				case 67:
+				case 114:
 					return -1
-				case 116:
+				case 82:
 					return -1
 				}
 				return -1
 			},
+		}, []int{ /* Start-of-input transitions */ -1, -1, -1, -1}, []int{ /* End-of-input transitions */ -1, -1, -1, -1}, nil},
+
+		// [fF][oO][rR][cC][eE]
+		{[]bool{false, false, false, false, false, true}, []func(rune) int{ // Transitions
 			func(r rune) int {
 				switch r {
 				case 102:
+					return 1
+				case 70:
+					return 1
+				case 114:
 					return -1
-				case 99:
-					return 4
-				case 105:
-					return -1
-				case 73:
+				case 82:
 					return -1
-				case 111:
+				case 99:
 					return -1
-				case 117:
+				case 101:
 					return -1
-				case 85:
+				case 69:
 					return -1
-				case 78:
+				case 111:
 					return -1
 				case 79:
 					return -1
 				case 67:
-					return 4
-				case 116:
-					return -1
-				case 70:
-					return -1
-				case 110:
-					return -1
-				case 84:
 					return -1
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
-				case 117:
-					return -1
-				case 85:
-					return -1
-				case 78:
-					return -1
-				case 79:
-					return -1
-				case 67:
+				case 102:
 					return -1
-				case 116:
-					return 5
 				case 70:
 					return -1
-				case 110:
+				case 114:
 					return -1
-				case 84:
-					return 5
-				case 102:
+				case 82:
 					return -1
 				case 99:
 					return -1
-				case 105:
+				case 101:
 					return -1
-				case 73:
+				case 69:
 					return -1
 				case 111:
+					return 2
+				case 79:
+					return 2
+				case 67:
 					return -1
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
-				case 70:
-					return -1
-				case 110:
-					return -1
-				case 84:
-					return -1
 				case 102:
 					return -1
-				case 99:
+				case 70:
 					return -1
-				case 105:
-					return 6
-				case 73:
-					return 6
-				case 111:
+				case 114:
+					return 3
+				case 82:
+					return 3
+				case 99:
 					return -1
-				case 117:
+				case 101:
 					return -1
-				case 85:
+				case 69:
 					return -1
-				case 78:
+				case 111:
 					return -1
 				case 79:
 					return -1
 				case 67:
 					return -1
-				case 116:
-					return -1
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
-				case 102:
-					return -1
-				case 99:
-					return -1
-				case 105:
-					return -1
-				case 73:
-					return -1
 				case 111:
-					return 7
-				case 117:
-					return -1
-				case 85:
-					return -1
-				case 78:
 					return -1
 				case 79:
-					return 7
-				case 67:
 					return -1
-				case 116:
+				case 67:
+					return 4
+				case 102:
 					return -1
 				case 70:
 					return -1
-				case 110:
+				case 114:
 					return -1
-				case 84:
+				case 82:
+					return -1
+				case 99:
+					return 4
+				case 101:
+					return -1
+				case 69:
 					return -1
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
-				case 67:
-					return -1
-				case 116:
+				case 102:
 					return -1
 				case 70:
 					return -1
-				case 110:
-					return 8
-				case 84:
+				case 114:
 					return -1
-				case 102:
+				case 82:
 					return -1
 				case 99:
 					return -1
-				case 105:
-					return -1
-				case 73:
-					return -1
+				case 101:
+					return 5
+				case 69:
+					return 5
 				case 111:
 					return -1
-				case 117:
-					return -1
-				case 85:
-					return -1
-				case 78:
-					return 8
 				case 79:
 					return -1
+				case 67:
+					return -1
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
-				case 67:
-					return -1
-				case 116:
-					return -1
-				case 70:
+				case 111:
 					return -1
-				case 110:
+				case 79:
 					return -1
-				case 84:
+				case 67:
 					return -1
 				case 102:
 					return -1
-				case 99:
-					return -1
-				case 105:
-					return -1
-				case 73:
+				case 70:
 					return -1
-				case 111:
+				case 114:
 					return -1
-				case 117:
+				case 82:
 					return -1
-				case 85:
+				case 99:
 					return -1
-				case 78:
+				case 101:
 					return -1
-				case 79:
+				case 69:
 					return -1
 				}
 				return -1
 			},
-		}, []int{ /* Start-of-input transitions */ -1, -1, -1, -1, -1, -1, -1, -1, -1}, []int{ /* End-of-input transitions */ -1, -1, -1, -1, -1, -1, -1, -1, -1}, nil},
+		}, []int{ /* Start-of-input transitions */ -1, -1, -1, -1, -1, -1}, []int{ /* End-of-input transitions */ -1, -1, -1, -1, -1, -1}, nil},
 
-		// [gG][rR][aA][nN][tT]
-		{[]bool{false, false, false, false, false, true}, []func(rune) int{ // Transitions
+		// [fF][rR][oO][mM]
+		{[]bool{false, false, false, false, true}, []func(rune) int{ // Transitions
 			func(r rune) int {
 				switch r {
-				case 103:
+				case 102:
 					return 1
-				case 71:
+				case 70:
 					return 1
-				case 82:
-					return -1
-				case 97:
-					return -1
-				case 65:
-					return -1
 				case 114:
 					return -1
-				case 110:
+				case 82:
 					return -1
-				case 78:
+				case 111:
 					return -1
-				case 116:
+				case 79:
 					return -1
-				case 84:
+				case 109:
+					return -1
+				case 77:
 					return -1
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
-				case 114:
-					return 2
-				case 110:
-					return -1
-				case 78:
-					return -1
-				case 116:
-					return -1
-				case 84:
-					return -1
-				case 103:
+				case 102:
 					return -1
-				case 71:
+				case 70:
 					return -1
+				case 114:
+					return 2
 				case 82:
 					return 2
-				case 97:
+				case 111:
 					return -1
-				case 65:
+				case 79:
+					return -1
+				case 109:
+					return -1
+				case 77:
 					return -1
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
-				case 114:
-					return -1
-				case 110:
-					return -1
-				case 78:
-					return -1
-				case 116:
-					return -1
-				case 84:
+				case 102:
 					return -1
-				case 103:
+				case 70:
 					return -1
-				case 71:
+				case 114:
 					return -1
 				case 82:
 					return -1
-				case 97:
+				case 111:
 					return 3
-				case 65:
+				case 79:
 					return 3
+				case 109:
+					return -1
+				case 77:
+					return -1
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
-				case 103:
+				case 102:
 					return -1
-				case 71:
+				case 70:
 					return -1
-				case 82:
+				case 114:
 					return -1
-				case 97:
+				case 82:
 					return -1
-				case 65:
+				case 111:
 					return -1
-				case 114:
+				case 79:
 					return -1
-				case 110:
+				case 109:
 					return 4
-				case 78:
+				case 77:
 					return 4
-				case 116:
-					return -1
-				case 84:
-					return -1
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
-				case 114:
+				case 102:
 					return -1
-				case 110:
+				case 70:
 					return -1
-				case 78:
+				case 114:
 					return -1
-				case 116:
-					return 5
-				case 84:
-					return 5
-				case 103:
+				case 82:
 					return -1
-				case 71:
+				case 111:
 					return -1
-				case 82:
+				case 79:
 					return -1
-				case 97:
+				case 109:
 					return -1
-				case 65:
+				case 77:
 					return -1
 				}
 				return -1
 			},
+		}, []int{ /* Start-of-input transitions */ -1, -1, -1, -1, -1}, []int{ /* End-of-input transitions */ -1, -1, -1, -1, -1}, nil},
+
+		// [fF][uU][nN][cC][tT][iI][oO][nN]
+		{[]bool{false, false, false, false, false, false, false, false, true}, []func(rune) int{ // Transitions
 			func(r rune) int {
 				switch r {
-				case 103:
-					return -1
-				case 71:
+				case 102:
+					return 1
+				case 99:
 					return -1
-				case 82:
+				case 105:
 					return -1
-				case 97:
+				case 73:
 					return -1
-				case 65:
+				case 111:
 					return -1
-				case 114:
+				case 117:
 					return -1
-				case 110:
+				case 85:
 					return -1
 				case 78:
 					return -1
+				case 79:
+					return -1
+				case 67:
+					return -1
 				case 116:
 					return -1
+				case 70:
+					return 1
+				case 110:
+					return -1
 				case 84:
 					return -1
 				}
 				return -1
 			},
-		}, []int{ /* Start-of-input transitions */ -1, -1, -1, -1, -1, -1}, []int{ /* End-of-input transitions */ -1, -1, -1, -1, -1, -1}, nil},
-
-		// [gG][rR][oO][uU][pP]
-		{[]bool{false, false, false, false, false, true}, []func(rune) int{ // Transitions
 			func(r rune) int {
 				switch r {
-				case 103:
-					return 1
-				case 111:
+				case 117:
+					return 2
+				case 85:
+					return 2
+				case 78:
 					return -1
 				case 79:
 					return -1
-				case 71:
-					return 1
-				case 114:
+				case 67:
 					return -1
-				case 82:
+				case 116:
 					return -1
-				case 117:
+				case 70:
 					return -1
-				case 85:
+				case 110:
 					return -1
-				case 112:
+				case 84:
 					return -1
-				case 80:
+				case 102:
+					return -1
+				case 99:
+					return -1
+				case 105:
+					return -1
+				case 73:
+					return -1
+				case 111:
 					return -1
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
-				case 103:
+				case 70:
 					return -1
-				case 111:
+				case 110:
+					return 3
+				case 84:
 					return -1
-				case 79:
+				case 102:
 					return -1
-				case 71:
+				case 99:
+					return -1
+				case 105:
+					return -1
+				case 73:
+					return -1
+				case 111:
 					return -1
-				case 114:
-					return 2
-				case 82:
-					return 2
 				case 117:
 					return -1
 				case 85:
 					return -1
-				case 112:
+				case 78:
+					return 3
+				case 79:
 					return -1
-				case 80:
+				case 67:
+					return -1
+				case 116:
 					return -1
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
-				case 71:
+				case 102:
 					return -1
-				case 114:
+				case 99:
+					return 4
+				case 105:
 					return -1
-				case 82:
+				case 73:
+					return -1
+				case 111:
 					return -1
 				case 117:
 					return -1
 				case 85:
 					return -1
-				case 112:
+				case 78:
 					return -1
-				case 80:
+				case 79:
 					return -1
-				case 103:
+				case 67:
+					return 4
+				case 116:
+					return -1
+				case 70:
+					return -1
+				case 110:
+					return -1
+				case 84:
 					return -1
-				case 111:
-					return 3
-				case 79:
-					return 3
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
-				case 103:
+				case 117:
 					return -1
-				case 111:
+				case 85:
+					return -1
+				case 78:
 					return -1
 				case 79:
 					return -1
-				case 71:
+				case 67:
 					return -1
-				case 114:
+				case 116:
+					return 5
+				case 70:
 					return -1
-				case 82:
+				case 110:
 					return -1
-				case 117:
-					return 4
-				case 85:
-					return 4
-				case 112:
+				case 84:
+					return 5
+				case 102:
 					return -1
-				case 80:
+				case 99:
+					return -1
+				case 105:
+					return -1
+				case 73:
+					return -1
+				case 111:
 					return -1
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
-				case 71:
+				case 70:
 					return -1
-				case 114:
+				case 110:
 					return -1
-				case 82:
+				case 84:
+					return -1
+				case 102:
+					return -1
+				case 99:
+					return -1
+				case 105:
+					return 6
+				case 73:
+					return 6
+				case 111:
 					return -1
 				case 117:
 					return -1
 				case 85:
 					return -1
-				case 112:
-					return 5
-				case 80:
-					return 5
-				case 103:
-					return -1
-				case 111:
+				case 78:
 					return -1
 				case 79:
 					return -1
+				case 67:
+					return -1
+				case 116:
+					return -1
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
-				case 71:
+				case 102:
 					return -1
-				case 114:
+				case 99:
 					return -1
-				case 82:
+				case 105:
 					return -1
+				case 73:
+					return -1
+				case 111:
+					return 7
 				case 117:
 					return -1
 				case 85:
 					return -1
-				case 112:
+				case 78:
 					return -1
-				case 80:
+				case 79:
+					return 7
+				case 67:
 					return -1
-				case 103:
+				case 116:
 					return -1
-				case 111:
+				case 70:
 					return -1
-				case 79:
+				case 110:
+					return -1
+				case 84:
 					return -1
 				}
 				return -1
 			},
-		}, []int{ /* Start-of-input transitions */ -1, -1, -1, -1, -1, -1}, []int{ /* End-of-input transitions */ -1, -1, -1, -1, -1, -1}, nil},
-
-		// [gG][sS][iI]
-		{[]bool{false, false, false, true}, []func(rune) int{ // Transitions
 			func(r rune) int {
 				switch r {
-				case 103:
-					return 1
-				case 71:
-					return 1
-				case 115:
+				case 67:
 					return -1
-				case 83:
+				case 116:
 					return -1
-				case 105:
+				case 70:
 					return -1
-				case 73:
+				case 110:
+					return 8
+				case 84:
 					return -1
-				}
-				return -1
-			},
-			func(r rune) int {
-				switch r {
-				case 103:
+				case 102:
 					return -1
-				case 71:
+				case 99:
 					return -1
-				case 115:
-					return 2
-				case 83:
-					return 2
 				case 105:
 					return -1
 				case 73:
 					return -1
-				}
-				return -1
-			},
-			func(r rune) int {
-				switch r {
-				case 103:
+				case 111:
 					return -1
-				case 71:
+				case 117:
 					return -1
-				case 115:
+				case 85:
 					return -1
-				case 83:
+				case 78:
+					return 8
+				case 79:
 					return -1
-				case 105:
-					return 3
-				case 73:
-					return 3
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
-				case 103:
+				case 67:
 					return -1
-				case 71:
+				case 116:
 					return -1
-				case 115:
+				case 70:
 					return -1
-				case 83:
+				case 110:
+					return -1
+				case 84:
+					return -1
+				case 102:
+					return -1
+				case 99:
 					return -1
 				case 105:
 					return -1
 				case 73:
 					return -1
+				case 111:
+					return -1
+				case 117:
+					return -1
+				case 85:
+					return -1
+				case 78:
+					return -1
+				case 79:
+					return -1
 				}
 				return -1
 			},
-		}, []int{ /* Start-of-input transitions */ -1, -1, -1, -1}, []int{ /* End-of-input transitions */ -1, -1, -1, -1}, nil},
+		}, []int{ /* Start-of-input transitions */ -1, -1, -1, -1, -1, -1, -1, -1, -1}, []int{ /* End-of-input transitions */ -1, -1, -1, -1, -1, -1, -1, -1, -1}, nil},
 
-		// [hH][aA][vV][iI][nN][gG]
-		{[]bool{false, false, false, false, false, false, true}, []func(rune) int{ // Transitions
+		// [gG][rR][aA][nN][tT]
+		{[]bool{false, false, false, false, false, true}, []func(rune) int{ // Transitions
 			func(r rune) int {
 				switch r {
-				case 65:
-					return -1
-				case 110:
-					return -1
-				case 78:
-					return -1
 				case 103:
-					return -1
-				case 104:
 					return 1
-				case 72:
+				case 71:
 					return 1
+				case 82:
+					return -1
 				case 97:
 					return -1
-				case 118:
+				case 65:
 					return -1
-				case 86:
+				case 114:
 					return -1
-				case 105:
+				case 110:
 					return -1
-				case 73:
+				case 78:
 					return -1
-				case 71:
+				case 116:
+					return -1
+				case 84:
 					return -1
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
-				case 65:
+				case 114:
 					return 2
 				case 110:
 					return -1
 				case 78:
 					return -1
-				case 103:
-					return -1
-				case 104:
-					return -1
-				case 72:
+				case 116:
 					return -1
-				case 97:
-					return 2
-				case 118:
+				case 84:
 					return -1
-				case 86:
+				case 103:
 					return -1
-				case 105:
+				case 71:
 					return -1
-				case 73:
+				case 82:
+					return 2
+				case 97:
 					return -1
-				case 71:
+				case 65:
 					return -1
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
-				case 65:
+				case 114:
 					return -1
 				case 110:
 					return -1
 				case 78:
 					return -1
+				case 116:
+					return -1
+				case 84:
+					return -1
 				case 103:
 					return -1
-				case 104:
+				case 71:
 					return -1
-				case 72:
+				case 82:
 					return -1
 				case 97:
-					return -1
-				case 118:
 					return 3
-				case 86:
+				case 65:
 					return 3
-				case 105:
-					return -1
-				case 73:
-					return -1
-				case 71:
-					return -1
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
-				case 104:
+				case 103:
 					return -1
-				case 72:
+				case 71:
+					return -1
+				case 82:
 					return -1
 				case 97:
 					return -1
-				case 118:
+				case 65:
 					return -1
-				case 86:
+				case 114:
 					return -1
-				case 105:
+				case 110:
 					return 4
-				case 73:
+				case 78:
 					return 4
-				case 71:
-					return -1
-				case 65:
+				case 116:
 					return -1
-				case 110:
-					return -1
-				case 78:
-					return -1
-				case 103:
+				case 84:
 					return -1
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
-				case 104:
-					return -1
-				case 72:
+				case 114:
 					return -1
-				case 97:
+				case 110:
 					return -1
-				case 118:
+				case 78:
 					return -1
-				case 86:
+				case 116:
+					return 5
+				case 84:
+					return 5
+				case 103:
 					return -1
-				case 105:
+				case 71:
 					return -1
-				case 73:
+				case 82:
 					return -1
-				case 71:
+				case 97:
 					return -1
 				case 65:
 					return -1
-				case 110:
-					return 5
-				case 78:
-					return 5
-				case 103:
-					return -1
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
-				case 65:
+				case 103:
 					return -1
-				case 110:
+				case 71:
 					return -1
-				case 78:
+				case 82:
 					return -1
-				case 103:
-					return 6
-				case 104:
+				case 97:
 					return -1
-				case 72:
+				case 65:
 					return -1
-				case 97:
+				case 114:
 					return -1
-				case 118:
+				case 110:
 					return -1
-				case 86:
+				case 78:
 					return -1
-				case 105:
+				case 116:
 					return -1
-				case 73:
+				case 84:
 					return -1
-				case 71:
-					return 6
 				}
 				return -1
 			},
+		}, []int{ /* Start-of-input transitions */ -1, -1, -1, -1, -1, -1}, []int{ /* End-of-input transitions */ -1, -1, -1, -1, -1, -1}, nil},
+
+		// [gG][rR][oO][uU][pP]
+		{[]bool{false, false, false, false, false, true}, []func(rune) int{ // Transitions
 			func(r rune) int {
 				switch r {
-				case 65:
-					return -1
-				case 110:
-					return -1
-				case 78:
-					return -1
 				case 103:
+					return 1
+				case 111:
 					return -1
-				case 104:
-					return -1
-				case 72:
+				case 79:
 					return -1
-				case 97:
+				case 71:
+					return 1
+				case 114:
 					return -1
-				case 118:
+				case 82:
 					return -1
-				case 86:
+				case 117:
 					return -1
-				case 105:
+				case 85:
 					return -1
-				case 73:
+				case 112:
 					return -1
-				case 71:
+				case 80:
 					return -1
 				}
 				return -1
 			},
-		}, []int{ /* Start-of-input transitions */ -1, -1, -1, -1, -1, -1, -1}, []int{ /* End-of-input transitions */ -1, -1, -1, -1, -1, -1, -1}, nil},
-
-		// [iI][fF]
-		{[]bool{false, false, true}, []func(rune) int{ // Transitions
 			func(r rune) int {
 				switch r {
-				case 105:
-					return 1
-				case 73:
-					return 1
-				case 102:
+				case 103:
 					return -1
-				case 70:
+				case 111:
 					return -1
-				}
-				return -1
-			},
-			func(r rune) int {
-				switch r {
-				case 105:
+				case 79:
 					return -1
-				case 73:
+				case 71:
 					return -1
-				case 102:
+				case 114:
 					return 2
-				case 70:
+				case 82:
 					return 2
-				}
-				return -1
-			},
-			func(r rune) int {
-				switch r {
-				case 105:
+				case 117:
 					return -1
-				case 73:
+				case 85:
 					return -1
-				case 102:
+				case 112:
 					return -1
-				case 70:
+				case 80:
 					return -1
 				}
 				return -1
 			},
-		}, []int{ /* Start-of-input transitions */ -1, -1, -1}, []int{ /* End-of-input transitions */ -1, -1, -1}, nil},
-
-		// [iI][gG][nN][oO][rR][eE]
-		{[]bool{false, false, false, false, false, false, true}, []func(rune) int{ // Transitions
 			func(r rune) int {
 				switch r {
-				case 73:
-					return 1
-				case 103:
-					return -1
-				case 110:
-					return -1
-				case 78:
+				case 71:
 					return -1
 				case 114:
 					return -1
-				case 105:
-					return 1
-				case 71:
+				case 82:
 					return -1
-				case 111:
+				case 117:
 					return -1
-				case 79:
+				case 85:
 					return -1
-				case 82:
+				case 112:
 					return -1
-				case 101:
+				case 80:
 					return -1
-				case 69:
+				case 103:
 					return -1
+				case 111:
+					return 3
+				case 79:
+					return 3
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
-				case 73:
-					return -1
 				case 103:
-					return 2
-				case 110:
 					return -1
-				case 78:
-					return -1
-				case 114:
+				case 111:
 					return -1
-				case 105:
+				case 79:
 					return -1
 				case 71:
-					return 2
-				case 111:
 					return -1
-				case 79:
+				case 114:
 					return -1
 				case 82:
 					return -1
-				case 101:
+				case 117:
+					return 4
+				case 85:
+					return 4
+				case 112:
 					return -1
-				case 69:
+				case 80:
 					return -1
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
-				case 73:
-					return -1
-				case 103:
+				case 71:
 					return -1
-				case 110:
-					return 3
-				case 78:
-					return 3
 				case 114:
 					return -1
-				case 105:
-					return -1
-				case 71:
+				case 82:
 					return -1
-				case 111:
+				case 117:
 					return -1
-				case 79:
+				case 85:
 					return -1
-				case 82:
+				case 112:
+					return 5
+				case 80:
+					return 5
+				case 103:
 					return -1
-				case 101:
+				case 111:
 					return -1
-				case 69:
+				case 79:
 					return -1
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
-				case 105:
-					return -1
 				case 71:
 					return -1
-				case 111:
-					return 4
-				case 79:
-					return 4
+				case 114:
+					return -1
 				case 82:
 					return -1
-				case 101:
+				case 117:
 					return -1
-				case 69:
+				case 85:
 					return -1
-				case 73:
+				case 112:
 					return -1
-				case 103:
+				case 80:
 					return -1
-				case 110:
+				case 103:
 					return -1
-				case 78:
+				case 111:
 					return -1
-				case 114:
+				case 79:
 					return -1
 				}
 				return -1
 			},
+		}, []int{ /* Start-of-input transitions */ -1, -1, -1, -1, -1, -1}, []int{ /* End-of-input transitions */ -1, -1, -1, -1, -1, -1}, nil},
+
+		// [gG][sS][iI]
+		{[]bool{false, false, false, true}, []func(rune) int{ // Transitions
 			func(r rune) int {
 				switch r {
-				case 105:
-					return -1
+				case 103:
+					return 1
 				case 71:
+					return 1
+				case 115:
 					return -1
-				case 111:
-					return -1
-				case 79:
-					return -1
-				case 82:
-					return 5
-				case 101:
+				case 83:
 					return -1
-				case 69:
+				case 105:
 					return -1
 				case 73:
 					return -1
-				case 103:
-					return -1
-				case 110:
-					return -1
-				case 78:
-					return -1
-				case 114:
-					return 5
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
-				case 105:
+				case 103:
 					return -1
 				case 71:
 					return -1
-				case 111:
-					return -1
-				case 79:
-					return -1
-				case 82:
+				case 115:
+					return 2
+				case 83:
+					return 2
+				case 105:
 					return -1
-				case 101:
-					return 6
-				case 69:
-					return 6
 				case 73:
 					return -1
-				case 103:
-					return -1
-				case 110:
-					return -1
-				case 78:
-					return -1
-				case 114:
-					return -1
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
-				case 73:
-					return -1
 				case 103:
 					return -1
-				case 110:
+				case 71:
 					return -1
-				case 78:
+				case 115:
 					return -1
-				case 114:
+				case 83:
 					return -1
 				case 105:
+					return 3
+				case 73:
+					return 3
+				}
+				return -1
+			},
+			func(r rune) int {
+				switch r {
+				case 103:
 					return -1
 				case 71:
 					return -1
-				case 111:
-					return -1
-				case 79:
+				case 115:
 					return -1
-				case 82:
+				case 83:
 					return -1
-				case 101:
+				case 105:
 					return -1
-				case 69:
+				case 73:
 					return -1
 				}
 				return -1
 			},
-		}, []int{ /* Start-of-input transitions */ -1, -1, -1, -1, -1, -1, -1}, []int{ /* End-of-input transitions */ -1, -1, -1, -1, -1, -1, -1}, nil},
+		}, []int{ /* Start-of-input transitions */ -1, -1, -1, -1}, []int{ /* End-of-input transitions */ -1, -1, -1, -1}, nil},
 
-		// [iI][lL][iI][kK][eE]
-		{[]bool{false, false, false, false, false, true}, []func(rune) int{ // Transitions
+		// [hH][aA][vV][iI][nN][gG]
+		{[]bool{false, false, false, false, false, false, true}, []func(rune) int{ // Transitions
 			func(r rune) int {
 				switch r {
-				case 105:
+				case 65:
+					return -1
+				case 110:
+					return -1
+				case 78:
+					return -1
+				case 103:
+					return -1
+				case 104:
 					return 1
-				case 73:
+				case 72:
 					return 1
-				case 108:
+				case 97:
 					return -1
-				case 76:
+				case 118:
 					return -1
-				case 107:
+				case 86:
 					return -1
-				case 75:
+				case 105:
 					return -1
-				case 101:
+				case 73:
 					return -1
-				case 69:
+				case 71:
 					return -1
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
-				case 105:
+				case 65:
+					return 2
+				case 110:
 					return -1
-				case 73:
+				case 78:
 					return -1
-				case 108:
-					return 2
-				case 76:
+				case 103:
+					return -1
+				case 104:
+					return -1
+				case 72:
+					return -1
+				case 97:
 					return 2
-				case 107:
+				case 118:
 					return -1
-				case 75:
+				case 86:
 					return -1
-				case 101:
+				case 105:
 					return -1
-				case 69:
+				case 73:
+					return -1
+				case 71:
 					return -1
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
-				case 105:
-					return 3
-				case 73:
-					return 3
-				case 108:
+				case 65:
 					return -1
-				case 76:
+				case 110:
 					return -1
-				case 107:
+				case 78:
 					return -1
-				case 75:
+				case 103:
 					return -1
-				case 101:
+				case 104:
 					return -1
-				case 69:
+				case 72:
+					return -1
+				case 97:
+					return -1
+				case 118:
+					return 3
+				case 86:
+					return 3
+				case 105:
+					return -1
+				case 73:
+					return -1
+				case 71:
 					return -1
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
-				case 105:
+				case 104:
 					return -1
-				case 73:
+				case 72:
 					return -1
-				case 108:
+				case 97:
 					return -1
-				case 76:
+				case 118:
 					return -1
-				case 107:
+				case 86:
+					return -1
+				case 105:
 					return 4
-				case 75:
+				case 73:
 					return 4
-				case 101:
+				case 71:
 					return -1
-				case 69:
+				case 65:
+					return -1
+				case 110:
+					return -1
+				case 78:
+					return -1
+				case 103:
 					return -1
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
-				case 105:
+				case 104:
 					return -1
-				case 73:
+				case 72:
 					return -1
-				case 108:
+				case 97:
 					return -1
-				case 76:
+				case 118:
 					return -1
-				case 107:
+				case 86:
 					return -1
-				case 75:
+				case 105:
 					return -1
-				case 101:
+				case 73:
+					return -1
+				case 71:
+					return -1
+				case 65:
+					return -1
+				case 110:
 					return 5
-				case 69:
+				case 78:
 					return 5
+				case 103:
+					return -1
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
+				case 65:
+					return -1
+				case 110:
+					return -1
+				case 78:
+					return -1
+				case 103:
+					return 6
+				case 104:
+					return -1
+				case 72:
+					return -1
+				case 97:
+					return -1
+				case 118:
+					return -1
+				case 86:
+					return -1
 				case 105:
 					return -1
 				case 73:
 					return -1
-				case 108:
+				case 71:
+					return 6
+				}
+				return -1
+			},
+			func(r rune) int {
+				switch r {
+				case 65:
 					return -1
-				case 76:
+				case 110:
 					return -1
-				case 107:
+				case 78:
 					return -1
-				case 75:
+				case 103:
 					return -1
-				case 101:
+				case 104:
 					return -1
-				case 69:
+				case 72:
+					return -1
+				case 97:
+					return -1
+				case 118:
+					return -1
+				case 86:
+					return -1
+				case 105:
+					return -1
+				case 73:
+					return -1
+				case 71:
 					return -1
 				}
 				return -1
 			},
-		}, []int{ /* Start-of-input transitions */ -1, -1, -1, -1, -1, -1}, []int{ /* End-of-input transitions */ -1, -1, -1, -1, -1, -1}, nil},
+		}, []int{ /* Start-of-input transitions */ -1, -1, -1, -1, -1, -1, -1}, []int{ /* End-of-input transitions */ -1, -1, -1, -1, -1, -1, -1}, nil},
 
-		// [iI][nN]
+		// [iI][fF]
 		{[]bool{false, false, true}, []func(rune) int{ // Transitions
 			func(r rune) int {
 				switch r {
@@ -12748,9 +12938,9 @@ func NewLexerWithInit(in io.Reader, initFun func(*Lexer)) *Lexer {
 					return 1
 				case 73:
 					return 1
-				case 110:
+				case 102:
 					return -1
-				case 78:
+				case 70:
 					return -1
 				}
 				return -1
@@ -12761,9 +12951,9 @@ func NewLexerWithInit(in io.Reader, initFun func(*Lexer)) *Lexer {
 					return -1
 				case 73:
 					return -1
-				case 110:
+				case 102:
 					return 2
-				case 78:
+				case 70:
 					return 2
 				}
 				return -1
@@ -12774,77 +12964,69 @@ func NewLexerWithInit(in io.Reader, initFun func(*Lexer)) *Lexer {
 					return -1
 				case 73:
 					return -1
-				case 110:
+				case 102:
 					return -1
-				case 78:
+				case 70:
 					return -1
 				}
 				return -1
 			},
 		}, []int{ /* Start-of-input transitions */ -1, -1, -1}, []int{ /* End-of-input transitions */ -1, -1, -1}, nil},
 
-		// [iI][nN][cC][lL][uU][dD][eE]
-		{[]bool{false, false, false, false, false, false, false, true}, []func(rune) int{ // Transitions
+		// [iI][gG][nN][oO][rR][eE]
+		{[]bool{false, false, false, false, false, false, true}, []func(rune) int{ // Transitions
 			func(r rune) int {
 				switch r {
-				case 99:
+				case 73:
+					return 1
+				case 103:
 					return -1
-				case 85:
+				case 110:
 					return -1
-				case 100:
+				case 78:
 					return -1
-				case 69:
-					return -1
-				case 108:
-					return -1
-				case 68:
+				case 114:
 					return -1
 				case 105:
 					return 1
-				case 73:
-					return 1
-				case 78:
+				case 71:
 					return -1
-				case 67:
+				case 111:
 					return -1
-				case 76:
+				case 79:
 					return -1
-				case 101:
+				case 82:
 					return -1
-				case 110:
+				case 101:
 					return -1
-				case 117:
+				case 69:
 					return -1
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
-				case 108:
-					return -1
-				case 68:
-					return -1
-				case 105:
-					return -1
 				case 73:
 					return -1
-				case 78:
+				case 103:
 					return 2
-				case 67:
+				case 110:
 					return -1
-				case 76:
+				case 78:
 					return -1
-				case 101:
+				case 114:
 					return -1
-				case 110:
+				case 105:
+					return -1
+				case 71:
 					return 2
-				case 117:
+				case 111:
 					return -1
-				case 99:
+				case 79:
 					return -1
-				case 85:
+				case 82:
 					return -1
-				case 100:
+				case 101:
 					return -1
 				case 69:
 					return -1
@@ -12853,235 +13035,233 @@ func NewLexerWithInit(in io.Reader, initFun func(*Lexer)) *Lexer {
 			},
 			func(r rune) int {
 				switch r {
-				case 110:
+				case 73:
 					return -1
-				case 117:
+				case 103:
 					return -1
-				case 99:
+				case 110:
 					return 3
-				case 85:
-					return -1
-				case 100:
-					return -1
-				case 69:
-					return -1
-				case 108:
-					return -1
-				case 68:
+				case 78:
+					return 3
+				case 114:
 					return -1
 				case 105:
 					return -1
-				case 73:
+				case 71:
 					return -1
-				case 78:
+				case 111:
 					return -1
-				case 67:
-					return 3
-				case 76:
+				case 79:
+					return -1
+				case 82:
 					return -1
 				case 101:
 					return -1
+				case 69:
+					return -1
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
-				case 99:
-					return -1
-				case 85:
-					return -1
-				case 100:
+				case 105:
 					return -1
-				case 69:
+				case 71:
 					return -1
-				case 108:
+				case 111:
 					return 4
-				case 68:
-					return -1
-				case 105:
+				case 79:
+					return 4
+				case 82:
 					return -1
-				case 73:
+				case 101:
 					return -1
-				case 78:
+				case 69:
 					return -1
-				case 67:
+				case 73:
 					return -1
-				case 76:
-					return 4
-				case 101:
+				case 103:
 					return -1
 				case 110:
 					return -1
-				case 117:
+				case 78:
+					return -1
+				case 114:
 					return -1
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
-				case 108:
+				case 105:
 					return -1
-				case 68:
+				case 71:
 					return -1
-				case 105:
+				case 111:
 					return -1
-				case 73:
+				case 79:
 					return -1
-				case 78:
+				case 82:
+					return 5
+				case 101:
 					return -1
-				case 67:
+				case 69:
 					return -1
-				case 76:
+				case 73:
 					return -1
-				case 101:
+				case 103:
 					return -1
 				case 110:
 					return -1
-				case 117:
-					return 5
-				case 99:
+				case 78:
 					return -1
-				case 85:
+				case 114:
 					return 5
-				case 100:
-					return -1
-				case 69:
-					return -1
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
-				case 110:
+				case 105:
 					return -1
-				case 117:
+				case 71:
 					return -1
-				case 99:
+				case 111:
 					return -1
-				case 85:
+				case 79:
 					return -1
-				case 100:
+				case 82:
+					return -1
+				case 101:
 					return 6
 				case 69:
-					return -1
-				case 108:
-					return -1
-				case 68:
 					return 6
-				case 105:
-					return -1
 				case 73:
 					return -1
-				case 78:
+				case 103:
 					return -1
-				case 67:
+				case 110:
 					return -1
-				case 76:
+				case 78:
 					return -1
-				case 101:
+				case 114:
 					return -1
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
-				case 110:
-					return -1
-				case 117:
-					return -1
-				case 99:
+				case 73:
 					return -1
-				case 85:
+				case 103:
 					return -1
-				case 100:
+				case 110:
 					return -1
-				case 69:
-					return 7
-				case 108:
+				case 78:
 					return -1
-				case 68:
+				case 114:
 					return -1
 				case 105:
 					return -1
-				case 73:
+				case 71:
 					return -1
-				case 78:
+				case 111:
 					return -1
-				case 67:
+				case 79:
 					return -1
-				case 76:
+				case 82:
 					return -1
 				case 101:
-					return 7
+					return -1
+				case 69:
+					return -1
 				}
 				return -1
 			},
+		}, []int{ /* Start-of-input transitions */ -1, -1, -1, -1, -1, -1, -1}, []int{ /* End-of-input transitions */ -1, -1, -1, -1, -1, -1, -1}, nil},
+
+		// [iI][lL][iI][kK][eE]
+		{[]bool{false, false, false, false, false, true}, []func(rune) int{ // Transitions
 			func(r rune) int {
 				switch r {
-				case 110:
+				case 105:
+					return 1
+				case 73:
+					return 1
+				case 108:
 					return -1
-				case 117:
+				case 76:
 					return -1
-				case 99:
+				case 107:
 					return -1
-				case 85:
+				case 75:
 					return -1
-				case 100:
+				case 101:
 					return -1
 				case 69:
 					return -1
-				case 108:
-					return -1
-				case 68:
-					return -1
+				}
+				return -1
+			},
+			func(r rune) int {
+				switch r {
 				case 105:
 					return -1
 				case 73:
 					return -1
-				case 78:
-					return -1
-				case 67:
-					return -1
+				case 108:
+					return 2
 				case 76:
+					return 2
+				case 107:
+					return -1
+				case 75:
 					return -1
 				case 101:
 					return -1
+				case 69:
+					return -1
 				}
 				return -1
 			},
-		}, []int{ /* Start-of-input transitions */ -1, -1, -1, -1, -1, -1, -1, -1}, []int{ /* End-of-input transitions */ -1, -1, -1, -1, -1, -1, -1, -1}, nil},
-
-		// [iI][nN][cC][rR][eE][mM][eE][nN][tT]
-		{[]bool{false, false, false, false, false, false, false, false, false, true}, []func(rune) int{ // Transitions
 			func(r rune) int {
 				switch r {
-				case 99:
-					return -1
-				case 77:
+				case 105:
+					return 3
+				case 73:
+					return 3
+				case 108:
 					return -1
-				case 84:
+				case 76:
 					return -1
-				case 78:
+				case 107:
 					return -1
-				case 67:
+				case 75:
 					return -1
-				case 114:
+				case 101:
 					return -1
 				case 69:
 					return -1
+				}
+				return -1
+			},
+			func(r rune) int {
+				switch r {
+				case 105:
+					return -1
 				case 73:
-					return 1
-				case 82:
 					return -1
-				case 101:
+				case 108:
 					return -1
-				case 109:
+				case 76:
 					return -1
-				case 116:
+				case 107:
+					return 4
+				case 75:
+					return 4
+				case 101:
 					return -1
-				case 105:
-					return 1
-				case 110:
+				case 69:
 					return -1
 				}
 				return -1
@@ -13090,229 +13270,186 @@ func NewLexerWithInit(in io.Reader, initFun func(*Lexer)) *Lexer {
 				switch r {
 				case 105:
 					return -1
-				case 110:
-					return 2
-				case 99:
-					return -1
-				case 77:
-					return -1
-				case 84:
-					return -1
-				case 78:
-					return 2
-				case 67:
-					return -1
-				case 114:
-					return -1
-				case 69:
-					return -1
 				case 73:
 					return -1
-				case 82:
+				case 108:
 					return -1
-				case 101:
+				case 76:
 					return -1
-				case 109:
+				case 107:
 					return -1
-				case 116:
+				case 75:
 					return -1
+				case 101:
+					return 5
+				case 69:
+					return 5
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
-				case 73:
-					return -1
-				case 82:
-					return -1
-				case 101:
-					return -1
-				case 109:
-					return -1
-				case 116:
-					return -1
 				case 105:
 					return -1
-				case 110:
+				case 73:
 					return -1
-				case 99:
-					return 3
-				case 77:
+				case 108:
 					return -1
-				case 84:
+				case 76:
 					return -1
-				case 78:
+				case 107:
 					return -1
-				case 67:
-					return 3
-				case 114:
+				case 75:
+					return -1
+				case 101:
 					return -1
 				case 69:
 					return -1
 				}
 				return -1
 			},
+		}, []int{ /* Start-of-input transitions */ -1, -1, -1, -1, -1, -1}, []int{ /* End-of-input transitions */ -1, -1, -1, -1, -1, -1}, nil},
+
+		// [iI][nN]
+		{[]bool{false, false, true}, []func(rune) int{ // Transitions
 			func(r rune) int {
 				switch r {
 				case 105:
-					return -1
+					return 1
+				case 73:
+					return 1
 				case 110:
 					return -1
-				case 99:
-					return -1
-				case 77:
-					return -1
-				case 84:
-					return -1
 				case 78:
 					return -1
-				case 67:
-					return -1
-				case 114:
-					return 4
-				case 69:
+				}
+				return -1
+			},
+			func(r rune) int {
+				switch r {
+				case 105:
 					return -1
 				case 73:
 					return -1
-				case 82:
-					return 4
-				case 101:
-					return -1
-				case 109:
-					return -1
-				case 116:
-					return -1
+				case 110:
+					return 2
+				case 78:
+					return 2
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
-				case 73:
-					return -1
-				case 82:
-					return -1
-				case 101:
-					return 5
-				case 109:
-					return -1
-				case 116:
-					return -1
 				case 105:
 					return -1
-				case 110:
-					return -1
-				case 99:
-					return -1
-				case 77:
+				case 73:
 					return -1
-				case 84:
+				case 110:
 					return -1
 				case 78:
 					return -1
-				case 67:
-					return -1
-				case 114:
-					return -1
-				case 69:
-					return 5
 				}
 				return -1
 			},
+		}, []int{ /* Start-of-input transitions */ -1, -1, -1}, []int{ /* End-of-input transitions */ -1, -1, -1}, nil},
+
+		// [iI][nN][cC][lL][uU][dD][eE]
+		{[]bool{false, false, false, false, false, false, false, true}, []func(rune) int{ // Transitions
 			func(r rune) int {
 				switch r {
-				case 105:
-					return -1
-				case 110:
-					return -1
 				case 99:
 					return -1
-				case 77:
-					return 6
-				case 84:
+				case 85:
 					return -1
-				case 78:
+				case 100:
 					return -1
-				case 67:
+				case 69:
 					return -1
-				case 114:
+				case 108:
 					return -1
-				case 69:
+				case 68:
 					return -1
+				case 105:
+					return 1
 				case 73:
+					return 1
+				case 78:
 					return -1
-				case 82:
+				case 67:
+					return -1
+				case 76:
 					return -1
 				case 101:
 					return -1
-				case 109:
-					return 6
-				case 116:
+				case 110:
+					return -1
+				case 117:
 					return -1
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
-				case 78:
+				case 108:
 					return -1
-				case 67:
+				case 68:
 					return -1
-				case 114:
+				case 105:
 					return -1
-				case 69:
-					return 7
 				case 73:
 					return -1
-				case 82:
-					return -1
-				case 101:
-					return 7
-				case 109:
+				case 78:
+					return 2
+				case 67:
 					return -1
-				case 116:
+				case 76:
 					return -1
-				case 105:
+				case 101:
 					return -1
 				case 110:
+					return 2
+				case 117:
 					return -1
 				case 99:
 					return -1
-				case 77:
+				case 85:
 					return -1
-				case 84:
+				case 100:
+					return -1
+				case 69:
 					return -1
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
-				case 73:
+				case 110:
 					return -1
-				case 82:
+				case 117:
 					return -1
-				case 101:
+				case 99:
+					return 3
+				case 85:
 					return -1
-				case 109:
+				case 100:
 					return -1
-				case 116:
+				case 69:
 					return -1
-				case 105:
+				case 108:
 					return -1
-				case 110:
-					return 8
-				case 99:
+				case 68:
 					return -1
-				case 77:
+				case 105:
 					return -1
-				case 84:
+				case 73:
 					return -1
 				case 78:
-					return 8
-				case 67:
 					return -1
-				case 114:
+				case 67:
+					return 3
+				case 76:
 					return -1
-				case 69:
+				case 101:
 					return -1
 				}
 				return -1
@@ -13321,273 +13458,234 @@ func NewLexerWithInit(in io.Reader, initFun func(*Lexer)) *Lexer {
 				switch r {
 				case 99:
 					return -1
-				case 77:
+				case 85:
 					return -1
-				case 84:
-					return 9
-				case 78:
+				case 100:
 					return -1
-				case 67:
+				case 69:
 					return -1
-				case 114:
+				case 108:
+					return 4
+				case 68:
 					return -1
-				case 69:
+				case 105:
 					return -1
 				case 73:
 					return -1
-				case 82:
-					return -1
-				case 101:
+				case 78:
 					return -1
-				case 109:
+				case 67:
 					return -1
-				case 116:
-					return 9
-				case 105:
+				case 76:
+					return 4
+				case 101:
 					return -1
 				case 110:
 					return -1
+				case 117:
+					return -1
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
-				case 99:
+				case 108:
 					return -1
-				case 77:
+				case 68:
 					return -1
-				case 84:
+				case 105:
+					return -1
+				case 73:
 					return -1
 				case 78:
 					return -1
 				case 67:
 					return -1
-				case 114:
-					return -1
-				case 69:
-					return -1
-				case 73:
-					return -1
-				case 82:
+				case 76:
 					return -1
 				case 101:
 					return -1
-				case 109:
+				case 110:
 					return -1
-				case 116:
+				case 117:
+					return 5
+				case 99:
 					return -1
-				case 105:
+				case 85:
+					return 5
+				case 100:
 					return -1
-				case 110:
+				case 69:
 					return -1
 				}
 				return -1
 			},
-		}, []int{ /* Start-of-input transitions */ -1, -1, -1, -1, -1, -1, -1, -1, -1, -1}, []int{ /* End-of-input transitions */ -1, -1, -1, -1, -1, -1, -1, -1, -1, -1}, nil},
-
-		// [iI][nN][dD][eE][xX]
-		{[]bool{false, false, false, false, false, true}, []func(rune) int{ // Transitions
 			func(r rune) int {
 				switch r {
-				case 105:
-					return 1
 				case 110:
 					return -1
-				case 101:
-					return -1
-				case 120:
+				case 117:
 					return -1
-				case 88:
+				case 99:
 					return -1
-				case 73:
-					return 1
-				case 78:
+				case 85:
 					return -1
 				case 100:
-					return -1
-				case 68:
-					return -1
+					return 6
 				case 69:
 					return -1
-				}
-				return -1
-			},
-			func(r rune) int {
-				switch r {
-				case 105:
-					return -1
-				case 110:
-					return 2
-				case 101:
-					return -1
-				case 120:
+				case 108:
 					return -1
-				case 88:
+				case 68:
+					return 6
+				case 105:
 					return -1
 				case 73:
 					return -1
 				case 78:
-					return 2
-				case 100:
 					return -1
-				case 68:
+				case 67:
 					return -1
-				case 69:
+				case 76:
+					return -1
+				case 101:
 					return -1
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
-				case 105:
-					return -1
 				case 110:
 					return -1
-				case 101:
+				case 117:
 					return -1
-				case 120:
+				case 99:
 					return -1
-				case 88:
+				case 85:
 					return -1
-				case 73:
+				case 100:
 					return -1
-				case 78:
+				case 69:
+					return 7
+				case 108:
 					return -1
-				case 100:
-					return 3
 				case 68:
-					return 3
-				case 69:
 					return -1
-				}
-				return -1
-			},
-			func(r rune) int {
-				switch r {
 				case 105:
 					return -1
-				case 110:
-					return -1
-				case 101:
-					return 4
-				case 120:
-					return -1
-				case 88:
-					return -1
 				case 73:
 					return -1
 				case 78:
 					return -1
-				case 100:
+				case 67:
 					return -1
-				case 68:
+				case 76:
 					return -1
-				case 69:
-					return 4
+				case 101:
+					return 7
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
-				case 73:
+				case 110:
 					return -1
-				case 78:
+				case 117:
 					return -1
-				case 100:
+				case 99:
 					return -1
-				case 68:
+				case 85:
+					return -1
+				case 100:
 					return -1
 				case 69:
 					return -1
-				case 105:
+				case 108:
 					return -1
-				case 110:
+				case 68:
 					return -1
-				case 101:
+				case 105:
 					return -1
-				case 120:
-					return 5
-				case 88:
-					return 5
-				}
-				return -1
-			},
-			func(r rune) int {
-				switch r {
 				case 73:
 					return -1
 				case 78:
 					return -1
-				case 100:
-					return -1
-				case 68:
-					return -1
-				case 69:
-					return -1
-				case 105:
+				case 67:
 					return -1
-				case 110:
+				case 76:
 					return -1
 				case 101:
 					return -1
-				case 120:
-					return -1
-				case 88:
-					return -1
 				}
 				return -1
 			},
-		}, []int{ /* Start-of-input transitions */ -1, -1, -1, -1, -1, -1}, []int{ /* End-of-input transitions */ -1, -1, -1, -1, -1, -1}, nil},
+		}, []int{ /* Start-of-input transitions */ -1, -1, -1, -1, -1, -1, -1, -1}, []int{ /* End-of-input transitions */ -1, -1, -1, -1, -1, -1, -1, -1}, nil},
 
-		// [iI][nN][fF][eE][rR]
-		{[]bool{false, false, false, false, false, true}, []func(rune) int{ // Transitions
+		// [iI][nN][cC][rR][eE][mM][eE][nN][tT]
+		{[]bool{false, false, false, false, false, false, false, false, false, true}, []func(rune) int{ // Transitions
 			func(r rune) int {
 				switch r {
-				case 105:
-					return 1
+				case 99:
+					return -1
+				case 77:
+					return -1
+				case 84:
+					return -1
 				case 78:
 					return -1
-				case 70:
+				case 67:
 					return -1
-				case 101:
+				case 114:
 					return -1
 				case 69:
 					return -1
-				case 82:
-					return -1
 				case 73:
 					return 1
-				case 110:
+				case 82:
 					return -1
-				case 102:
+				case 101:
 					return -1
-				case 114:
+				case 109:
+					return -1
+				case 116:
+					return -1
+				case 105:
+					return 1
+				case 110:
 					return -1
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
-				case 73:
+				case 105:
 					return -1
 				case 110:
 					return 2
-				case 102:
+				case 99:
 					return -1
-				case 114:
+				case 77:
 					return -1
-				case 105:
+				case 84:
 					return -1
 				case 78:
 					return 2
-				case 70:
+				case 67:
 					return -1
-				case 101:
+				case 114:
 					return -1
 				case 69:
 					return -1
+				case 73:
+					return -1
 				case 82:
 					return -1
+				case 101:
+					return -1
+				case 109:
+					return -1
+				case 116:
+					return -1
 				}
 				return -1
 			},
@@ -13595,24 +13693,32 @@ func NewLexerWithInit(in io.Reader, initFun func(*Lexer)) *Lexer {
 				switch r {
 				case 73:
 					return -1
+				case 82:
+					return -1
+				case 101:
+					return -1
+				case 109:
+					return -1
+				case 116:
+					return -1
+				case 105:
+					return -1
 				case 110:
 					return -1
-				case 102:
+				case 99:
 					return 3
-				case 114:
+				case 77:
 					return -1
-				case 105:
+				case 84:
 					return -1
 				case 78:
 					return -1
-				case 70:
+				case 67:
 					return 3
-				case 101:
+				case 114:
 					return -1
 				case 69:
 					return -1
-				case 82:
-					return -1
 				}
 				return -1
 			},
@@ -13620,159 +13726,161 @@ func NewLexerWithInit(in io.Reader, initFun func(*Lexer)) *Lexer {
 				switch r {
 				case 105:
 					return -1
+				case 110:
+					return -1
+				case 99:
+					return -1
+				case 77:
+					return -1
+				case 84:
+					return -1
 				case 78:
 					return -1
-				case 70:
+				case 67:
 					return -1
-				case 101:
+				case 114:
 					return 4
 				case 69:
-					return 4
-				case 82:
 					return -1
 				case 73:
 					return -1
-				case 110:
+				case 82:
+					return 4
+				case 101:
 					return -1
-				case 102:
+				case 109:
 					return -1
-				case 114:
+				case 116:
 					return -1
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
-				case 105:
-					return -1
-				case 78:
+				case 73:
 					return -1
-				case 70:
+				case 82:
 					return -1
 				case 101:
+					return 5
+				case 109:
 					return -1
-				case 69:
+				case 116:
 					return -1
-				case 82:
-					return 5
-				case 73:
+				case 105:
 					return -1
 				case 110:
 					return -1
-				case 102:
+				case 99:
 					return -1
-				case 114:
-					return 5
+				case 77:
+					return -1
+				case 84:
+					return -1
+				case 78:
+					return -1
+				case 67:
+					return -1
+				case 114:
+					return -1
+				case 69:
+					return 5
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
-				case 73:
+				case 105:
 					return -1
 				case 110:
 					return -1
-				case 102:
-					return -1
-				case 114:
+				case 99:
 					return -1
-				case 105:
+				case 77:
+					return 6
+				case 84:
 					return -1
 				case 78:
 					return -1
-				case 70:
+				case 67:
 					return -1
-				case 101:
+				case 114:
 					return -1
 				case 69:
 					return -1
+				case 73:
+					return -1
 				case 82:
 					return -1
+				case 101:
+					return -1
+				case 109:
+					return 6
+				case 116:
+					return -1
 				}
 				return -1
 			},
-		}, []int{ /* Start-of-input transitions */ -1, -1, -1, -1, -1, -1}, []int{ /* End-of-input transitions */ -1, -1, -1, -1, -1, -1}, nil},
-
-		// [iI][nN][lL][iI][nN][eE]
-		{[]bool{false, false, false, false, false, false, true}, []func(rune) int{ // Transitions
 			func(r rune) int {
 				switch r {
-				case 105:
-					return 1
-				case 73:
-					return 1
-				case 110:
-					return -1
 				case 78:
 					return -1
-				case 108:
+				case 67:
 					return -1
-				case 76:
+				case 114:
+					return -1
+				case 69:
+					return 7
+				case 73:
+					return -1
+				case 82:
 					return -1
 				case 101:
+					return 7
+				case 109:
 					return -1
-				case 69:
+				case 116:
 					return -1
-				}
-				return -1
-			},
-			func(r rune) int {
-				switch r {
 				case 105:
 					return -1
-				case 73:
-					return -1
 				case 110:
-					return 2
-				case 78:
-					return 2
-				case 108:
 					return -1
-				case 76:
+				case 99:
 					return -1
-				case 101:
+				case 77:
 					return -1
-				case 69:
+				case 84:
 					return -1
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
-				case 105:
-					return -1
 				case 73:
 					return -1
-				case 110:
-					return -1
-				case 78:
+				case 82:
 					return -1
-				case 108:
-					return 3
-				case 76:
-					return 3
 				case 101:
 					return -1
-				case 69:
+				case 109:
+					return -1
+				case 116:
 					return -1
-				}
-				return -1
-			},
-			func(r rune) int {
-				switch r {
 				case 105:
-					return 4
-				case 73:
-					return 4
+					return -1
 				case 110:
+					return 8
+				case 99:
 					return -1
-				case 78:
+				case 77:
 					return -1
-				case 108:
+				case 84:
 					return -1
-				case 76:
+				case 78:
+					return 8
+				case 67:
 					return -1
-				case 101:
+				case 114:
 					return -1
 				case 69:
 					return -1
@@ -13781,88 +13889,95 @@ func NewLexerWithInit(in io.Reader, initFun func(*Lexer)) *Lexer {
 			},
 			func(r rune) int {
 				switch r {
-				case 105:
+				case 99:
 					return -1
-				case 73:
+				case 77:
 					return -1
-				case 110:
-					return 5
+				case 84:
+					return 9
 				case 78:
-					return 5
-				case 108:
 					return -1
-				case 76:
+				case 67:
 					return -1
-				case 101:
+				case 114:
 					return -1
 				case 69:
 					return -1
+				case 73:
+					return -1
+				case 82:
+					return -1
+				case 101:
+					return -1
+				case 109:
+					return -1
+				case 116:
+					return 9
+				case 105:
+					return -1
+				case 110:
+					return -1
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
-				case 105:
+				case 99:
 					return -1
-				case 73:
+				case 77:
 					return -1
-				case 110:
+				case 84:
 					return -1
 				case 78:
 					return -1
-				case 108:
+				case 67:
 					return -1
-				case 76:
+				case 114:
 					return -1
-				case 101:
-					return 6
 				case 69:
-					return 6
-				}
-				return -1
-			},
-			func(r rune) int {
-				switch r {
-				case 105:
 					return -1
 				case 73:
 					return -1
-				case 110:
+				case 82:
 					return -1
-				case 78:
+				case 101:
 					return -1
-				case 108:
+				case 109:
 					return -1
-				case 76:
+				case 116:
 					return -1
-				case 101:
+				case 105:
 					return -1
-				case 69:
+				case 110:
 					return -1
 				}
 				return -1
 			},
-		}, []int{ /* Start-of-input transitions */ -1, -1, -1, -1, -1, -1, -1}, []int{ /* End-of-input transitions */ -1, -1, -1, -1, -1, -1, -1}, nil},
+		}, []int{ /* Start-of-input transitions */ -1, -1, -1, -1, -1, -1, -1, -1, -1, -1}, []int{ /* End-of-input transitions */ -1, -1, -1, -1, -1, -1, -1, -1, -1, -1}, nil},
 
-		// [iI][nN][nN][eE][rR]
+		// [iI][nN][dD][eE][xX]
 		{[]bool{false, false, false, false, false, true}, []func(rune) int{ // Transitions
 			func(r rune) int {
 				switch r {
 				case 105:
 					return 1
-				case 73:
-					return 1
 				case 110:
 					return -1
-				case 78:
-					return -1
 				case 101:
 					return -1
-				case 69:
+				case 120:
 					return -1
-				case 114:
+				case 88:
 					return -1
-				case 82:
+				case 73:
+					return 1
+				case 78:
+					return -1
+				case 100:
+					return -1
+				case 68:
+					return -1
+				case 69:
 					return -1
 				}
 				return -1
@@ -13871,19 +13986,23 @@ func NewLexerWithInit(in io.Reader, initFun func(*Lexer)) *Lexer {
 				switch r {
 				case 105:
 					return -1
-				case 73:
-					return -1
 				case 110:
 					return 2
-				case 78:
-					return 2
 				case 101:
 					return -1
-				case 69:
+				case 120:
 					return -1
-				case 114:
+				case 88:
 					return -1
-				case 82:
+				case 73:
+					return -1
+				case 78:
+					return 2
+				case 100:
+					return -1
+				case 68:
+					return -1
+				case 69:
 					return -1
 				}
 				return -1
@@ -13892,19 +14011,23 @@ func NewLexerWithInit(in io.Reader, initFun func(*Lexer)) *Lexer {
 				switch r {
 				case 105:
 					return -1
-				case 73:
-					return -1
 				case 110:
-					return 3
-				case 78:
-					return 3
+					return -1
 				case 101:
 					return -1
-				case 69:
+				case 120:
 					return -1
-				case 114:
+				case 88:
 					return -1
-				case 82:
+				case 73:
+					return -1
+				case 78:
+					return -1
+				case 100:
+					return 3
+				case 68:
+					return 3
+				case 69:
 					return -1
 				}
 				return -1
@@ -13913,94 +14036,102 @@ func NewLexerWithInit(in io.Reader, initFun func(*Lexer)) *Lexer {
 				switch r {
 				case 105:
 					return -1
-				case 73:
-					return -1
 				case 110:
 					return -1
-				case 78:
-					return -1
 				case 101:
 					return 4
-				case 69:
-					return 4
-				case 114:
+				case 120:
 					return -1
-				case 82:
+				case 88:
+					return -1
+				case 73:
+					return -1
+				case 78:
+					return -1
+				case 100:
+					return -1
+				case 68:
 					return -1
+				case 69:
+					return 4
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
-				case 105:
-					return -1
 				case 73:
 					return -1
-				case 110:
-					return -1
 				case 78:
 					return -1
-				case 101:
+				case 100:
 					return -1
-				case 69:
+				case 68:
 					return -1
-				case 114:
+				case 69:
+					return -1
+				case 105:
+					return -1
+				case 110:
+					return -1
+				case 101:
+					return -1
+				case 120:
 					return 5
-				case 82:
+				case 88:
 					return 5
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
-				case 105:
-					return -1
 				case 73:
 					return -1
-				case 110:
-					return -1
 				case 78:
 					return -1
-				case 101:
+				case 100:
+					return -1
+				case 68:
 					return -1
 				case 69:
 					return -1
-				case 114:
+				case 105:
 					return -1
-				case 82:
+				case 110:
+					return -1
+				case 101:
+					return -1
+				case 120:
+					return -1
+				case 88:
 					return -1
 				}
 				return -1
 			},
 		}, []int{ /* Start-of-input transitions */ -1, -1, -1, -1, -1, -1}, []int{ /* End-of-input transitions */ -1, -1, -1, -1, -1, -1}, nil},
 
-		// [iI][nN][sS][eE][rR][tT]
-		{[]bool{false, false, false, false, false, false, true}, []func(rune) int{ // Transitions
+		// [iI][nN][fF][eE][rR]
+		{[]bool{false, false, false, false, false, true}, []func(rune) int{ // Transitions
 			func(r rune) int {
 				switch r {
-				case 73:
-					return 1
-				case 115:
-					return -1
-				case 83:
-					return -1
-				case 82:
-					return -1
 				case 105:
 					return 1
-				case 110:
-					return -1
 				case 78:
 					return -1
+				case 70:
+					return -1
 				case 101:
 					return -1
 				case 69:
 					return -1
-				case 114:
+				case 82:
 					return -1
-				case 116:
+				case 73:
+					return 1
+				case 110:
 					return -1
-				case 84:
+				case 102:
+					return -1
+				case 114:
 					return -1
 				}
 				return -1
@@ -14009,27 +14140,23 @@ func NewLexerWithInit(in io.Reader, initFun func(*Lexer)) *Lexer {
 				switch r {
 				case 73:
 					return -1
-				case 115:
-					return -1
-				case 83:
+				case 110:
+					return 2
+				case 102:
 					return -1
-				case 82:
+				case 114:
 					return -1
 				case 105:
 					return -1
-				case 110:
-					return 2
 				case 78:
 					return 2
+				case 70:
+					return -1
 				case 101:
 					return -1
 				case 69:
 					return -1
-				case 114:
-					return -1
-				case 116:
-					return -1
-				case 84:
+				case 82:
 					return -1
 				}
 				return -1
@@ -14038,115 +14165,74 @@ func NewLexerWithInit(in io.Reader, initFun func(*Lexer)) *Lexer {
 				switch r {
 				case 73:
 					return -1
-				case 115:
-					return 3
-				case 83:
+				case 110:
+					return -1
+				case 102:
 					return 3
-				case 82:
+				case 114:
 					return -1
 				case 105:
 					return -1
-				case 110:
-					return -1
 				case 78:
 					return -1
+				case 70:
+					return 3
 				case 101:
 					return -1
 				case 69:
 					return -1
-				case 114:
-					return -1
-				case 116:
-					return -1
-				case 84:
+				case 82:
 					return -1
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
-				case 73:
-					return -1
-				case 115:
-					return -1
-				case 83:
-					return -1
-				case 82:
-					return -1
 				case 105:
 					return -1
-				case 110:
-					return -1
 				case 78:
 					return -1
+				case 70:
+					return -1
 				case 101:
 					return 4
 				case 69:
 					return 4
-				case 114:
+				case 82:
 					return -1
-				case 116:
+				case 73:
 					return -1
-				case 84:
+				case 110:
+					return -1
+				case 102:
+					return -1
+				case 114:
 					return -1
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
-				case 73:
-					return -1
-				case 115:
-					return -1
-				case 83:
-					return -1
-				case 82:
-					return 5
 				case 105:
 					return -1
-				case 110:
-					return -1
 				case 78:
 					return -1
+				case 70:
+					return -1
 				case 101:
 					return -1
 				case 69:
 					return -1
-				case 114:
+				case 82:
 					return 5
-				case 116:
-					return -1
-				case 84:
-					return -1
-				}
-				return -1
-			},
-			func(r rune) int {
-				switch r {
 				case 73:
 					return -1
-				case 115:
-					return -1
-				case 83:
-					return -1
-				case 82:
-					return -1
-				case 105:
-					return -1
 				case 110:
 					return -1
-				case 78:
-					return -1
-				case 101:
-					return -1
-				case 69:
+				case 102:
 					return -1
 				case 114:
-					return -1
-				case 116:
-					return 6
-				case 84:
-					return 6
+					return 5
 				}
 				return -1
 			},
@@ -14154,386 +14240,262 @@ func NewLexerWithInit(in io.Reader, initFun func(*Lexer)) *Lexer {
 				switch r {
 				case 73:
 					return -1
-				case 115:
+				case 110:
 					return -1
-				case 83:
+				case 102:
 					return -1
-				case 82:
+				case 114:
 					return -1
 				case 105:
 					return -1
-				case 110:
-					return -1
 				case 78:
 					return -1
+				case 70:
+					return -1
 				case 101:
 					return -1
 				case 69:
 					return -1
-				case 114:
-					return -1
-				case 116:
-					return -1
-				case 84:
+				case 82:
 					return -1
 				}
 				return -1
 			},
-		}, []int{ /* Start-of-input transitions */ -1, -1, -1, -1, -1, -1, -1}, []int{ /* End-of-input transitions */ -1, -1, -1, -1, -1, -1, -1}, nil},
+		}, []int{ /* Start-of-input transitions */ -1, -1, -1, -1, -1, -1}, []int{ /* End-of-input transitions */ -1, -1, -1, -1, -1, -1}, nil},
 
-		// [iI][nN][tT][eE][rR][sS][eE][cC][tT]
-		{[]bool{false, false, false, false, false, false, false, false, false, true}, []func(rune) int{ // Transitions
+		// [iI][nN][lL][iI][nN][eE]
+		{[]bool{false, false, false, false, false, false, true}, []func(rune) int{ // Transitions
 			func(r rune) int {
 				switch r {
-				case 78:
-					return -1
-				case 84:
-					return -1
-				case 101:
-					return -1
-				case 67:
-					return -1
 				case 105:
 					return 1
 				case 73:
 					return 1
-				case 82:
-					return -1
-				case 99:
-					return -1
 				case 110:
 					return -1
-				case 116:
+				case 78:
 					return -1
-				case 114:
+				case 108:
 					return -1
-				case 83:
+				case 76:
 					return -1
-				case 69:
+				case 101:
 					return -1
-				case 115:
+				case 69:
 					return -1
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
-				case 78:
-					return 2
-				case 84:
-					return -1
-				case 101:
-					return -1
-				case 67:
-					return -1
 				case 105:
 					return -1
 				case 73:
 					return -1
-				case 82:
-					return -1
-				case 99:
-					return -1
 				case 110:
 					return 2
-				case 116:
+				case 78:
+					return 2
+				case 108:
 					return -1
-				case 114:
+				case 76:
 					return -1
-				case 83:
+				case 101:
 					return -1
 				case 69:
 					return -1
-				case 115:
-					return -1
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
-				case 78:
-					return -1
-				case 84:
-					return 3
-				case 101:
-					return -1
-				case 67:
-					return -1
 				case 105:
 					return -1
 				case 73:
 					return -1
-				case 82:
-					return -1
-				case 99:
-					return -1
 				case 110:
 					return -1
-				case 116:
-					return 3
-				case 114:
+				case 78:
 					return -1
-				case 83:
+				case 108:
+					return 3
+				case 76:
+					return 3
+				case 101:
 					return -1
 				case 69:
 					return -1
-				case 115:
-					return -1
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
-				case 69:
+				case 105:
 					return 4
-				case 115:
+				case 73:
+					return 4
+				case 110:
 					return -1
 				case 78:
 					return -1
-				case 84:
+				case 108:
 					return -1
-				case 101:
-					return 4
-				case 67:
-					return -1
-				case 105:
-					return -1
-				case 73:
-					return -1
-				case 82:
-					return -1
-				case 99:
-					return -1
-				case 110:
-					return -1
-				case 116:
+				case 76:
 					return -1
-				case 114:
+				case 101:
 					return -1
-				case 83:
+				case 69:
 					return -1
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
-				case 110:
+				case 105:
 					return -1
-				case 116:
+				case 73:
 					return -1
-				case 114:
+				case 110:
 					return 5
-				case 83:
-					return -1
-				case 69:
-					return -1
-				case 115:
-					return -1
 				case 78:
+					return 5
+				case 108:
 					return -1
-				case 84:
+				case 76:
 					return -1
 				case 101:
 					return -1
-				case 67:
-					return -1
-				case 105:
-					return -1
-				case 73:
-					return -1
-				case 82:
-					return 5
-				case 99:
+				case 69:
 					return -1
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
-				case 69:
-					return -1
-				case 115:
-					return 6
-				case 78:
-					return -1
-				case 84:
-					return -1
-				case 101:
-					return -1
-				case 67:
-					return -1
 				case 105:
 					return -1
 				case 73:
 					return -1
-				case 82:
-					return -1
-				case 99:
-					return -1
 				case 110:
 					return -1
-				case 116:
+				case 78:
 					return -1
-				case 114:
+				case 108:
 					return -1
-				case 83:
+				case 76:
+					return -1
+				case 101:
+					return 6
+				case 69:
 					return 6
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
-				case 110:
-					return -1
-				case 116:
-					return -1
-				case 114:
+				case 105:
 					return -1
-				case 83:
+				case 73:
 					return -1
-				case 69:
-					return 7
-				case 115:
+				case 110:
 					return -1
 				case 78:
 					return -1
-				case 84:
-					return -1
-				case 101:
-					return 7
-				case 67:
-					return -1
-				case 105:
+				case 108:
 					return -1
-				case 73:
+				case 76:
 					return -1
-				case 82:
+				case 101:
 					return -1
-				case 99:
+				case 69:
 					return -1
 				}
 				return -1
 			},
+		}, []int{ /* Start-of-input transitions */ -1, -1, -1, -1, -1, -1, -1}, []int{ /* End-of-input transitions */ -1, -1, -1, -1, -1, -1, -1}, nil},
+
+		// [iI][nN][nN][eE][rR]
+		{[]bool{false, false, false, false, false, true}, []func(rune) int{ // Transitions
 			func(r rune) int {
 				switch r {
-				case 78:
-					return -1
-				case 84:
-					return -1
-				case 101:
-					return -1
-				case 67:
-					return 8
 				case 105:
-					return -1
+					return 1
 				case 73:
-					return -1
-				case 82:
-					return -1
-				case 99:
-					return 8
+					return 1
 				case 110:
 					return -1
-				case 116:
-					return -1
-				case 114:
+				case 78:
 					return -1
-				case 83:
+				case 101:
 					return -1
 				case 69:
 					return -1
-				case 115:
+				case 114:
+					return -1
+				case 82:
 					return -1
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
-				case 78:
-					return -1
-				case 84:
-					return 9
-				case 101:
-					return -1
-				case 67:
-					return -1
 				case 105:
 					return -1
 				case 73:
 					return -1
-				case 82:
-					return -1
-				case 99:
-					return -1
 				case 110:
-					return -1
-				case 116:
-					return 9
-				case 114:
-					return -1
-				case 83:
+					return 2
+				case 78:
+					return 2
+				case 101:
 					return -1
 				case 69:
 					return -1
-				case 115:
+				case 114:
+					return -1
+				case 82:
 					return -1
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
-				case 78:
-					return -1
-				case 84:
-					return -1
-				case 101:
-					return -1
-				case 67:
-					return -1
 				case 105:
 					return -1
 				case 73:
 					return -1
-				case 82:
-					return -1
-				case 99:
-					return -1
 				case 110:
+					return 3
+				case 78:
+					return 3
+				case 101:
 					return -1
-				case 116:
+				case 69:
 					return -1
 				case 114:
 					return -1
-				case 83:
-					return -1
-				case 69:
-					return -1
-				case 115:
+				case 82:
 					return -1
 				}
 				return -1
 			},
-		}, []int{ /* Start-of-input transitions */ -1, -1, -1, -1, -1, -1, -1, -1, -1, -1}, []int{ /* End-of-input transitions */ -1, -1, -1, -1, -1, -1, -1, -1, -1, -1}, nil},
-
-		// [iI][nN][tT][oO]
-		{[]bool{false, false, false, false, true}, []func(rune) int{ // Transitions
 			func(r rune) int {
 				switch r {
 				case 105:
-					return 1
+					return -1
 				case 73:
-					return 1
+					return -1
 				case 110:
 					return -1
 				case 78:
 					return -1
-				case 116:
-					return -1
-				case 84:
-					return -1
-				case 111:
+				case 101:
+					return 4
+				case 69:
+					return 4
+				case 114:
 					return -1
-				case 79:
+				case 82:
 					return -1
 				}
 				return -1
@@ -14545,17 +14507,17 @@ func NewLexerWithInit(in io.Reader, initFun func(*Lexer)) *Lexer {
 				case 73:
 					return -1
 				case 110:
-					return 2
-				case 78:
-					return 2
-				case 116:
 					return -1
-				case 84:
+				case 78:
 					return -1
-				case 111:
+				case 101:
 					return -1
-				case 79:
+				case 69:
 					return -1
+				case 114:
+					return 5
+				case 82:
+					return 5
 				}
 				return -1
 			},
@@ -14569,1229 +14531,1132 @@ func NewLexerWithInit(in io.Reader, initFun func(*Lexer)) *Lexer {
 					return -1
 				case 78:
 					return -1
-				case 116:
-					return 3
-				case 84:
-					return 3
-				case 111:
+				case 101:
 					return -1
-				case 79:
+				case 69:
+					return -1
+				case 114:
+					return -1
+				case 82:
 					return -1
 				}
 				return -1
 			},
+		}, []int{ /* Start-of-input transitions */ -1, -1, -1, -1, -1, -1}, []int{ /* End-of-input transitions */ -1, -1, -1, -1, -1, -1}, nil},
+
+		// [iI][nN][sS][eE][rR][tT]
+		{[]bool{false, false, false, false, false, false, true}, []func(rune) int{ // Transitions
 			func(r rune) int {
 				switch r {
-				case 105:
-					return -1
 				case 73:
+					return 1
+				case 115:
 					return -1
+				case 83:
+					return -1
+				case 82:
+					return -1
+				case 105:
+					return 1
 				case 110:
 					return -1
 				case 78:
 					return -1
+				case 101:
+					return -1
+				case 69:
+					return -1
+				case 114:
+					return -1
 				case 116:
 					return -1
 				case 84:
 					return -1
-				case 111:
-					return 4
-				case 79:
-					return 4
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
-				case 105:
-					return -1
 				case 73:
 					return -1
-				case 110:
+				case 115:
 					return -1
-				case 78:
+				case 83:
 					return -1
-				case 116:
+				case 82:
 					return -1
-				case 84:
+				case 105:
 					return -1
-				case 111:
+				case 110:
+					return 2
+				case 78:
+					return 2
+				case 101:
 					return -1
-				case 79:
+				case 69:
+					return -1
+				case 114:
+					return -1
+				case 116:
+					return -1
+				case 84:
 					return -1
 				}
 				return -1
 			},
-		}, []int{ /* Start-of-input transitions */ -1, -1, -1, -1, -1}, []int{ /* End-of-input transitions */ -1, -1, -1, -1, -1}, nil},
-
-		// [iI][sS]
-		{[]bool{false, false, true}, []func(rune) int{ // Transitions
 			func(r rune) int {
 				switch r {
-				case 105:
-					return 1
 				case 73:
-					return 1
-				case 115:
 					return -1
+				case 115:
+					return 3
 				case 83:
+					return 3
+				case 82:
 					return -1
-				}
-				return -1
-			},
-			func(r rune) int {
-				switch r {
 				case 105:
 					return -1
-				case 73:
+				case 110:
+					return -1
+				case 78:
+					return -1
+				case 101:
+					return -1
+				case 69:
+					return -1
+				case 114:
+					return -1
+				case 116:
+					return -1
+				case 84:
 					return -1
-				case 115:
-					return 2
-				case 83:
-					return 2
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
-				case 105:
-					return -1
 				case 73:
 					return -1
 				case 115:
 					return -1
 				case 83:
 					return -1
-				}
-				return -1
-			},
-		}, []int{ /* Start-of-input transitions */ -1, -1, -1}, []int{ /* End-of-input transitions */ -1, -1, -1}, nil},
-
-		// [jJ][oO][iI][nN]
-		{[]bool{false, false, false, false, true}, []func(rune) int{ // Transitions
-			func(r rune) int {
-				switch r {
-				case 106:
-					return 1
-				case 74:
-					return 1
-				case 111:
-					return -1
-				case 79:
+				case 82:
 					return -1
 				case 105:
 					return -1
-				case 73:
-					return -1
 				case 110:
 					return -1
 				case 78:
 					return -1
+				case 101:
+					return 4
+				case 69:
+					return 4
+				case 114:
+					return -1
+				case 116:
+					return -1
+				case 84:
+					return -1
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
-				case 106:
+				case 73:
 					return -1
-				case 74:
+				case 115:
 					return -1
-				case 111:
-					return 2
-				case 79:
-					return 2
-				case 105:
+				case 83:
 					return -1
-				case 73:
+				case 82:
+					return 5
+				case 105:
 					return -1
 				case 110:
 					return -1
 				case 78:
 					return -1
-				}
-				return -1
-			},
-			func(r rune) int {
-				switch r {
-				case 106:
-					return -1
-				case 74:
-					return -1
-				case 111:
+				case 101:
 					return -1
-				case 79:
+				case 69:
 					return -1
-				case 105:
-					return 3
-				case 73:
-					return 3
-				case 110:
+				case 114:
+					return 5
+				case 116:
 					return -1
-				case 78:
+				case 84:
 					return -1
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
-				case 106:
+				case 73:
 					return -1
-				case 74:
+				case 115:
 					return -1
-				case 111:
+				case 83:
 					return -1
-				case 79:
+				case 82:
 					return -1
 				case 105:
 					return -1
-				case 73:
-					return -1
 				case 110:
-					return 4
+					return -1
 				case 78:
-					return 4
+					return -1
+				case 101:
+					return -1
+				case 69:
+					return -1
+				case 114:
+					return -1
+				case 116:
+					return 6
+				case 84:
+					return 6
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
-				case 106:
+				case 73:
 					return -1
-				case 74:
+				case 115:
 					return -1
-				case 111:
+				case 83:
 					return -1
-				case 79:
+				case 82:
 					return -1
 				case 105:
 					return -1
-				case 73:
-					return -1
 				case 110:
 					return -1
 				case 78:
 					return -1
-				}
-				return -1
-			},
-		}, []int{ /* Start-of-input transitions */ -1, -1, -1, -1, -1}, []int{ /* End-of-input transitions */ -1, -1, -1, -1, -1}, nil},
-
-		// [kK][eE][yY]
-		{[]bool{false, false, false, true}, []func(rune) int{ // Transitions
-			func(r rune) int {
-				switch r {
-				case 107:
-					return 1
-				case 75:
-					return 1
 				case 101:
 					return -1
 				case 69:
 					return -1
-				case 121:
+				case 114:
 					return -1
-				case 89:
+				case 116:
+					return -1
+				case 84:
 					return -1
 				}
 				return -1
 			},
+		}, []int{ /* Start-of-input transitions */ -1, -1, -1, -1, -1, -1, -1}, []int{ /* End-of-input transitions */ -1, -1, -1, -1, -1, -1, -1}, nil},
+
+		// [iI][nN][tT][eE][rR][sS][eE][cC][tT]
+		{[]bool{false, false, false, false, false, false, false, false, false, true}, []func(rune) int{ // Transitions
 			func(r rune) int {
 				switch r {
-				case 107:
+				case 78:
 					return -1
-				case 75:
+				case 84:
 					return -1
 				case 101:
-					return 2
-				case 69:
-					return 2
-				case 121:
 					return -1
-				case 89:
-					return -1
-				}
-				return -1
-			},
-			func(r rune) int {
-				switch r {
-				case 107:
+				case 67:
 					return -1
-				case 75:
+				case 105:
+					return 1
+				case 73:
+					return 1
+				case 82:
 					return -1
-				case 101:
+				case 99:
 					return -1
-				case 69:
+				case 110:
 					return -1
-				case 121:
-					return 3
-				case 89:
-					return 3
-				}
-				return -1
-			},
-			func(r rune) int {
-				switch r {
-				case 107:
+				case 116:
 					return -1
-				case 75:
+				case 114:
 					return -1
-				case 101:
+				case 83:
 					return -1
 				case 69:
 					return -1
-				case 121:
-					return -1
-				case 89:
+				case 115:
 					return -1
 				}
 				return -1
 			},
-		}, []int{ /* Start-of-input transitions */ -1, -1, -1, -1}, []int{ /* End-of-input transitions */ -1, -1, -1, -1}, nil},
-
-		// [kK][eE][yY][sS]
-		{[]bool{false, false, false, false, true}, []func(rune) int{ // Transitions
 			func(r rune) int {
 				switch r {
-				case 107:
-					return 1
-				case 75:
-					return 1
+				case 78:
+					return 2
+				case 84:
+					return -1
 				case 101:
 					return -1
-				case 69:
+				case 67:
 					return -1
-				case 121:
+				case 105:
 					return -1
-				case 89:
+				case 73:
 					return -1
-				case 115:
+				case 82:
+					return -1
+				case 99:
+					return -1
+				case 110:
+					return 2
+				case 116:
+					return -1
+				case 114:
 					return -1
 				case 83:
 					return -1
+				case 69:
+					return -1
+				case 115:
+					return -1
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
-				case 107:
-					return -1
-				case 75:
+				case 78:
 					return -1
+				case 84:
+					return 3
 				case 101:
-					return 2
-				case 69:
-					return 2
-				case 121:
 					return -1
-				case 89:
-					return -1
-				case 115:
+				case 67:
 					return -1
-				case 83:
+				case 105:
 					return -1
-				}
-				return -1
-			},
-			func(r rune) int {
-				switch r {
-				case 107:
+				case 73:
 					return -1
-				case 75:
+				case 82:
 					return -1
-				case 101:
+				case 99:
 					return -1
-				case 69:
+				case 110:
 					return -1
-				case 121:
-					return 3
-				case 89:
+				case 116:
 					return 3
-				case 115:
+				case 114:
 					return -1
 				case 83:
 					return -1
+				case 69:
+					return -1
+				case 115:
+					return -1
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
-				case 107:
-					return -1
-				case 75:
-					return -1
-				case 101:
-					return -1
 				case 69:
+					return 4
+				case 115:
 					return -1
-				case 121:
+				case 78:
 					return -1
-				case 89:
+				case 84:
 					return -1
-				case 115:
-					return 4
-				case 83:
+				case 101:
 					return 4
-				}
-				return -1
-			},
-			func(r rune) int {
-				switch r {
-				case 107:
+				case 67:
 					return -1
-				case 75:
+				case 105:
 					return -1
-				case 101:
+				case 73:
 					return -1
-				case 69:
+				case 82:
 					return -1
-				case 121:
+				case 99:
 					return -1
-				case 89:
+				case 110:
 					return -1
-				case 115:
+				case 116:
+					return -1
+				case 114:
 					return -1
 				case 83:
 					return -1
 				}
 				return -1
 			},
-		}, []int{ /* Start-of-input transitions */ -1, -1, -1, -1, -1}, []int{ /* End-of-input transitions */ -1, -1, -1, -1, -1}, nil},
-
-		// [kK][eE][yY][sS][pP][aA][cC][eE]
-		{[]bool{false, false, false, false, false, false, false, false, true}, []func(rune) int{ // Transitions
 			func(r rune) int {
 				switch r {
-				case 101:
+				case 110:
 					return -1
-				case 112:
+				case 116:
 					return -1
-				case 67:
+				case 114:
+					return 5
+				case 83:
 					return -1
-				case 107:
-					return 1
-				case 75:
-					return 1
 				case 69:
 					return -1
-				case 83:
+				case 115:
 					return -1
-				case 97:
+				case 78:
 					return -1
-				case 115:
+				case 84:
 					return -1
-				case 80:
+				case 101:
 					return -1
-				case 65:
+				case 67:
 					return -1
-				case 99:
+				case 105:
 					return -1
-				case 121:
+				case 73:
 					return -1
-				case 89:
+				case 82:
+					return 5
+				case 99:
 					return -1
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
-				case 121:
+				case 69:
 					return -1
-				case 89:
+				case 115:
+					return 6
+				case 78:
+					return -1
+				case 84:
 					return -1
 				case 101:
-					return 2
-				case 112:
 					return -1
 				case 67:
 					return -1
-				case 107:
-					return -1
-				case 75:
+				case 105:
 					return -1
-				case 69:
-					return 2
-				case 83:
+				case 73:
 					return -1
-				case 97:
+				case 82:
 					return -1
-				case 115:
+				case 99:
 					return -1
-				case 80:
+				case 110:
 					return -1
-				case 65:
+				case 116:
 					return -1
-				case 99:
+				case 114:
 					return -1
+				case 83:
+					return 6
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
-				case 101:
-					return -1
-				case 112:
+				case 110:
 					return -1
-				case 67:
+				case 116:
 					return -1
-				case 107:
+				case 114:
 					return -1
-				case 75:
+				case 83:
 					return -1
 				case 69:
+					return 7
+				case 115:
 					return -1
-				case 83:
+				case 78:
 					return -1
-				case 97:
+				case 84:
 					return -1
-				case 115:
+				case 101:
+					return 7
+				case 67:
 					return -1
-				case 80:
+				case 105:
 					return -1
-				case 65:
+				case 73:
+					return -1
+				case 82:
 					return -1
 				case 99:
 					return -1
-				case 121:
-					return 3
-				case 89:
-					return 3
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
-				case 121:
+				case 78:
 					return -1
-				case 89:
+				case 84:
 					return -1
 				case 101:
 					return -1
-				case 112:
-					return -1
 				case 67:
+					return 8
+				case 105:
 					return -1
-				case 107:
+				case 73:
 					return -1
-				case 75:
+				case 82:
 					return -1
-				case 69:
+				case 99:
+					return 8
+				case 110:
 					return -1
-				case 83:
-					return 4
-				case 97:
+				case 116:
 					return -1
-				case 115:
-					return 4
-				case 80:
+				case 114:
 					return -1
-				case 65:
+				case 83:
 					return -1
-				case 99:
+				case 69:
+					return -1
+				case 115:
 					return -1
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
+				case 78:
+					return -1
+				case 84:
+					return 9
 				case 101:
 					return -1
-				case 112:
-					return 5
 				case 67:
 					return -1
-				case 107:
-					return -1
-				case 75:
+				case 105:
 					return -1
-				case 69:
+				case 73:
 					return -1
-				case 83:
+				case 82:
 					return -1
-				case 97:
+				case 99:
 					return -1
-				case 115:
+				case 110:
 					return -1
-				case 80:
-					return 5
-				case 65:
+				case 116:
+					return 9
+				case 114:
 					return -1
-				case 99:
+				case 83:
 					return -1
-				case 121:
+				case 69:
 					return -1
-				case 89:
+				case 115:
 					return -1
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
-				case 115:
+				case 78:
 					return -1
-				case 80:
+				case 84:
 					return -1
-				case 65:
-					return 6
-				case 99:
+				case 101:
 					return -1
-				case 121:
+				case 67:
 					return -1
-				case 89:
+				case 105:
 					return -1
-				case 101:
+				case 73:
 					return -1
-				case 112:
+				case 82:
 					return -1
-				case 67:
+				case 99:
 					return -1
-				case 107:
+				case 110:
 					return -1
-				case 75:
+				case 116:
 					return -1
-				case 69:
+				case 114:
 					return -1
 				case 83:
 					return -1
-				case 97:
-					return 6
-				}
-				return -1
-			},
-			func(r rune) int {
-				switch r {
-				case 115:
-					return -1
-				case 80:
-					return -1
-				case 65:
-					return -1
-				case 99:
-					return 7
-				case 121:
-					return -1
-				case 89:
-					return -1
-				case 101:
-					return -1
-				case 112:
-					return -1
-				case 67:
-					return 7
-				case 107:
-					return -1
-				case 75:
-					return -1
-				case 69:
-					return -1
-				case 83:
-					return -1
-				case 97:
-					return -1
-				}
-				return -1
-			},
-			func(r rune) int {
-				switch r {
-				case 115:
-					return -1
-				case 80:
-					return -1
-				case 65:
-					return -1
-				case 99:
-					return -1
-				case 121:
-					return -1
-				case 89:
-					return -1
-				case 101:
-					return 8
-				case 112:
-					return -1
-				case 67:
-					return -1
-				case 107:
-					return -1
-				case 75:
-					return -1
-				case 69:
-					return 8
-				case 83:
-					return -1
-				case 97:
-					return -1
-				}
-				return -1
-			},
-			func(r rune) int {
-				switch r {
-				case 121:
-					return -1
-				case 89:
-					return -1
-				case 101:
-					return -1
-				case 112:
-					return -1
-				case 67:
-					return -1
-				case 107:
-					return -1
-				case 75:
-					return -1
 				case 69:
 					return -1
-				case 83:
-					return -1
-				case 97:
-					return -1
 				case 115:
 					return -1
-				case 80:
-					return -1
-				case 65:
-					return -1
-				case 99:
-					return -1
 				}
 				return -1
 			},
-		}, []int{ /* Start-of-input transitions */ -1, -1, -1, -1, -1, -1, -1, -1, -1}, []int{ /* End-of-input transitions */ -1, -1, -1, -1, -1, -1, -1, -1, -1}, nil},
+		}, []int{ /* Start-of-input transitions */ -1, -1, -1, -1, -1, -1, -1, -1, -1, -1}, []int{ /* End-of-input transitions */ -1, -1, -1, -1, -1, -1, -1, -1, -1, -1}, nil},
 
-		// [kK][nN][oO][wW][nN]
-		{[]bool{false, false, false, false, false, true}, []func(rune) int{ // Transitions
+		// [iI][nN][tT][oO]
+		{[]bool{false, false, false, false, true}, []func(rune) int{ // Transitions
 			func(r rune) int {
 				switch r {
-				case 107:
+				case 105:
 					return 1
-				case 75:
+				case 73:
 					return 1
 				case 110:
 					return -1
 				case 78:
 					return -1
-				case 111:
+				case 116:
 					return -1
-				case 79:
+				case 84:
 					return -1
-				case 119:
+				case 111:
 					return -1
-				case 87:
+				case 79:
 					return -1
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
-				case 107:
+				case 105:
 					return -1
-				case 75:
+				case 73:
 					return -1
 				case 110:
 					return 2
 				case 78:
 					return 2
-				case 111:
+				case 116:
 					return -1
-				case 79:
+				case 84:
 					return -1
-				case 119:
+				case 111:
 					return -1
-				case 87:
+				case 79:
 					return -1
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
-				case 107:
+				case 105:
 					return -1
-				case 75:
+				case 73:
 					return -1
 				case 110:
 					return -1
 				case 78:
 					return -1
-				case 111:
+				case 116:
 					return 3
-				case 79:
+				case 84:
 					return 3
-				case 119:
+				case 111:
 					return -1
-				case 87:
+				case 79:
 					return -1
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
-				case 107:
+				case 105:
 					return -1
-				case 75:
+				case 73:
 					return -1
 				case 110:
 					return -1
 				case 78:
 					return -1
-				case 111:
+				case 116:
 					return -1
-				case 79:
+				case 84:
 					return -1
-				case 119:
+				case 111:
 					return 4
-				case 87:
+				case 79:
 					return 4
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
-				case 107:
+				case 105:
 					return -1
-				case 75:
+				case 73:
 					return -1
 				case 110:
-					return 5
+					return -1
 				case 78:
-					return 5
-				case 111:
 					return -1
-				case 79:
+				case 116:
 					return -1
-				case 119:
+				case 84:
 					return -1
-				case 87:
+				case 111:
+					return -1
+				case 79:
 					return -1
 				}
 				return -1
 			},
+		}, []int{ /* Start-of-input transitions */ -1, -1, -1, -1, -1}, []int{ /* End-of-input transitions */ -1, -1, -1, -1, -1}, nil},
+
+		// [iI][sS]
+		{[]bool{false, false, true}, []func(rune) int{ // Transitions
 			func(r rune) int {
 				switch r {
-				case 107:
+				case 105:
+					return 1
+				case 73:
+					return 1
+				case 115:
 					return -1
-				case 75:
+				case 83:
 					return -1
-				case 110:
+				}
+				return -1
+			},
+			func(r rune) int {
+				switch r {
+				case 105:
 					return -1
-				case 78:
+				case 73:
 					return -1
-				case 111:
+				case 115:
+					return 2
+				case 83:
+					return 2
+				}
+				return -1
+			},
+			func(r rune) int {
+				switch r {
+				case 105:
 					return -1
-				case 79:
+				case 73:
 					return -1
-				case 119:
+				case 115:
 					return -1
-				case 87:
+				case 83:
 					return -1
 				}
 				return -1
 			},
-		}, []int{ /* Start-of-input transitions */ -1, -1, -1, -1, -1, -1}, []int{ /* End-of-input transitions */ -1, -1, -1, -1, -1, -1}, nil},
+		}, []int{ /* Start-of-input transitions */ -1, -1, -1}, []int{ /* End-of-input transitions */ -1, -1, -1}, nil},
 
-		// [lL][aA][sS][tT]
+		// [jJ][oO][iI][nN]
 		{[]bool{false, false, false, false, true}, []func(rune) int{ // Transitions
 			func(r rune) int {
 				switch r {
-				case 108:
+				case 106:
 					return 1
-				case 76:
+				case 74:
 					return 1
-				case 97:
+				case 111:
 					return -1
-				case 65:
+				case 79:
 					return -1
-				case 115:
+				case 105:
 					return -1
-				case 83:
+				case 73:
 					return -1
-				case 116:
+				case 110:
 					return -1
-				case 84:
+				case 78:
 					return -1
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
-				case 108:
+				case 106:
 					return -1
-				case 76:
+				case 74:
 					return -1
-				case 97:
+				case 111:
 					return 2
-				case 65:
+				case 79:
 					return 2
-				case 115:
+				case 105:
 					return -1
-				case 83:
+				case 73:
 					return -1
-				case 116:
+				case 110:
 					return -1
-				case 84:
+				case 78:
 					return -1
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
-				case 108:
+				case 106:
 					return -1
-				case 76:
+				case 74:
 					return -1
-				case 97:
+				case 111:
 					return -1
-				case 65:
+				case 79:
 					return -1
-				case 115:
+				case 105:
 					return 3
-				case 83:
+				case 73:
 					return 3
-				case 116:
+				case 110:
 					return -1
-				case 84:
+				case 78:
 					return -1
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
-				case 108:
+				case 106:
 					return -1
-				case 76:
+				case 74:
 					return -1
-				case 97:
+				case 111:
 					return -1
-				case 65:
+				case 79:
 					return -1
-				case 115:
+				case 105:
 					return -1
-				case 83:
+				case 73:
 					return -1
-				case 116:
+				case 110:
 					return 4
-				case 84:
+				case 78:
 					return 4
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
-				case 108:
+				case 106:
 					return -1
-				case 76:
+				case 74:
 					return -1
-				case 97:
+				case 111:
 					return -1
-				case 65:
+				case 79:
 					return -1
-				case 115:
+				case 105:
 					return -1
-				case 83:
+				case 73:
 					return -1
-				case 116:
+				case 110:
 					return -1
-				case 84:
+				case 78:
 					return -1
 				}
 				return -1
 			},
 		}, []int{ /* Start-of-input transitions */ -1, -1, -1, -1, -1}, []int{ /* End-of-input transitions */ -1, -1, -1, -1, -1}, nil},
 
-		// [lL][eE][fF][tT]
-		{[]bool{false, false, false, false, true}, []func(rune) int{ // Transitions
+		// [kK][eE][yY]
+		{[]bool{false, false, false, true}, []func(rune) int{ // Transitions
 			func(r rune) int {
 				switch r {
-				case 108:
+				case 107:
 					return 1
-				case 76:
+				case 75:
 					return 1
 				case 101:
 					return -1
 				case 69:
 					return -1
-				case 102:
-					return -1
-				case 70:
-					return -1
-				case 116:
+				case 121:
 					return -1
-				case 84:
+				case 89:
 					return -1
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
-				case 108:
+				case 107:
 					return -1
-				case 76:
+				case 75:
 					return -1
 				case 101:
 					return 2
 				case 69:
 					return 2
-				case 102:
-					return -1
-				case 70:
-					return -1
-				case 116:
+				case 121:
 					return -1
-				case 84:
+				case 89:
 					return -1
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
-				case 108:
+				case 107:
 					return -1
-				case 76:
+				case 75:
 					return -1
 				case 101:
 					return -1
 				case 69:
 					return -1
-				case 102:
+				case 121:
 					return 3
-				case 70:
+				case 89:
 					return 3
-				case 116:
-					return -1
-				case 84:
-					return -1
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
-				case 108:
+				case 107:
 					return -1
-				case 76:
+				case 75:
 					return -1
 				case 101:
 					return -1
 				case 69:
 					return -1
-				case 102:
+				case 121:
 					return -1
-				case 70:
+				case 89:
 					return -1
-				case 116:
-					return 4
-				case 84:
-					return 4
 				}
 				return -1
 			},
+		}, []int{ /* Start-of-input transitions */ -1, -1, -1, -1}, []int{ /* End-of-input transitions */ -1, -1, -1, -1}, nil},
+
+		// [kK][eE][yY][sS]
+		{[]bool{false, false, false, false, true}, []func(rune) int{ // Transitions
 			func(r rune) int {
 				switch r {
-				case 108:
-					return -1
-				case 76:
-					return -1
+				case 107:
+					return 1
+				case 75:
+					return 1
 				case 101:
 					return -1
 				case 69:
 					return -1
-				case 102:
+				case 121:
 					return -1
-				case 70:
+				case 89:
 					return -1
-				case 116:
+				case 115:
 					return -1
-				case 84:
+				case 83:
 					return -1
 				}
 				return -1
 			},
-		}, []int{ /* Start-of-input transitions */ -1, -1, -1, -1, -1}, []int{ /* End-of-input transitions */ -1, -1, -1, -1, -1}, nil},
-
-		// [lL][eE][tT]
-		{[]bool{false, false, false, true}, []func(rune) int{ // Transitions
 			func(r rune) int {
 				switch r {
-				case 108:
-					return 1
-				case 76:
-					return 1
-				case 101:
+				case 107:
+					return -1
+				case 75:
 					return -1
+				case 101:
+					return 2
 				case 69:
+					return 2
+				case 121:
 					return -1
-				case 116:
+				case 89:
 					return -1
-				case 84:
+				case 115:
+					return -1
+				case 83:
 					return -1
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
-				case 108:
+				case 107:
 					return -1
-				case 76:
+				case 75:
 					return -1
 				case 101:
-					return 2
+					return -1
 				case 69:
-					return 2
-				case 116:
 					return -1
-				case 84:
+				case 121:
+					return 3
+				case 89:
+					return 3
+				case 115:
+					return -1
+				case 83:
 					return -1
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
-				case 108:
+				case 107:
 					return -1
-				case 76:
+				case 75:
 					return -1
 				case 101:
 					return -1
 				case 69:
 					return -1
-				case 116:
-					return 3
-				case 84:
-					return 3
+				case 121:
+					return -1
+				case 89:
+					return -1
+				case 115:
+					return 4
+				case 83:
+					return 4
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
-				case 108:
+				case 107:
 					return -1
-				case 76:
+				case 75:
 					return -1
 				case 101:
 					return -1
 				case 69:
 					return -1
-				case 116:
+				case 121:
 					return -1
-				case 84:
+				case 89:
+					return -1
+				case 115:
+					return -1
+				case 83:
 					return -1
 				}
 				return -1
 			},
-		}, []int{ /* Start-of-input transitions */ -1, -1, -1, -1}, []int{ /* End-of-input transitions */ -1, -1, -1, -1}, nil},
+		}, []int{ /* Start-of-input transitions */ -1, -1, -1, -1, -1}, []int{ /* End-of-input transitions */ -1, -1, -1, -1, -1}, nil},
 
-		// [lL][eE][tT][tT][iI][nN][gG]
-		{[]bool{false, false, false, false, false, false, false, true}, []func(rune) int{ // Transitions
+		// [kK][eE][yY][sS][pP][aA][cC][eE]
+		{[]bool{false, false, false, false, false, false, false, false, true}, []func(rune) int{ // Transitions
 			func(r rune) int {
 				switch r {
-				case 108:
+				case 101:
+					return -1
+				case 112:
+					return -1
+				case 67:
+					return -1
+				case 107:
 					return 1
-				case 76:
+				case 75:
 					return 1
 				case 69:
 					return -1
-				case 116:
-					return -1
-				case 84:
+				case 83:
 					return -1
-				case 105:
+				case 97:
 					return -1
-				case 78:
+				case 115:
 					return -1
-				case 71:
+				case 80:
 					return -1
-				case 101:
+				case 65:
 					return -1
-				case 73:
+				case 99:
 					return -1
-				case 110:
+				case 121:
 					return -1
-				case 103:
+				case 89:
 					return -1
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
+				case 121:
+					return -1
+				case 89:
+					return -1
 				case 101:
 					return 2
-				case 73:
-					return -1
-				case 110:
+				case 112:
 					return -1
-				case 103:
+				case 67:
 					return -1
-				case 108:
+				case 107:
 					return -1
-				case 76:
+				case 75:
 					return -1
 				case 69:
 					return 2
-				case 116:
+				case 83:
 					return -1
-				case 84:
+				case 97:
 					return -1
-				case 105:
+				case 115:
 					return -1
-				case 78:
+				case 80:
 					return -1
-				case 71:
+				case 65:
+					return -1
+				case 99:
 					return -1
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
-				case 108:
+				case 101:
 					return -1
-				case 76:
+				case 112:
 					return -1
-				case 69:
+				case 67:
 					return -1
-				case 116:
-					return 3
-				case 84:
-					return 3
-				case 105:
+				case 107:
 					return -1
-				case 78:
+				case 75:
 					return -1
-				case 71:
+				case 69:
 					return -1
-				case 101:
+				case 83:
 					return -1
-				case 73:
+				case 97:
 					return -1
-				case 110:
+				case 115:
 					return -1
-				case 103:
+				case 80:
 					return -1
-				}
-				return -1
-			},
-			func(r rune) int {
-				switch r {
-				case 108:
+				case 65:
 					return -1
-				case 76:
+				case 99:
 					return -1
-				case 69:
+				case 121:
+					return 3
+				case 89:
+					return 3
+				}
+				return -1
+			},
+			func(r rune) int {
+				switch r {
+				case 121:
 					return -1
-				case 116:
-					return 4
-				case 84:
-					return 4
-				case 105:
+				case 89:
 					return -1
-				case 78:
+				case 101:
 					return -1
-				case 71:
+				case 112:
 					return -1
-				case 101:
+				case 67:
 					return -1
-				case 73:
+				case 107:
 					return -1
-				case 110:
+				case 75:
 					return -1
-				case 103:
+				case 69:
+					return -1
+				case 83:
+					return 4
+				case 97:
+					return -1
+				case 115:
+					return 4
+				case 80:
+					return -1
+				case 65:
+					return -1
+				case 99:
 					return -1
 				}
 				return -1
@@ -15800,244 +15665,314 @@ func NewLexerWithInit(in io.Reader, initFun func(*Lexer)) *Lexer {
 				switch r {
 				case 101:
 					return -1
-				case 73:
+				case 112:
 					return 5
-				case 110:
-					return -1
-				case 103:
+				case 67:
 					return -1
-				case 108:
+				case 107:
 					return -1
-				case 76:
+				case 75:
 					return -1
 				case 69:
 					return -1
-				case 116:
+				case 83:
 					return -1
-				case 84:
+				case 97:
 					return -1
-				case 105:
+				case 115:
+					return -1
+				case 80:
 					return 5
-				case 78:
+				case 65:
 					return -1
-				case 71:
+				case 99:
+					return -1
+				case 121:
+					return -1
+				case 89:
 					return -1
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
-				case 108:
+				case 115:
 					return -1
-				case 76:
+				case 80:
 					return -1
-				case 69:
+				case 65:
+					return 6
+				case 99:
 					return -1
-				case 116:
+				case 121:
 					return -1
-				case 84:
+				case 89:
 					return -1
-				case 105:
+				case 101:
 					return -1
-				case 78:
-					return 6
-				case 71:
+				case 112:
 					return -1
-				case 101:
+				case 67:
 					return -1
-				case 73:
+				case 107:
 					return -1
-				case 110:
-					return 6
-				case 103:
+				case 75:
+					return -1
+				case 69:
+					return -1
+				case 83:
 					return -1
+				case 97:
+					return 6
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
-				case 108:
+				case 115:
 					return -1
-				case 76:
+				case 80:
 					return -1
-				case 69:
+				case 65:
 					return -1
-				case 116:
+				case 99:
+					return 7
+				case 121:
 					return -1
-				case 84:
+				case 89:
 					return -1
-				case 105:
+				case 101:
 					return -1
-				case 78:
+				case 112:
 					return -1
-				case 71:
+				case 67:
 					return 7
-				case 101:
+				case 107:
 					return -1
-				case 73:
+				case 75:
 					return -1
-				case 110:
+				case 69:
+					return -1
+				case 83:
+					return -1
+				case 97:
 					return -1
-				case 103:
-					return 7
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
+				case 115:
+					return -1
+				case 80:
+					return -1
+				case 65:
+					return -1
+				case 99:
+					return -1
+				case 121:
+					return -1
+				case 89:
+					return -1
 				case 101:
+					return 8
+				case 112:
 					return -1
-				case 73:
+				case 67:
 					return -1
-				case 110:
+				case 107:
 					return -1
-				case 103:
+				case 75:
 					return -1
-				case 108:
+				case 69:
+					return 8
+				case 83:
 					return -1
-				case 76:
+				case 97:
+					return -1
+				}
+				return -1
+			},
+			func(r rune) int {
+				switch r {
+				case 121:
+					return -1
+				case 89:
+					return -1
+				case 101:
+					return -1
+				case 112:
+					return -1
+				case 67:
+					return -1
+				case 107:
+					return -1
+				case 75:
 					return -1
 				case 69:
 					return -1
-				case 116:
+				case 83:
 					return -1
-				case 84:
+				case 97:
 					return -1
-				case 105:
+				case 115:
 					return -1
-				case 78:
+				case 80:
 					return -1
-				case 71:
+				case 65:
+					return -1
+				case 99:
 					return -1
 				}
 				return -1
 			},
-		}, []int{ /* Start-of-input transitions */ -1, -1, -1, -1, -1, -1, -1, -1}, []int{ /* End-of-input transitions */ -1, -1, -1, -1, -1, -1, -1, -1}, nil},
+		}, []int{ /* Start-of-input transitions */ -1, -1, -1, -1, -1, -1, -1, -1, -1}, []int{ /* End-of-input transitions */ -1, -1, -1, -1, -1, -1, -1, -1, -1}, nil},
 
-		// [lL][iI][kK][eE]
-		{[]bool{false, false, false, false, true}, []func(rune) int{ // Transitions
+		// [kK][nN][oO][wW][nN]
+		{[]bool{false, false, false, false, false, true}, []func(rune) int{ // Transitions
 			func(r rune) int {
 				switch r {
-				case 108:
+				case 107:
 					return 1
-				case 76:
+				case 75:
 					return 1
-				case 105:
+				case 110:
 					return -1
-				case 73:
+				case 78:
 					return -1
-				case 107:
+				case 111:
 					return -1
-				case 75:
+				case 79:
 					return -1
-				case 101:
+				case 119:
 					return -1
-				case 69:
+				case 87:
 					return -1
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
-				case 108:
+				case 107:
 					return -1
-				case 76:
+				case 75:
 					return -1
-				case 105:
+				case 110:
 					return 2
-				case 73:
+				case 78:
 					return 2
-				case 107:
+				case 111:
 					return -1
-				case 75:
+				case 79:
 					return -1
-				case 101:
+				case 119:
 					return -1
-				case 69:
+				case 87:
 					return -1
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
-				case 108:
+				case 107:
 					return -1
-				case 76:
+				case 75:
 					return -1
-				case 105:
+				case 110:
 					return -1
-				case 73:
+				case 78:
 					return -1
-				case 107:
+				case 111:
 					return 3
-				case 75:
+				case 79:
 					return 3
-				case 101:
+				case 119:
 					return -1
-				case 69:
+				case 87:
 					return -1
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
-				case 108:
+				case 107:
 					return -1
-				case 76:
+				case 75:
 					return -1
-				case 105:
+				case 110:
 					return -1
-				case 73:
+				case 78:
 					return -1
-				case 107:
+				case 111:
 					return -1
-				case 75:
+				case 79:
 					return -1
-				case 101:
+				case 119:
 					return 4
-				case 69:
+				case 87:
 					return 4
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
-				case 108:
+				case 107:
 					return -1
-				case 76:
+				case 75:
 					return -1
-				case 105:
+				case 110:
+					return 5
+				case 78:
+					return 5
+				case 111:
 					return -1
-				case 73:
+				case 79:
 					return -1
+				case 119:
+					return -1
+				case 87:
+					return -1
+				}
+				return -1
+			},
+			func(r rune) int {
+				switch r {
 				case 107:
 					return -1
 				case 75:
 					return -1
-				case 101:
+				case 110:
 					return -1
-				case 69:
+				case 78:
+					return -1
+				case 111:
+					return -1
+				case 79:
+					return -1
+				case 119:
+					return -1
+				case 87:
 					return -1
 				}
 				return -1
 			},
-		}, []int{ /* Start-of-input transitions */ -1, -1, -1, -1, -1}, []int{ /* End-of-input transitions */ -1, -1, -1, -1, -1}, nil},
+		}, []int{ /* Start-of-input transitions */ -1, -1, -1, -1, -1, -1}, []int{ /* End-of-input transitions */ -1, -1, -1, -1, -1, -1}, nil},
 
-		// [lL][iI][mM][iI][tT]
-		{[]bool{false, false, false, false, false, true}, []func(rune) int{ // Transitions
+		// [lL][aA][sS][tT]
+		{[]bool{false, false, false, false, true}, []func(rune) int{ // Transitions
 			func(r rune) int {
 				switch r {
 				case 108:
 					return 1
 				case 76:
 					return 1
-				case 105:
+				case 97:
 					return -1
-				case 73:
+				case 65:
 					return -1
-				case 109:
+				case 115:
 					return -1
-				case 77:
+				case 83:
 					return -1
 				case 116:
 					return -1
@@ -16052,13 +15987,13 @@ func NewLexerWithInit(in io.Reader, initFun func(*Lexer)) *Lexer {
 					return -1
 				case 76:
 					return -1
-				case 105:
+				case 97:
 					return 2
-				case 73:
+				case 65:
 					return 2
-				case 109:
+				case 115:
 					return -1
-				case 77:
+				case 83:
 					return -1
 				case 116:
 					return -1
@@ -16073,13 +16008,13 @@ func NewLexerWithInit(in io.Reader, initFun func(*Lexer)) *Lexer {
 					return -1
 				case 76:
 					return -1
-				case 105:
+				case 97:
 					return -1
-				case 73:
+				case 65:
 					return -1
-				case 109:
+				case 115:
 					return 3
-				case 77:
+				case 83:
 					return 3
 				case 116:
 					return -1
@@ -16094,18 +16029,18 @@ func NewLexerWithInit(in io.Reader, initFun func(*Lexer)) *Lexer {
 					return -1
 				case 76:
 					return -1
-				case 105:
-					return 4
-				case 73:
-					return 4
-				case 109:
+				case 97:
 					return -1
-				case 77:
+				case 65:
 					return -1
-				case 116:
+				case 115:
 					return -1
-				case 84:
+				case 83:
 					return -1
+				case 116:
+					return 4
+				case 84:
+					return 4
 				}
 				return -1
 			},
@@ -16115,34 +16050,38 @@ func NewLexerWithInit(in io.Reader, initFun func(*Lexer)) *Lexer {
 					return -1
 				case 76:
 					return -1
-				case 105:
+				case 97:
 					return -1
-				case 73:
+				case 65:
 					return -1
-				case 109:
+				case 115:
 					return -1
-				case 77:
+				case 83:
 					return -1
 				case 116:
-					return 5
+					return -1
 				case 84:
-					return 5
+					return -1
 				}
 				return -1
 			},
+		}, []int{ /* Start-of-input transitions */ -1, -1, -1, -1, -1}, []int{ /* End-of-input transitions */ -1, -1, -1, -1, -1}, nil},
+
+		// [lL][eE][fF][tT]
+		{[]bool{false, false, false, false, true}, []func(rune) int{ // Transitions
 			func(r rune) int {
 				switch r {
 				case 108:
-					return -1
+					return 1
 				case 76:
+					return 1
+				case 101:
 					return -1
-				case 105:
-					return -1
-				case 73:
+				case 69:
 					return -1
-				case 109:
+				case 102:
 					return -1
-				case 77:
+				case 70:
 					return -1
 				case 116:
 					return -1
@@ -16151,23 +16090,23 @@ func NewLexerWithInit(in io.Reader, initFun func(*Lexer)) *Lexer {
 				}
 				return -1
 			},
-		}, []int{ /* Start-of-input transitions */ -1, -1, -1, -1, -1, -1}, []int{ /* End-of-input transitions */ -1, -1, -1, -1, -1, -1}, nil},
-
-		// [lL][sS][mM]
-		{[]bool{false, false, false, true}, []func(rune) int{ // Transitions
 			func(r rune) int {
 				switch r {
 				case 108:
-					return 1
+					return -1
 				case 76:
-					return 1
-				case 115:
 					return -1
-				case 83:
+				case 101:
+					return 2
+				case 69:
+					return 2
+				case 102:
 					return -1
-				case 109:
+				case 70:
 					return -1
-				case 77:
+				case 116:
+					return -1
+				case 84:
 					return -1
 				}
 				return -1
@@ -16178,13 +16117,17 @@ func NewLexerWithInit(in io.Reader, initFun func(*Lexer)) *Lexer {
 					return -1
 				case 76:
 					return -1
-				case 115:
-					return 2
-				case 83:
-					return 2
-				case 109:
+				case 101:
 					return -1
-				case 77:
+				case 69:
+					return -1
+				case 102:
+					return 3
+				case 70:
+					return 3
+				case 116:
+					return -1
+				case 84:
 					return -1
 				}
 				return -1
@@ -16195,14 +16138,18 @@ func NewLexerWithInit(in io.Reader, initFun func(*Lexer)) *Lexer {
 					return -1
 				case 76:
 					return -1
-				case 115:
+				case 101:
 					return -1
-				case 83:
+				case 69:
 					return -1
-				case 109:
-					return 3
-				case 77:
-					return 3
+				case 102:
+					return -1
+				case 70:
+					return -1
+				case 116:
+					return 4
+				case 84:
+					return 4
 				}
 				return -1
 			},
@@ -16212,235 +16159,1796 @@ func NewLexerWithInit(in io.Reader, initFun func(*Lexer)) *Lexer {
 					return -1
 				case 76:
 					return -1
-				case 115:
+				case 101:
 					return -1
-				case 83:
+				case 69:
 					return -1
-				case 109:
+				case 102:
 					return -1
-				case 77:
+				case 70:
+					return -1
+				case 116:
+					return -1
+				case 84:
 					return -1
 				}
 				return -1
 			},
-		}, []int{ /* Start-of-input transitions */ -1, -1, -1, -1}, []int{ /* End-of-input transitions */ -1, -1, -1, -1}, nil},
+		}, []int{ /* Start-of-input transitions */ -1, -1, -1, -1, -1}, []int{ /* End-of-input transitions */ -1, -1, -1, -1, -1}, nil},
 
-		// [mM][aA][pP]
+		// [lL][eE][tT]
 		{[]bool{false, false, false, true}, []func(rune) int{ // Transitions
 			func(r rune) int {
 				switch r {
-				case 109:
+				case 108:
 					return 1
-				case 77:
+				case 76:
 					return 1
-				case 97:
+				case 101:
 					return -1
-				case 65:
+				case 69:
 					return -1
-				case 112:
+				case 116:
 					return -1
-				case 80:
+				case 84:
 					return -1
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
-				case 109:
+				case 108:
 					return -1
-				case 77:
+				case 76:
 					return -1
-				case 97:
+				case 101:
 					return 2
-				case 65:
+				case 69:
 					return 2
-				case 112:
+				case 116:
 					return -1
-				case 80:
+				case 84:
 					return -1
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
-				case 109:
+				case 108:
 					return -1
-				case 77:
+				case 76:
 					return -1
-				case 97:
+				case 101:
 					return -1
-				case 65:
+				case 69:
 					return -1
-				case 112:
+				case 116:
 					return 3
-				case 80:
+				case 84:
 					return 3
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
-				case 109:
+				case 108:
+					return -1
+				case 76:
+					return -1
+				case 101:
+					return -1
+				case 69:
+					return -1
+				case 116:
+					return -1
+				case 84:
+					return -1
+				}
+				return -1
+			},
+		}, []int{ /* Start-of-input transitions */ -1, -1, -1, -1}, []int{ /* End-of-input transitions */ -1, -1, -1, -1}, nil},
+
+		// [lL][eE][tT][tT][iI][nN][gG]
+		{[]bool{false, false, false, false, false, false, false, true}, []func(rune) int{ // Transitions
+			func(r rune) int {
+				switch r {
+				case 108:
+					return 1
+				case 76:
+					return 1
+				case 69:
+					return -1
+				case 116:
+					return -1
+				case 84:
+					return -1
+				case 105:
+					return -1
+				case 78:
+					return -1
+				case 71:
+					return -1
+				case 101:
+					return -1
+				case 73:
+					return -1
+				case 110:
+					return -1
+				case 103:
+					return -1
+				}
+				return -1
+			},
+			func(r rune) int {
+				switch r {
+				case 101:
+					return 2
+				case 73:
+					return -1
+				case 110:
+					return -1
+				case 103:
+					return -1
+				case 108:
+					return -1
+				case 76:
+					return -1
+				case 69:
+					return 2
+				case 116:
+					return -1
+				case 84:
+					return -1
+				case 105:
+					return -1
+				case 78:
+					return -1
+				case 71:
+					return -1
+				}
+				return -1
+			},
+			func(r rune) int {
+				switch r {
+				case 108:
+					return -1
+				case 76:
+					return -1
+				case 69:
+					return -1
+				case 116:
+					return 3
+				case 84:
+					return 3
+				case 105:
+					return -1
+				case 78:
+					return -1
+				case 71:
+					return -1
+				case 101:
+					return -1
+				case 73:
+					return -1
+				case 110:
+					return -1
+				case 103:
+					return -1
+				}
+				return -1
+			},
+			func(r rune) int {
+				switch r {
+				case 108:
+					return -1
+				case 76:
+					return -1
+				case 69:
+					return -1
+				case 116:
+					return 4
+				case 84:
+					return 4
+				case 105:
+					return -1
+				case 78:
+					return -1
+				case 71:
+					return -1
+				case 101:
+					return -1
+				case 73:
+					return -1
+				case 110:
+					return -1
+				case 103:
+					return -1
+				}
+				return -1
+			},
+			func(r rune) int {
+				switch r {
+				case 101:
+					return -1
+				case 73:
+					return 5
+				case 110:
+					return -1
+				case 103:
+					return -1
+				case 108:
+					return -1
+				case 76:
+					return -1
+				case 69:
+					return -1
+				case 116:
+					return -1
+				case 84:
+					return -1
+				case 105:
+					return 5
+				case 78:
+					return -1
+				case 71:
+					return -1
+				}
+				return -1
+			},
+			func(r rune) int {
+				switch r {
+				case 108:
+					return -1
+				case 76:
+					return -1
+				case 69:
+					return -1
+				case 116:
+					return -1
+				case 84:
+					return -1
+				case 105:
+					return -1
+				case 78:
+					return 6
+				case 71:
+					return -1
+				case 101:
+					return -1
+				case 73:
+					return -1
+				case 110:
+					return 6
+				case 103:
+					return -1
+				}
+				return -1
+			},
+			func(r rune) int {
+				switch r {
+				case 108:
+					return -1
+				case 76:
+					return -1
+				case 69:
+					return -1
+				case 116:
+					return -1
+				case 84:
+					return -1
+				case 105:
+					return -1
+				case 78:
+					return -1
+				case 71:
+					return 7
+				case 101:
+					return -1
+				case 73:
+					return -1
+				case 110:
+					return -1
+				case 103:
+					return 7
+				}
+				return -1
+			},
+			func(r rune) int {
+				switch r {
+				case 101:
+					return -1
+				case 73:
+					return -1
+				case 110:
+					return -1
+				case 103:
+					return -1
+				case 108:
+					return -1
+				case 76:
+					return -1
+				case 69:
+					return -1
+				case 116:
+					return -1
+				case 84:
+					return -1
+				case 105:
+					return -1
+				case 78:
+					return -1
+				case 71:
+					return -1
+				}
+				return -1
+			},
+		}, []int{ /* Start-of-input transitions */ -1, -1, -1, -1, -1, -1, -1, -1}, []int{ /* End-of-input transitions */ -1, -1, -1, -1, -1, -1, -1, -1}, nil},
+
+		// [lL][iI][kK][eE]
+		{[]bool{false, false, false, false, true}, []func(rune) int{ // Transitions
+			func(r rune) int {
+				switch r {
+				case 108:
+					return 1
+				case 76:
+					return 1
+				case 105:
+					return -1
+				case 73:
+					return -1
+				case 107:
+					return -1
+				case 75:
+					return -1
+				case 101:
+					return -1
+				case 69:
+					return -1
+				}
+				return -1
+			},
+			func(r rune) int {
+				switch r {
+				case 108:
+					return -1
+				case 76:
+					return -1
+				case 105:
+					return 2
+				case 73:
+					return 2
+				case 107:
+					return -1
+				case 75:
+					return -1
+				case 101:
+					return -1
+				case 69:
+					return -1
+				}
+				return -1
+			},
+			func(r rune) int {
+				switch r {
+				case 108:
+					return -1
+				case 76:
+					return -1
+				case 105:
+					return -1
+				case 73:
+					return -1
+				case 107:
+					return 3
+				case 75:
+					return 3
+				case 101:
+					return -1
+				case 69:
+					return -1
+				}
+				return -1
+			},
+			func(r rune) int {
+				switch r {
+				case 108:
+					return -1
+				case 76:
+					return -1
+				case 105:
+					return -1
+				case 73:
+					return -1
+				case 107:
+					return -1
+				case 75:
+					return -1
+				case 101:
+					return 4
+				case 69:
+					return 4
+				}
+				return -1
+			},
+			func(r rune) int {
+				switch r {
+				case 108:
+					return -1
+				case 76:
+					return -1
+				case 105:
+					return -1
+				case 73:
+					return -1
+				case 107:
+					return -1
+				case 75:
+					return -1
+				case 101:
+					return -1
+				case 69:
+					return -1
+				}
+				return -1
+			},
+		}, []int{ /* Start-of-input transitions */ -1, -1, -1, -1, -1}, []int{ /* End-of-input transitions */ -1, -1, -1, -1, -1}, nil},
+
+		// [lL][iI][mM][iI][tT]
+		{[]bool{false, false, false, false, false, true}, []func(rune) int{ // Transitions
+			func(r rune) int {
+				switch r {
+				case 108:
+					return 1
+				case 76:
+					return 1
+				case 105:
+					return -1
+				case 73:
+					return -1
+				case 109:
+					return -1
+				case 77:
+					return -1
+				case 116:
+					return -1
+				case 84:
+					return -1
+				}
+				return -1
+			},
+			func(r rune) int {
+				switch r {
+				case 108:
+					return -1
+				case 76:
+					return -1
+				case 105:
+					return 2
+				case 73:
+					return 2
+				case 109:
+					return -1
+				case 77:
+					return -1
+				case 116:
+					return -1
+				case 84:
+					return -1
+				}
+				return -1
+			},
+			func(r rune) int {
+				switch r {
+				case 108:
+					return -1
+				case 76:
+					return -1
+				case 105:
+					return -1
+				case 73:
+					return -1
+				case 109:
+					return 3
+				case 77:
+					return 3
+				case 116:
+					return -1
+				case 84:
+					return -1
+				}
+				return -1
+			},
+			func(r rune) int {
+				switch r {
+				case 108:
+					return -1
+				case 76:
+					return -1
+				case 105:
+					return 4
+				case 73:
+					return 4
+				case 109:
+					return -1
+				case 77:
+					return -1
+				case 116:
+					return -1
+				case 84:
+					return -1
+				}
+				return -1
+			},
+			func(r rune) int {
+				switch r {
+				case 108:
+					return -1
+				case 76:
+					return -1
+				case 105:
+					return -1
+				case 73:
+					return -1
+				case 109:
+					return -1
+				case 77:
+					return -1
+				case 116:
+					return 5
+				case 84:
+					return 5
+				}
+				return -1
+			},
+			func(r rune) int {
+				switch r {
+				case 108:
+					return -1
+				case 76:
+					return -1
+				case 105:
+					return -1
+				case 73:
+					return -1
+				case 109:
+					return -1
+				case 77:
+					return -1
+				case 116:
+					return -1
+				case 84:
+					return -1
+				}
+				return -1
+			},
+		}, []int{ /* Start-of-input transitions */ -1, -1, -1, -1, -1, -1}, []int{ /* End-of-input transitions */ -1, -1, -1, -1, -1, -1}, nil},
+
+		// [lL][sS][mM]
+		{[]bool{false, false, false, true}, []func(rune) int{ // Transitions
+			func(r rune) int {
+				switch r {
+				case 108:
+					return 1
+				case 76:
+					return 1
+				case 115:
+					return -1
+				case 83:
+					return -1
+				case 109:
+					return -1
+				case 77:
+					return -1
+				}
+				return -1
+			},
+			func(r rune) int {
+				switch r {
+				case 108:
+					return -1
+				case 76:
+					return -1
+				case 115:
+					return 2
+				case 83:
+					return 2
+				case 109:
+					return -1
+				case 77:
+					return -1
+				}
+				return -1
+			},
+			func(r rune) int {
+				switch r {
+				case 108:
+					return -1
+				case 76:
+					return -1
+				case 115:
+					return -1
+				case 83:
+					return -1
+				case 109:
+					return 3
+				case 77:
+					return 3
+				}
+				return -1
+			},
+			func(r rune) int {
+				switch r {
+				case 108:
+					return -1
+				case 76:
+					return -1
+				case 115:
+					return -1
+				case 83:
+					return -1
+				case 109:
+					return -1
+				case 77:
+					return -1
+				}
+				return -1
+			},
+		}, []int{ /* Start-of-input transitions */ -1, -1, -1, -1}, []int{ /* End-of-input transitions */ -1, -1, -1, -1}, nil},
+
+		// [mM][aA][pP]
+		{[]bool{false, false, false, true}, []func(rune) int{ // Transitions
+			func(r rune) int {
+				switch r {
+				case 109:
+					return 1
+				case 77:
+					return 1
+				case 97:
+					return -1
+				case 65:
+					return -1
+				case 112:
+					return -1
+				case 80:
+					return -1
+				}
+				return -1
+			},
+			func(r rune) int {
+				switch r {
+				case 109:
+					return -1
+				case 77:
+					return -1
+				case 97:
+					return 2
+				case 65:
+					return 2
+				case 112:
+					return -1
+				case 80:
+					return -1
+				}
+				return -1
+			},
+			func(r rune) int {
+				switch r {
+				case 109:
+					return -1
+				case 77:
+					return -1
+				case 97:
+					return -1
+				case 65:
+					return -1
+				case 112:
+					return 3
+				case 80:
+					return 3
+				}
+				return -1
+			},
+			func(r rune) int {
+				switch r {
+				case 109:
+					return -1
+				case 77:
+					return -1
+				case 97:
+					return -1
+				case 65:
+					return -1
+				case 112:
+					return -1
+				case 80:
+					return -1
+				}
+				return -1
+			},
+		}, []int{ /* Start-of-input transitions */ -1, -1, -1, -1}, []int{ /* End-of-input transitions */ -1, -1, -1, -1}, nil},
+
+		// [mM][aA][pP][pP][iI][nN][gG]
+		{[]bool{false, false, false, false, false, false, false, true}, []func(rune) int{ // Transitions
+			func(r rune) int {
+				switch r {
+				case 109:
+					return 1
+				case 97:
+					return -1
+				case 65:
+					return -1
+				case 112:
+					return -1
+				case 80:
+					return -1
+				case 105:
+					return -1
+				case 71:
+					return -1
+				case 77:
+					return 1
+				case 73:
+					return -1
+				case 110:
+					return -1
+				case 78:
+					return -1
+				case 103:
+					return -1
+				}
+				return -1
+			},
+			func(r rune) int {
+				switch r {
+				case 109:
+					return -1
+				case 97:
+					return 2
+				case 65:
+					return 2
+				case 112:
+					return -1
+				case 80:
+					return -1
+				case 105:
+					return -1
+				case 71:
+					return -1
+				case 77:
+					return -1
+				case 73:
+					return -1
+				case 110:
+					return -1
+				case 78:
+					return -1
+				case 103:
+					return -1
+				}
+				return -1
+			},
+			func(r rune) int {
+				switch r {
+				case 77:
+					return -1
+				case 73:
+					return -1
+				case 110:
+					return -1
+				case 78:
+					return -1
+				case 103:
+					return -1
+				case 109:
+					return -1
+				case 97:
+					return -1
+				case 65:
+					return -1
+				case 112:
+					return 3
+				case 80:
+					return 3
+				case 105:
+					return -1
+				case 71:
+					return -1
+				}
+				return -1
+			},
+			func(r rune) int {
+				switch r {
+				case 77:
+					return -1
+				case 73:
+					return -1
+				case 110:
+					return -1
+				case 78:
+					return -1
+				case 103:
+					return -1
+				case 109:
+					return -1
+				case 97:
+					return -1
+				case 65:
+					return -1
+				case 112:
+					return 4
+				case 80:
+					return 4
+				case 105:
+					return -1
+				case 71:
+					return -1
+				}
+				return -1
+			},
+			func(r rune) int {
+				switch r {
+				case 109:
+					return -1
+				case 97:
+					return -1
+				case 65:
+					return -1
+				case 112:
+					return -1
+				case 80:
+					return -1
+				case 105:
+					return 5
+				case 71:
+					return -1
+				case 77:
+					return -1
+				case 73:
+					return 5
+				case 110:
+					return -1
+				case 78:
+					return -1
+				case 103:
+					return -1
+				}
+				return -1
+			},
+			func(r rune) int {
+				switch r {
+				case 109:
+					return -1
+				case 97:
+					return -1
+				case 65:
+					return -1
+				case 112:
+					return -1
+				case 80:
+					return -1
+				case 105:
+					return -1
+				case 71:
+					return -1
+				case 77:
+					return -1
+				case 73:
+					return -1
+				case 110:
+					return 6
+				case 78:
+					return 6
+				case 103:
+					return -1
+				}
+				return -1
+			},
+			func(r rune) int {
+				switch r {
+				case 77:
+					return -1
+				case 73:
+					return -1
+				case 110:
+					return -1
+				case 78:
+					return -1
+				case 103:
+					return 7
+				case 109:
+					return -1
+				case 97:
+					return -1
+				case 65:
+					return -1
+				case 112:
+					return -1
+				case 80:
+					return -1
+				case 105:
+					return -1
+				case 71:
+					return 7
+				}
+				return -1
+			},
+			func(r rune) int {
+				switch r {
+				case 109:
+					return -1
+				case 97:
+					return -1
+				case 65:
+					return -1
+				case 112:
+					return -1
+				case 80:
+					return -1
+				case 105:
+					return -1
+				case 71:
+					return -1
+				case 77:
+					return -1
+				case 73:
+					return -1
+				case 110:
+					return -1
+				case 78:
+					return -1
+				case 103:
+					return -1
+				}
+				return -1
+			},
+		}, []int{ /* Start-of-input transitions */ -1, -1, -1, -1, -1, -1, -1, -1}, []int{ /* End-of-input transitions */ -1, -1, -1, -1, -1, -1, -1, -1}, nil},
+
+		// [mM][aA][tT][cC][hH][eE][dD]
+		{[]bool{false, false, false, false, false, false, false, true}, []func(rune) int{ // Transitions
+			func(r rune) int {
+				switch r {
+				case 97:
+					return -1
+				case 65:
+					return -1
+				case 67:
+					return -1
+				case 116:
+					return -1
+				case 84:
+					return -1
+				case 104:
+					return -1
+				case 69:
+					return -1
+				case 68:
+					return -1
+				case 109:
+					return 1
+				case 99:
+					return -1
+				case 101:
+					return -1
+				case 100:
+					return -1
+				case 77:
+					return 1
+				case 72:
+					return -1
+				}
+				return -1
+			},
+			func(r rune) int {
+				switch r {
+				case 77:
+					return -1
+				case 72:
+					return -1
+				case 97:
+					return 2
+				case 65:
+					return 2
+				case 67:
+					return -1
+				case 116:
+					return -1
+				case 84:
+					return -1
+				case 104:
+					return -1
+				case 69:
+					return -1
+				case 68:
+					return -1
+				case 109:
+					return -1
+				case 99:
+					return -1
+				case 101:
+					return -1
+				case 100:
+					return -1
+				}
+				return -1
+			},
+			func(r rune) int {
+				switch r {
+				case 116:
+					return 3
+				case 84:
+					return 3
+				case 104:
+					return -1
+				case 69:
+					return -1
+				case 68:
+					return -1
+				case 109:
+					return -1
+				case 99:
+					return -1
+				case 101:
+					return -1
+				case 100:
+					return -1
+				case 77:
+					return -1
+				case 72:
+					return -1
+				case 97:
+					return -1
+				case 65:
+					return -1
+				case 67:
+					return -1
+				}
+				return -1
+			},
+			func(r rune) int {
+				switch r {
+				case 109:
+					return -1
+				case 99:
+					return 4
+				case 101:
+					return -1
+				case 100:
+					return -1
+				case 77:
+					return -1
+				case 72:
+					return -1
+				case 97:
+					return -1
+				case 65:
+					return -1
+				case 67:
+					return 4
+				case 116:
+					return -1
+				case 84:
+					return -1
+				case 104:
+					return -1
+				case 69:
+					return -1
+				case 68:
+					return -1
+				}
+				return -1
+			},
+			func(r rune) int {
+				switch r {
+				case 77:
+					return -1
+				case 72:
+					return 5
+				case 97:
+					return -1
+				case 65:
+					return -1
+				case 67:
+					return -1
+				case 116:
+					return -1
+				case 84:
+					return -1
+				case 104:
+					return 5
+				case 69:
+					return -1
+				case 68:
+					return -1
+				case 109:
+					return -1
+				case 99:
+					return -1
+				case 101:
+					return -1
+				case 100:
+					return -1
+				}
+				return -1
+			},
+			func(r rune) int {
+				switch r {
+				case 116:
+					return -1
+				case 84:
+					return -1
+				case 104:
+					return -1
+				case 69:
+					return 6
+				case 68:
+					return -1
+				case 109:
+					return -1
+				case 99:
+					return -1
+				case 101:
+					return 6
+				case 100:
+					return -1
+				case 77:
+					return -1
+				case 72:
+					return -1
+				case 97:
+					return -1
+				case 65:
+					return -1
+				case 67:
+					return -1
+				}
+				return -1
+			},
+			func(r rune) int {
+				switch r {
+				case 109:
+					return -1
+				case 99:
+					return -1
+				case 101:
+					return -1
+				case 100:
+					return 7
+				case 77:
+					return -1
+				case 72:
+					return -1
+				case 97:
+					return -1
+				case 65:
+					return -1
+				case 67:
+					return -1
+				case 116:
+					return -1
+				case 84:
+					return -1
+				case 104:
+					return -1
+				case 69:
+					return -1
+				case 68:
+					return 7
+				}
+				return -1
+			},
+			func(r rune) int {
+				switch r {
+				case 97:
+					return -1
+				case 65:
+					return -1
+				case 67:
+					return -1
+				case 116:
+					return -1
+				case 84:
+					return -1
+				case 104:
+					return -1
+				case 69:
+					return -1
+				case 68:
+					return -1
+				case 109:
+					return -1
+				case 99:
+					return -1
+				case 101:
+					return -1
+				case 100:
+					return -1
+				case 77:
+					return -1
+				case 72:
+					return -1
+				}
+				return -1
+			},
+		}, []int{ /* Start-of-input transitions */ -1, -1, -1, -1, -1, -1, -1, -1}, []int{ /* End-of-input transitions */ -1, -1, -1, -1, -1, -1, -1, -1}, nil},
+
+		// [mM][aA][tT][eE][rR][iI][aA][lL][iI][zZ][eE][dD]
+		{[]bool{false, false, false, false, false, false, false, false, false, false, false, false, true}, []func(rune) int{ // Transitions
+			func(r rune) int {
+				switch r {
+				case 114:
+					return -1
+				case 105:
+					return -1
+				case 73:
+					return -1
+				case 77:
+					return 1
+				case 84:
+					return -1
+				case 82:
+					return -1
+				case 100:
+					return -1
+				case 68:
+					return -1
+				case 101:
+					return -1
+				case 69:
+					return -1
+				case 122:
+					return -1
+				case 90:
+					return -1
+				case 109:
+					return 1
+				case 97:
+					return -1
+				case 65:
+					return -1
+				case 116:
+					return -1
+				case 108:
+					return -1
+				case 76:
+					return -1
+				}
+				return -1
+			},
+			func(r rune) int {
+				switch r {
+				case 101:
+					return -1
+				case 69:
+					return -1
+				case 122:
+					return -1
+				case 90:
+					return -1
+				case 109:
+					return -1
+				case 97:
+					return 2
+				case 65:
+					return 2
+				case 116:
+					return -1
+				case 108:
+					return -1
+				case 76:
+					return -1
+				case 114:
+					return -1
+				case 105:
+					return -1
+				case 73:
+					return -1
+				case 77:
+					return -1
+				case 84:
+					return -1
+				case 82:
+					return -1
+				case 100:
+					return -1
+				case 68:
+					return -1
+				}
+				return -1
+			},
+			func(r rune) int {
+				switch r {
+				case 109:
+					return -1
+				case 97:
+					return -1
+				case 65:
+					return -1
+				case 116:
+					return 3
+				case 108:
+					return -1
+				case 76:
+					return -1
+				case 114:
+					return -1
+				case 105:
+					return -1
+				case 73:
+					return -1
+				case 77:
+					return -1
+				case 84:
+					return 3
+				case 82:
+					return -1
+				case 100:
+					return -1
+				case 68:
+					return -1
+				case 101:
+					return -1
+				case 69:
+					return -1
+				case 122:
+					return -1
+				case 90:
+					return -1
+				}
+				return -1
+			},
+			func(r rune) int {
+				switch r {
+				case 114:
+					return -1
+				case 105:
+					return -1
+				case 73:
+					return -1
+				case 77:
+					return -1
+				case 84:
+					return -1
+				case 82:
+					return -1
+				case 100:
+					return -1
+				case 68:
+					return -1
+				case 101:
+					return 4
+				case 69:
+					return 4
+				case 122:
+					return -1
+				case 90:
+					return -1
+				case 109:
+					return -1
+				case 97:
+					return -1
+				case 65:
+					return -1
+				case 116:
+					return -1
+				case 108:
+					return -1
+				case 76:
+					return -1
+				}
+				return -1
+			},
+			func(r rune) int {
+				switch r {
+				case 109:
+					return -1
+				case 97:
+					return -1
+				case 65:
+					return -1
+				case 116:
+					return -1
+				case 108:
+					return -1
+				case 76:
+					return -1
+				case 114:
+					return 5
+				case 105:
+					return -1
+				case 73:
+					return -1
+				case 77:
+					return -1
+				case 84:
+					return -1
+				case 82:
+					return 5
+				case 100:
+					return -1
+				case 68:
+					return -1
+				case 101:
+					return -1
+				case 69:
+					return -1
+				case 122:
+					return -1
+				case 90:
+					return -1
+				}
+				return -1
+			},
+			func(r rune) int {
+				switch r {
+				case 114:
+					return -1
+				case 105:
+					return 6
+				case 73:
+					return 6
+				case 77:
+					return -1
+				case 84:
+					return -1
+				case 82:
+					return -1
+				case 100:
+					return -1
+				case 68:
+					return -1
+				case 101:
+					return -1
+				case 69:
+					return -1
+				case 122:
+					return -1
+				case 90:
+					return -1
+				case 109:
+					return -1
+				case 97:
+					return -1
+				case 65:
+					return -1
+				case 116:
+					return -1
+				case 108:
+					return -1
+				case 76:
+					return -1
+				}
+				return -1
+			},
+			func(r rune) int {
+				switch r {
+				case 101:
+					return -1
+				case 69:
+					return -1
+				case 122:
+					return -1
+				case 90:
+					return -1
+				case 109:
+					return -1
+				case 97:
+					return 7
+				case 65:
+					return 7
+				case 116:
+					return -1
+				case 108:
+					return -1
+				case 76:
+					return -1
+				case 114:
+					return -1
+				case 105:
+					return -1
+				case 73:
+					return -1
+				case 77:
+					return -1
+				case 84:
+					return -1
+				case 82:
+					return -1
+				case 100:
+					return -1
+				case 68:
+					return -1
+				}
+				return -1
+			},
+			func(r rune) int {
+				switch r {
+				case 109:
+					return -1
+				case 97:
+					return -1
+				case 65:
+					return -1
+				case 116:
+					return -1
+				case 108:
+					return 8
+				case 76:
+					return 8
+				case 114:
+					return -1
+				case 105:
+					return -1
+				case 73:
+					return -1
+				case 77:
+					return -1
+				case 84:
+					return -1
+				case 82:
+					return -1
+				case 100:
+					return -1
+				case 68:
+					return -1
+				case 101:
+					return -1
+				case 69:
+					return -1
+				case 122:
+					return -1
+				case 90:
+					return -1
+				}
+				return -1
+			},
+			func(r rune) int {
+				switch r {
+				case 114:
+					return -1
+				case 105:
+					return 9
+				case 73:
+					return 9
+				case 77:
+					return -1
+				case 84:
+					return -1
+				case 82:
+					return -1
+				case 100:
+					return -1
+				case 68:
+					return -1
+				case 101:
+					return -1
+				case 69:
+					return -1
+				case 122:
+					return -1
+				case 90:
+					return -1
+				case 109:
+					return -1
+				case 97:
+					return -1
+				case 65:
+					return -1
+				case 116:
+					return -1
+				case 108:
+					return -1
+				case 76:
+					return -1
+				}
+				return -1
+			},
+			func(r rune) int {
+				switch r {
+				case 101:
+					return -1
+				case 69:
+					return -1
+				case 122:
+					return 10
+				case 90:
+					return 10
+				case 109:
+					return -1
+				case 97:
+					return -1
+				case 65:
+					return -1
+				case 116:
+					return -1
+				case 108:
+					return -1
+				case 76:
+					return -1
+				case 114:
+					return -1
+				case 105:
+					return -1
+				case 73:
 					return -1
 				case 77:
 					return -1
-				case 97:
+				case 84:
 					return -1
-				case 65:
+				case 82:
 					return -1
-				case 112:
+				case 100:
 					return -1
-				case 80:
+				case 68:
 					return -1
 				}
 				return -1
 			},
-		}, []int{ /* Start-of-input transitions */ -1, -1, -1, -1}, []int{ /* End-of-input transitions */ -1, -1, -1, -1}, nil},
-
-		// [mM][aA][pP][pP][iI][nN][gG]
-		{[]bool{false, false, false, false, false, false, false, true}, []func(rune) int{ // Transitions
 			func(r rune) int {
 				switch r {
+				case 101:
+					return 11
+				case 69:
+					return 11
+				case 122:
+					return -1
+				case 90:
+					return -1
 				case 109:
-					return 1
+					return -1
 				case 97:
 					return -1
 				case 65:
 					return -1
-				case 112:
+				case 116:
 					return -1
-				case 80:
+				case 108:
+					return -1
+				case 76:
+					return -1
+				case 114:
 					return -1
 				case 105:
 					return -1
-				case 71:
+				case 73:
 					return -1
 				case 77:
-					return 1
-				case 73:
 					return -1
-				case 110:
+				case 84:
 					return -1
-				case 78:
+				case 82:
 					return -1
-				case 103:
+				case 100:
+					return -1
+				case 68:
 					return -1
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
+				case 101:
+					return -1
+				case 69:
+					return -1
+				case 122:
+					return -1
+				case 90:
+					return -1
 				case 109:
 					return -1
 				case 97:
-					return 2
+					return -1
 				case 65:
-					return 2
-				case 112:
 					return -1
-				case 80:
+				case 116:
 					return -1
-				case 105:
+				case 108:
 					return -1
-				case 71:
+				case 76:
 					return -1
-				case 77:
+				case 114:
+					return -1
+				case 105:
 					return -1
 				case 73:
 					return -1
-				case 110:
+				case 77:
 					return -1
-				case 78:
+				case 84:
 					return -1
-				case 103:
+				case 82:
 					return -1
+				case 100:
+					return 12
+				case 68:
+					return 12
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
-				case 77:
-					return -1
-				case 73:
+				case 109:
 					return -1
-				case 110:
+				case 97:
 					return -1
-				case 78:
+				case 65:
 					return -1
-				case 103:
+				case 116:
 					return -1
-				case 109:
+				case 108:
 					return -1
-				case 97:
+				case 76:
 					return -1
-				case 65:
+				case 114:
 					return -1
-				case 112:
-					return 3
-				case 80:
-					return 3
 				case 105:
 					return -1
-				case 71:
+				case 73:
 					return -1
-				}
-				return -1
-			},
-			func(r rune) int {
-				switch r {
 				case 77:
 					return -1
-				case 73:
-					return -1
-				case 110:
+				case 84:
 					return -1
-				case 78:
+				case 82:
 					return -1
-				case 103:
+				case 100:
 					return -1
-				case 109:
+				case 68:
 					return -1
-				case 97:
+				case 101:
 					return -1
-				case 65:
+				case 69:
 					return -1
-				case 112:
-					return 4
-				case 80:
-					return 4
-				case 105:
+				case 122:
 					return -1
-				case 71:
+				case 90:
 					return -1
 				}
 				return -1
 			},
+		}, []int{ /* Start-of-input transitions */ -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1}, []int{ /* End-of-input transitions */ -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1}, nil},
+
+		// [mM][eE][rR][gG][eE]
+		{[]bool{false, false, false, false, false, true}, []func(rune) int{ // Transitions
 			func(r rune) int {
 				switch r {
 				case 109:
+					return 1
+				case 77:
+					return 1
+				case 101:
 					return -1
-				case 97:
+				case 69:
 					return -1
-				case 65:
+				case 114:
 					return -1
-				case 112:
+				case 82:
 					return -1
-				case 80:
+				case 103:
 					return -1
-				case 105:
-					return 5
 				case 71:
 					return -1
+				}
+				return -1
+			},
+			func(r rune) int {
+				switch r {
+				case 109:
+					return -1
 				case 77:
 					return -1
-				case 73:
-					return 5
-				case 110:
+				case 101:
+					return 2
+				case 69:
+					return 2
+				case 114:
 					return -1
-				case 78:
+				case 82:
 					return -1
 				case 103:
 					return -1
+				case 71:
+					return -1
 				}
 				return -1
 			},
@@ -16448,57 +17956,62 @@ func NewLexerWithInit(in io.Reader, initFun func(*Lexer)) *Lexer {
 				switch r {
 				case 109:
 					return -1
-				case 97:
-					return -1
-				case 65:
+				case 77:
 					return -1
-				case 112:
+				case 101:
 					return -1
-				case 80:
+				case 69:
 					return -1
-				case 105:
+				case 114:
+					return 3
+				case 82:
+					return 3
+				case 103:
 					return -1
 				case 71:
 					return -1
-				case 77:
-					return -1
-				case 73:
-					return -1
-				case 110:
-					return 6
-				case 78:
-					return 6
-				case 103:
-					return -1
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
+				case 109:
+					return -1
 				case 77:
 					return -1
-				case 73:
+				case 101:
 					return -1
-				case 110:
+				case 69:
 					return -1
-				case 78:
+				case 114:
+					return -1
+				case 82:
 					return -1
 				case 103:
-					return 7
+					return 4
+				case 71:
+					return 4
+				}
+				return -1
+			},
+			func(r rune) int {
+				switch r {
 				case 109:
 					return -1
-				case 97:
-					return -1
-				case 65:
+				case 77:
 					return -1
-				case 112:
+				case 101:
+					return 5
+				case 69:
+					return 5
+				case 114:
 					return -1
-				case 80:
+				case 82:
 					return -1
-				case 105:
+				case 103:
 					return -1
 				case 71:
-					return 7
+					return -1
 				}
 				return -1
 			},
@@ -16506,64 +18019,98 @@ func NewLexerWithInit(in io.Reader, initFun func(*Lexer)) *Lexer {
 				switch r {
 				case 109:
 					return -1
-				case 97:
+				case 77:
 					return -1
-				case 65:
+				case 101:
 					return -1
-				case 112:
+				case 69:
 					return -1
-				case 80:
+				case 114:
 					return -1
-				case 105:
+				case 82:
+					return -1
+				case 103:
 					return -1
 				case 71:
 					return -1
+				}
+				return -1
+			},
+		}, []int{ /* Start-of-input transitions */ -1, -1, -1, -1, -1, -1}, []int{ /* End-of-input transitions */ -1, -1, -1, -1, -1, -1}, nil},
+
+		// [mM][iI][nN][uU][sS]
+		{[]bool{false, false, false, false, false, true}, []func(rune) int{ // Transitions
+			func(r rune) int {
+				switch r {
 				case 77:
+					return 1
+				case 105:
+					return -1
+				case 117:
+					return -1
+				case 85:
+					return -1
+				case 115:
 					return -1
+				case 109:
+					return 1
 				case 73:
 					return -1
 				case 110:
 					return -1
 				case 78:
 					return -1
-				case 103:
+				case 83:
 					return -1
 				}
 				return -1
 			},
-		}, []int{ /* Start-of-input transitions */ -1, -1, -1, -1, -1, -1, -1, -1}, []int{ /* End-of-input transitions */ -1, -1, -1, -1, -1, -1, -1, -1}, nil},
-
-		// [mM][aA][tT][cC][hH][eE][dD]
-		{[]bool{false, false, false, false, false, false, false, true}, []func(rune) int{ // Transitions
 			func(r rune) int {
 				switch r {
-				case 97:
+				case 109:
 					return -1
-				case 65:
+				case 73:
+					return 2
+				case 110:
 					return -1
-				case 67:
+				case 78:
 					return -1
-				case 116:
+				case 83:
 					return -1
-				case 84:
+				case 77:
 					return -1
-				case 104:
+				case 105:
+					return 2
+				case 117:
 					return -1
-				case 69:
+				case 85:
 					return -1
-				case 68:
+				case 115:
 					return -1
+				}
+				return -1
+			},
+			func(r rune) int {
+				switch r {
 				case 109:
-					return 1
-				case 99:
 					return -1
-				case 101:
+				case 73:
 					return -1
-				case 100:
+				case 110:
+					return 3
+				case 78:
+					return 3
+				case 83:
 					return -1
 				case 77:
-					return 1
-				case 72:
+					return -1
+				case 105:
+					return -1
+				case 117:
+					return -1
+				case 85:
+					return -1
+				case 115:
 					return -1
 				}
 				return -1
@@ -16572,65 +18119,49 @@ func NewLexerWithInit(in io.Reader, initFun func(*Lexer)) *Lexer {
 				switch r {
 				case 77:
 					return -1
-				case 72:
-					return -1
-				case 97:
-					return 2
-				case 65:
-					return 2
-				case 67:
-					return -1
-				case 116:
-					return -1
-				case 84:
-					return -1
-				case 104:
-					return -1
-				case 69:
+				case 105:
 					return -1
-				case 68:
+				case 117:
+					return 4
+				case 85:
+					return 4
+				case 115:
 					return -1
 				case 109:
 					return -1
-				case 99:
+				case 73:
 					return -1
-				case 101:
+				case 110:
 					return -1
-				case 100:
+				case 78:
+					return -1
+				case 83:
 					return -1
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
-				case 116:
-					return 3
-				case 84:
-					return 3
-				case 104:
-					return -1
-				case 69:
-					return -1
-				case 68:
-					return -1
 				case 109:
 					return -1
-				case 99:
+				case 73:
 					return -1
-				case 101:
+				case 110:
 					return -1
-				case 100:
+				case 78:
 					return -1
+				case 83:
+					return 5
 				case 77:
 					return -1
-				case 72:
-					return -1
-				case 97:
+				case 105:
 					return -1
-				case 65:
+				case 117:
 					return -1
-				case 67:
+				case 85:
 					return -1
+				case 115:
+					return 5
 				}
 				return -1
 			},
@@ -16638,97 +18169,77 @@ func NewLexerWithInit(in io.Reader, initFun func(*Lexer)) *Lexer {
 				switch r {
 				case 109:
 					return -1
-				case 99:
-					return 4
-				case 101:
-					return -1
-				case 100:
-					return -1
-				case 77:
+				case 73:
 					return -1
-				case 72:
+				case 110:
 					return -1
-				case 97:
+				case 78:
 					return -1
-				case 65:
+				case 83:
 					return -1
-				case 67:
-					return 4
-				case 116:
+				case 77:
 					return -1
-				case 84:
+				case 105:
 					return -1
-				case 104:
+				case 117:
 					return -1
-				case 69:
+				case 85:
 					return -1
-				case 68:
+				case 115:
 					return -1
 				}
 				return -1
 			},
+		}, []int{ /* Start-of-input transitions */ -1, -1, -1, -1, -1, -1}, []int{ /* End-of-input transitions */ -1, -1, -1, -1, -1, -1}, nil},
+
+		// [mM][iI][sS][sS][iI][nN][gG]
+		{[]bool{false, false, false, false, false, false, false, true}, []func(rune) int{ // Transitions
 			func(r rune) int {
 				switch r {
-				case 77:
-					return -1
-				case 72:
-					return 5
-				case 97:
-					return -1
-				case 65:
-					return -1
-				case 67:
-					return -1
-				case 116:
+				case 73:
 					return -1
-				case 84:
+				case 115:
 					return -1
-				case 104:
-					return 5
-				case 69:
+				case 110:
 					return -1
-				case 68:
+				case 103:
 					return -1
 				case 109:
+					return 1
+				case 77:
+					return 1
+				case 105:
 					return -1
-				case 99:
+				case 83:
 					return -1
-				case 101:
+				case 78:
 					return -1
-				case 100:
+				case 71:
 					return -1
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
-				case 116:
-					return -1
-				case 84:
-					return -1
-				case 104:
-					return -1
-				case 69:
-					return 6
-				case 68:
-					return -1
 				case 109:
 					return -1
-				case 99:
+				case 77:
 					return -1
-				case 101:
-					return 6
-				case 100:
+				case 105:
+					return 2
+				case 83:
 					return -1
-				case 77:
+				case 78:
 					return -1
-				case 72:
+				case 71:
 					return -1
-				case 97:
+				case 73:
+					return 2
+				case 115:
 					return -1
-				case 65:
+				case 110:
 					return -1
-				case 67:
+				case 103:
 					return -1
 				}
 				return -1
@@ -16737,151 +18248,124 @@ func NewLexerWithInit(in io.Reader, initFun func(*Lexer)) *Lexer {
 				switch r {
 				case 109:
 					return -1
-				case 99:
-					return -1
-				case 101:
-					return -1
-				case 100:
-					return 7
 				case 77:
 					return -1
-				case 72:
-					return -1
-				case 97:
-					return -1
-				case 65:
+				case 105:
 					return -1
-				case 67:
+				case 83:
+					return 3
+				case 78:
 					return -1
-				case 116:
+				case 71:
 					return -1
-				case 84:
+				case 73:
 					return -1
-				case 104:
+				case 115:
+					return 3
+				case 110:
 					return -1
-				case 69:
+				case 103:
 					return -1
-				case 68:
-					return 7
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
-				case 97:
-					return -1
-				case 65:
-					return -1
-				case 67:
-					return -1
-				case 116:
-					return -1
-				case 84:
-					return -1
-				case 104:
-					return -1
-				case 69:
+				case 109:
 					return -1
-				case 68:
+				case 77:
 					return -1
-				case 109:
+				case 105:
 					return -1
-				case 99:
+				case 83:
+					return 4
+				case 78:
 					return -1
-				case 101:
+				case 71:
 					return -1
-				case 100:
+				case 73:
 					return -1
-				case 77:
+				case 115:
+					return 4
+				case 110:
 					return -1
-				case 72:
+				case 103:
 					return -1
 				}
 				return -1
 			},
-		}, []int{ /* Start-of-input transitions */ -1, -1, -1, -1, -1, -1, -1, -1}, []int{ /* End-of-input transitions */ -1, -1, -1, -1, -1, -1, -1, -1}, nil},
-
-		// [mM][aA][tT][eE][rR][iI][aA][lL][iI][zZ][eE][dD]
-		{[]bool{false, false, false, false, false, false, false, false, false, false, false, false, true}, []func(rune) int{ // Transitions
 			func(r rune) int {
 				switch r {
-				case 114:
+				case 73:
+					return 5
+				case 115:
 					return -1
-				case 105:
+				case 110:
 					return -1
-				case 73:
+				case 103:
 					return -1
-				case 77:
-					return 1
-				case 84:
+				case 109:
 					return -1
-				case 82:
+				case 77:
 					return -1
-				case 100:
+				case 105:
+					return 5
+				case 83:
 					return -1
-				case 68:
+				case 78:
 					return -1
-				case 101:
+				case 71:
 					return -1
-				case 69:
+				}
+				return -1
+			},
+			func(r rune) int {
+				switch r {
+				case 73:
 					return -1
-				case 122:
+				case 115:
 					return -1
-				case 90:
+				case 110:
+					return 6
+				case 103:
 					return -1
 				case 109:
-					return 1
-				case 97:
 					return -1
-				case 65:
+				case 77:
 					return -1
-				case 116:
+				case 105:
 					return -1
-				case 108:
+				case 83:
 					return -1
-				case 76:
+				case 78:
+					return 6
+				case 71:
 					return -1
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
-				case 101:
-					return -1
-				case 69:
+				case 73:
 					return -1
-				case 122:
+				case 115:
 					return -1
-				case 90:
+				case 110:
 					return -1
+				case 103:
+					return 7
 				case 109:
 					return -1
-				case 97:
-					return 2
-				case 65:
-					return 2
-				case 116:
-					return -1
-				case 108:
-					return -1
-				case 76:
-					return -1
-				case 114:
-					return -1
-				case 105:
-					return -1
-				case 73:
-					return -1
 				case 77:
 					return -1
-				case 84:
-					return -1
-				case 82:
+				case 105:
 					return -1
-				case 100:
+				case 83:
 					return -1
-				case 68:
+				case 78:
 					return -1
+				case 71:
+					return 7
 				}
 				return -1
 			},
@@ -16889,203 +18373,159 @@ func NewLexerWithInit(in io.Reader, initFun func(*Lexer)) *Lexer {
 				switch r {
 				case 109:
 					return -1
-				case 97:
-					return -1
-				case 65:
-					return -1
-				case 116:
-					return 3
-				case 108:
-					return -1
-				case 76:
-					return -1
-				case 114:
+				case 77:
 					return -1
 				case 105:
 					return -1
-				case 73:
-					return -1
-				case 77:
-					return -1
-				case 84:
-					return 3
-				case 82:
+				case 83:
 					return -1
-				case 100:
+				case 78:
 					return -1
-				case 68:
+				case 71:
 					return -1
-				case 101:
+				case 73:
 					return -1
-				case 69:
+				case 115:
 					return -1
-				case 122:
+				case 110:
 					return -1
-				case 90:
+				case 103:
 					return -1
 				}
 				return -1
 			},
+		}, []int{ /* Start-of-input transitions */ -1, -1, -1, -1, -1, -1, -1, -1}, []int{ /* End-of-input transitions */ -1, -1, -1, -1, -1, -1, -1, -1}, nil},
+
+		// [nN][aA][mM][eE][sS][pP][aA][cC][eE]
+		{[]bool{false, false, false, false, false, false, false, false, false, true}, []func(rune) int{ // Transitions
 			func(r rune) int {
 				switch r {
-				case 114:
-					return -1
-				case 105:
-					return -1
-				case 73:
+				case 109:
 					return -1
 				case 77:
 					return -1
-				case 84:
+				case 80:
 					return -1
-				case 82:
+				case 99:
 					return -1
-				case 100:
+				case 67:
 					return -1
-				case 68:
+				case 97:
 					return -1
 				case 101:
-					return 4
-				case 69:
-					return 4
-				case 122:
-					return -1
-				case 90:
 					return -1
-				case 109:
+				case 115:
 					return -1
-				case 97:
+				case 112:
 					return -1
 				case 65:
 					return -1
-				case 116:
-					return -1
-				case 108:
+				case 83:
 					return -1
-				case 76:
+				case 110:
+					return 1
+				case 78:
+					return 1
+				case 69:
 					return -1
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
-				case 109:
-					return -1
-				case 97:
-					return -1
 				case 65:
+					return 2
+				case 83:
 					return -1
-				case 116:
-					return -1
-				case 108:
+				case 110:
 					return -1
-				case 76:
+				case 78:
 					return -1
-				case 114:
-					return 5
-				case 105:
+				case 69:
 					return -1
-				case 73:
+				case 109:
 					return -1
 				case 77:
 					return -1
-				case 84:
+				case 80:
 					return -1
-				case 82:
-					return 5
-				case 100:
+				case 99:
 					return -1
-				case 68:
+				case 67:
 					return -1
+				case 97:
+					return 2
 				case 101:
 					return -1
-				case 69:
-					return -1
-				case 122:
+				case 115:
 					return -1
-				case 90:
+				case 112:
 					return -1
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
-				case 114:
-					return -1
-				case 105:
-					return 6
-				case 73:
-					return 6
-				case 77:
-					return -1
-				case 84:
-					return -1
-				case 82:
+				case 65:
 					return -1
-				case 100:
+				case 83:
 					return -1
-				case 68:
+				case 110:
 					return -1
-				case 101:
+				case 78:
 					return -1
 				case 69:
 					return -1
-				case 122:
+				case 109:
+					return 3
+				case 77:
+					return 3
+				case 80:
 					return -1
-				case 90:
+				case 99:
 					return -1
-				case 109:
+				case 67:
 					return -1
 				case 97:
 					return -1
-				case 65:
-					return -1
-				case 116:
+				case 101:
 					return -1
-				case 108:
+				case 115:
 					return -1
-				case 76:
+				case 112:
 					return -1
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
-				case 101:
-					return -1
-				case 69:
-					return -1
-				case 122:
+				case 110:
 					return -1
-				case 90:
+				case 78:
 					return -1
+				case 69:
+					return 4
 				case 109:
 					return -1
-				case 97:
-					return 7
-				case 65:
-					return 7
-				case 116:
-					return -1
-				case 108:
-					return -1
-				case 76:
+				case 77:
 					return -1
-				case 114:
+				case 80:
 					return -1
-				case 105:
+				case 99:
 					return -1
-				case 73:
+				case 67:
 					return -1
-				case 77:
+				case 97:
 					return -1
-				case 84:
+				case 101:
+					return 4
+				case 115:
 					return -1
-				case 82:
+				case 112:
 					return -1
-				case 100:
+				case 65:
 					return -1
-				case 68:
+				case 83:
 					return -1
 				}
 				return -1
@@ -17094,1085 +18534,1039 @@ func NewLexerWithInit(in io.Reader, initFun func(*Lexer)) *Lexer {
 				switch r {
 				case 109:
 					return -1
-				case 97:
-					return -1
-				case 65:
-					return -1
-				case 116:
+				case 77:
 					return -1
-				case 108:
-					return 8
-				case 76:
-					return 8
-				case 114:
+				case 80:
 					return -1
-				case 105:
+				case 99:
 					return -1
-				case 73:
+				case 67:
 					return -1
-				case 77:
+				case 97:
 					return -1
-				case 84:
+				case 101:
 					return -1
-				case 82:
+				case 115:
+					return 5
+				case 112:
 					return -1
-				case 100:
+				case 65:
 					return -1
-				case 68:
+				case 83:
+					return 5
+				case 110:
 					return -1
-				case 101:
+				case 78:
 					return -1
 				case 69:
 					return -1
-				case 122:
-					return -1
-				case 90:
-					return -1
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
-				case 114:
-					return -1
-				case 105:
-					return 9
-				case 73:
-					return 9
-				case 77:
-					return -1
-				case 84:
-					return -1
-				case 82:
+				case 65:
 					return -1
-				case 100:
+				case 83:
 					return -1
-				case 68:
+				case 110:
 					return -1
-				case 101:
+				case 78:
 					return -1
 				case 69:
 					return -1
-				case 122:
-					return -1
-				case 90:
-					return -1
 				case 109:
 					return -1
-				case 97:
+				case 77:
 					return -1
-				case 65:
+				case 80:
+					return 6
+				case 99:
 					return -1
-				case 116:
+				case 67:
 					return -1
-				case 108:
+				case 97:
 					return -1
-				case 76:
+				case 101:
 					return -1
+				case 115:
+					return -1
+				case 112:
+					return 6
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
-				case 101:
-					return -1
-				case 69:
-					return -1
-				case 122:
-					return 10
-				case 90:
-					return 10
-				case 109:
-					return -1
-				case 97:
-					return -1
 				case 65:
+					return 7
+				case 83:
 					return -1
-				case 116:
+				case 110:
 					return -1
-				case 108:
+				case 78:
 					return -1
-				case 76:
+				case 69:
 					return -1
-				case 114:
+				case 109:
 					return -1
-				case 105:
+				case 77:
 					return -1
-				case 73:
+				case 80:
 					return -1
-				case 77:
+				case 99:
 					return -1
-				case 84:
+				case 67:
 					return -1
-				case 82:
+				case 97:
+					return 7
+				case 101:
 					return -1
-				case 100:
+				case 115:
 					return -1
-				case 68:
+				case 112:
 					return -1
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
-				case 101:
-					return 11
-				case 69:
-					return 11
-				case 122:
-					return -1
-				case 90:
-					return -1
-				case 109:
-					return -1
-				case 97:
-					return -1
 				case 65:
 					return -1
-				case 116:
-					return -1
-				case 108:
+				case 83:
 					return -1
-				case 76:
+				case 110:
 					return -1
-				case 114:
+				case 78:
 					return -1
-				case 105:
+				case 69:
 					return -1
-				case 73:
+				case 109:
 					return -1
 				case 77:
 					return -1
-				case 84:
+				case 80:
 					return -1
-				case 82:
+				case 99:
+					return 8
+				case 67:
+					return 8
+				case 97:
 					return -1
-				case 100:
+				case 101:
 					return -1
-				case 68:
+				case 115:
+					return -1
+				case 112:
 					return -1
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
-				case 101:
-					return -1
-				case 69:
-					return -1
-				case 122:
+				case 110:
 					return -1
-				case 90:
+				case 78:
 					return -1
+				case 69:
+					return 9
 				case 109:
 					return -1
-				case 97:
-					return -1
-				case 65:
-					return -1
-				case 116:
+				case 77:
 					return -1
-				case 108:
+				case 80:
 					return -1
-				case 76:
+				case 99:
 					return -1
-				case 114:
+				case 67:
 					return -1
-				case 105:
+				case 97:
 					return -1
-				case 73:
+				case 101:
+					return 9
+				case 115:
 					return -1
-				case 77:
+				case 112:
 					return -1
-				case 84:
+				case 65:
 					return -1
-				case 82:
+				case 83:
 					return -1
-				case 100:
-					return 12
-				case 68:
-					return 12
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
-				case 109:
-					return -1
 				case 97:
 					return -1
-				case 65:
-					return -1
-				case 116:
-					return -1
-				case 108:
-					return -1
-				case 76:
+				case 101:
 					return -1
-				case 114:
+				case 115:
 					return -1
-				case 105:
+				case 112:
 					return -1
-				case 73:
+				case 65:
 					return -1
-				case 77:
+				case 83:
 					return -1
-				case 84:
+				case 110:
 					return -1
-				case 82:
+				case 78:
 					return -1
-				case 100:
+				case 69:
 					return -1
-				case 68:
+				case 109:
 					return -1
-				case 101:
+				case 77:
 					return -1
-				case 69:
+				case 80:
 					return -1
-				case 122:
+				case 99:
 					return -1
-				case 90:
+				case 67:
 					return -1
 				}
 				return -1
 			},
-		}, []int{ /* Start-of-input transitions */ -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1}, []int{ /* End-of-input transitions */ -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1}, nil},
+		}, []int{ /* Start-of-input transitions */ -1, -1, -1, -1, -1, -1, -1, -1, -1, -1}, []int{ /* End-of-input transitions */ -1, -1, -1, -1, -1, -1, -1, -1, -1, -1}, nil},
 
-		// [mM][eE][rR][gG][eE]
-		{[]bool{false, false, false, false, false, true}, []func(rune) int{ // Transitions
+		// [nN][eE][sS][tT]
+		{[]bool{false, false, false, false, true}, []func(rune) int{ // Transitions
 			func(r rune) int {
 				switch r {
-				case 109:
+				case 110:
 					return 1
-				case 77:
+				case 78:
 					return 1
 				case 101:
 					return -1
 				case 69:
 					return -1
-				case 114:
+				case 115:
 					return -1
-				case 82:
+				case 83:
 					return -1
-				case 103:
+				case 116:
 					return -1
-				case 71:
+				case 84:
 					return -1
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
-				case 109:
+				case 110:
 					return -1
-				case 77:
+				case 78:
 					return -1
 				case 101:
 					return 2
 				case 69:
 					return 2
-				case 114:
+				case 115:
 					return -1
-				case 82:
+				case 83:
 					return -1
-				case 103:
+				case 116:
 					return -1
-				case 71:
+				case 84:
 					return -1
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
-				case 109:
+				case 110:
 					return -1
-				case 77:
+				case 78:
 					return -1
 				case 101:
 					return -1
 				case 69:
 					return -1
-				case 114:
+				case 115:
 					return 3
-				case 82:
+				case 83:
 					return 3
-				case 103:
+				case 116:
 					return -1
-				case 71:
+				case 84:
 					return -1
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
-				case 109:
+				case 110:
 					return -1
-				case 77:
+				case 78:
 					return -1
 				case 101:
 					return -1
 				case 69:
 					return -1
-				case 114:
+				case 115:
 					return -1
-				case 82:
+				case 83:
 					return -1
-				case 103:
+				case 116:
 					return 4
-				case 71:
+				case 84:
 					return 4
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
-				case 109:
+				case 110:
 					return -1
-				case 77:
+				case 78:
 					return -1
 				case 101:
-					return 5
+					return -1
 				case 69:
-					return 5
-				case 114:
 					return -1
-				case 82:
+				case 115:
 					return -1
-				case 103:
+				case 83:
 					return -1
-				case 71:
+				case 116:
+					return -1
+				case 84:
 					return -1
 				}
 				return -1
 			},
+		}, []int{ /* Start-of-input transitions */ -1, -1, -1, -1, -1}, []int{ /* End-of-input transitions */ -1, -1, -1, -1, -1}, nil},
+
+		// [nN][oO][tT]
+		{[]bool{false, false, false, true}, []func(rune) int{ // Transitions
 			func(r rune) int {
 				switch r {
-				case 109:
-					return -1
-				case 77:
-					return -1
-				case 101:
-					return -1
-				case 69:
-					return -1
-				case 114:
+				case 110:
+					return 1
+				case 78:
+					return 1
+				case 111:
 					return -1
-				case 82:
+				case 79:
 					return -1
-				case 103:
+				case 116:
 					return -1
-				case 71:
+				case 84:
 					return -1
 				}
 				return -1
 			},
-		}, []int{ /* Start-of-input transitions */ -1, -1, -1, -1, -1, -1}, []int{ /* End-of-input transitions */ -1, -1, -1, -1, -1, -1}, nil},
-
-		// [mM][iI][nN][uU][sS]
-		{[]bool{false, false, false, false, false, true}, []func(rune) int{ // Transitions
 			func(r rune) int {
 				switch r {
-				case 77:
-					return 1
-				case 105:
-					return -1
-				case 117:
+				case 110:
 					return -1
-				case 85:
+				case 78:
 					return -1
-				case 115:
+				case 111:
+					return 2
+				case 79:
+					return 2
+				case 116:
 					return -1
-				case 109:
-					return 1
-				case 73:
+				case 84:
 					return -1
+				}
+				return -1
+			},
+			func(r rune) int {
+				switch r {
 				case 110:
 					return -1
 				case 78:
 					return -1
-				case 83:
+				case 111:
+					return -1
+				case 79:
 					return -1
+				case 116:
+					return 3
+				case 84:
+					return 3
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
-				case 109:
-					return -1
-				case 73:
-					return 2
 				case 110:
 					return -1
 				case 78:
 					return -1
-				case 83:
-					return -1
-				case 77:
+				case 111:
 					return -1
-				case 105:
-					return 2
-				case 117:
+				case 79:
 					return -1
-				case 85:
+				case 116:
 					return -1
-				case 115:
+				case 84:
 					return -1
 				}
 				return -1
 			},
+		}, []int{ /* Start-of-input transitions */ -1, -1, -1, -1}, []int{ /* End-of-input transitions */ -1, -1, -1, -1}, nil},
+
+		// [nN][uU][lL][lL]
+		{[]bool{false, false, false, false, true}, []func(rune) int{ // Transitions
 			func(r rune) int {
 				switch r {
-				case 109:
-					return -1
-				case 73:
-					return -1
 				case 110:
-					return 3
+					return 1
 				case 78:
-					return 3
-				case 83:
-					return -1
-				case 77:
-					return -1
-				case 105:
-					return -1
+					return 1
 				case 117:
 					return -1
 				case 85:
 					return -1
-				case 115:
+				case 108:
+					return -1
+				case 76:
 					return -1
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
-				case 77:
+				case 110:
 					return -1
-				case 105:
+				case 78:
 					return -1
 				case 117:
-					return 4
+					return 2
 				case 85:
-					return 4
-				case 115:
-					return -1
-				case 109:
-					return -1
-				case 73:
-					return -1
-				case 110:
-					return -1
-				case 78:
+					return 2
+				case 108:
 					return -1
-				case 83:
+				case 76:
 					return -1
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
-				case 109:
-					return -1
-				case 73:
-					return -1
 				case 110:
 					return -1
 				case 78:
 					return -1
-				case 83:
-					return 5
-				case 77:
-					return -1
-				case 105:
-					return -1
 				case 117:
 					return -1
 				case 85:
 					return -1
-				case 115:
-					return 5
+				case 108:
+					return 3
+				case 76:
+					return 3
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
-				case 109:
-					return -1
-				case 73:
-					return -1
 				case 110:
 					return -1
 				case 78:
 					return -1
-				case 83:
-					return -1
-				case 77:
-					return -1
-				case 105:
-					return -1
 				case 117:
 					return -1
 				case 85:
 					return -1
-				case 115:
-					return -1
+				case 108:
+					return 4
+				case 76:
+					return 4
 				}
 				return -1
 			},
-		}, []int{ /* Start-of-input transitions */ -1, -1, -1, -1, -1, -1}, []int{ /* End-of-input transitions */ -1, -1, -1, -1, -1, -1}, nil},
-
-		// [mM][iI][sS][sS][iI][nN][gG]
-		{[]bool{false, false, false, false, false, false, false, true}, []func(rune) int{ // Transitions
 			func(r rune) int {
 				switch r {
-				case 73:
-					return -1
-				case 115:
-					return -1
 				case 110:
 					return -1
-				case 103:
+				case 78:
 					return -1
-				case 109:
-					return 1
-				case 77:
-					return 1
-				case 105:
+				case 117:
 					return -1
-				case 83:
+				case 85:
 					return -1
-				case 78:
+				case 108:
 					return -1
-				case 71:
+				case 76:
 					return -1
 				}
 				return -1
 			},
+		}, []int{ /* Start-of-input transitions */ -1, -1, -1, -1, -1}, []int{ /* End-of-input transitions */ -1, -1, -1, -1, -1}, nil},
+
+		// [nN][uN][mM][bB][eE][rR]
+		{[]bool{false, false, false, false, false, false, true}, []func(rune) int{ // Transitions
 			func(r rune) int {
 				switch r {
+				case 117:
+					return -1
 				case 109:
 					return -1
 				case 77:
 					return -1
-				case 105:
-					return 2
-				case 83:
+				case 69:
 					return -1
+				case 110:
+					return 1
 				case 78:
+					return 1
+				case 98:
 					return -1
-				case 71:
+				case 66:
 					return -1
-				case 73:
-					return 2
-				case 115:
+				case 101:
 					return -1
-				case 110:
+				case 114:
 					return -1
-				case 103:
+				case 82:
 					return -1
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
+				case 117:
+					return 2
 				case 109:
 					return -1
 				case 77:
 					return -1
-				case 105:
+				case 69:
+					return -1
+				case 110:
 					return -1
-				case 83:
-					return 3
 				case 78:
+					return 2
+				case 98:
 					return -1
-				case 71:
+				case 66:
 					return -1
-				case 73:
+				case 101:
 					return -1
-				case 115:
-					return 3
-				case 110:
+				case 114:
 					return -1
-				case 103:
+				case 82:
 					return -1
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
-				case 109:
+				case 110:
 					return -1
-				case 77:
+				case 78:
 					return -1
-				case 105:
+				case 98:
 					return -1
-				case 83:
-					return 4
-				case 78:
+				case 66:
 					return -1
-				case 71:
+				case 101:
 					return -1
-				case 73:
+				case 114:
 					return -1
-				case 115:
-					return 4
-				case 110:
+				case 82:
 					return -1
-				case 103:
+				case 117:
+					return -1
+				case 109:
+					return 3
+				case 77:
+					return 3
+				case 69:
 					return -1
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
-				case 73:
-					return 5
-				case 115:
-					return -1
 				case 110:
 					return -1
-				case 103:
+				case 78:
 					return -1
-				case 109:
+				case 98:
+					return 4
+				case 66:
+					return 4
+				case 101:
 					return -1
-				case 77:
+				case 114:
 					return -1
-				case 105:
-					return 5
-				case 83:
+				case 82:
 					return -1
-				case 78:
+				case 117:
 					return -1
-				case 71:
+				case 109:
+					return -1
+				case 77:
+					return -1
+				case 69:
 					return -1
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
-				case 73:
+				case 117:
 					return -1
-				case 115:
+				case 109:
+					return -1
+				case 77:
 					return -1
+				case 69:
+					return 5
 				case 110:
-					return 6
-				case 103:
 					return -1
-				case 109:
+				case 78:
 					return -1
-				case 77:
+				case 98:
 					return -1
-				case 105:
+				case 66:
 					return -1
-				case 83:
+				case 101:
+					return 5
+				case 114:
 					return -1
-				case 78:
-					return 6
-				case 71:
+				case 82:
 					return -1
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
-				case 73:
-					return -1
-				case 115:
-					return -1
-				case 110:
+				case 117:
 					return -1
-				case 103:
-					return 7
 				case 109:
 					return -1
 				case 77:
 					return -1
-				case 105:
+				case 69:
 					return -1
-				case 83:
+				case 110:
 					return -1
 				case 78:
 					return -1
-				case 71:
-					return 7
+				case 98:
+					return -1
+				case 66:
+					return -1
+				case 101:
+					return -1
+				case 114:
+					return 6
+				case 82:
+					return 6
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
+				case 117:
+					return -1
 				case 109:
 					return -1
 				case 77:
 					return -1
-				case 105:
+				case 69:
 					return -1
-				case 83:
+				case 110:
 					return -1
 				case 78:
 					return -1
-				case 71:
+				case 98:
 					return -1
-				case 73:
+				case 66:
 					return -1
-				case 115:
+				case 101:
 					return -1
-				case 110:
+				case 114:
 					return -1
-				case 103:
+				case 82:
 					return -1
 				}
 				return -1
 			},
-		}, []int{ /* Start-of-input transitions */ -1, -1, -1, -1, -1, -1, -1, -1}, []int{ /* End-of-input transitions */ -1, -1, -1, -1, -1, -1, -1, -1}, nil},
+		}, []int{ /* Start-of-input transitions */ -1, -1, -1, -1, -1, -1, -1}, []int{ /* End-of-input transitions */ -1, -1, -1, -1, -1, -1, -1}, nil},
 
-		// [nN][aA][mM][eE][sS][pP][aA][cC][eE]
-		{[]bool{false, false, false, false, false, false, false, false, false, true}, []func(rune) int{ // Transitions
+		// [oO][bB][jJ][eE][cC][tT]
+		{[]bool{false, false, false, false, false, false, true}, []func(rune) int{ // Transitions
 			func(r rune) int {
 				switch r {
-				case 109:
+				case 98:
 					return -1
-				case 77:
+				case 66:
 					return -1
-				case 80:
+				case 106:
 					return -1
-				case 99:
+				case 74:
 					return -1
 				case 67:
 					return -1
-				case 97:
-					return -1
-				case 101:
-					return -1
-				case 115:
-					return -1
-				case 112:
-					return -1
-				case 65:
+				case 116:
 					return -1
-				case 83:
+				case 84:
 					return -1
-				case 110:
+				case 111:
 					return 1
-				case 78:
+				case 79:
 					return 1
+				case 101:
+					return -1
 				case 69:
 					return -1
+				case 99:
+					return -1
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
-				case 65:
-					return 2
-				case 83:
+				case 111:
 					return -1
-				case 110:
+				case 79:
 					return -1
-				case 78:
+				case 101:
 					return -1
 				case 69:
 					return -1
-				case 109:
-					return -1
-				case 77:
+				case 99:
 					return -1
-				case 80:
+				case 98:
+					return 2
+				case 66:
+					return 2
+				case 106:
 					return -1
-				case 99:
+				case 74:
 					return -1
 				case 67:
 					return -1
-				case 97:
-					return 2
-				case 101:
-					return -1
-				case 115:
+				case 116:
 					return -1
-				case 112:
+				case 84:
 					return -1
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
-				case 65:
-					return -1
-				case 83:
+				case 111:
 					return -1
-				case 110:
+				case 79:
 					return -1
-				case 78:
+				case 101:
 					return -1
 				case 69:
 					return -1
-				case 109:
-					return 3
-				case 77:
-					return 3
-				case 80:
-					return -1
 				case 99:
 					return -1
-				case 67:
+				case 98:
 					return -1
-				case 97:
+				case 66:
 					return -1
-				case 101:
+				case 106:
+					return 3
+				case 74:
+					return 3
+				case 67:
 					return -1
-				case 115:
+				case 116:
 					return -1
-				case 112:
+				case 84:
 					return -1
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
-				case 110:
+				case 111:
 					return -1
-				case 78:
+				case 79:
 					return -1
+				case 101:
+					return 4
 				case 69:
 					return 4
-				case 109:
+				case 99:
 					return -1
-				case 77:
+				case 98:
 					return -1
-				case 80:
+				case 66:
 					return -1
-				case 99:
+				case 106:
+					return -1
+				case 74:
 					return -1
 				case 67:
 					return -1
-				case 97:
+				case 116:
 					return -1
-				case 101:
-					return 4
-				case 115:
+				case 84:
+					return -1
+				}
+				return -1
+			},
+			func(r rune) int {
+				switch r {
+				case 98:
+					return -1
+				case 66:
+					return -1
+				case 106:
+					return -1
+				case 74:
+					return -1
+				case 67:
+					return 5
+				case 116:
+					return -1
+				case 84:
 					return -1
-				case 112:
+				case 111:
 					return -1
-				case 65:
+				case 79:
 					return -1
-				case 83:
+				case 101:
+					return -1
+				case 69:
 					return -1
+				case 99:
+					return 5
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
-				case 109:
+				case 98:
 					return -1
-				case 77:
+				case 66:
 					return -1
-				case 80:
+				case 106:
 					return -1
-				case 99:
+				case 74:
 					return -1
 				case 67:
 					return -1
-				case 97:
-					return -1
-				case 101:
-					return -1
-				case 115:
-					return 5
-				case 112:
-					return -1
-				case 65:
+				case 116:
+					return 6
+				case 84:
+					return 6
+				case 111:
 					return -1
-				case 83:
-					return 5
-				case 110:
+				case 79:
 					return -1
-				case 78:
+				case 101:
 					return -1
 				case 69:
 					return -1
+				case 99:
+					return -1
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
-				case 65:
-					return -1
-				case 83:
+				case 98:
 					return -1
-				case 110:
+				case 66:
 					return -1
-				case 78:
+				case 106:
 					return -1
-				case 69:
+				case 74:
 					return -1
-				case 109:
+				case 67:
 					return -1
-				case 77:
+				case 116:
 					return -1
-				case 80:
-					return 6
-				case 99:
+				case 84:
 					return -1
-				case 67:
+				case 111:
 					return -1
-				case 97:
+				case 79:
 					return -1
 				case 101:
 					return -1
-				case 115:
+				case 69:
+					return -1
+				case 99:
 					return -1
-				case 112:
-					return 6
 				}
 				return -1
 			},
+		}, []int{ /* Start-of-input transitions */ -1, -1, -1, -1, -1, -1, -1}, []int{ /* End-of-input transitions */ -1, -1, -1, -1, -1, -1, -1}, nil},
+
+		// [oO][fF][fF][sS][eE][tT]
+		{[]bool{false, false, false, false, false, false, true}, []func(rune) int{ // Transitions
 			func(r rune) int {
 				switch r {
-				case 65:
-					return 7
-				case 83:
-					return -1
-				case 110:
+				case 111:
+					return 1
+				case 79:
+					return 1
+				case 115:
 					return -1
-				case 78:
+				case 101:
 					return -1
 				case 69:
 					return -1
-				case 109:
-					return -1
-				case 77:
-					return -1
-				case 80:
-					return -1
-				case 99:
+				case 102:
 					return -1
-				case 67:
+				case 70:
 					return -1
-				case 97:
-					return 7
-				case 101:
+				case 83:
 					return -1
-				case 115:
+				case 116:
 					return -1
-				case 112:
+				case 84:
 					return -1
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
-				case 65:
-					return -1
+				case 102:
+					return 2
+				case 70:
+					return 2
 				case 83:
 					return -1
-				case 110:
-					return -1
-				case 78:
-					return -1
-				case 69:
+				case 116:
 					return -1
-				case 109:
+				case 84:
 					return -1
-				case 77:
+				case 111:
 					return -1
-				case 80:
+				case 79:
 					return -1
-				case 99:
-					return 8
-				case 67:
-					return 8
-				case 97:
+				case 115:
 					return -1
 				case 101:
 					return -1
-				case 115:
-					return -1
-				case 112:
+				case 69:
 					return -1
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
-				case 110:
+				case 111:
 					return -1
-				case 78:
+				case 79:
+					return -1
+				case 115:
+					return -1
+				case 101:
 					return -1
 				case 69:
-					return 9
-				case 109:
 					return -1
-				case 77:
+				case 102:
+					return 3
+				case 70:
+					return 3
+				case 83:
 					return -1
-				case 80:
+				case 116:
 					return -1
-				case 99:
+				case 84:
 					return -1
-				case 67:
+				}
+				return -1
+			},
+			func(r rune) int {
+				switch r {
+				case 111:
 					return -1
-				case 97:
+				case 79:
 					return -1
-				case 101:
-					return 9
 				case 115:
+					return 4
+				case 101:
 					return -1
-				case 112:
+				case 69:
 					return -1
-				case 65:
+				case 102:
+					return -1
+				case 70:
 					return -1
 				case 83:
+					return 4
+				case 116:
+					return -1
+				case 84:
 					return -1
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
-				case 97:
+				case 111:
 					return -1
-				case 101:
+				case 79:
 					return -1
 				case 115:
 					return -1
-				case 112:
+				case 101:
+					return 5
+				case 69:
+					return 5
+				case 102:
 					return -1
-				case 65:
+				case 70:
 					return -1
 				case 83:
 					return -1
-				case 110:
+				case 116:
 					return -1
-				case 78:
+				case 84:
 					return -1
-				case 69:
+				}
+				return -1
+			},
+			func(r rune) int {
+				switch r {
+				case 102:
 					return -1
-				case 109:
+				case 70:
 					return -1
-				case 77:
+				case 83:
 					return -1
-				case 80:
+				case 116:
+					return 6
+				case 84:
+					return 6
+				case 111:
 					return -1
-				case 99:
+				case 79:
 					return -1
-				case 67:
+				case 115:
+					return -1
+				case 101:
+					return -1
+				case 69:
 					return -1
 				}
 				return -1
 			},
-		}, []int{ /* Start-of-input transitions */ -1, -1, -1, -1, -1, -1, -1, -1, -1, -1}, []int{ /* End-of-input transitions */ -1, -1, -1, -1, -1, -1, -1, -1, -1, -1}, nil},
-
-		// [nN][eE][sS][tT]
-		{[]bool{false, false, false, false, true}, []func(rune) int{ // Transitions
 			func(r rune) int {
 				switch r {
-				case 110:
-					return 1
-				case 78:
-					return 1
+				case 111:
+					return -1
+				case 79:
+					return -1
+				case 115:
+					return -1
 				case 101:
 					return -1
 				case 69:
 					return -1
-				case 115:
+				case 102:
+					return -1
+				case 70:
 					return -1
 				case 83:
 					return -1
@@ -18183,331 +19577,333 @@ func NewLexerWithInit(in io.Reader, initFun func(*Lexer)) *Lexer {
 				}
 				return -1
 			},
+		}, []int{ /* Start-of-input transitions */ -1, -1, -1, -1, -1, -1, -1}, []int{ /* End-of-input transitions */ -1, -1, -1, -1, -1, -1, -1}, nil},
+
+		// [oO][nN]
+		{[]bool{false, false, true}, []func(rune) int{ // Transitions
 			func(r rune) int {
 				switch r {
+				case 111:
+					return 1
+				case 79:
+					return 1
 				case 110:
 					return -1
 				case 78:
 					return -1
-				case 101:
-					return 2
-				case 69:
-					return 2
-				case 115:
-					return -1
-				case 83:
-					return -1
-				case 116:
+				}
+				return -1
+			},
+			func(r rune) int {
+				switch r {
+				case 111:
 					return -1
-				case 84:
+				case 79:
 					return -1
+				case 110:
+					return 2
+				case 78:
+					return 2
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
+				case 111:
+					return -1
+				case 79:
+					return -1
 				case 110:
 					return -1
 				case 78:
 					return -1
-				case 101:
+				}
+				return -1
+			},
+		}, []int{ /* Start-of-input transitions */ -1, -1, -1}, []int{ /* End-of-input transitions */ -1, -1, -1}, nil},
+
+		// [oO][pP][tT][iI][oO][nN]
+		{[]bool{false, false, false, false, false, false, true}, []func(rune) int{ // Transitions
+			func(r rune) int {
+				switch r {
+				case 79:
+					return 1
+				case 80:
 					return -1
-				case 69:
+				case 111:
+					return 1
+				case 112:
 					return -1
-				case 115:
-					return 3
-				case 83:
-					return 3
 				case 116:
 					return -1
 				case 84:
 					return -1
+				case 105:
+					return -1
+				case 73:
+					return -1
+				case 110:
+					return -1
+				case 78:
+					return -1
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
-				case 110:
+				case 111:
 					return -1
-				case 78:
+				case 112:
+					return 2
+				case 116:
 					return -1
-				case 101:
+				case 84:
 					return -1
-				case 69:
+				case 105:
 					return -1
-				case 115:
+				case 73:
 					return -1
-				case 83:
+				case 110:
 					return -1
-				case 116:
-					return 4
-				case 84:
-					return 4
+				case 78:
+					return -1
+				case 79:
+					return -1
+				case 80:
+					return 2
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
-				case 110:
+				case 79:
 					return -1
-				case 78:
+				case 80:
 					return -1
-				case 101:
+				case 111:
 					return -1
-				case 69:
+				case 112:
 					return -1
-				case 115:
+				case 116:
+					return 3
+				case 84:
+					return 3
+				case 105:
 					return -1
-				case 83:
+				case 73:
 					return -1
-				case 116:
+				case 110:
 					return -1
-				case 84:
+				case 78:
 					return -1
 				}
 				return -1
 			},
-		}, []int{ /* Start-of-input transitions */ -1, -1, -1, -1, -1}, []int{ /* End-of-input transitions */ -1, -1, -1, -1, -1}, nil},
-
-		// [nN][oO][tT]
-		{[]bool{false, false, false, true}, []func(rune) int{ // Transitions
 			func(r rune) int {
 				switch r {
-				case 110:
-					return 1
-				case 78:
-					return 1
 				case 111:
 					return -1
-				case 79:
+				case 112:
 					return -1
 				case 116:
 					return -1
 				case 84:
 					return -1
-				}
-				return -1
-			},
-			func(r rune) int {
-				switch r {
+				case 105:
+					return 4
+				case 73:
+					return 4
 				case 110:
 					return -1
 				case 78:
 					return -1
-				case 111:
-					return 2
 				case 79:
-					return 2
-				case 116:
 					return -1
-				case 84:
+				case 80:
 					return -1
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
-				case 110:
-					return -1
-				case 78:
+				case 79:
+					return 5
+				case 80:
 					return -1
 				case 111:
-					return -1
-				case 79:
+					return 5
+				case 112:
 					return -1
 				case 116:
-					return 3
+					return -1
 				case 84:
-					return 3
+					return -1
+				case 105:
+					return -1
+				case 73:
+					return -1
+				case 110:
+					return -1
+				case 78:
+					return -1
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
-				case 110:
+				case 79:
 					return -1
-				case 78:
+				case 80:
 					return -1
 				case 111:
 					return -1
-				case 79:
+				case 112:
 					return -1
 				case 116:
 					return -1
 				case 84:
 					return -1
+				case 105:
+					return -1
+				case 73:
+					return -1
+				case 110:
+					return 6
+				case 78:
+					return 6
 				}
 				return -1
 			},
-		}, []int{ /* Start-of-input transitions */ -1, -1, -1, -1}, []int{ /* End-of-input transitions */ -1, -1, -1, -1}, nil},
-
-		// [nN][uU][lL][lL]
-		{[]bool{false, false, false, false, true}, []func(rune) int{ // Transitions
 			func(r rune) int {
 				switch r {
-				case 110:
-					return 1
-				case 78:
-					return 1
-				case 117:
+				case 79:
 					return -1
-				case 85:
+				case 80:
 					return -1
-				case 108:
+				case 111:
 					return -1
-				case 76:
+				case 112:
 					return -1
-				}
-				return -1
-			},
-			func(r rune) int {
-				switch r {
-				case 110:
+				case 116:
 					return -1
-				case 78:
+				case 84:
 					return -1
-				case 117:
-					return 2
-				case 85:
-					return 2
-				case 108:
+				case 105:
 					return -1
-				case 76:
+				case 73:
+					return -1
+				case 110:
+					return -1
+				case 78:
 					return -1
 				}
 				return -1
 			},
+		}, []int{ /* Start-of-input transitions */ -1, -1, -1, -1, -1, -1, -1}, []int{ /* End-of-input transitions */ -1, -1, -1, -1, -1, -1, -1}, nil},
+
+		// [oO][rR]
+		{[]bool{false, false, true}, []func(rune) int{ // Transitions
 			func(r rune) int {
 				switch r {
-				case 110:
-					return -1
-				case 78:
-					return -1
-				case 117:
+				case 111:
+					return 1
+				case 79:
+					return 1
+				case 114:
 					return -1
-				case 85:
+				case 82:
 					return -1
-				case 108:
-					return 3
-				case 76:
-					return 3
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
-				case 110:
-					return -1
-				case 78:
-					return -1
-				case 117:
+				case 111:
 					return -1
-				case 85:
+				case 79:
 					return -1
-				case 108:
-					return 4
-				case 76:
-					return 4
+				case 114:
+					return 2
+				case 82:
+					return 2
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
-				case 110:
-					return -1
-				case 78:
-					return -1
-				case 117:
+				case 111:
 					return -1
-				case 85:
+				case 79:
 					return -1
-				case 108:
+				case 114:
 					return -1
-				case 76:
+				case 82:
 					return -1
 				}
 				return -1
 			},
-		}, []int{ /* Start-of-input transitions */ -1, -1, -1, -1, -1}, []int{ /* End-of-input transitions */ -1, -1, -1, -1, -1}, nil},
+		}, []int{ /* Start-of-input transitions */ -1, -1, -1}, []int{ /* End-of-input transitions */ -1, -1, -1}, nil},
 
-		// [nN][uN][mM][bB][eE][rR]
-		{[]bool{false, false, false, false, false, false, true}, []func(rune) int{ // Transitions
+		// [oO][rR][dD][eE][rR]
+		{[]bool{false, false, false, false, false, true}, []func(rune) int{ // Transitions
 			func(r rune) int {
 				switch r {
-				case 117:
-					return -1
-				case 109:
-					return -1
-				case 77:
-					return -1
-				case 69:
-					return -1
-				case 110:
+				case 111:
 					return 1
-				case 78:
+				case 79:
 					return 1
-				case 98:
+				case 114:
 					return -1
-				case 66:
+				case 82:
 					return -1
-				case 101:
+				case 100:
 					return -1
-				case 114:
+				case 68:
+					return -1
+				case 101:
 					return -1
-				case 82:
+				case 69:
 					return -1
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
-				case 117:
-					return 2
-				case 109:
-					return -1
-				case 77:
-					return -1
-				case 69:
+				case 111:
 					return -1
-				case 110:
+				case 79:
 					return -1
-				case 78:
+				case 114:
 					return 2
-				case 98:
+				case 82:
+					return 2
+				case 100:
 					return -1
-				case 66:
+				case 68:
 					return -1
 				case 101:
 					return -1
-				case 114:
-					return -1
-				case 82:
+				case 69:
 					return -1
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
-				case 110:
-					return -1
-				case 78:
-					return -1
-				case 98:
-					return -1
-				case 66:
+				case 111:
 					return -1
-				case 101:
+				case 79:
 					return -1
 				case 114:
 					return -1
 				case 82:
 					return -1
-				case 117:
-					return -1
-				case 109:
+				case 100:
 					return 3
-				case 77:
+				case 68:
 					return 3
+				case 101:
+					return -1
 				case 69:
 					return -1
 				}
@@ -18515,200 +19911,168 @@ func NewLexerWithInit(in io.Reader, initFun func(*Lexer)) *Lexer {
 			},
 			func(r rune) int {
 				switch r {
-				case 110:
-					return -1
-				case 78:
+				case 111:
 					return -1
-				case 98:
-					return 4
-				case 66:
-					return 4
-				case 101:
+				case 79:
 					return -1
 				case 114:
 					return -1
 				case 82:
 					return -1
-				case 117:
-					return -1
-				case 109:
+				case 100:
 					return -1
-				case 77:
+				case 68:
 					return -1
+				case 101:
+					return 4
 				case 69:
-					return -1
+					return 4
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
-				case 117:
-					return -1
-				case 109:
+				case 111:
 					return -1
-				case 77:
+				case 79:
 					return -1
-				case 69:
+				case 114:
 					return 5
-				case 110:
-					return -1
-				case 78:
-					return -1
-				case 98:
+				case 82:
+					return 5
+				case 100:
 					return -1
-				case 66:
+				case 68:
 					return -1
 				case 101:
-					return 5
-				case 114:
 					return -1
-				case 82:
+				case 69:
 					return -1
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
-				case 117:
-					return -1
-				case 109:
-					return -1
-				case 77:
+				case 111:
 					return -1
-				case 69:
+				case 79:
 					return -1
-				case 110:
+				case 114:
 					return -1
-				case 78:
+				case 82:
 					return -1
-				case 98:
+				case 100:
 					return -1
-				case 66:
+				case 68:
 					return -1
 				case 101:
 					return -1
-				case 114:
-					return 6
-				case 82:
-					return 6
+				case 69:
+					return -1
 				}
 				return -1
 			},
+		}, []int{ /* Start-of-input transitions */ -1, -1, -1, -1, -1, -1}, []int{ /* End-of-input transitions */ -1, -1, -1, -1, -1, -1}, nil},
+
+		// [oO][uU][tT][eE][rR]
+		{[]bool{false, false, false, false, false, true}, []func(rune) int{ // Transitions
 			func(r rune) int {
 				switch r {
 				case 117:
 					return -1
-				case 109:
-					return -1
-				case 77:
+				case 101:
 					return -1
 				case 69:
 					return -1
-				case 110:
-					return -1
-				case 78:
-					return -1
-				case 98:
+				case 114:
 					return -1
-				case 66:
+				case 111:
+					return 1
+				case 79:
+					return 1
+				case 85:
 					return -1
-				case 101:
+				case 116:
 					return -1
-				case 114:
+				case 84:
 					return -1
 				case 82:
 					return -1
 				}
 				return -1
 			},
-		}, []int{ /* Start-of-input transitions */ -1, -1, -1, -1, -1, -1, -1}, []int{ /* End-of-input transitions */ -1, -1, -1, -1, -1, -1, -1}, nil},
-
-		// [oO][bB][jJ][eE][cC][tT]
-		{[]bool{false, false, false, false, false, false, true}, []func(rune) int{ // Transitions
 			func(r rune) int {
 				switch r {
-				case 98:
-					return -1
-				case 66:
-					return -1
-				case 106:
-					return -1
-				case 74:
+				case 111:
 					return -1
-				case 67:
+				case 79:
 					return -1
+				case 85:
+					return 2
 				case 116:
 					return -1
 				case 84:
 					return -1
-				case 111:
-					return 1
-				case 79:
-					return 1
+				case 82:
+					return -1
+				case 117:
+					return 2
 				case 101:
 					return -1
 				case 69:
 					return -1
-				case 99:
+				case 114:
 					return -1
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
-				case 111:
-					return -1
-				case 79:
+				case 117:
 					return -1
 				case 101:
 					return -1
 				case 69:
 					return -1
-				case 99:
+				case 114:
 					return -1
-				case 98:
-					return 2
-				case 66:
-					return 2
-				case 106:
+				case 111:
 					return -1
-				case 74:
+				case 79:
 					return -1
-				case 67:
+				case 85:
 					return -1
 				case 116:
-					return -1
+					return 3
 				case 84:
+					return 3
+				case 82:
 					return -1
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
-				case 111:
-					return -1
-				case 79:
+				case 117:
 					return -1
 				case 101:
-					return -1
+					return 4
 				case 69:
+					return 4
+				case 114:
 					return -1
-				case 99:
-					return -1
-				case 98:
+				case 111:
 					return -1
-				case 66:
+				case 79:
 					return -1
-				case 106:
-					return 3
-				case 74:
-					return 3
-				case 67:
+				case 85:
 					return -1
 				case 116:
 					return -1
 				case 84:
 					return -1
+				case 82:
+					return -1
 				}
 				return -1
 			},
@@ -18718,360 +20082,442 @@ func NewLexerWithInit(in io.Reader, initFun func(*Lexer)) *Lexer {
 					return -1
 				case 79:
 					return -1
-				case 101:
-					return 4
-				case 69:
-					return 4
-				case 99:
-					return -1
-				case 98:
-					return -1
-				case 66:
+				case 85:
 					return -1
-				case 106:
+				case 116:
 					return -1
-				case 74:
+				case 84:
 					return -1
-				case 67:
+				case 82:
+					return 5
+				case 117:
 					return -1
-				case 116:
+				case 101:
 					return -1
-				case 84:
+				case 69:
 					return -1
+				case 114:
+					return 5
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
-				case 98:
-					return -1
-				case 66:
+				case 111:
 					return -1
-				case 106:
+				case 79:
 					return -1
-				case 74:
+				case 85:
 					return -1
-				case 67:
-					return 5
 				case 116:
 					return -1
 				case 84:
 					return -1
-				case 111:
+				case 82:
+					return -1
+				case 117:
+					return -1
+				case 101:
+					return -1
+				case 69:
 					return -1
+				case 114:
+					return -1
+				}
+				return -1
+			},
+		}, []int{ /* Start-of-input transitions */ -1, -1, -1, -1, -1, -1}, []int{ /* End-of-input transitions */ -1, -1, -1, -1, -1, -1}, nil},
+
+		// [oO][vV][eE][rR]
+		{[]bool{false, false, false, false, true}, []func(rune) int{ // Transitions
+			func(r rune) int {
+				switch r {
+				case 111:
+					return 1
 				case 79:
+					return 1
+				case 118:
+					return -1
+				case 86:
 					return -1
 				case 101:
 					return -1
 				case 69:
 					return -1
-				case 99:
-					return 5
+				case 114:
+					return -1
+				case 82:
+					return -1
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
-				case 98:
+				case 111:
 					return -1
-				case 66:
+				case 79:
 					return -1
-				case 106:
+				case 118:
+					return 2
+				case 86:
+					return 2
+				case 101:
 					return -1
-				case 74:
+				case 69:
 					return -1
-				case 67:
+				case 114:
 					return -1
-				case 116:
-					return 6
-				case 84:
-					return 6
+				case 82:
+					return -1
+				}
+				return -1
+			},
+			func(r rune) int {
+				switch r {
 				case 111:
 					return -1
 				case 79:
 					return -1
-				case 101:
+				case 118:
+					return -1
+				case 86:
 					return -1
+				case 101:
+					return 3
 				case 69:
+					return 3
+				case 114:
 					return -1
-				case 99:
+				case 82:
 					return -1
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
-				case 98:
-					return -1
-				case 66:
+				case 111:
 					return -1
-				case 106:
+				case 79:
 					return -1
-				case 74:
+				case 118:
 					return -1
-				case 67:
+				case 86:
 					return -1
-				case 116:
+				case 101:
 					return -1
-				case 84:
+				case 69:
 					return -1
+				case 114:
+					return 4
+				case 82:
+					return 4
+				}
+				return -1
+			},
+			func(r rune) int {
+				switch r {
 				case 111:
 					return -1
 				case 79:
 					return -1
+				case 118:
+					return -1
+				case 86:
+					return -1
 				case 101:
 					return -1
 				case 69:
 					return -1
-				case 99:
+				case 114:
+					return -1
+				case 82:
 					return -1
 				}
 				return -1
 			},
-		}, []int{ /* Start-of-input transitions */ -1, -1, -1, -1, -1, -1, -1}, []int{ /* End-of-input transitions */ -1, -1, -1, -1, -1, -1, -1}, nil},
+		}, []int{ /* Start-of-input transitions */ -1, -1, -1, -1, -1}, []int{ /* End-of-input transitions */ -1, -1, -1, -1, -1}, nil},
 
-		// [oO][fF][fF][sS][eE][tT]
-		{[]bool{false, false, false, false, false, false, true}, []func(rune) int{ // Transitions
+		// [pP][aA][rR][sS][eE]
+		{[]bool{false, false, false, false, false, true}, []func(rune) int{ // Transitions
 			func(r rune) int {
 				switch r {
-				case 111:
-					return 1
-				case 79:
+				case 112:
 					return 1
-				case 115:
+				case 114:
 					return -1
-				case 101:
+				case 82:
 					return -1
-				case 69:
+				case 83:
 					return -1
-				case 102:
+				case 101:
 					return -1
-				case 70:
+				case 80:
+					return 1
+				case 97:
 					return -1
-				case 83:
+				case 65:
 					return -1
-				case 116:
+				case 115:
 					return -1
-				case 84:
+				case 69:
 					return -1
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
-				case 102:
+				case 80:
+					return -1
+				case 97:
 					return 2
-				case 70:
+				case 65:
 					return 2
-				case 83:
+				case 115:
 					return -1
-				case 116:
+				case 69:
 					return -1
-				case 84:
+				case 112:
 					return -1
-				case 111:
+				case 114:
 					return -1
-				case 79:
+				case 82:
 					return -1
-				case 115:
+				case 83:
 					return -1
 				case 101:
 					return -1
-				case 69:
-					return -1
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
-				case 111:
+				case 80:
 					return -1
-				case 79:
+				case 97:
 					return -1
-				case 115:
+				case 65:
 					return -1
-				case 101:
+				case 115:
 					return -1
 				case 69:
 					return -1
-				case 102:
+				case 112:
+					return -1
+				case 114:
 					return 3
-				case 70:
+				case 82:
 					return 3
 				case 83:
 					return -1
-				case 116:
-					return -1
-				case 84:
+				case 101:
 					return -1
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
-				case 111:
+				case 80:
 					return -1
-				case 79:
+				case 97:
+					return -1
+				case 65:
 					return -1
 				case 115:
 					return 4
-				case 101:
-					return -1
 				case 69:
 					return -1
-				case 102:
+				case 112:
 					return -1
-				case 70:
+				case 114:
+					return -1
+				case 82:
 					return -1
 				case 83:
 					return 4
-				case 116:
-					return -1
-				case 84:
+				case 101:
 					return -1
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
-				case 111:
+				case 80:
 					return -1
-				case 79:
+				case 97:
+					return -1
+				case 65:
 					return -1
 				case 115:
 					return -1
-				case 101:
-					return 5
 				case 69:
 					return 5
-				case 102:
-					return -1
-				case 70:
+				case 112:
 					return -1
-				case 83:
+				case 114:
 					return -1
-				case 116:
+				case 82:
 					return -1
-				case 84:
+				case 83:
 					return -1
+				case 101:
+					return 5
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
-				case 102:
+				case 112:
 					return -1
-				case 70:
+				case 114:
+					return -1
+				case 82:
 					return -1
 				case 83:
 					return -1
-				case 116:
-					return 6
-				case 84:
-					return 6
-				case 111:
+				case 101:
 					return -1
-				case 79:
+				case 80:
 					return -1
-				case 115:
+				case 97:
 					return -1
-				case 101:
+				case 65:
+					return -1
+				case 115:
 					return -1
 				case 69:
 					return -1
 				}
 				return -1
 			},
+		}, []int{ /* Start-of-input transitions */ -1, -1, -1, -1, -1, -1}, []int{ /* End-of-input transitions */ -1, -1, -1, -1, -1, -1}, nil},
+
+		// [pP][aA][rR][tT][iI][tT][iI][oO][nN]
+		{[]bool{false, false, false, false, false, false, false, false, false, true}, []func(rune) int{ // Transitions
 			func(r rune) int {
 				switch r {
+				case 80:
+					return 1
+				case 97:
+					return -1
+				case 116:
+					return -1
 				case 111:
 					return -1
-				case 79:
+				case 112:
+					return 1
+				case 114:
 					return -1
-				case 115:
+				case 82:
 					return -1
-				case 101:
+				case 84:
 					return -1
-				case 69:
+				case 65:
 					return -1
-				case 102:
+				case 105:
 					return -1
-				case 70:
+				case 73:
 					return -1
-				case 83:
+				case 79:
 					return -1
-				case 116:
+				case 110:
 					return -1
-				case 84:
+				case 78:
 					return -1
 				}
 				return -1
 			},
-		}, []int{ /* Start-of-input transitions */ -1, -1, -1, -1, -1, -1, -1}, []int{ /* End-of-input transitions */ -1, -1, -1, -1, -1, -1, -1}, nil},
-
-		// [oO][nN]
-		{[]bool{false, false, true}, []func(rune) int{ // Transitions
 			func(r rune) int {
 				switch r {
-				case 111:
-					return 1
+				case 65:
+					return 2
+				case 105:
+					return -1
+				case 73:
+					return -1
 				case 79:
-					return 1
+					return -1
 				case 110:
 					return -1
 				case 78:
 					return -1
+				case 80:
+					return -1
+				case 97:
+					return 2
+				case 116:
+					return -1
+				case 111:
+					return -1
+				case 112:
+					return -1
+				case 114:
+					return -1
+				case 82:
+					return -1
+				case 84:
+					return -1
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
-				case 111:
+				case 105:
+					return -1
+				case 73:
 					return -1
 				case 79:
 					return -1
 				case 110:
-					return 2
+					return -1
 				case 78:
-					return 2
-				}
-				return -1
-			},
-			func(r rune) int {
-				switch r {
+					return -1
+				case 80:
+					return -1
+				case 97:
+					return -1
+				case 116:
+					return -1
 				case 111:
 					return -1
-				case 79:
+				case 112:
 					return -1
-				case 110:
+				case 114:
+					return 3
+				case 82:
+					return 3
+				case 84:
 					return -1
-				case 78:
+				case 65:
 					return -1
 				}
 				return -1
 			},
-		}, []int{ /* Start-of-input transitions */ -1, -1, -1}, []int{ /* End-of-input transitions */ -1, -1, -1}, nil},
-
-		// [oO][pP][tT][iI][oO][nN]
-		{[]bool{false, false, false, false, false, false, true}, []func(rune) int{ // Transitions
 			func(r rune) int {
 				switch r {
-				case 79:
-					return 1
 				case 80:
 					return -1
+				case 97:
+					return -1
+				case 116:
+					return 4
 				case 111:
-					return 1
+					return -1
 				case 112:
 					return -1
-				case 116:
+				case 114:
+					return -1
+				case 82:
 					return -1
 				case 84:
+					return 4
+				case 65:
 					return -1
 				case 105:
 					return -1
 				case 73:
 					return -1
+				case 79:
+					return -1
 				case 110:
 					return -1
 				case 78:
@@ -19081,47 +20527,63 @@ func NewLexerWithInit(in io.Reader, initFun func(*Lexer)) *Lexer {
 			},
 			func(r rune) int {
 				switch r {
-				case 111:
+				case 105:
+					return 5
+				case 73:
+					return 5
+				case 79:
+					return -1
+				case 110:
+					return -1
+				case 78:
+					return -1
+				case 80:
+					return -1
+				case 97:
 					return -1
-				case 112:
-					return 2
 				case 116:
 					return -1
-				case 84:
+				case 111:
 					return -1
-				case 105:
+				case 112:
 					return -1
-				case 73:
+				case 114:
 					return -1
-				case 110:
+				case 82:
 					return -1
-				case 78:
+				case 84:
 					return -1
-				case 79:
+				case 65:
 					return -1
-				case 80:
-					return 2
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
-				case 79:
-					return -1
 				case 80:
 					return -1
+				case 97:
+					return -1
+				case 116:
+					return 6
 				case 111:
 					return -1
 				case 112:
 					return -1
-				case 116:
-					return 3
+				case 114:
+					return -1
+				case 82:
+					return -1
 				case 84:
-					return 3
+					return 6
+				case 65:
+					return -1
 				case 105:
 					return -1
 				case 73:
 					return -1
+				case 79:
+					return -1
 				case 110:
 					return -1
 				case 78:
@@ -19131,666 +20593,615 @@ func NewLexerWithInit(in io.Reader, initFun func(*Lexer)) *Lexer {
 			},
 			func(r rune) int {
 				switch r {
-				case 111:
+				case 105:
+					return 7
+				case 73:
+					return 7
+				case 79:
 					return -1
-				case 112:
+				case 110:
+					return -1
+				case 78:
+					return -1
+				case 80:
+					return -1
+				case 97:
 					return -1
 				case 116:
 					return -1
-				case 84:
+				case 111:
 					return -1
-				case 105:
-					return 4
-				case 73:
-					return 4
-				case 110:
+				case 112:
 					return -1
-				case 78:
+				case 114:
 					return -1
-				case 79:
+				case 82:
 					return -1
-				case 80:
+				case 84:
+					return -1
+				case 65:
 					return -1
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
-				case 79:
-					return 5
-				case 80:
-					return -1
-				case 111:
-					return 5
 				case 112:
 					return -1
-				case 116:
+				case 114:
+					return -1
+				case 82:
 					return -1
 				case 84:
 					return -1
+				case 65:
+					return -1
 				case 105:
 					return -1
 				case 73:
 					return -1
+				case 79:
+					return 8
 				case 110:
 					return -1
 				case 78:
 					return -1
+				case 80:
+					return -1
+				case 97:
+					return -1
+				case 116:
+					return -1
+				case 111:
+					return 8
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
-				case 79:
-					return -1
-				case 80:
-					return -1
-				case 111:
-					return -1
 				case 112:
 					return -1
-				case 116:
+				case 114:
+					return -1
+				case 82:
 					return -1
 				case 84:
 					return -1
+				case 65:
+					return -1
 				case 105:
 					return -1
 				case 73:
 					return -1
+				case 79:
+					return -1
 				case 110:
-					return 6
+					return 9
 				case 78:
-					return 6
+					return 9
+				case 80:
+					return -1
+				case 97:
+					return -1
+				case 116:
+					return -1
+				case 111:
+					return -1
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
-				case 79:
-					return -1
-				case 80:
-					return -1
-				case 111:
-					return -1
 				case 112:
 					return -1
-				case 116:
+				case 114:
+					return -1
+				case 82:
 					return -1
 				case 84:
 					return -1
+				case 65:
+					return -1
 				case 105:
 					return -1
 				case 73:
 					return -1
+				case 79:
+					return -1
 				case 110:
 					return -1
 				case 78:
 					return -1
-				}
-				return -1
-			},
-		}, []int{ /* Start-of-input transitions */ -1, -1, -1, -1, -1, -1, -1}, []int{ /* End-of-input transitions */ -1, -1, -1, -1, -1, -1, -1}, nil},
-
-		// [oO][rR]
-		{[]bool{false, false, true}, []func(rune) int{ // Transitions
-			func(r rune) int {
-				switch r {
-				case 111:
-					return 1
-				case 79:
-					return 1
-				case 114:
+				case 80:
 					return -1
-				case 82:
+				case 97:
 					return -1
-				}
-				return -1
-			},
-			func(r rune) int {
-				switch r {
-				case 111:
+				case 116:
 					return -1
-				case 79:
+				case 111:
 					return -1
-				case 114:
-					return 2
-				case 82:
-					return 2
 				}
 				return -1
 			},
+		}, []int{ /* Start-of-input transitions */ -1, -1, -1, -1, -1, -1, -1, -1, -1, -1}, []int{ /* End-of-input transitions */ -1, -1, -1, -1, -1, -1, -1, -1, -1, -1}, nil},
+
+		// [pP][aA][sS][sS][wW][oO][rR][dD]
+		{[]bool{false, false, false, false, false, false, false, false, true}, []func(rune) int{ // Transitions
 			func(r rune) int {
 				switch r {
-				case 111:
+				case 65:
 					return -1
-				case 79:
+				case 115:
+					return -1
+				case 119:
+					return -1
+				case 87:
 					return -1
 				case 114:
 					return -1
 				case 82:
 					return -1
-				}
-				return -1
-			},
-		}, []int{ /* Start-of-input transitions */ -1, -1, -1}, []int{ /* End-of-input transitions */ -1, -1, -1}, nil},
-
-		// [oO][rR][dD][eE][rR]
-		{[]bool{false, false, false, false, false, true}, []func(rune) int{ // Transitions
-			func(r rune) int {
-				switch r {
-				case 111:
-					return 1
-				case 79:
+				case 80:
 					return 1
-				case 114:
+				case 111:
 					return -1
-				case 82:
+				case 112:
+					return 1
+				case 97:
 					return -1
-				case 100:
+				case 83:
 					return -1
-				case 68:
+				case 79:
 					return -1
-				case 101:
+				case 100:
 					return -1
-				case 69:
+				case 68:
 					return -1
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
+				case 80:
+					return -1
 				case 111:
 					return -1
-				case 79:
+				case 112:
 					return -1
-				case 114:
-					return 2
-				case 82:
+				case 97:
 					return 2
+				case 83:
+					return -1
+				case 79:
+					return -1
 				case 100:
 					return -1
 				case 68:
 					return -1
-				case 101:
+				case 65:
+					return 2
+				case 115:
 					return -1
-				case 69:
+				case 119:
+					return -1
+				case 87:
+					return -1
+				case 114:
+					return -1
+				case 82:
 					return -1
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
-				case 111:
+				case 65:
 					return -1
-				case 79:
+				case 115:
+					return 3
+				case 119:
+					return -1
+				case 87:
 					return -1
 				case 114:
 					return -1
 				case 82:
 					return -1
-				case 100:
-					return 3
-				case 68:
-					return 3
-				case 101:
-					return -1
-				case 69:
+				case 80:
 					return -1
-				}
-				return -1
-			},
-			func(r rune) int {
-				switch r {
 				case 111:
 					return -1
-				case 79:
+				case 112:
 					return -1
-				case 114:
+				case 97:
 					return -1
-				case 82:
+				case 83:
+					return 3
+				case 79:
 					return -1
 				case 100:
 					return -1
 				case 68:
 					return -1
-				case 101:
-					return 4
-				case 69:
-					return 4
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
-				case 111:
+				case 65:
 					return -1
-				case 79:
+				case 115:
+					return 4
+				case 119:
 					return -1
-				case 114:
-					return 5
-				case 82:
-					return 5
-				case 100:
+				case 87:
 					return -1
-				case 68:
+				case 114:
 					return -1
-				case 101:
+				case 82:
 					return -1
-				case 69:
+				case 80:
 					return -1
-				}
-				return -1
-			},
-			func(r rune) int {
-				switch r {
 				case 111:
 					return -1
-				case 79:
+				case 112:
 					return -1
-				case 114:
+				case 97:
 					return -1
-				case 82:
+				case 83:
+					return 4
+				case 79:
 					return -1
 				case 100:
 					return -1
 				case 68:
 					return -1
-				case 101:
-					return -1
-				case 69:
-					return -1
 				}
 				return -1
 			},
-		}, []int{ /* Start-of-input transitions */ -1, -1, -1, -1, -1, -1}, []int{ /* End-of-input transitions */ -1, -1, -1, -1, -1, -1}, nil},
-
-		// [oO][uU][tT][eE][rR]
-		{[]bool{false, false, false, false, false, true}, []func(rune) int{ // Transitions
 			func(r rune) int {
 				switch r {
-				case 117:
-					return -1
-				case 101:
+				case 112:
 					return -1
-				case 69:
+				case 97:
 					return -1
-				case 114:
+				case 83:
 					return -1
-				case 111:
-					return 1
 				case 79:
-					return 1
-				case 85:
-					return -1
-				case 116:
-					return -1
-				case 84:
 					return -1
-				case 82:
+				case 100:
 					return -1
-				}
-				return -1
-			},
-			func(r rune) int {
-				switch r {
-				case 111:
+				case 68:
 					return -1
-				case 79:
+				case 65:
 					return -1
-				case 85:
-					return 2
-				case 116:
+				case 115:
 					return -1
-				case 84:
+				case 119:
+					return 5
+				case 87:
+					return 5
+				case 114:
 					return -1
 				case 82:
 					return -1
-				case 117:
-					return 2
-				case 101:
-					return -1
-				case 69:
+				case 80:
 					return -1
-				case 114:
+				case 111:
 					return -1
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
-				case 117:
+				case 65:
 					return -1
-				case 101:
+				case 115:
 					return -1
-				case 69:
+				case 119:
+					return -1
+				case 87:
 					return -1
 				case 114:
 					return -1
+				case 82:
+					return -1
+				case 80:
+					return -1
 				case 111:
+					return 6
+				case 112:
 					return -1
-				case 79:
+				case 97:
 					return -1
-				case 85:
+				case 83:
 					return -1
-				case 116:
-					return 3
-				case 84:
-					return 3
-				case 82:
+				case 79:
+					return 6
+				case 100:
+					return -1
+				case 68:
 					return -1
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
-				case 117:
-					return -1
-				case 101:
-					return 4
-				case 69:
-					return 4
-				case 114:
+				case 80:
 					return -1
 				case 111:
 					return -1
+				case 112:
+					return -1
+				case 97:
+					return -1
+				case 83:
+					return -1
 				case 79:
 					return -1
-				case 85:
+				case 100:
 					return -1
-				case 116:
+				case 68:
 					return -1
-				case 84:
+				case 65:
 					return -1
-				case 82:
+				case 115:
+					return -1
+				case 119:
 					return -1
+				case 87:
+					return -1
+				case 114:
+					return 7
+				case 82:
+					return 7
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
-				case 111:
+				case 65:
 					return -1
-				case 79:
+				case 115:
 					return -1
-				case 85:
+				case 119:
 					return -1
-				case 116:
+				case 87:
 					return -1
-				case 84:
+				case 114:
 					return -1
 				case 82:
-					return 5
-				case 117:
 					return -1
-				case 101:
+				case 80:
 					return -1
-				case 69:
+				case 111:
 					return -1
-				case 114:
-					return 5
+				case 112:
+					return -1
+				case 97:
+					return -1
+				case 83:
+					return -1
+				case 79:
+					return -1
+				case 100:
+					return 8
+				case 68:
+					return 8
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
-				case 111:
+				case 112:
+					return -1
+				case 97:
+					return -1
+				case 83:
 					return -1
 				case 79:
 					return -1
-				case 85:
+				case 100:
 					return -1
-				case 116:
+				case 68:
 					return -1
-				case 84:
+				case 65:
 					return -1
-				case 82:
+				case 115:
 					return -1
-				case 117:
+				case 119:
 					return -1
-				case 101:
+				case 87:
 					return -1
-				case 69:
+				case 114:
+					return -1
+				case 82:
+					return -1
+				case 80:
 					return -1
-				case 114:
+				case 111:
 					return -1
 				}
 				return -1
 			},
-		}, []int{ /* Start-of-input transitions */ -1, -1, -1, -1, -1, -1}, []int{ /* End-of-input transitions */ -1, -1, -1, -1, -1, -1}, nil},
+		}, []int{ /* Start-of-input transitions */ -1, -1, -1, -1, -1, -1, -1, -1, -1}, []int{ /* End-of-input transitions */ -1, -1, -1, -1, -1, -1, -1, -1, -1}, nil},
 
-		// [oO][vV][eE][rR]
+		// [pP][aA][tT][hH]
 		{[]bool{false, false, false, false, true}, []func(rune) int{ // Transitions
 			func(r rune) int {
 				switch r {
-				case 111:
+				case 112:
 					return 1
-				case 79:
+				case 80:
 					return 1
-				case 118:
+				case 97:
 					return -1
-				case 86:
+				case 65:
 					return -1
-				case 101:
+				case 116:
 					return -1
-				case 69:
+				case 84:
 					return -1
-				case 114:
+				case 104:
 					return -1
-				case 82:
+				case 72:
 					return -1
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
-				case 111:
+				case 112:
 					return -1
-				case 79:
+				case 80:
 					return -1
-				case 118:
+				case 97:
 					return 2
-				case 86:
+				case 65:
 					return 2
-				case 101:
+				case 116:
 					return -1
-				case 69:
+				case 84:
 					return -1
-				case 114:
+				case 104:
 					return -1
-				case 82:
+				case 72:
 					return -1
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
-				case 111:
+				case 112:
 					return -1
-				case 79:
+				case 80:
 					return -1
-				case 118:
+				case 97:
 					return -1
-				case 86:
+				case 65:
 					return -1
-				case 101:
+				case 116:
 					return 3
-				case 69:
+				case 84:
 					return 3
-				case 114:
+				case 104:
 					return -1
-				case 82:
+				case 72:
 					return -1
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
-				case 111:
+				case 112:
 					return -1
-				case 79:
+				case 80:
 					return -1
-				case 118:
+				case 97:
 					return -1
-				case 86:
+				case 65:
 					return -1
-				case 101:
+				case 116:
 					return -1
-				case 69:
+				case 84:
 					return -1
-				case 114:
+				case 104:
 					return 4
-				case 82:
+				case 72:
 					return 4
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
-				case 111:
+				case 112:
 					return -1
-				case 79:
+				case 80:
 					return -1
-				case 118:
+				case 97:
 					return -1
-				case 86:
+				case 65:
 					return -1
-				case 101:
+				case 116:
 					return -1
-				case 69:
+				case 84:
 					return -1
-				case 114:
+				case 104:
 					return -1
-				case 82:
+				case 72:
 					return -1
 				}
 				return -1
 			},
 		}, []int{ /* Start-of-input transitions */ -1, -1, -1, -1, -1}, []int{ /* End-of-input transitions */ -1, -1, -1, -1, -1}, nil},
 
-		// [pP][aA][rR][sS][eE]
-		{[]bool{false, false, false, false, false, true}, []func(rune) int{ // Transitions
+		// [pP][oO][oO][lL]
+		{[]bool{false, false, false, false, true}, []func(rune) int{ // Transitions
 			func(r rune) int {
 				switch r {
 				case 112:
 					return 1
-				case 114:
-					return -1
-				case 82:
-					return -1
-				case 83:
-					return -1
-				case 101:
-					return -1
 				case 80:
 					return 1
-				case 97:
+				case 111:
 					return -1
-				case 65:
+				case 79:
 					return -1
-				case 115:
+				case 108:
 					return -1
-				case 69:
+				case 76:
 					return -1
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
+				case 112:
+					return -1
 				case 80:
 					return -1
-				case 97:
+				case 111:
 					return 2
-				case 65:
+				case 79:
 					return 2
-				case 115:
-					return -1
-				case 69:
-					return -1
-				case 112:
-					return -1
-				case 114:
-					return -1
-				case 82:
-					return -1
-				case 83:
+				case 108:
 					return -1
-				case 101:
+				case 76:
 					return -1
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
-				case 80:
-					return -1
-				case 97:
-					return -1
-				case 65:
-					return -1
-				case 115:
-					return -1
-				case 69:
-					return -1
 				case 112:
 					return -1
-				case 114:
+				case 80:
+					return -1
+				case 111:
 					return 3
-				case 82:
+				case 79:
 					return 3
-				case 83:
+				case 108:
 					return -1
-				case 101:
+				case 76:
 					return -1
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
-				case 80:
-					return -1
-				case 97:
-					return -1
-				case 65:
-					return -1
-				case 115:
-					return 4
-				case 69:
-					return -1
 				case 112:
 					return -1
-				case 114:
-					return -1
-				case 82:
-					return -1
-				case 83:
-					return 4
-				case 101:
-					return -1
-				}
-				return -1
-			},
-			func(r rune) int {
-				switch r {
 				case 80:
 					return -1
-				case 97:
-					return -1
-				case 65:
-					return -1
-				case 115:
-					return -1
-				case 69:
-					return 5
-				case 112:
-					return -1
-				case 114:
-					return -1
-				case 82:
+				case 111:
 					return -1
-				case 83:
+				case 79:
 					return -1
-				case 101:
-					return 5
+				case 108:
+					return 4
+				case 76:
+					return 4
 				}
 				return -1
 			},
@@ -19798,258 +21209,278 @@ func NewLexerWithInit(in io.Reader, initFun func(*Lexer)) *Lexer {
 				switch r {
 				case 112:
 					return -1
-				case 114:
-					return -1
-				case 82:
-					return -1
-				case 83:
-					return -1
-				case 101:
-					return -1
 				case 80:
 					return -1
-				case 97:
+				case 111:
 					return -1
-				case 65:
+				case 79:
 					return -1
-				case 115:
+				case 108:
 					return -1
-				case 69:
+				case 76:
 					return -1
 				}
 				return -1
 			},
-		}, []int{ /* Start-of-input transitions */ -1, -1, -1, -1, -1, -1}, []int{ /* End-of-input transitions */ -1, -1, -1, -1, -1, -1}, nil},
+		}, []int{ /* Start-of-input transitions */ -1, -1, -1, -1, -1}, []int{ /* End-of-input transitions */ -1, -1, -1, -1, -1}, nil},
 
-		// [pP][aA][rR][tT][iI][tT][iI][oO][nN]
+		// [pP][rR][eE][cC][eE][dD][iI][nN][gG]
 		{[]bool{false, false, false, false, false, false, false, false, false, true}, []func(rune) int{ // Transitions
 			func(r rune) int {
 				switch r {
+				case 112:
+					return 1
 				case 80:
 					return 1
-				case 97:
+				case 114:
 					return -1
-				case 116:
+				case 82:
 					return -1
-				case 111:
+				case 101:
 					return -1
-				case 112:
-					return 1
-				case 114:
+				case 69:
 					return -1
-				case 82:
+				case 99:
 					return -1
-				case 84:
+				case 67:
 					return -1
-				case 65:
+				case 100:
+					return -1
+				case 68:
 					return -1
 				case 105:
 					return -1
 				case 73:
 					return -1
-				case 79:
-					return -1
 				case 110:
 					return -1
 				case 78:
 					return -1
+				case 103:
+					return -1
+				case 71:
+					return -1
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
-				case 65:
+				case 112:
+					return -1
+				case 80:
+					return -1
+				case 114:
 					return 2
-				case 105:
+				case 82:
+					return 2
+				case 101:
 					return -1
-				case 73:
+				case 69:
 					return -1
-				case 79:
+				case 99:
 					return -1
-				case 110:
+				case 67:
 					return -1
-				case 78:
+				case 100:
 					return -1
-				case 80:
+				case 68:
 					return -1
-				case 97:
-					return 2
-				case 116:
+				case 105:
 					return -1
-				case 111:
+				case 73:
 					return -1
-				case 112:
+				case 110:
 					return -1
-				case 114:
+				case 78:
 					return -1
-				case 82:
+				case 103:
 					return -1
-				case 84:
+				case 71:
 					return -1
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
-				case 105:
+				case 112:
 					return -1
-				case 73:
+				case 80:
 					return -1
-				case 79:
+				case 114:
 					return -1
-				case 110:
+				case 82:
 					return -1
-				case 78:
+				case 101:
+					return 3
+				case 69:
+					return 3
+				case 99:
 					return -1
-				case 80:
+				case 67:
 					return -1
-				case 97:
+				case 100:
 					return -1
-				case 116:
+				case 68:
 					return -1
-				case 111:
+				case 105:
 					return -1
-				case 112:
+				case 73:
 					return -1
-				case 114:
-					return 3
-				case 82:
-					return 3
-				case 84:
+				case 110:
 					return -1
-				case 65:
+				case 78:
+					return -1
+				case 103:
+					return -1
+				case 71:
 					return -1
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
-				case 80:
-					return -1
-				case 97:
-					return -1
-				case 116:
-					return 4
-				case 111:
-					return -1
 				case 112:
 					return -1
+				case 80:
+					return -1
 				case 114:
 					return -1
 				case 82:
 					return -1
-				case 84:
+				case 101:
+					return -1
+				case 69:
+					return -1
+				case 99:
 					return 4
-				case 65:
+				case 67:
+					return 4
+				case 100:
+					return -1
+				case 68:
 					return -1
 				case 105:
 					return -1
 				case 73:
 					return -1
-				case 79:
-					return -1
 				case 110:
 					return -1
 				case 78:
 					return -1
+				case 103:
+					return -1
+				case 71:
+					return -1
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
-				case 105:
-					return 5
-				case 73:
-					return 5
-				case 79:
+				case 112:
 					return -1
-				case 110:
+				case 80:
 					return -1
-				case 78:
+				case 114:
 					return -1
-				case 80:
+				case 82:
 					return -1
-				case 97:
+				case 101:
+					return 5
+				case 69:
+					return 5
+				case 99:
 					return -1
-				case 116:
+				case 67:
 					return -1
-				case 111:
+				case 100:
 					return -1
-				case 112:
+				case 68:
 					return -1
-				case 114:
+				case 105:
 					return -1
-				case 82:
+				case 73:
 					return -1
-				case 84:
+				case 110:
 					return -1
-				case 65:
+				case 78:
+					return -1
+				case 103:
+					return -1
+				case 71:
 					return -1
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
+				case 112:
+					return -1
 				case 80:
 					return -1
-				case 97:
+				case 114:
 					return -1
-				case 116:
-					return 6
-				case 111:
+				case 82:
 					return -1
-				case 112:
+				case 101:
 					return -1
-				case 114:
+				case 69:
 					return -1
-				case 82:
+				case 99:
 					return -1
-				case 84:
-					return 6
-				case 65:
+				case 67:
 					return -1
+				case 100:
+					return 6
+				case 68:
+					return 6
 				case 105:
 					return -1
 				case 73:
 					return -1
-				case 79:
-					return -1
 				case 110:
 					return -1
 				case 78:
 					return -1
+				case 103:
+					return -1
+				case 71:
+					return -1
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
-				case 105:
-					return 7
-				case 73:
-					return 7
-				case 79:
+				case 112:
 					return -1
-				case 110:
+				case 80:
 					return -1
-				case 78:
+				case 114:
 					return -1
-				case 80:
+				case 82:
 					return -1
-				case 97:
+				case 101:
 					return -1
-				case 116:
+				case 69:
 					return -1
-				case 111:
+				case 99:
 					return -1
-				case 112:
+				case 67:
 					return -1
-				case 114:
+				case 100:
 					return -1
-				case 82:
+				case 68:
 					return -1
-				case 84:
+				case 105:
+					return 7
+				case 73:
+					return 7
+				case 110:
 					return -1
-				case 65:
+				case 78:
+					return -1
+				case 103:
+					return -1
+				case 71:
 					return -1
 				}
 				return -1
@@ -20058,32 +21489,36 @@ func NewLexerWithInit(in io.Reader, initFun func(*Lexer)) *Lexer {
 				switch r {
 				case 112:
 					return -1
+				case 80:
+					return -1
 				case 114:
 					return -1
 				case 82:
 					return -1
-				case 84:
+				case 101:
 					return -1
-				case 65:
+				case 69:
+					return -1
+				case 99:
+					return -1
+				case 67:
+					return -1
+				case 100:
+					return -1
+				case 68:
 					return -1
 				case 105:
 					return -1
 				case 73:
 					return -1
-				case 79:
-					return 8
 				case 110:
-					return -1
+					return 8
 				case 78:
+					return 8
+				case 103:
 					return -1
-				case 80:
-					return -1
-				case 97:
-					return -1
-				case 116:
+				case 71:
 					return -1
-				case 111:
-					return 8
 				}
 				return -1
 			},
@@ -20091,32 +21526,36 @@ func NewLexerWithInit(in io.Reader, initFun func(*Lexer)) *Lexer {
 				switch r {
 				case 112:
 					return -1
+				case 80:
+					return -1
 				case 114:
 					return -1
 				case 82:
 					return -1
-				case 84:
+				case 101:
 					return -1
-				case 65:
+				case 69:
 					return -1
-				case 105:
+				case 99:
 					return -1
-				case 73:
+				case 67:
 					return -1
-				case 79:
+				case 100:
 					return -1
-				case 110:
-					return 9
-				case 78:
-					return 9
-				case 80:
+				case 68:
 					return -1
-				case 97:
+				case 105:
 					return -1
-				case 116:
+				case 73:
 					return -1
-				case 111:
+				case 110:
+					return -1
+				case 78:
 					return -1
+				case 103:
+					return 9
+				case 71:
+					return 9
 				}
 				return -1
 			},
@@ -20124,168 +21563,145 @@ func NewLexerWithInit(in io.Reader, initFun func(*Lexer)) *Lexer {
 				switch r {
 				case 112:
 					return -1
+				case 80:
+					return -1
 				case 114:
 					return -1
 				case 82:
 					return -1
-				case 84:
+				case 101:
 					return -1
-				case 65:
+				case 69:
+					return -1
+				case 99:
+					return -1
+				case 67:
+					return -1
+				case 100:
+					return -1
+				case 68:
 					return -1
 				case 105:
 					return -1
 				case 73:
 					return -1
-				case 79:
-					return -1
 				case 110:
 					return -1
 				case 78:
 					return -1
-				case 80:
-					return -1
-				case 97:
-					return -1
-				case 116:
+				case 103:
 					return -1
-				case 111:
+				case 71:
 					return -1
 				}
 				return -1
 			},
 		}, []int{ /* Start-of-input transitions */ -1, -1, -1, -1, -1, -1, -1, -1, -1, -1}, []int{ /* End-of-input transitions */ -1, -1, -1, -1, -1, -1, -1, -1, -1, -1}, nil},
 
-		// [pP][aA][sS][sS][wW][oO][rR][dD]
-		{[]bool{false, false, false, false, false, false, false, false, true}, []func(rune) int{ // Transitions
+		// [pP][rR][eE][pP][aA][rR][eE]
+		{[]bool{false, false, false, false, false, false, false, true}, []func(rune) int{ // Transitions
 			func(r rune) int {
 				switch r {
-				case 65:
-					return -1
-				case 115:
-					return -1
-				case 119:
-					return -1
-				case 87:
-					return -1
-				case 114:
-					return -1
-				case 82:
-					return -1
+				case 112:
+					return 1
 				case 80:
 					return 1
-				case 111:
+				case 114:
 					return -1
-				case 112:
-					return 1
-				case 97:
+				case 82:
 					return -1
-				case 83:
+				case 101:
 					return -1
-				case 79:
+				case 69:
 					return -1
-				case 100:
+				case 97:
 					return -1
-				case 68:
+				case 65:
 					return -1
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
-				case 80:
-					return -1
-				case 111:
-					return -1
 				case 112:
 					return -1
-				case 97:
-					return 2
-				case 83:
+				case 80:
 					return -1
-				case 79:
+				case 114:
+					return 2
+				case 82:
+					return 2
+				case 101:
 					return -1
-				case 100:
+				case 69:
 					return -1
-				case 68:
+				case 97:
 					return -1
 				case 65:
-					return 2
-				case 115:
 					return -1
-				case 119:
+				}
+				return -1
+			},
+			func(r rune) int {
+				switch r {
+				case 112:
 					return -1
-				case 87:
+				case 80:
 					return -1
 				case 114:
 					return -1
 				case 82:
 					return -1
+				case 101:
+					return 3
+				case 69:
+					return 3
+				case 97:
+					return -1
+				case 65:
+					return -1
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
-				case 65:
-					return -1
-				case 115:
-					return 3
-				case 119:
-					return -1
-				case 87:
-					return -1
+				case 112:
+					return 4
+				case 80:
+					return 4
 				case 114:
 					return -1
 				case 82:
 					return -1
-				case 80:
-					return -1
-				case 111:
+				case 101:
 					return -1
-				case 112:
+				case 69:
 					return -1
 				case 97:
 					return -1
-				case 83:
-					return 3
-				case 79:
-					return -1
-				case 100:
-					return -1
-				case 68:
+				case 65:
 					return -1
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
-				case 65:
-					return -1
-				case 115:
-					return 4
-				case 119:
+				case 112:
 					return -1
-				case 87:
+				case 80:
 					return -1
 				case 114:
 					return -1
 				case 82:
 					return -1
-				case 80:
-					return -1
-				case 111:
+				case 101:
 					return -1
-				case 112:
+				case 69:
 					return -1
 				case 97:
-					return -1
-				case 83:
-					return 4
-				case 79:
-					return -1
-				case 100:
-					return -1
-				case 68:
-					return -1
+					return 5
+				case 65:
+					return 5
 				}
 				return -1
 			},
@@ -20293,252 +21709,240 @@ func NewLexerWithInit(in io.Reader, initFun func(*Lexer)) *Lexer {
 				switch r {
 				case 112:
 					return -1
-				case 97:
-					return -1
-				case 83:
+				case 80:
 					return -1
-				case 79:
+				case 114:
+					return 6
+				case 82:
+					return 6
+				case 101:
 					return -1
-				case 100:
+				case 69:
 					return -1
-				case 68:
+				case 97:
 					return -1
 				case 65:
 					return -1
-				case 115:
+				}
+				return -1
+			},
+			func(r rune) int {
+				switch r {
+				case 112:
+					return -1
+				case 80:
 					return -1
-				case 119:
-					return 5
-				case 87:
-					return 5
 				case 114:
 					return -1
 				case 82:
 					return -1
-				case 80:
+				case 101:
+					return 7
+				case 69:
+					return 7
+				case 97:
 					return -1
-				case 111:
+				case 65:
 					return -1
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
-				case 65:
-					return -1
-				case 115:
-					return -1
-				case 119:
+				case 112:
 					return -1
-				case 87:
+				case 80:
 					return -1
 				case 114:
 					return -1
 				case 82:
 					return -1
-				case 80:
+				case 101:
 					return -1
-				case 111:
-					return 6
-				case 112:
+				case 69:
 					return -1
 				case 97:
 					return -1
-				case 83:
-					return -1
-				case 79:
-					return 6
-				case 100:
-					return -1
-				case 68:
+				case 65:
 					return -1
 				}
 				return -1
 			},
+		}, []int{ /* Start-of-input transitions */ -1, -1, -1, -1, -1, -1, -1, -1}, []int{ /* End-of-input transitions */ -1, -1, -1, -1, -1, -1, -1, -1}, nil},
+
+		// [pP][rR][iI][mM][aA][rR][yY]
+		{[]bool{false, false, false, false, false, false, false, true}, []func(rune) int{ // Transitions
 			func(r rune) int {
 				switch r {
-				case 80:
-					return -1
-				case 111:
-					return -1
 				case 112:
+					return 1
+				case 80:
+					return 1
+				case 77:
 					return -1
-				case 97:
+				case 65:
 					return -1
-				case 83:
+				case 121:
 					return -1
-				case 79:
+				case 114:
 					return -1
-				case 100:
+				case 82:
 					return -1
-				case 68:
+				case 105:
 					return -1
-				case 65:
+				case 73:
 					return -1
-				case 115:
+				case 109:
 					return -1
-				case 119:
+				case 97:
 					return -1
-				case 87:
+				case 89:
 					return -1
-				case 114:
-					return 7
-				case 82:
-					return 7
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
-				case 65:
+				case 114:
+					return 2
+				case 82:
+					return 2
+				case 105:
 					return -1
-				case 115:
+				case 73:
 					return -1
-				case 119:
+				case 109:
 					return -1
-				case 87:
+				case 97:
 					return -1
-				case 114:
+				case 89:
 					return -1
-				case 82:
+				case 112:
 					return -1
 				case 80:
 					return -1
-				case 111:
-					return -1
-				case 112:
-					return -1
-				case 97:
+				case 77:
 					return -1
-				case 83:
+				case 65:
 					return -1
-				case 79:
+				case 121:
 					return -1
-				case 100:
-					return 8
-				case 68:
-					return 8
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
-				case 112:
-					return -1
-				case 97:
-					return -1
-				case 83:
-					return -1
-				case 79:
-					return -1
-				case 100:
+				case 114:
 					return -1
-				case 68:
+				case 82:
 					return -1
-				case 65:
+				case 105:
+					return 3
+				case 73:
+					return 3
+				case 109:
 					return -1
-				case 115:
+				case 97:
 					return -1
-				case 119:
+				case 89:
 					return -1
-				case 87:
+				case 112:
 					return -1
-				case 114:
+				case 80:
 					return -1
-				case 82:
+				case 77:
 					return -1
-				case 80:
+				case 65:
 					return -1
-				case 111:
+				case 121:
 					return -1
 				}
 				return -1
 			},
-		}, []int{ /* Start-of-input transitions */ -1, -1, -1, -1, -1, -1, -1, -1, -1}, []int{ /* End-of-input transitions */ -1, -1, -1, -1, -1, -1, -1, -1, -1}, nil},
-
-		// [pP][aA][tT][hH]
-		{[]bool{false, false, false, false, true}, []func(rune) int{ // Transitions
 			func(r rune) int {
 				switch r {
 				case 112:
-					return 1
+					return -1
 				case 80:
-					return 1
-				case 97:
 					return -1
+				case 77:
+					return 4
 				case 65:
 					return -1
-				case 116:
+				case 121:
 					return -1
-				case 84:
+				case 114:
 					return -1
-				case 104:
+				case 82:
 					return -1
-				case 72:
+				case 105:
+					return -1
+				case 73:
+					return -1
+				case 109:
+					return 4
+				case 97:
+					return -1
+				case 89:
 					return -1
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
-				case 112:
+				case 114:
 					return -1
-				case 80:
+				case 82:
 					return -1
-				case 97:
-					return 2
-				case 65:
-					return 2
-				case 116:
+				case 105:
 					return -1
-				case 84:
+				case 73:
 					return -1
-				case 104:
+				case 109:
 					return -1
-				case 72:
+				case 97:
+					return 5
+				case 89:
 					return -1
-				}
-				return -1
-			},
-			func(r rune) int {
-				switch r {
 				case 112:
 					return -1
 				case 80:
 					return -1
-				case 97:
+				case 77:
 					return -1
 				case 65:
-					return -1
-				case 116:
-					return 3
-				case 84:
-					return 3
-				case 104:
-					return -1
-				case 72:
+					return 5
+				case 121:
 					return -1
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
+				case 114:
+					return 6
+				case 82:
+					return 6
+				case 105:
+					return -1
+				case 73:
+					return -1
+				case 109:
+					return -1
+				case 97:
+					return -1
+				case 89:
+					return -1
 				case 112:
 					return -1
 				case 80:
 					return -1
-				case 97:
+				case 77:
 					return -1
 				case 65:
 					return -1
-				case 116:
-					return -1
-				case 84:
+				case 121:
 					return -1
-				case 104:
-					return 4
-				case 72:
-					return 4
 				}
 				return -1
 			},
@@ -20548,90 +21952,92 @@ func NewLexerWithInit(in io.Reader, initFun func(*Lexer)) *Lexer {
 					return -1
 				case 80:
 					return -1
-				case 97:
+				case 77:
 					return -1
 				case 65:
 					return -1
-				case 116:
+				case 121:
+					return 7
+				case 114:
 					return -1
-				case 84:
+				case 82:
 					return -1
-				case 104:
+				case 105:
 					return -1
-				case 72:
+				case 73:
+					return -1
+				case 109:
+					return -1
+				case 97:
 					return -1
+				case 89:
+					return 7
 				}
 				return -1
 			},
-		}, []int{ /* Start-of-input transitions */ -1, -1, -1, -1, -1}, []int{ /* End-of-input transitions */ -1, -1, -1, -1, -1}, nil},
-
-		// [pP][oO][oO][lL]
-		{[]bool{false, false, false, false, true}, []func(rune) int{ // Transitions
 			func(r rune) int {
 				switch r {
-				case 112:
-					return 1
-				case 80:
-					return 1
-				case 111:
+				case 114:
 					return -1
-				case 79:
+				case 82:
 					return -1
-				case 108:
+				case 105:
 					return -1
-				case 76:
+				case 73:
+					return -1
+				case 109:
+					return -1
+				case 97:
+					return -1
+				case 89:
 					return -1
-				}
-				return -1
-			},
-			func(r rune) int {
-				switch r {
 				case 112:
 					return -1
 				case 80:
 					return -1
-				case 111:
-					return 2
-				case 79:
-					return 2
-				case 108:
+				case 77:
 					return -1
-				case 76:
+				case 65:
+					return -1
+				case 121:
 					return -1
 				}
 				return -1
 			},
+		}, []int{ /* Start-of-input transitions */ -1, -1, -1, -1, -1, -1, -1, -1}, []int{ /* End-of-input transitions */ -1, -1, -1, -1, -1, -1, -1, -1}, nil},
+
+		// [pP][rR][iI][vV][aA][tT][eE]
+		{[]bool{false, false, false, false, false, false, false, true}, []func(rune) int{ // Transitions
 			func(r rune) int {
 				switch r {
 				case 112:
+					return 1
+				case 82:
+					return -1
+				case 101:
+					return -1
+				case 69:
 					return -1
 				case 80:
+					return 1
+				case 73:
 					return -1
-				case 111:
-					return 3
-				case 79:
-					return 3
-				case 108:
+				case 114:
 					return -1
-				case 76:
+				case 105:
 					return -1
-				}
-				return -1
-			},
-			func(r rune) int {
-				switch r {
-				case 112:
+				case 65:
 					return -1
-				case 80:
+				case 116:
 					return -1
-				case 111:
+				case 118:
 					return -1
-				case 79:
+				case 86:
+					return -1
+				case 97:
+					return -1
+				case 84:
 					return -1
-				case 108:
-					return 4
-				case 76:
-					return 4
 				}
 				return -1
 			},
@@ -20639,93 +22045,87 @@ func NewLexerWithInit(in io.Reader, initFun func(*Lexer)) *Lexer {
 				switch r {
 				case 112:
 					return -1
+				case 82:
+					return 2
+				case 101:
+					return -1
+				case 69:
+					return -1
 				case 80:
 					return -1
-				case 111:
+				case 73:
 					return -1
-				case 79:
+				case 114:
+					return 2
+				case 105:
 					return -1
-				case 108:
+				case 65:
 					return -1
-				case 76:
+				case 116:
+					return -1
+				case 118:
+					return -1
+				case 86:
+					return -1
+				case 97:
+					return -1
+				case 84:
 					return -1
 				}
 				return -1
 			},
-		}, []int{ /* Start-of-input transitions */ -1, -1, -1, -1, -1}, []int{ /* End-of-input transitions */ -1, -1, -1, -1, -1}, nil},
-
-		// [pP][rR][eE][pP][aA][rR][eE]
-		{[]bool{false, false, false, false, false, false, false, true}, []func(rune) int{ // Transitions
 			func(r rune) int {
 				switch r {
-				case 112:
-					return 1
 				case 80:
-					return 1
+					return -1
+				case 73:
+					return 3
 				case 114:
 					return -1
-				case 82:
+				case 105:
+					return 3
+				case 65:
 					return -1
-				case 101:
+				case 116:
 					return -1
-				case 69:
+				case 118:
 					return -1
-				case 97:
+				case 86:
 					return -1
-				case 65:
+				case 97:
 					return -1
-				}
-				return -1
-			},
-			func(r rune) int {
-				switch r {
-				case 112:
+				case 84:
 					return -1
-				case 80:
+				case 112:
 					return -1
-				case 114:
-					return 2
 				case 82:
-					return 2
+					return -1
 				case 101:
 					return -1
 				case 69:
 					return -1
-				case 97:
-					return -1
-				case 65:
-					return -1
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
-				case 112:
+				case 114:
 					return -1
-				case 80:
+				case 105:
 					return -1
-				case 114:
+				case 65:
 					return -1
-				case 82:
+				case 116:
 					return -1
-				case 101:
-					return 3
-				case 69:
-					return 3
+				case 118:
+					return 4
+				case 86:
+					return 4
 				case 97:
 					return -1
-				case 65:
+				case 84:
 					return -1
-				}
-				return -1
-			},
-			func(r rune) int {
-				switch r {
 				case 112:
-					return 4
-				case 80:
-					return 4
-				case 114:
 					return -1
 				case 82:
 					return -1
@@ -20733,9 +22133,9 @@ func NewLexerWithInit(in io.Reader, initFun func(*Lexer)) *Lexer {
 					return -1
 				case 69:
 					return -1
-				case 97:
+				case 80:
 					return -1
-				case 65:
+				case 73:
 					return -1
 				}
 				return -1
@@ -20744,51 +22144,79 @@ func NewLexerWithInit(in io.Reader, initFun func(*Lexer)) *Lexer {
 				switch r {
 				case 112:
 					return -1
+				case 82:
+					return -1
+				case 101:
+					return -1
+				case 69:
+					return -1
 				case 80:
 					return -1
+				case 73:
+					return -1
 				case 114:
 					return -1
-				case 82:
+				case 105:
 					return -1
-				case 101:
+				case 65:
+					return 5
+				case 116:
 					return -1
-				case 69:
+				case 118:
+					return -1
+				case 86:
 					return -1
 				case 97:
 					return 5
-				case 65:
-					return 5
+				case 84:
+					return -1
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
-				case 112:
-					return -1
 				case 80:
 					return -1
+				case 73:
+					return -1
 				case 114:
+					return -1
+				case 105:
+					return -1
+				case 65:
+					return -1
+				case 116:
 					return 6
-				case 82:
-					return 6
-				case 101:
+				case 118:
 					return -1
-				case 69:
+				case 86:
 					return -1
 				case 97:
 					return -1
-				case 65:
+				case 84:
+					return 6
+				case 112:
+					return -1
+				case 82:
+					return -1
+				case 101:
+					return -1
+				case 69:
 					return -1
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
-				case 112:
+				case 118:
 					return -1
-				case 80:
+				case 86:
 					return -1
-				case 114:
+				case 97:
+					return -1
+				case 84:
+					return -1
+				case 112:
 					return -1
 				case 82:
 					return -1
@@ -20796,20 +22224,32 @@ func NewLexerWithInit(in io.Reader, initFun func(*Lexer)) *Lexer {
 					return 7
 				case 69:
 					return 7
-				case 97:
+				case 80:
+					return -1
+				case 73:
+					return -1
+				case 114:
+					return -1
+				case 105:
 					return -1
 				case 65:
 					return -1
+				case 116:
+					return -1
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
-				case 112:
+				case 118:
 					return -1
-				case 80:
+				case 86:
 					return -1
-				case 114:
+				case 97:
+					return -1
+				case 84:
+					return -1
+				case 112:
 					return -1
 				case 82:
 					return -1
@@ -20817,315 +22257,318 @@ func NewLexerWithInit(in io.Reader, initFun func(*Lexer)) *Lexer {
 					return -1
 				case 69:
 					return -1
-				case 97:
-					return -1
-				case 65:
-					return -1
-				}
-				return -1
-			},
-		}, []int{ /* Start-of-input transitions */ -1, -1, -1, -1, -1, -1, -1, -1}, []int{ /* End-of-input transitions */ -1, -1, -1, -1, -1, -1, -1, -1}, nil},
-
-		// [pP][rR][iI][mM][aA][rR][yY]
-		{[]bool{false, false, false, false, false, false, false, true}, []func(rune) int{ // Transitions
-			func(r rune) int {
-				switch r {
-				case 112:
-					return 1
 				case 80:
-					return 1
-				case 77:
 					return -1
-				case 65:
-					return -1
-				case 121:
+				case 73:
 					return -1
 				case 114:
 					return -1
-				case 82:
-					return -1
 				case 105:
 					return -1
-				case 73:
-					return -1
-				case 109:
-					return -1
-				case 97:
+				case 65:
 					return -1
-				case 89:
+				case 116:
 					return -1
 				}
 				return -1
 			},
+		}, []int{ /* Start-of-input transitions */ -1, -1, -1, -1, -1, -1, -1, -1}, []int{ /* End-of-input transitions */ -1, -1, -1, -1, -1, -1, -1, -1}, nil},
+
+		// [pP][rR][iI][vV][iI][lL][eE][gG][eE]
+		{[]bool{false, false, false, false, false, false, false, false, false, true}, []func(rune) int{ // Transitions
 			func(r rune) int {
 				switch r {
 				case 114:
-					return 2
-				case 82:
-					return 2
+					return -1
 				case 105:
 					return -1
-				case 73:
+				case 86:
 					return -1
-				case 109:
+				case 76:
 					return -1
-				case 97:
+				case 112:
+					return 1
+				case 118:
 					return -1
-				case 89:
+				case 108:
 					return -1
-				case 112:
+				case 101:
 					return -1
-				case 80:
+				case 73:
 					return -1
-				case 77:
+				case 69:
 					return -1
-				case 65:
+				case 103:
 					return -1
-				case 121:
+				case 80:
+					return 1
+				case 82:
+					return -1
+				case 71:
 					return -1
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
-				case 114:
+				case 73:
+					return -1
+				case 69:
+					return -1
+				case 103:
+					return -1
+				case 80:
 					return -1
 				case 82:
+					return 2
+				case 71:
 					return -1
+				case 114:
+					return 2
 				case 105:
-					return 3
-				case 73:
-					return 3
-				case 109:
 					return -1
-				case 97:
+				case 86:
 					return -1
-				case 89:
+				case 76:
 					return -1
 				case 112:
 					return -1
-				case 80:
-					return -1
-				case 77:
+				case 118:
 					return -1
-				case 65:
+				case 108:
 					return -1
-				case 121:
+				case 101:
 					return -1
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
-				case 112:
+				case 73:
+					return 3
+				case 69:
+					return -1
+				case 103:
 					return -1
 				case 80:
 					return -1
-				case 77:
-					return 4
-				case 65:
+				case 82:
 					return -1
-				case 121:
+				case 71:
 					return -1
 				case 114:
 					return -1
-				case 82:
-					return -1
 				case 105:
+					return 3
+				case 86:
 					return -1
-				case 73:
+				case 76:
 					return -1
-				case 109:
-					return 4
-				case 97:
+				case 112:
 					return -1
-				case 89:
+				case 118:
+					return -1
+				case 108:
+					return -1
+				case 101:
 					return -1
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
-				case 114:
+				case 73:
+					return -1
+				case 69:
+					return -1
+				case 103:
+					return -1
+				case 80:
 					return -1
 				case 82:
 					return -1
-				case 105:
+				case 71:
 					return -1
-				case 73:
+				case 114:
 					return -1
-				case 109:
+				case 105:
 					return -1
-				case 97:
-					return 5
-				case 89:
+				case 86:
+					return 4
+				case 76:
 					return -1
 				case 112:
 					return -1
-				case 80:
-					return -1
-				case 77:
+				case 118:
+					return 4
+				case 108:
 					return -1
-				case 65:
-					return 5
-				case 121:
+				case 101:
 					return -1
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
-				case 114:
-					return 6
+				case 73:
+					return 5
+				case 69:
+					return -1
+				case 103:
+					return -1
+				case 80:
+					return -1
 				case 82:
-					return 6
-				case 105:
 					return -1
-				case 73:
+				case 71:
 					return -1
-				case 109:
+				case 114:
 					return -1
-				case 97:
+				case 105:
+					return 5
+				case 86:
 					return -1
-				case 89:
+				case 76:
 					return -1
 				case 112:
 					return -1
-				case 80:
-					return -1
-				case 77:
+				case 118:
 					return -1
-				case 65:
+				case 108:
 					return -1
-				case 121:
+				case 101:
 					return -1
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
-				case 112:
+				case 73:
+					return -1
+				case 69:
+					return -1
+				case 103:
 					return -1
 				case 80:
 					return -1
-				case 77:
+				case 82:
 					return -1
-				case 65:
+				case 71:
 					return -1
-				case 121:
-					return 7
 				case 114:
 					return -1
-				case 82:
-					return -1
 				case 105:
 					return -1
-				case 73:
+				case 86:
 					return -1
-				case 109:
+				case 76:
+					return 6
+				case 112:
 					return -1
-				case 97:
+				case 118:
+					return -1
+				case 108:
+					return 6
+				case 101:
 					return -1
-				case 89:
-					return 7
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
-				case 114:
+				case 80:
 					return -1
 				case 82:
 					return -1
-				case 105:
+				case 71:
 					return -1
-				case 73:
+				case 114:
 					return -1
-				case 109:
+				case 105:
 					return -1
-				case 97:
+				case 86:
 					return -1
-				case 89:
+				case 76:
 					return -1
 				case 112:
 					return -1
-				case 80:
+				case 118:
 					return -1
-				case 77:
+				case 108:
 					return -1
-				case 65:
+				case 101:
+					return 7
+				case 73:
 					return -1
-				case 121:
+				case 69:
+					return 7
+				case 103:
 					return -1
 				}
 				return -1
 			},
-		}, []int{ /* Start-of-input transitions */ -1, -1, -1, -1, -1, -1, -1, -1}, []int{ /* End-of-input transitions */ -1, -1, -1, -1, -1, -1, -1, -1}, nil},
-
-		// [pP][rR][iI][vV][aA][tT][eE]
-		{[]bool{false, false, false, false, false, false, false, true}, []func(rune) int{ // Transitions
 			func(r rune) int {
 				switch r {
 				case 112:
-					return 1
-				case 82:
+					return -1
+				case 118:
+					return -1
+				case 108:
 					return -1
 				case 101:
 					return -1
+				case 73:
+					return -1
 				case 69:
 					return -1
+				case 103:
+					return 8
 				case 80:
-					return 1
-				case 73:
 					return -1
+				case 82:
+					return -1
+				case 71:
+					return 8
 				case 114:
 					return -1
 				case 105:
 					return -1
-				case 65:
-					return -1
-				case 116:
-					return -1
-				case 118:
-					return -1
 				case 86:
 					return -1
-				case 97:
-					return -1
-				case 84:
+				case 76:
 					return -1
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
-				case 112:
+				case 114:
 					return -1
-				case 82:
-					return 2
-				case 101:
+				case 105:
 					return -1
-				case 69:
+				case 86:
 					return -1
-				case 80:
+				case 76:
 					return -1
-				case 73:
+				case 112:
 					return -1
-				case 114:
-					return 2
-				case 105:
+				case 118:
 					return -1
-				case 65:
+				case 108:
 					return -1
-				case 116:
+				case 101:
+					return 9
+				case 73:
 					return -1
-				case 118:
+				case 69:
+					return 9
+				case 103:
 					return -1
-				case 86:
+				case 80:
 					return -1
-				case 97:
+				case 82:
 					return -1
-				case 84:
+				case 71:
 					return -1
 				}
 				return -1
@@ -21134,97 +22577,97 @@ func NewLexerWithInit(in io.Reader, initFun func(*Lexer)) *Lexer {
 				switch r {
 				case 80:
 					return -1
-				case 73:
-					return 3
-				case 114:
+				case 82:
 					return -1
-				case 105:
-					return 3
-				case 65:
+				case 71:
 					return -1
-				case 116:
+				case 114:
 					return -1
-				case 118:
+				case 105:
 					return -1
 				case 86:
 					return -1
-				case 97:
-					return -1
-				case 84:
+				case 76:
 					return -1
 				case 112:
 					return -1
-				case 82:
+				case 118:
+					return -1
+				case 108:
 					return -1
 				case 101:
 					return -1
+				case 73:
+					return -1
 				case 69:
 					return -1
+				case 103:
+					return -1
 				}
 				return -1
 			},
+		}, []int{ /* Start-of-input transitions */ -1, -1, -1, -1, -1, -1, -1, -1, -1, -1}, []int{ /* End-of-input transitions */ -1, -1, -1, -1, -1, -1, -1, -1, -1, -1}, nil},
+
+		// [pP][rR][oO][cC][eE][dE][uU][rR][eE]
+		{[]bool{false, false, false, false, false, false, false, false, false, true}, []func(rune) int{ // Transitions
 			func(r rune) int {
 				switch r {
-				case 114:
-					return -1
-				case 105:
-					return -1
-				case 65:
-					return -1
-				case 116:
+				case 80:
+					return 1
+				case 79:
 					return -1
-				case 118:
-					return 4
-				case 86:
-					return 4
-				case 97:
+				case 67:
 					return -1
-				case 84:
+				case 100:
 					return -1
 				case 112:
+					return 1
+				case 114:
 					return -1
 				case 82:
 					return -1
+				case 111:
+					return -1
+				case 99:
+					return -1
 				case 101:
 					return -1
 				case 69:
 					return -1
-				case 80:
+				case 117:
 					return -1
-				case 73:
+				case 85:
 					return -1
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
-				case 112:
-					return -1
-				case 82:
+				case 80:
 					return -1
-				case 101:
+				case 79:
 					return -1
-				case 69:
+				case 67:
 					return -1
-				case 80:
+				case 100:
 					return -1
-				case 73:
+				case 112:
 					return -1
 				case 114:
+					return 2
+				case 82:
+					return 2
+				case 111:
 					return -1
-				case 105:
+				case 99:
 					return -1
-				case 65:
-					return 5
-				case 116:
+				case 101:
 					return -1
-				case 118:
+				case 69:
 					return -1
-				case 86:
+				case 117:
 					return -1
-				case 97:
-					return 5
-				case 84:
+				case 85:
 					return -1
 				}
 				return -1
@@ -21233,299 +22676,279 @@ func NewLexerWithInit(in io.Reader, initFun func(*Lexer)) *Lexer {
 				switch r {
 				case 80:
 					return -1
-				case 73:
-					return -1
-				case 114:
-					return -1
-				case 105:
-					return -1
-				case 65:
-					return -1
-				case 116:
-					return 6
-				case 118:
-					return -1
-				case 86:
+				case 79:
+					return 3
+				case 67:
 					return -1
-				case 97:
+				case 100:
 					return -1
-				case 84:
-					return 6
 				case 112:
 					return -1
+				case 114:
+					return -1
 				case 82:
 					return -1
+				case 111:
+					return 3
+				case 99:
+					return -1
 				case 101:
 					return -1
 				case 69:
 					return -1
+				case 117:
+					return -1
+				case 85:
+					return -1
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
-				case 118:
-					return -1
-				case 86:
-					return -1
-				case 97:
-					return -1
-				case 84:
-					return -1
 				case 112:
 					return -1
+				case 114:
+					return -1
 				case 82:
 					return -1
+				case 111:
+					return -1
+				case 99:
+					return 4
 				case 101:
-					return 7
+					return -1
 				case 69:
-					return 7
-				case 80:
 					return -1
-				case 73:
+				case 117:
 					return -1
-				case 114:
+				case 85:
 					return -1
-				case 105:
+				case 80:
 					return -1
-				case 65:
+				case 79:
 					return -1
-				case 116:
+				case 67:
+					return 4
+				case 100:
 					return -1
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
-				case 118:
+				case 80:
 					return -1
-				case 86:
+				case 79:
 					return -1
-				case 97:
+				case 67:
 					return -1
-				case 84:
+				case 100:
 					return -1
 				case 112:
 					return -1
-				case 82:
-					return -1
-				case 101:
-					return -1
-				case 69:
-					return -1
-				case 80:
+				case 114:
 					return -1
-				case 73:
+				case 82:
 					return -1
-				case 114:
+				case 111:
 					return -1
-				case 105:
+				case 99:
 					return -1
-				case 65:
+				case 101:
+					return 5
+				case 69:
+					return 5
+				case 117:
 					return -1
-				case 116:
+				case 85:
 					return -1
 				}
 				return -1
 			},
-		}, []int{ /* Start-of-input transitions */ -1, -1, -1, -1, -1, -1, -1, -1}, []int{ /* End-of-input transitions */ -1, -1, -1, -1, -1, -1, -1, -1}, nil},
-
-		// [pP][rR][iI][vV][iI][lL][eE][gG][eE]
-		{[]bool{false, false, false, false, false, false, false, false, false, true}, []func(rune) int{ // Transitions
 			func(r rune) int {
 				switch r {
-				case 114:
-					return -1
-				case 105:
+				case 80:
 					return -1
-				case 86:
+				case 79:
 					return -1
-				case 76:
+				case 67:
 					return -1
+				case 100:
+					return 6
 				case 112:
-					return 1
-				case 118:
 					return -1
-				case 108:
+				case 114:
 					return -1
-				case 101:
+				case 82:
 					return -1
-				case 73:
+				case 111:
 					return -1
-				case 69:
+				case 99:
 					return -1
-				case 103:
+				case 101:
 					return -1
-				case 80:
-					return 1
-				case 82:
+				case 69:
+					return 6
+				case 117:
 					return -1
-				case 71:
+				case 85:
 					return -1
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
-				case 73:
+				case 80:
 					return -1
-				case 69:
+				case 79:
 					return -1
-				case 103:
+				case 67:
 					return -1
-				case 80:
+				case 100:
 					return -1
-				case 82:
-					return 2
-				case 71:
+				case 112:
 					return -1
 				case 114:
-					return 2
-				case 105:
-					return -1
-				case 86:
-					return -1
-				case 76:
 					return -1
-				case 112:
+				case 82:
 					return -1
-				case 118:
+				case 111:
 					return -1
-				case 108:
+				case 99:
 					return -1
 				case 101:
 					return -1
+				case 69:
+					return -1
+				case 117:
+					return 7
+				case 85:
+					return 7
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
-				case 73:
-					return 3
-				case 69:
+				case 80:
 					return -1
-				case 103:
+				case 79:
 					return -1
-				case 80:
+				case 67:
 					return -1
-				case 82:
+				case 100:
 					return -1
-				case 71:
+				case 112:
 					return -1
 				case 114:
+					return 8
+				case 82:
+					return 8
+				case 111:
 					return -1
-				case 105:
-					return 3
-				case 86:
-					return -1
-				case 76:
+				case 99:
 					return -1
-				case 112:
+				case 101:
 					return -1
-				case 118:
+				case 69:
 					return -1
-				case 108:
+				case 117:
 					return -1
-				case 101:
+				case 85:
 					return -1
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
-				case 73:
-					return -1
-				case 69:
-					return -1
-				case 103:
+				case 112:
 					return -1
-				case 80:
+				case 114:
 					return -1
 				case 82:
 					return -1
-				case 71:
+				case 111:
 					return -1
-				case 114:
+				case 99:
 					return -1
-				case 105:
+				case 101:
+					return 9
+				case 69:
+					return 9
+				case 117:
 					return -1
-				case 86:
-					return 4
-				case 76:
+				case 85:
 					return -1
-				case 112:
+				case 80:
 					return -1
-				case 118:
-					return 4
-				case 108:
+				case 79:
 					return -1
-				case 101:
+				case 67:
+					return -1
+				case 100:
 					return -1
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
-				case 73:
-					return 5
-				case 69:
+				case 80:
 					return -1
-				case 103:
+				case 79:
 					return -1
-				case 80:
+				case 67:
 					return -1
-				case 82:
+				case 100:
 					return -1
-				case 71:
+				case 112:
 					return -1
 				case 114:
 					return -1
-				case 105:
-					return 5
-				case 86:
+				case 82:
 					return -1
-				case 76:
+				case 111:
 					return -1
-				case 112:
+				case 99:
 					return -1
-				case 118:
+				case 101:
 					return -1
-				case 108:
+				case 69:
 					return -1
-				case 101:
+				case 117:
+					return -1
+				case 85:
 					return -1
 				}
 				return -1
 			},
+		}, []int{ /* Start-of-input transitions */ -1, -1, -1, -1, -1, -1, -1, -1, -1, -1}, []int{ /* End-of-input transitions */ -1, -1, -1, -1, -1, -1, -1, -1, -1, -1}, nil},
+
+		// [pP][uU][bB][lL][iI][cC]
+		{[]bool{false, false, false, false, false, false, true}, []func(rune) int{ // Transitions
 			func(r rune) int {
 				switch r {
-				case 73:
+				case 112:
+					return 1
+				case 66:
 					return -1
-				case 69:
+				case 105:
 					return -1
-				case 103:
+				case 73:
 					return -1
 				case 80:
+					return 1
+				case 117:
 					return -1
-				case 82:
-					return -1
-				case 71:
-					return -1
-				case 114:
+				case 85:
 					return -1
-				case 105:
+				case 98:
 					return -1
-				case 86:
+				case 108:
 					return -1
 				case 76:
-					return 6
-				case 112:
 					return -1
-				case 118:
+				case 99:
 					return -1
-				case 108:
-					return 6
-				case 101:
+				case 67:
 					return -1
 				}
 				return -1
@@ -21534,689 +22957,622 @@ func NewLexerWithInit(in io.Reader, initFun func(*Lexer)) *Lexer {
 				switch r {
 				case 80:
 					return -1
-				case 82:
-					return -1
-				case 71:
+				case 117:
+					return 2
+				case 85:
+					return 2
+				case 98:
 					return -1
-				case 114:
+				case 108:
 					return -1
-				case 105:
+				case 76:
 					return -1
-				case 86:
+				case 99:
 					return -1
-				case 76:
+				case 67:
 					return -1
 				case 112:
 					return -1
-				case 118:
+				case 66:
 					return -1
-				case 108:
+				case 105:
 					return -1
-				case 101:
-					return 7
 				case 73:
 					return -1
-				case 69:
-					return 7
-				case 103:
-					return -1
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
-				case 112:
-					return -1
-				case 118:
+				case 80:
 					return -1
-				case 108:
+				case 117:
 					return -1
-				case 101:
+				case 85:
 					return -1
-				case 73:
+				case 98:
+					return 3
+				case 108:
 					return -1
-				case 69:
+				case 76:
 					return -1
-				case 103:
-					return 8
-				case 80:
+				case 99:
 					return -1
-				case 82:
+				case 67:
 					return -1
-				case 71:
-					return 8
-				case 114:
+				case 112:
 					return -1
+				case 66:
+					return 3
 				case 105:
 					return -1
-				case 86:
-					return -1
-				case 76:
+				case 73:
 					return -1
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
-				case 114:
-					return -1
-				case 105:
-					return -1
-				case 86:
-					return -1
-				case 76:
-					return -1
 				case 112:
 					return -1
-				case 118:
+				case 66:
 					return -1
-				case 108:
+				case 105:
 					return -1
-				case 101:
-					return 9
 				case 73:
 					return -1
-				case 69:
-					return 9
-				case 103:
-					return -1
 				case 80:
 					return -1
-				case 82:
+				case 117:
 					return -1
-				case 71:
+				case 85:
+					return -1
+				case 98:
+					return -1
+				case 108:
+					return 4
+				case 76:
+					return 4
+				case 99:
+					return -1
+				case 67:
 					return -1
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
-				case 80:
-					return -1
-				case 82:
-					return -1
-				case 71:
+				case 112:
 					return -1
-				case 114:
+				case 66:
 					return -1
 				case 105:
+					return 5
+				case 73:
+					return 5
+				case 80:
 					return -1
-				case 86:
-					return -1
-				case 76:
+				case 117:
 					return -1
-				case 112:
+				case 85:
 					return -1
-				case 118:
+				case 98:
 					return -1
 				case 108:
 					return -1
-				case 101:
-					return -1
-				case 73:
+				case 76:
 					return -1
-				case 69:
+				case 99:
 					return -1
-				case 103:
+				case 67:
 					return -1
 				}
 				return -1
 			},
-		}, []int{ /* Start-of-input transitions */ -1, -1, -1, -1, -1, -1, -1, -1, -1, -1}, []int{ /* End-of-input transitions */ -1, -1, -1, -1, -1, -1, -1, -1, -1, -1}, nil},
-
-		// [pP][rR][oO][cC][eE][dE][uU][rR][eE]
-		{[]bool{false, false, false, false, false, false, false, false, false, true}, []func(rune) int{ // Transitions
 			func(r rune) int {
 				switch r {
 				case 80:
-					return 1
-				case 79:
 					return -1
-				case 67:
+				case 117:
 					return -1
-				case 100:
+				case 85:
 					return -1
-				case 112:
-					return 1
-				case 114:
+				case 98:
 					return -1
-				case 82:
+				case 108:
 					return -1
-				case 111:
+				case 76:
 					return -1
 				case 99:
+					return 6
+				case 67:
+					return 6
+				case 112:
 					return -1
-				case 101:
-					return -1
-				case 69:
+				case 66:
 					return -1
-				case 117:
+				case 105:
 					return -1
-				case 85:
+				case 73:
 					return -1
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
-				case 80:
+				case 112:
 					return -1
-				case 79:
+				case 66:
 					return -1
-				case 67:
+				case 105:
 					return -1
-				case 100:
+				case 73:
 					return -1
-				case 112:
+				case 80:
 					return -1
-				case 114:
-					return 2
-				case 82:
-					return 2
-				case 111:
+				case 117:
 					return -1
-				case 99:
+				case 85:
 					return -1
-				case 101:
+				case 98:
 					return -1
-				case 69:
+				case 108:
 					return -1
-				case 117:
+				case 76:
 					return -1
-				case 85:
+				case 99:
+					return -1
+				case 67:
 					return -1
 				}
 				return -1
 			},
+		}, []int{ /* Start-of-input transitions */ -1, -1, -1, -1, -1, -1, -1}, []int{ /* End-of-input transitions */ -1, -1, -1, -1, -1, -1, -1}, nil},
+
+		// [rR][aA][nN][gG][eE]
+		{[]bool{false, false, false, false, false, true}, []func(rune) int{ // Transitions
 			func(r rune) int {
 				switch r {
-				case 80:
-					return -1
-				case 79:
-					return 3
-				case 67:
+				case 114:
+					return 1
+				case 82:
+					return 1
+				case 97:
 					return -1
-				case 100:
+				case 65:
 					return -1
-				case 112:
+				case 110:
 					return -1
-				case 114:
+				case 78:
 					return -1
-				case 82:
+				case 103:
 					return -1
-				case 111:
-					return 3
-				case 99:
+				case 71:
 					return -1
 				case 101:
 					return -1
 				case 69:
 					return -1
-				case 117:
-					return -1
-				case 85:
-					return -1
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
-				case 112:
-					return -1
 				case 114:
 					return -1
 				case 82:
 					return -1
-				case 111:
-					return -1
-				case 99:
-					return 4
-				case 101:
-					return -1
-				case 69:
+				case 97:
+					return 2
+				case 65:
+					return 2
+				case 110:
 					return -1
-				case 117:
+				case 78:
 					return -1
-				case 85:
+				case 103:
 					return -1
-				case 80:
+				case 71:
 					return -1
-				case 79:
+				case 101:
 					return -1
-				case 67:
-					return 4
-				case 100:
+				case 69:
 					return -1
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
-				case 80:
-					return -1
-				case 79:
-					return -1
-				case 67:
-					return -1
-				case 100:
-					return -1
-				case 112:
-					return -1
 				case 114:
 					return -1
 				case 82:
 					return -1
-				case 111:
+				case 97:
 					return -1
-				case 99:
+				case 65:
+					return -1
+				case 110:
+					return 3
+				case 78:
+					return 3
+				case 103:
+					return -1
+				case 71:
 					return -1
 				case 101:
-					return 5
-				case 69:
-					return 5
-				case 117:
 					return -1
-				case 85:
+				case 69:
 					return -1
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
-				case 80:
-					return -1
-				case 79:
-					return -1
-				case 67:
-					return -1
-				case 100:
-					return 6
-				case 112:
-					return -1
 				case 114:
 					return -1
 				case 82:
 					return -1
-				case 111:
+				case 97:
 					return -1
-				case 99:
+				case 65:
+					return -1
+				case 110:
+					return -1
+				case 78:
 					return -1
+				case 103:
+					return 4
+				case 71:
+					return 4
 				case 101:
 					return -1
 				case 69:
-					return 6
-				case 117:
-					return -1
-				case 85:
 					return -1
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
-				case 80:
-					return -1
-				case 79:
+				case 114:
 					return -1
-				case 67:
+				case 82:
 					return -1
-				case 100:
+				case 97:
 					return -1
-				case 112:
+				case 65:
 					return -1
-				case 114:
+				case 110:
 					return -1
-				case 82:
+				case 78:
 					return -1
-				case 111:
+				case 103:
 					return -1
-				case 99:
+				case 71:
 					return -1
 				case 101:
-					return -1
+					return 5
 				case 69:
-					return -1
-				case 117:
-					return 7
-				case 85:
-					return 7
+					return 5
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
-				case 80:
+				case 114:
 					return -1
-				case 79:
+				case 82:
 					return -1
-				case 67:
+				case 97:
 					return -1
-				case 100:
+				case 65:
 					return -1
-				case 112:
+				case 110:
 					return -1
-				case 114:
-					return 8
-				case 82:
-					return 8
-				case 111:
+				case 78:
 					return -1
-				case 99:
+				case 103:
+					return -1
+				case 71:
 					return -1
 				case 101:
 					return -1
 				case 69:
 					return -1
-				case 117:
-					return -1
-				case 85:
-					return -1
 				}
 				return -1
 			},
+		}, []int{ /* Start-of-input transitions */ -1, -1, -1, -1, -1, -1}, []int{ /* End-of-input transitions */ -1, -1, -1, -1, -1, -1}, nil},
+
+		// [rR][aA][wW]
+		{[]bool{false, false, false, true}, []func(rune) int{ // Transitions
 			func(r rune) int {
 				switch r {
-				case 112:
-					return -1
 				case 114:
-					return -1
+					return 1
 				case 82:
+					return 1
+				case 97:
 					return -1
-				case 111:
-					return -1
-				case 99:
+				case 65:
 					return -1
-				case 101:
-					return 9
-				case 69:
-					return 9
-				case 117:
+				case 119:
 					return -1
-				case 85:
+				case 87:
 					return -1
-				case 80:
+				}
+				return -1
+			},
+			func(r rune) int {
+				switch r {
+				case 114:
 					return -1
-				case 79:
+				case 82:
 					return -1
-				case 67:
+				case 97:
+					return 2
+				case 65:
+					return 2
+				case 119:
 					return -1
-				case 100:
+				case 87:
 					return -1
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
-				case 80:
-					return -1
-				case 79:
+				case 114:
 					return -1
-				case 67:
+				case 82:
 					return -1
-				case 100:
+				case 97:
 					return -1
-				case 112:
+				case 65:
 					return -1
+				case 119:
+					return 3
+				case 87:
+					return 3
+				}
+				return -1
+			},
+			func(r rune) int {
+				switch r {
 				case 114:
 					return -1
 				case 82:
 					return -1
-				case 111:
-					return -1
-				case 99:
-					return -1
-				case 101:
+				case 97:
 					return -1
-				case 69:
+				case 65:
 					return -1
-				case 117:
+				case 119:
 					return -1
-				case 85:
+				case 87:
 					return -1
 				}
 				return -1
 			},
-		}, []int{ /* Start-of-input transitions */ -1, -1, -1, -1, -1, -1, -1, -1, -1, -1}, []int{ /* End-of-input transitions */ -1, -1, -1, -1, -1, -1, -1, -1, -1, -1}, nil},
+		}, []int{ /* Start-of-input transitions */ -1, -1, -1, -1}, []int{ /* End-of-input transitions */ -1, -1, -1, -1}, nil},
 
-		// [pP][uU][bB][lL][iI][cC]
-		{[]bool{false, false, false, false, false, false, true}, []func(rune) int{ // Transitions
+		// [rR][eE][aA][lL][mM]
+		{[]bool{false, false, false, false, false, true}, []func(rune) int{ // Transitions
 			func(r rune) int {
 				switch r {
-				case 112:
-					return 1
-				case 66:
-					return -1
-				case 105:
-					return -1
-				case 73:
-					return -1
-				case 80:
+				case 114:
 					return 1
-				case 117:
-					return -1
-				case 85:
+				case 101:
 					return -1
-				case 98:
+				case 97:
 					return -1
 				case 108:
 					return -1
 				case 76:
 					return -1
-				case 99:
+				case 77:
 					return -1
-				case 67:
+				case 82:
+					return 1
+				case 69:
+					return -1
+				case 65:
+					return -1
+				case 109:
 					return -1
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
-				case 80:
+				case 114:
 					return -1
-				case 117:
-					return 2
-				case 85:
+				case 101:
 					return 2
-				case 98:
+				case 97:
 					return -1
 				case 108:
 					return -1
 				case 76:
 					return -1
-				case 99:
-					return -1
-				case 67:
-					return -1
-				case 112:
+				case 77:
 					return -1
-				case 66:
+				case 82:
 					return -1
-				case 105:
+				case 69:
+					return 2
+				case 65:
 					return -1
-				case 73:
+				case 109:
 					return -1
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
-				case 80:
-					return -1
-				case 117:
+				case 114:
 					return -1
-				case 85:
+				case 101:
 					return -1
-				case 98:
+				case 97:
 					return 3
 				case 108:
 					return -1
 				case 76:
 					return -1
-				case 99:
+				case 77:
 					return -1
-				case 67:
+				case 82:
 					return -1
-				case 112:
+				case 69:
 					return -1
-				case 66:
+				case 65:
 					return 3
-				case 105:
-					return -1
-				case 73:
+				case 109:
 					return -1
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
-				case 112:
-					return -1
-				case 66:
+				case 82:
 					return -1
-				case 105:
+				case 69:
 					return -1
-				case 73:
+				case 65:
 					return -1
-				case 80:
+				case 109:
 					return -1
-				case 117:
+				case 114:
 					return -1
-				case 85:
+				case 101:
 					return -1
-				case 98:
+				case 97:
 					return -1
 				case 108:
 					return 4
 				case 76:
 					return 4
-				case 99:
-					return -1
-				case 67:
+				case 77:
 					return -1
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
-				case 112:
+				case 82:
 					return -1
-				case 66:
+				case 69:
 					return -1
-				case 105:
-					return 5
-				case 73:
-					return 5
-				case 80:
+				case 65:
 					return -1
-				case 117:
+				case 109:
+					return 5
+				case 114:
 					return -1
-				case 85:
+				case 101:
 					return -1
-				case 98:
+				case 97:
 					return -1
 				case 108:
 					return -1
 				case 76:
 					return -1
-				case 99:
-					return -1
-				case 67:
-					return -1
+				case 77:
+					return 5
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
-				case 80:
-					return -1
-				case 117:
+				case 114:
 					return -1
-				case 85:
+				case 101:
 					return -1
-				case 98:
+				case 97:
 					return -1
 				case 108:
 					return -1
 				case 76:
 					return -1
-				case 99:
-					return 6
-				case 67:
-					return 6
-				case 112:
+				case 77:
 					return -1
-				case 66:
+				case 82:
 					return -1
-				case 105:
+				case 69:
 					return -1
-				case 73:
+				case 65:
+					return -1
+				case 109:
 					return -1
 				}
 				return -1
 			},
+		}, []int{ /* Start-of-input transitions */ -1, -1, -1, -1, -1, -1}, []int{ /* End-of-input transitions */ -1, -1, -1, -1, -1, -1}, nil},
+
+		// [rR][eE][cC][uU][rR][sS][iI][vV][eE]
+		{[]bool{false, false, false, false, false, false, false, false, false, true}, []func(rune) int{ // Transitions
 			func(r rune) int {
 				switch r {
-				case 112:
-					return -1
-				case 66:
+				case 114:
+					return 1
+				case 82:
+					return 1
+				case 101:
 					return -1
-				case 105:
+				case 69:
 					return -1
-				case 73:
+				case 99:
 					return -1
-				case 80:
+				case 67:
 					return -1
 				case 117:
 					return -1
 				case 85:
 					return -1
-				case 98:
+				case 115:
 					return -1
-				case 108:
+				case 83:
 					return -1
-				case 76:
+				case 105:
 					return -1
-				case 99:
+				case 73:
 					return -1
-				case 67:
+				case 118:
+					return -1
+				case 86:
 					return -1
 				}
 				return -1
 			},
-		}, []int{ /* Start-of-input transitions */ -1, -1, -1, -1, -1, -1, -1}, []int{ /* End-of-input transitions */ -1, -1, -1, -1, -1, -1, -1}, nil},
-
-		// [rR][aA][wW]
-		{[]bool{false, false, false, true}, []func(rune) int{ // Transitions
 			func(r rune) int {
 				switch r {
 				case 114:
-					return 1
+					return -1
 				case 82:
-					return 1
-				case 97:
 					return -1
-				case 65:
+				case 101:
+					return 2
+				case 69:
+					return 2
+				case 99:
 					return -1
-				case 119:
+				case 67:
 					return -1
-				case 87:
+				case 117:
 					return -1
-				}
-				return -1
-			},
-			func(r rune) int {
-				switch r {
-				case 114:
+				case 85:
 					return -1
-				case 82:
+				case 115:
 					return -1
-				case 97:
-					return 2
-				case 65:
-					return 2
-				case 119:
+				case 83:
 					return -1
-				case 87:
+				case 105:
+					return -1
+				case 73:
+					return -1
+				case 118:
+					return -1
+				case 86:
 					return -1
 				}
 				return -1
@@ -22227,14 +23583,30 @@ func NewLexerWithInit(in io.Reader, initFun func(*Lexer)) *Lexer {
 					return -1
 				case 82:
 					return -1
-				case 97:
+				case 101:
 					return -1
-				case 65:
+				case 69:
 					return -1
-				case 119:
+				case 99:
 					return 3
-				case 87:
+				case 67:
 					return 3
+				case 117:
+					return -1
+				case 85:
+					return -1
+				case 115:
+					return -1
+				case 83:
+					return -1
+				case 105:
+					return -1
+				case 73:
+					return -1
+				case 118:
+					return -1
+				case 86:
+					return -1
 				}
 				return -1
 			},
@@ -22244,42 +23616,62 @@ func NewLexerWithInit(in io.Reader, initFun func(*Lexer)) *Lexer {
 					return -1
 				case 82:
 					return -1
-				case 97:
+				case 101:
 					return -1
-				case 65:
+				case 69:
 					return -1
-				case 119:
+				case 99:
 					return -1
-				case 87:
+				case 67:
+					return -1
+				case 117:
+					return 4
+				case 85:
+					return 4
+				case 115:
+					return -1
+				case 83:
+					return -1
+				case 105:
+					return -1
+				case 73:
+					return -1
+				case 118:
+					return -1
+				case 86:
 					return -1
 				}
 				return -1
 			},
-		}, []int{ /* Start-of-input transitions */ -1, -1, -1, -1}, []int{ /* End-of-input transitions */ -1, -1, -1, -1}, nil},
-
-		// [rR][eE][aA][lL][mM]
-		{[]bool{false, false, false, false, false, true}, []func(rune) int{ // Transitions
 			func(r rune) int {
 				switch r {
 				case 114:
-					return 1
+					return 5
+				case 82:
+					return 5
 				case 101:
 					return -1
-				case 97:
+				case 69:
 					return -1
-				case 108:
+				case 99:
 					return -1
-				case 76:
+				case 67:
 					return -1
-				case 77:
+				case 117:
 					return -1
-				case 82:
-					return 1
-				case 69:
+				case 85:
 					return -1
-				case 65:
+				case 115:
 					return -1
-				case 109:
+				case 83:
+					return -1
+				case 105:
+					return -1
+				case 73:
+					return -1
+				case 118:
+					return -1
+				case 86:
 					return -1
 				}
 				return -1
@@ -22288,23 +23680,31 @@ func NewLexerWithInit(in io.Reader, initFun func(*Lexer)) *Lexer {
 				switch r {
 				case 114:
 					return -1
+				case 82:
+					return -1
 				case 101:
-					return 2
-				case 97:
 					return -1
-				case 108:
+				case 69:
 					return -1
-				case 76:
+				case 99:
 					return -1
-				case 77:
+				case 67:
 					return -1
-				case 82:
+				case 117:
 					return -1
-				case 69:
-					return 2
-				case 65:
+				case 85:
 					return -1
-				case 109:
+				case 115:
+					return 6
+				case 83:
+					return 6
+				case 105:
+					return -1
+				case 73:
+					return -1
+				case 118:
+					return -1
+				case 86:
 					return -1
 				}
 				return -1
@@ -22313,74 +23713,98 @@ func NewLexerWithInit(in io.Reader, initFun func(*Lexer)) *Lexer {
 				switch r {
 				case 114:
 					return -1
+				case 82:
+					return -1
 				case 101:
 					return -1
-				case 97:
-					return 3
-				case 108:
+				case 69:
 					return -1
-				case 76:
+				case 99:
 					return -1
-				case 77:
+				case 67:
 					return -1
-				case 82:
+				case 117:
 					return -1
-				case 69:
+				case 85:
 					return -1
-				case 65:
-					return 3
-				case 109:
+				case 115:
+					return -1
+				case 83:
+					return -1
+				case 105:
+					return 7
+				case 73:
+					return 7
+				case 118:
+					return -1
+				case 86:
 					return -1
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
+				case 114:
+					return -1
 				case 82:
 					return -1
+				case 101:
+					return -1
 				case 69:
 					return -1
-				case 65:
+				case 99:
 					return -1
-				case 109:
+				case 67:
 					return -1
-				case 114:
+				case 117:
+					return -1
+				case 85:
+					return -1
+				case 115:
 					return -1
-				case 101:
+				case 83:
 					return -1
-				case 97:
+				case 105:
 					return -1
-				case 108:
-					return 4
-				case 76:
-					return 4
-				case 77:
+				case 73:
 					return -1
+				case 118:
+					return 8
+				case 86:
+					return 8
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
+				case 114:
+					return -1
 				case 82:
 					return -1
+				case 101:
+					return 9
 				case 69:
+					return 9
+				case 99:
 					return -1
-				case 65:
+				case 67:
 					return -1
-				case 109:
-					return 5
-				case 114:
+				case 117:
 					return -1
-				case 101:
+				case 85:
 					return -1
-				case 97:
+				case 115:
 					return -1
-				case 108:
+				case 83:
 					return -1
-				case 76:
+				case 105:
+					return -1
+				case 73:
+					return -1
+				case 118:
+					return -1
+				case 86:
 					return -1
-				case 77:
-					return 5
 				}
 				return -1
 			},
@@ -22388,28 +23812,36 @@ func NewLexerWithInit(in io.Reader, initFun func(*Lexer)) *Lexer {
 				switch r {
 				case 114:
 					return -1
+				case 82:
+					return -1
 				case 101:
 					return -1
-				case 97:
+				case 69:
 					return -1
-				case 108:
+				case 99:
 					return -1
-				case 76:
+				case 67:
 					return -1
-				case 77:
+				case 117:
 					return -1
-				case 82:
+				case 85:
 					return -1
-				case 69:
+				case 115:
 					return -1
-				case 65:
+				case 83:
 					return -1
-				case 109:
+				case 105:
+					return -1
+				case 73:
+					return -1
+				case 118:
+					return -1
+				case 86:
 					return -1
 				}
 				return -1
 			},
-		}, []int{ /* Start-of-input transitions */ -1, -1, -1, -1, -1, -1}, []int{ /* End-of-input transitions */ -1, -1, -1, -1, -1, -1}, nil},
+		}, []int{ /* Start-of-input transitions */ -1, -1, -1, -1, -1, -1, -1, -1, -1, -1}, []int{ /* End-of-input transitions */ -1, -1, -1, -1, -1, -1, -1, -1, -1, -1}, nil},
 
 		// [rR][eE][dD][uU][cC][eE]
 		{[]bool{false, false, false, false, false, false, true}, []func(rune) int{ // Transitions
@@ -23992,38 +25424,219 @@ func NewLexerWithInit(in io.Reader, initFun func(*Lexer)) *Lexer {
 			},
 			func(r rune) int {
 				switch r {
-				case 82:
-					return -1
-				case 108:
-					return -1
-				case 65:
-					return -1
-				case 75:
-					return -1
-				case 98:
-					return -1
-				case 67:
-					return -1
-				case 66:
-					return -1
+				case 82:
+					return -1
+				case 108:
+					return -1
+				case 65:
+					return -1
+				case 75:
+					return -1
+				case 98:
+					return -1
+				case 67:
+					return -1
+				case 66:
+					return -1
+				case 114:
+					return -1
+				case 111:
+					return -1
+				case 79:
+					return -1
+				case 76:
+					return -1
+				case 97:
+					return -1
+				case 99:
+					return -1
+				case 107:
+					return -1
+				}
+				return -1
+			},
+		}, []int{ /* Start-of-input transitions */ -1, -1, -1, -1, -1, -1, -1, -1, -1}, []int{ /* End-of-input transitions */ -1, -1, -1, -1, -1, -1, -1, -1, -1}, nil},
+
+		// [rR][oO][wW]
+		{[]bool{false, false, false, true}, []func(rune) int{ // Transitions
+			func(r rune) int {
+				switch r {
+				case 114:
+					return 1
+				case 82:
+					return 1
+				case 111:
+					return -1
+				case 79:
+					return -1
+				case 119:
+					return -1
+				case 87:
+					return -1
+				}
+				return -1
+			},
+			func(r rune) int {
+				switch r {
+				case 114:
+					return -1
+				case 82:
+					return -1
+				case 111:
+					return 2
+				case 79:
+					return 2
+				case 119:
+					return -1
+				case 87:
+					return -1
+				}
+				return -1
+			},
+			func(r rune) int {
+				switch r {
+				case 114:
+					return -1
+				case 82:
+					return -1
+				case 111:
+					return -1
+				case 79:
+					return -1
+				case 119:
+					return 3
+				case 87:
+					return 3
+				}
+				return -1
+			},
+			func(r rune) int {
+				switch r {
+				case 114:
+					return -1
+				case 82:
+					return -1
+				case 111:
+					return -1
+				case 79:
+					return -1
+				case 119:
+					return -1
+				case 87:
+					return -1
+				}
+				return -1
+			},
+		}, []int{ /* Start-of-input transitions */ -1, -1, -1, -1}, []int{ /* End-of-input transitions */ -1, -1, -1, -1}, nil},
+
+		// [rR][oO][wW][sS]
+		{[]bool{false, false, false, false, true}, []func(rune) int{ // Transitions
+			func(r rune) int {
+				switch r {
+				case 114:
+					return 1
+				case 82:
+					return 1
+				case 111:
+					return -1
+				case 79:
+					return -1
+				case 119:
+					return -1
+				case 87:
+					return -1
+				case 115:
+					return -1
+				case 83:
+					return -1
+				}
+				return -1
+			},
+			func(r rune) int {
+				switch r {
+				case 114:
+					return -1
+				case 82:
+					return -1
+				case 111:
+					return 2
+				case 79:
+					return 2
+				case 119:
+					return -1
+				case 87:
+					return -1
+				case 115:
+					return -1
+				case 83:
+					return -1
+				}
+				return -1
+			},
+			func(r rune) int {
+				switch r {
+				case 114:
+					return -1
+				case 82:
+					return -1
+				case 111:
+					return -1
+				case 79:
+					return -1
+				case 119:
+					return 3
+				case 87:
+					return 3
+				case 115:
+					return -1
+				case 83:
+					return -1
+				}
+				return -1
+			},
+			func(r rune) int {
+				switch r {
+				case 114:
+					return -1
+				case 82:
+					return -1
+				case 111:
+					return -1
+				case 79:
+					return -1
+				case 119:
+					return -1
+				case 87:
+					return -1
+				case 115:
+					return 4
+				case 83:
+					return 4
+				}
+				return -1
+			},
+			func(r rune) int {
+				switch r {
 				case 114:
 					return -1
+				case 82:
+					return -1
 				case 111:
 					return -1
 				case 79:
 					return -1
-				case 76:
+				case 119:
 					return -1
-				case 97:
+				case 87:
 					return -1
-				case 99:
+				case 115:
 					return -1
-				case 107:
+				case 83:
 					return -1
 				}
 				return -1
 			},
-		}, []int{ /* Start-of-input transitions */ -1, -1, -1, -1, -1, -1, -1, -1, -1}, []int{ /* End-of-input transitions */ -1, -1, -1, -1, -1, -1, -1, -1, -1}, nil},
+		}, []int{ /* Start-of-input transitions */ -1, -1, -1, -1, -1}, []int{ /* End-of-input transitions */ -1, -1, -1, -1, -1}, nil},
 
 		// [sS][aA][tT][iI][sS][fF][iI][eE][sS]
 		{[]bool{false, false, false, false, false, false, false, false, false, true}, []func(rune) int{ // Transitions
@@ -26642,11 +28255,240 @@ func NewLexerWithInit(in io.Reader, initFun func(*Lexer)) *Lexer {
 					return 6
 				case 114:
 					return -1
-				case 73:
+				case 73:
+					return -1
+				case 103:
+					return -1
+				case 71:
+					return -1
+				}
+				return -1
+			},
+			func(r rune) int {
+				switch r {
+				case 116:
+					return -1
+				case 84:
+					return -1
+				case 82:
+					return 7
+				case 105:
+					return -1
+				case 101:
+					return -1
+				case 69:
+					return -1
+				case 114:
+					return 7
+				case 73:
+					return -1
+				case 103:
+					return -1
+				case 71:
+					return -1
+				}
+				return -1
+			},
+			func(r rune) int {
+				switch r {
+				case 116:
+					return -1
+				case 84:
+					return -1
+				case 82:
+					return -1
+				case 105:
+					return -1
+				case 101:
+					return -1
+				case 69:
+					return -1
+				case 114:
+					return -1
+				case 73:
+					return -1
+				case 103:
+					return -1
+				case 71:
+					return -1
+				}
+				return -1
+			},
+		}, []int{ /* Start-of-input transitions */ -1, -1, -1, -1, -1, -1, -1, -1}, []int{ /* End-of-input transitions */ -1, -1, -1, -1, -1, -1, -1, -1}, nil},
+
+		// [tT][rR][uU][eE]
+		{[]bool{false, false, false, false, true}, []func(rune) int{ // Transitions
+			func(r rune) int {
+				switch r {
+				case 116:
+					return 1
+				case 84:
+					return 1
+				case 114:
+					return -1
+				case 82:
+					return -1
+				case 117:
+					return -1
+				case 85:
+					return -1
+				case 101:
+					return -1
+				case 69:
+					return -1
+				}
+				return -1
+			},
+			func(r rune) int {
+				switch r {
+				case 116:
+					return -1
+				case 84:
+					return -1
+				case 114:
+					return 2
+				case 82:
+					return 2
+				case 117:
+					return -1
+				case 85:
+					return -1
+				case 101:
+					return -1
+				case 69:
+					return -1
+				}
+				return -1
+			},
+			func(r rune) int {
+				switch r {
+				case 116:
+					return -1
+				case 84:
+					return -1
+				case 114:
+					return -1
+				case 82:
+					return -1
+				case 117:
+					return 3
+				case 85:
+					return 3
+				case 101:
+					return -1
+				case 69:
+					return -1
+				}
+				return -1
+			},
+			func(r rune) int {
+				switch r {
+				case 116:
+					return -1
+				case 84:
+					return -1
+				case 114:
+					return -1
+				case 82:
+					return -1
+				case 117:
+					return -1
+				case 85:
+					return -1
+				case 101:
+					return 4
+				case 69:
+					return 4
+				}
+				return -1
+			},
+			func(r rune) int {
+				switch r {
+				case 116:
+					return -1
+				case 84:
+					return -1
+				case 114:
+					return -1
+				case 82:
+					return -1
+				case 117:
+					return -1
+				case 85:
+					return -1
+				case 101:
+					return -1
+				case 69:
+					return -1
+				}
+				return -1
+			},
+		}, []int{ /* Start-of-input transitions */ -1, -1, -1, -1, -1}, []int{ /* End-of-input transitions */ -1, -1, -1, -1, -1}, nil},
+
+		// [tT][rR][uU][nN][cC][aA][tT][eE]
+		{[]bool{false, false, false, false, false, false, false, false, true}, []func(rune) int{ // Transitions
+			func(r rune) int {
+				switch r {
+				case 116:
+					return 1
+				case 114:
+					return -1
+				case 82:
+					return -1
+				case 101:
+					return -1
+				case 69:
+					return -1
+				case 78:
+					return -1
+				case 99:
+					return -1
+				case 117:
+					return -1
+				case 110:
+					return -1
+				case 67:
+					return -1
+				case 65:
+					return -1
+				case 84:
+					return 1
+				case 85:
+					return -1
+				case 97:
+					return -1
+				}
+				return -1
+			},
+			func(r rune) int {
+				switch r {
+				case 116:
+					return -1
+				case 114:
+					return 2
+				case 82:
+					return 2
+				case 101:
+					return -1
+				case 69:
+					return -1
+				case 78:
+					return -1
+				case 99:
+					return -1
+				case 117:
+					return -1
+				case 110:
+					return -1
+				case 67:
+					return -1
+				case 65:
 					return -1
-				case 103:
+				case 84:
 					return -1
-				case 71:
+				case 85:
+					return -1
+				case 97:
 					return -1
 				}
 				return -1
@@ -26655,23 +28497,31 @@ func NewLexerWithInit(in io.Reader, initFun func(*Lexer)) *Lexer {
 				switch r {
 				case 116:
 					return -1
-				case 84:
+				case 114:
 					return -1
 				case 82:
-					return 7
-				case 105:
 					return -1
 				case 101:
 					return -1
 				case 69:
 					return -1
-				case 114:
-					return 7
-				case 73:
+				case 78:
 					return -1
-				case 103:
+				case 99:
 					return -1
-				case 71:
+				case 117:
+					return 3
+				case 110:
+					return -1
+				case 67:
+					return -1
+				case 65:
+					return -1
+				case 84:
+					return -1
+				case 85:
+					return 3
+				case 97:
 					return -1
 				}
 				return -1
@@ -26680,87 +28530,61 @@ func NewLexerWithInit(in io.Reader, initFun func(*Lexer)) *Lexer {
 				switch r {
 				case 116:
 					return -1
-				case 84:
+				case 114:
 					return -1
 				case 82:
 					return -1
-				case 105:
-					return -1
 				case 101:
 					return -1
 				case 69:
 					return -1
-				case 114:
+				case 78:
+					return 4
+				case 99:
 					return -1
-				case 73:
+				case 117:
 					return -1
-				case 103:
+				case 110:
+					return 4
+				case 67:
 					return -1
-				case 71:
+				case 65:
 					return -1
-				}
-				return -1
-			},
-		}, []int{ /* Start-of-input transitions */ -1, -1, -1, -1, -1, -1, -1, -1}, []int{ /* End-of-input transitions */ -1, -1, -1, -1, -1, -1, -1, -1}, nil},
-
-		// [tT][rR][uU][eE]
-		{[]bool{false, false, false, false, true}, []func(rune) int{ // Transitions
-			func(r rune) int {
-				switch r {
-				case 116:
-					return 1
 				case 84:
-					return 1
-				case 114:
-					return -1
-				case 82:
-					return -1
-				case 117:
 					return -1
 				case 85:
 					return -1
-				case 101:
-					return -1
-				case 69:
+				case 97:
 					return -1
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
-				case 116:
-					return -1
-				case 84:
+				case 78:
 					return -1
-				case 114:
-					return 2
-				case 82:
-					return 2
+				case 99:
+					return 5
 				case 117:
 					return -1
-				case 85:
+				case 110:
 					return -1
-				case 101:
+				case 67:
+					return 5
+				case 65:
 					return -1
-				case 69:
+				case 84:
 					return -1
-				}
-				return -1
-			},
-			func(r rune) int {
-				switch r {
-				case 116:
+				case 85:
 					return -1
-				case 84:
+				case 97:
+					return -1
+				case 116:
 					return -1
 				case 114:
 					return -1
 				case 82:
 					return -1
-				case 117:
-					return 3
-				case 85:
-					return 3
 				case 101:
 					return -1
 				case 69:
@@ -26770,62 +28594,82 @@ func NewLexerWithInit(in io.Reader, initFun func(*Lexer)) *Lexer {
 			},
 			func(r rune) int {
 				switch r {
-				case 116:
-					return -1
 				case 84:
 					return -1
+				case 85:
+					return -1
+				case 97:
+					return 6
+				case 116:
+					return -1
 				case 114:
 					return -1
 				case 82:
 					return -1
+				case 101:
+					return -1
+				case 69:
+					return -1
+				case 78:
+					return -1
+				case 99:
+					return -1
 				case 117:
 					return -1
-				case 85:
+				case 110:
 					return -1
-				case 101:
-					return 4
-				case 69:
-					return 4
+				case 67:
+					return -1
+				case 65:
+					return 6
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
-				case 116:
+				case 117:
+					return -1
+				case 110:
+					return -1
+				case 67:
+					return -1
+				case 65:
 					return -1
 				case 84:
+					return 7
+				case 85:
+					return -1
+				case 97:
 					return -1
+				case 116:
+					return 7
 				case 114:
 					return -1
 				case 82:
 					return -1
-				case 117:
-					return -1
-				case 85:
-					return -1
 				case 101:
 					return -1
 				case 69:
 					return -1
+				case 78:
+					return -1
+				case 99:
+					return -1
 				}
 				return -1
 			},
-		}, []int{ /* Start-of-input transitions */ -1, -1, -1, -1, -1}, []int{ /* End-of-input transitions */ -1, -1, -1, -1, -1}, nil},
-
-		// [tT][rR][uU][nN][cC][aA][tT][eE]
-		{[]bool{false, false, false, false, false, false, false, false, true}, []func(rune) int{ // Transitions
 			func(r rune) int {
 				switch r {
 				case 116:
-					return 1
+					return -1
 				case 114:
 					return -1
 				case 82:
 					return -1
 				case 101:
-					return -1
+					return 8
 				case 69:
-					return -1
+					return 8
 				case 78:
 					return -1
 				case 99:
@@ -26839,7 +28683,7 @@ func NewLexerWithInit(in io.Reader, initFun func(*Lexer)) *Lexer {
 				case 65:
 					return -1
 				case 84:
-					return 1
+					return -1
 				case 85:
 					return -1
 				case 97:
@@ -26849,16 +28693,6 @@ func NewLexerWithInit(in io.Reader, initFun func(*Lexer)) *Lexer {
 			},
 			func(r rune) int {
 				switch r {
-				case 116:
-					return -1
-				case 114:
-					return 2
-				case 82:
-					return 2
-				case 101:
-					return -1
-				case 69:
-					return -1
 				case 78:
 					return -1
 				case 99:
@@ -26877,100 +28711,160 @@ func NewLexerWithInit(in io.Reader, initFun func(*Lexer)) *Lexer {
 					return -1
 				case 97:
 					return -1
+				case 116:
+					return -1
+				case 114:
+					return -1
+				case 82:
+					return -1
+				case 101:
+					return -1
+				case 69:
+					return -1
 				}
 				return -1
 			},
+		}, []int{ /* Start-of-input transitions */ -1, -1, -1, -1, -1, -1, -1, -1, -1}, []int{ /* End-of-input transitions */ -1, -1, -1, -1, -1, -1, -1, -1, -1}, nil},
+
+		// [uU][nN][bB][oO][uU][nN][dD][eE][dD]
+		{[]bool{false, false, false, false, false, false, false, false, false, true}, []func(rune) int{ // Transitions
 			func(r rune) int {
 				switch r {
-				case 116:
+				case 117:
+					return 1
+				case 85:
+					return 1
+				case 110:
 					return -1
-				case 114:
+				case 78:
 					return -1
-				case 82:
+				case 98:
+					return -1
+				case 66:
+					return -1
+				case 111:
+					return -1
+				case 79:
+					return -1
+				case 100:
+					return -1
+				case 68:
 					return -1
 				case 101:
 					return -1
 				case 69:
 					return -1
+				}
+				return -1
+			},
+			func(r rune) int {
+				switch r {
+				case 117:
+					return -1
+				case 85:
+					return -1
+				case 110:
+					return 2
 				case 78:
+					return 2
+				case 98:
 					return -1
-				case 99:
+				case 66:
+					return -1
+				case 111:
+					return -1
+				case 79:
+					return -1
+				case 100:
+					return -1
+				case 68:
+					return -1
+				case 101:
+					return -1
+				case 69:
 					return -1
+				}
+				return -1
+			},
+			func(r rune) int {
+				switch r {
 				case 117:
-					return 3
+					return -1
+				case 85:
+					return -1
 				case 110:
 					return -1
-				case 67:
+				case 78:
 					return -1
-				case 65:
+				case 98:
+					return 3
+				case 66:
+					return 3
+				case 111:
+					return -1
+				case 79:
+					return -1
+				case 100:
+					return -1
+				case 68:
 					return -1
-				case 84:
+				case 101:
 					return -1
-				case 85:
-					return 3
-				case 97:
+				case 69:
 					return -1
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
-				case 116:
-					return -1
-				case 114:
-					return -1
-				case 82:
+				case 117:
 					return -1
-				case 101:
+				case 85:
 					return -1
-				case 69:
+				case 110:
 					return -1
 				case 78:
-					return 4
-				case 99:
 					return -1
-				case 117:
+				case 98:
 					return -1
-				case 110:
-					return 4
-				case 67:
+				case 66:
 					return -1
-				case 65:
+				case 111:
+					return 4
+				case 79:
+					return 4
+				case 100:
 					return -1
-				case 84:
+				case 68:
 					return -1
-				case 85:
+				case 101:
 					return -1
-				case 97:
+				case 69:
 					return -1
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
-				case 78:
-					return -1
-				case 99:
-					return 5
 				case 117:
-					return -1
+					return 5
+				case 85:
+					return 5
 				case 110:
 					return -1
-				case 67:
-					return 5
-				case 65:
+				case 78:
 					return -1
-				case 84:
+				case 98:
 					return -1
-				case 85:
+				case 66:
 					return -1
-				case 97:
+				case 111:
 					return -1
-				case 116:
+				case 79:
 					return -1
-				case 114:
+				case 100:
 					return -1
-				case 82:
+				case 68:
 					return -1
 				case 101:
 					return -1
@@ -26981,34 +28875,30 @@ func NewLexerWithInit(in io.Reader, initFun func(*Lexer)) *Lexer {
 			},
 			func(r rune) int {
 				switch r {
-				case 84:
+				case 117:
 					return -1
 				case 85:
 					return -1
-				case 97:
+				case 110:
 					return 6
-				case 116:
-					return -1
-				case 114:
-					return -1
-				case 82:
+				case 78:
+					return 6
+				case 98:
 					return -1
-				case 101:
+				case 66:
 					return -1
-				case 69:
+				case 111:
 					return -1
-				case 78:
+				case 79:
 					return -1
-				case 99:
+				case 100:
 					return -1
-				case 117:
+				case 68:
 					return -1
-				case 110:
+				case 101:
 					return -1
-				case 67:
+				case 69:
 					return -1
-				case 65:
-					return 6
 				}
 				return -1
 			},
@@ -27016,93 +28906,110 @@ func NewLexerWithInit(in io.Reader, initFun func(*Lexer)) *Lexer {
 				switch r {
 				case 117:
 					return -1
-				case 110:
+				case 85:
 					return -1
-				case 67:
+				case 110:
 					return -1
-				case 65:
+				case 78:
 					return -1
-				case 84:
-					return 7
-				case 85:
+				case 98:
 					return -1
-				case 97:
+				case 66:
 					return -1
-				case 116:
-					return 7
-				case 114:
+				case 111:
 					return -1
-				case 82:
+				case 79:
 					return -1
+				case 100:
+					return 7
+				case 68:
+					return 7
 				case 101:
 					return -1
 				case 69:
 					return -1
-				case 78:
-					return -1
-				case 99:
-					return -1
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
-				case 116:
+				case 117:
 					return -1
-				case 114:
+				case 85:
 					return -1
-				case 82:
+				case 110:
+					return -1
+				case 78:
+					return -1
+				case 98:
+					return -1
+				case 66:
+					return -1
+				case 111:
+					return -1
+				case 79:
+					return -1
+				case 100:
+					return -1
+				case 68:
 					return -1
 				case 101:
 					return 8
 				case 69:
 					return 8
-				case 78:
-					return -1
-				case 99:
-					return -1
+				}
+				return -1
+			},
+			func(r rune) int {
+				switch r {
 				case 117:
 					return -1
+				case 85:
+					return -1
 				case 110:
 					return -1
-				case 67:
+				case 78:
 					return -1
-				case 65:
+				case 98:
 					return -1
-				case 84:
+				case 66:
 					return -1
-				case 85:
+				case 111:
 					return -1
-				case 97:
+				case 79:
+					return -1
+				case 100:
+					return 9
+				case 68:
+					return 9
+				case 101:
+					return -1
+				case 69:
 					return -1
 				}
 				return -1
 			},
 			func(r rune) int {
 				switch r {
-				case 78:
-					return -1
-				case 99:
-					return -1
 				case 117:
 					return -1
-				case 110:
+				case 85:
 					return -1
-				case 67:
+				case 110:
 					return -1
-				case 65:
+				case 78:
 					return -1
-				case 84:
+				case 98:
 					return -1
-				case 85:
+				case 66:
 					return -1
-				case 97:
+				case 111:
 					return -1
-				case 116:
+				case 79:
 					return -1
-				case 114:
+				case 100:
 					return -1
-				case 82:
+				case 68:
 					return -1
 				case 101:
 					return -1
@@ -27111,7 +29018,7 @@ func NewLexerWithInit(in io.Reader, initFun func(*Lexer)) *Lexer {
 				}
 				return -1
 			},
-		}, []int{ /* Start-of-input transitions */ -1, -1, -1, -1, -1, -1, -1, -1, -1}, []int{ /* End-of-input transitions */ -1, -1, -1, -1, -1, -1, -1, -1, -1}, nil},
+		}, []int{ /* Start-of-input transitions */ -1, -1, -1, -1, -1, -1, -1, -1, -1, -1}, []int{ /* End-of-input transitions */ -1, -1, -1, -1, -1, -1, -1, -1, -1, -1}, nil},
 
 		// [uU][nN][dD][eE][rR]
 		{[]bool{false, false, false, false, false, true}, []func(rune) int{ // Transitions
@@ -31418,894 +33325,949 @@ func (yylex *Lexer) Lex(lval *yySymType) int {
 			}
 			continue
 		case 63:
+			{
+				lval.s = yylex.Text()
+				logToken(yylex.Text(), "CURRENT")
+				return CURRENT
+			}
+			continue
+		case 64:
 			{
 				logToken(yylex.Text(), "DATABASE")
 				return DATABASE
 			}
 			continue
-		case 64:
+		case 65:
 			{
 				logToken(yylex.Text(), "DATASET")
 				return DATASET
 			}
 			continue
-		case 65:
+		case 66:
 			{
 				logToken(yylex.Text(), "DATASTORE")
 				return DATASTORE
 			}
 			continue
-		case 66:
+		case 67:
 			{
 				logToken(yylex.Text(), "DECLARE")
 				return DECLARE
 			}
 			continue
-		case 67:
+		case 68:
 			{
 				logToken(yylex.Text(), "DECREMENT")
 				return DECREMENT
 			}
 			continue
-		case 68:
+		case 69:
 			{
 				logToken(yylex.Text(), "DELETE")
 				return DELETE
 			}
 			continue
-		case 69:
+		case 70:
 			{
 				logToken(yylex.Text(), "DERIVED")
 				return DERIVED
 			}
 			continue
-		case 70:
+		case 71:
 			{
 				logToken(yylex.Text(), "DESC")
 				return DESC
 			}
 			continue
-		case 71:
+		case 72:
 			{
 				logToken(yylex.Text(), "DESCRIBE")
 				return DESCRIBE
 			}
 			continue
-		case 72:
+		case 73:
 			{
 				logToken(yylex.Text(), "DISTINCT")
 				return DISTINCT
 			}
 			continue
-		case 73:
+		case 74:
 			{
 				logToken(yylex.Text(), "DO")
 				return DO
 			}
 			continue
-		case 74:
+		case 75:
 			{
 				logToken(yylex.Text(), "DROP")
 				return DROP
 			}
 			continue
-		case 75:
+		case 76:
 			{
 				logToken(yylex.Text(), "EACH")
 				return EACH
 			}
 			continue
-		case 76:
+		case 77:
 			{
 				logToken(yylex.Text(), "ELEMENT")
 				return ELEMENT
 			}
 			continue
-		case 77:
+		case 78:
 			{
 				logToken(yylex.Text(), "ELSE")
 				return ELSE
 			}
 			continue
-		case 78:
+		case 79:
 			{
 				logToken(yylex.Text(), "END")
 				return END
 			}
 			continue
-		case 79:
+		case 80:
 			{
 				logToken(yylex.Text(), "EVERY")
 				return EVERY
 			}
 			continue
-		case 80:
+		case 81:
 			{
 				logToken(yylex.Text(), "EXCEPT")
 				return EXCEPT
 			}
 			continue
-		case 81:
+		case 82:
 			{
 				logToken(yylex.Text(), "EXCLUDE")
 				return EXCLUDE
 			}
 			continue
-		case 82:
+		case 83:
 			{
 				logToken(yylex.Text(), "EXECUTE")
 				return EXECUTE
 			}
 			continue
-		case 83:
+		case 84:
 			{
 				logToken(yylex.Text(), "EXISTS")
 				return EXISTS
 			}
 			continue
-		case 84:
+		case 85:
 			{
 				logToken(yylex.Text(), "EXPLAIN")
 				lval.tokOffset = curOffset
 				return EXPLAIN
 			}
 			continue
-		case 85:
+		case 86:
 			{
 				logToken(yylex.Text(), "FALSE")
 				return FALSE
 			}
 			continue
-		case 86:
+		case 87:
 			{
 				logToken(yylex.Text(), "FETCH")
 				return FETCH
 			}
 			continue
-		case 87:
+		case 88:
 			{
 				logToken(yylex.Text(), "FIRST")
 				return FIRST
 			}
 			continue
-		case 88:
+		case 89:
 			{
 				logToken(yylex.Text(), "FLATTEN")
 				return FLATTEN
 			}
 			continue
-		case 89:
+		case 90:
+			{
+				lval.s = yylex.Text()
+				logToken(yylex.Text(), "FOLLOWING")
+				return FOLLOWING
+			}
+			continue
+		case 91:
 			{
 				logToken(yylex.Text(), "FOR")
 				return FOR
 			}
 			continue
-		case 90:
+		case 92:
 			{
 				logToken(yylex.Text(), "FORCE")
 				return FORCE
 			}
 			continue
-		case 91:
+		case 93:
 			{
 				logToken(yylex.Text(), "FROM")
 				lval.tokOffset = curOffset
 				return FROM
 			}
 			continue
-		case 92:
+		case 94:
 			{
 				logToken(yylex.Text(), "FUNCTION")
 				return FUNCTION
 			}
 			continue
-		case 93:
+		case 95:
 			{
 				logToken(yylex.Text(), "GRANT")
 				return GRANT
 			}
 			continue
-		case 94:
+		case 96:
 			{
 				logToken(yylex.Text(), "GROUP")
 				return GROUP
 			}
 			continue
-		case 95:
+		case 97:
 			{
 				logToken(yylex.Text(), "GSI")
 				return GSI
 			}
 			continue
-		case 96:
+		case 98:
 			{
 				logToken(yylex.Text(), "HAVING")
 				return HAVING
 			}
 			continue
-		case 97:
+		case 99:
 			{
 				logToken(yylex.Text(), "IF")
 				return IF
 			}
 			continue
-		case 98:
+		case 100:
 			{
 				logToken(yylex.Text(), "IGNORE")
 				return IGNORE
 			}
 			continue
-		case 99:
+		case 101:
 			{
 				logToken(yylex.Text(), "ILIKE")
 				return ILIKE
 			}
 			continue
-		case 100:
+		case 102:
 			{
 				logToken(yylex.Text(), "IN")
 				return IN
 			}
 			continue
-		case 101:
+		case 103:
 			{
 				logToken(yylex.Text(), "INCLUDE")
 				return INCLUDE
 			}
 			continue
-		case 102:
+		case 104:
 			{
 				logToken(yylex.Text(), "INCREMENT")
 				return INCREMENT
 			}
 			continue
-		case 103:
+		case 105:
 			{
 				logToken(yylex.Text(), "INDEX")
 				return INDEX
 			}
 			continue
-		case 104:
+		case 106:
 			{
 				logToken(yylex.Text(), "INFER")
 				return INFER
 			}
 			continue
-		case 105:
+		case 107:
 			{
 				logToken(yylex.Text(), "INLINE")
 				return INLINE
 			}
 			continue
-		case 106:
+		case 108:
 			{
 				logToken(yylex.Text(), "INNER")
 				return INNER
 			}
 			continue
-		case 107:
+		case 109:
 			{
 				logToken(yylex.Text(), "INSERT")
 				return INSERT
 			}
 			continue
-		case 108:
+		case 110:
 			{
 				logToken(yylex.Text(), "INTERSECT")
 				return INTERSECT
 			}
 			continue
-		case 109:
+		case 111:
 			{
 				logToken(yylex.Text(), "INTO")
 				return INTO
 			}
 			continue
-		case 110:
+		case 112:
 			{
 				logToken(yylex.Text(), "IS")
 				return IS
 			}
 			continue
-		case 111:
+		case 113:
 			{
 				logToken(yylex.Text(), "JOIN")
 				return JOIN
 			}
 			continue
-		case 112:
+		case 114:
 			{
 				logToken(yylex.Text(), "KEY")
 				return KEY
 			}
 			continue
-		case 113:
+		case 115:
 			{
 				logToken(yylex.Text(), "KEYS")
 				return KEYS
 			}
 			continue
-		case 114:
+		case 116:
 			{
 				logToken(yylex.Text(), "KEYSPACE")
 				return KEYSPACE
 			}
 			continue
-		case 115:
+		case 117:
 			{
 				logToken(yylex.Text(), "KNOWN")
 				return KNOWN
 			}
 			continue
-		case 116:
+		case 118:
 			{
 				logToken(yylex.Text(), "LAST")
 				return LAST
 			}
 			continue
-		case 117:
+		case 119:
 			{
 				logToken(yylex.Text(), "LEFT")
 				return LEFT
 			}
 			continue
-		case 118:
+		case 120:
 			{
 				logToken(yylex.Text(), "LET")
 				return LET
 			}
 			continue
-		case 119:
+		case 121:
 			{
 				logToken(yylex.Text(), "LETTING")
 				return LETTING
 			}
 			continue
-		case 120:
+		case 122:
 			{
 				logToken(yylex.Text(), "LIKE")
 				return LIKE
 			}
 			continue
-		case 121:
+		case 123:
 			{
 				logToken(yylex.Text(), "LIMIT")
 				return LIMIT
 			}
 			continue
-		case 122:
+		case 124:
 			{
 				logToken(yylex.Text(), "LSM")
 				return LSM
 			}
 			continue
-		case 123:
+		case 125:
 			{
 				logToken(yylex.Text(), "MAP")
 				return MAP
 			}
 			continue
-		case 124:
+		case 126:
 			{
 				logToken(yylex.Text(), "MAPPING")
 				return MAPPING
 			}
 			continue
-		case 125:
+		case 127:
 			{
 				logToken(yylex.Text(), "MATCHED")
 				return MATCHED
 			}
 			continue
-		case 126:
+		case 128:
 			{
 				logToken(yylex.Text(), "MATERIALIZED")
 				return MATERIALIZED
 			}
 			continue
-		case 127:
+		case 129:
 			{
 				logToken(yylex.Text(), "MERGE")
 				return MERGE
 			}
 			continue
-		case 128:
+		case 130:
 			{
 				logToken(yylex.Text(), "MINUS")
 				return MINUS
 			}
 			continue
-		case 129:
+		case 131:
 			{
 				logToken(yylex.Text(), "MISSING")
 				return MISSING
 			}
 			continue
-		case 130:
+		case 132:
 			{
 				logToken(yylex.Text(), "NAMESPACE")
 				return NAMESPACE
 			}
 			continue
-		case 131:
+		case 133:
 			{
 				logToken(yylex.Text(), "NEST")
 				return NEST
 			}
 			continue
-		case 132:
+		case 134:
 			{
 				logToken(yylex.Text(), "NOT")
 				return NOT
 			}
 			continue
-		case 133:
+		case 135:
 			{
 				logToken(yylex.Text(), "NULL")
 				return NULL
 			}
 			continue
-		case 134:
+		case 136:
 			{
 				logToken(yylex.Text(), "NUMBER")
 				return NUMBER
 			}
 			continue
-		case 135:
+		case 137:
 			{
 				logToken(yylex.Text(), "OBJECT")
 				return OBJECT
 			}
 			continue
-		case 136:
+		case 138:
 			{
 				logToken(yylex.Text(), "OFFSET")
 				return OFFSET
 			}
 			continue
-		case 137:
+		case 139:
 			{
 				logToken(yylex.Text(), "ON")
 				return ON
 			}
 			continue
-		case 138:
+		case 140:
 			{
 				logToken(yylex.Text(), "OPTION")
 				return OPTION
 			}
 			continue
-		case 139:
+		case 141:
 			{
 				logToken(yylex.Text(), "OR")
 				return OR
 			}
 			continue
-		case 140:
+		case 142:
 			{
 				logToken(yylex.Text(), "ORDER")
 				return ORDER
 			}
 			continue
-		case 141:
+		case 143:
 			{
 				logToken(yylex.Text(), "OUTER")
 				return OUTER
 			}
 			continue
-		case 142:
+		case 144:
 			{
 				logToken(yylex.Text(), "OVER")
 				return OVER
 			}
 			continue
-		case 143:
+		case 145:
 			{
 				logToken(yylex.Text(), "PARSE")
 				return PARSE
 			}
 			continue
-		case 144:
+		case 146:
 			{
 				logToken(yylex.Text(), "PARTITION")
 				return PARTITION
 			}
 			continue
-		case 145:
+		case 147:
 			{
 				logToken(yylex.Text(), "PASSWORD")
 				return PASSWORD
 			}
 			continue
-		case 146:
+		case 148:
 			{
 				logToken(yylex.Text(), "PATH")
 				return PATH
 			}
 			continue
-		case 147:
+		case 149:
 			{
 				logToken(yylex.Text(), "POOL")
 				return POOL
 			}
 			continue
-		case 148:
+		case 150:
+			{
+				lval.s = yylex.Text()
+				logToken(yylex.Text(), "PRECEDING")
+				return PRECEDING
+			}
+			continue
+		case 151:
 			{
 				logToken(yylex.Text(), "PREPARE")
 				lval.tokOffset = curOffset
 				return PREPARE
 			}
 			continue
-		case 149:
+		case 152:
 			{
 				logToken(yylex.Text(), "PRIMARY")
 				return PRIMARY
 			}
 			continue
-		case 150:
+		case 153:
 			{
 				logToken(yylex.Text(), "PRIVATE")
 				return PRIVATE
 			}
 			continue
-		case 151:
+		case 154:
 			{
 				logToken(yylex.Text(), "PRIVILEGE")
 				return PRIVILEGE
 			}
 			continue
-		case 152:
+		case 155:
 			{
 				logToken(yylex.Text(), "PROCEDURE")
 				return PROCEDURE
 			}
 			continue
-		case 153:
+		case 156:
 			{
 				logToken(yylex.Text(), "PUBLIC")
 				return PUBLIC
 			}
 			continue
-		case 154:
+		case 157:
+			{
+				lval.s = yylex.Text()
+				logToken(yylex.Text(), "RANGE")
+				return RANGE
+			}
+			continue
+		case 158:
 			{
 				logToken(yylex.Text(), "RAW")
 				return RAW
 			}
 			continue
-		case 155:
+		case 159:
 			{
 				logToken(yylex.Text(), "REALM")
 				return REALM
 			}
 			continue
-		case 156:
+		case 160:
+			{
+				logToken(yylex.Text(), "RECURSIVE")
+				return RECURSIVE
+			}
+			continue
+		case 161:
 			{
 				logToken(yylex.Text(), "REDUCE")
 				return REDUCE
 			}
 			continue
-		case 157:
+		case 162:
 			{
 				logToken(yylex.Text(), "RENAME")
 				return RENAME
 			}
 			continue
-		case 158:
+		case 163:
 			{
 				logToken(yylex.Text(), "RETURN")
 				return RETURN
 			}
 			continue
-		case 159:
+		case 164:
 			{
 				logToken(yylex.Text(), "RETURNING")
 				return RETURNING
 			}
 			continue
-		case 160:
+		case 165:
 			{
 				logToken(yylex.Text(), "REVOKE")
 				return REVOKE
 			}
 			continue
-		case 161:
+		case 166:
 			{
 				logToken(yylex.Text(), "RIGHT")
 				return RIGHT
 			}
 			continue
-		case 162:
+		case 167:
 			{
 				logToken(yylex.Text(), "ROLE")
 				return ROLE
 			}
 			continue
-		case 163:
+		case 168:
 			{
 				logToken(yylex.Text(), "ROLLBACK")
 				return ROLLBACK
 			}
 			continue
-		case 164:
+		case 169:
+			{
+				lval.s = yylex.Text()
+				logToken(yylex.Text(), "ROW")
+				return ROW
+			}
+			continue
+		case 170:
+			{
+				lval.s = yylex.Text()
+				logToken(yylex.Text(), "ROWS")
+				return ROWS
+			}
+			continue
+		case 171:
 			{
 				logToken(yylex.Text(), "SATISFIES")
 				return SATISFIES
 			}
 			continue
-		case 165:
+		case 172:
 			{
 				logToken(yylex.Text(), "SCHEMA")
 				return SCHEMA
 			}
 			continue
-		case 166:
+		case 173:
 			{
 				logToken(yylex.Text(), "SELECT")
 				return SELECT
 			}
 			continue
-		case 167:
+		case 174:
 			{
 				logToken(yylex.Text(), "SELF")
 				return SELF
 			}
 			continue
-		case 168:
+		case 175:
 			{
 				logToken(yylex.Text(), "SET")
 				return SET
 			}
 			continue
-		case 169:
+		case 176:
 			{
 				logToken(yylex.Text(), "SHOW")
 				return SHOW
 			}
 			continue
-		case 170:
+		case 177:
 			{
 				logToken(yylex.Text(), "SOME")
 				return SOME
 			}
 			continue
-		case 171:
+		case 178:
 			{
 				logToken(yylex.Text(), "START")
 				return START
 			}
 			continue
-		case 172:
+		case 179:
 			{
 				logToken(yylex.Text(), "STATISTICS")
 				return STATISTICS
 			}
 			continue
-		case 173:
+		case 180:
 			{
 				logToken(yylex.Text(), "STRING")
 				return STRING
 			}
 			continue
-		case 174:
+		case 181:
 			{
 				logToken(yylex.Text(), "SYSTEM")
 				return SYSTEM
 			}
 			continue
-		case 175:
+		case 182:
 			{
 				logToken(yylex.Text(), "THEN")
 				return THEN
 			}
 			continue
-		case 176:
+		case 183:
 			{
 				logToken(yylex.Text(), "TO")
 				return TO
 			}
 			continue
-		case 177:
+		case 184:
 			{
 				logToken(yylex.Text(), "TRANSACTION")
 				return TRANSACTION
 			}
 			continue
-		case 178:
+		case 185:
 			{
 				logToken(yylex.Text(), "TRIGGER")
 				return TRIGGER
 			}
 			continue
-		case 179:
+		case 186:
 			{
 				logToken(yylex.Text(), "TRUE")
 				return TRUE
 			}
 			continue
-		case 180:
+		case 187:
 			{
 				logToken(yylex.Text(), "TRUNCATE")
 				return TRUNCATE
 			}
 			continue
-		case 181:
+		case 188:
+			{
+				lval.s = yylex.Text()
+				logToken(yylex.Text(), "UNBOUNDED")
+				return UNBOUNDED
+			}
+			continue
+		case 189:
 			{
 				logToken(yylex.Text(), "UNDER")
 				return UNDER
 			}
 			continue
-		case 182:
+		case 190:
 			{
 				logToken(yylex.Text(), "UNION")
 				return UNION
 			}
 			continue
-		case 183:
+		case 191:
 			{
 				logToken(yylex.Text(), "UNIQUE")
 				return UNIQUE
 			}
 			continue
-		case 184:
+		case 192:
 			{
 				logToken(yylex.Text(), "UNKNOWN")
 				return UNKNOWN
 			}
 			continue
-		case 185:
+		case 193:
 			{
 				logToken(yylex.Text(), "UNNEST")
 				return UNNEST
 			}
 			continue
-		case 186:
+		case 194:
 			{
 				logToken(yylex.Text(), "UNSET")
 				return UNSET
 			}
 			continue
-		case 187:
+		case 195:
 			{
 				logToken(yylex.Text(), "UPDATE")
 				return UPDATE
 			}
 			continue
-		case 188:
+		case 196:
 			{
 				logToken(yylex.Text(), "UPSERT")
 				return UPSERT
 			}
 			continue
-		case 189:
+		case 197:
 			{
 				logToken(yylex.Text(), "USE")
 				return USE
 			}
 			continue
-		case 190:
+		case 198:
 			{
 				logToken(yylex.Text(), "USER")
 				return USER
 			}
 			continue
-		case 191:
+		case 199:
 			{
 				logToken(yylex.Text(), "USING")
 				return USING
 			}
 			continue
-		case 192:
+		case 200:
 			{
 				logToken(yylex.Text(), "VALIDATE")
 				return VALIDATE
 			}
 			continue
-		case 193:
+		case 201:
 			{
 				logToken(yylex.Text(), "VALUE")
 				return VALUE
 			}
 			continue
-		case 194:
+		case 202:
 			{
 				logToken(yylex.Text(), "VALUED")
 				return VALUED
 			}
 			continue
-		case 195:
+		case 203:
 			{
 				logToken(yylex.Text(), "VALUES")
 				return VALUES
 			}
 			continue
-		case 196:
+		case 204:
 			{
 				logToken(yylex.Text(), "VIA")
 				return VIA
 			}
 			continue
-		case 197:
+		case 205:
 			{
 				logToken(yylex.Text(), "VIEW")
 				return VIEW
 			}
 			continue
-		case 198:
+		case 206:
 			{
 				logToken(yylex.Text(), "WHEN")
 				return WHEN
 			}
 			continue
-		case 199:
+		case 207:
 			{
 				logToken(yylex.Text(), "WHERE")
 				return WHERE
 			}
 			continue
-		case 200:
+		case 208:
 			{
 				logToken(yylex.Text(), "WHILE")
 				return WHILE
 			}
 			continue
-		case 201:
+		case 209:
 			{
 				logToken(yylex.Text(), "WITH")
 				return WITH
 			}
 			continue
-		case 202:
+		case 210:
 			{
 				logToken(yylex.Text(), "WITHIN")
 				return WITHIN
 			}
 			continue
-		case 203:
+		case 211:
 			{
 				logToken(yylex.Text(), "WORK")
 				return WORK
 			}
 			continue
-		case 204:
+		case 212:
 			{
 				logToken(yylex.Text(), "XOR")
 				return XOR
 			}
 			continue
-		case 205:
+		case 213:
 			{
 				lval.s = yylex.Text()
 				logToken(yylex.Text(), "IDENT - %s", lval.s)
 				return IDENT
 			}
 			continue
-		case 206:
+		case 214:
 			{
 				lval.s = yylex.Text()[1:]
 				logToken(yylex.Text(), "NAMED_PARAM - %s", lval.s)
 				return NAMED_PARAM
 			}
 			continue
-		case 207:
+		case 215:
 			{
 				lval.n, _ = strconv.Atoi(yylex.Text()[1:])
 				logToken(yylex.Text(), "POSITIONAL_PARAM - %d", lval.n)
 				return POSITIONAL_PARAM
 			}
 			continue
-		case 208:
+		case 216:
 			{
 				lval.n = 0 // Handled by parser
 				logToken(yylex.Text(), "NEXT_PARAM - ?")
 				return NEXT_PARAM
 			}
 			continue
-		case 209:
+		case 217:
 			{
 				curOffset++
 			}
 			continue
-		case 210:
+		case 218:
 			{
 				curOffset++
 			}
@@ -36,6 +36,9 @@ fullselect       *algebra.Select
 subresult        algebra.Subresult
 selectTerm       *algebra.SelectTerm
 subselect        *algebra.Subselect
+with             *algebra.With
+ansiJoin         *algebra.AnsiJoin
+withs            algebra.Withs
 fromTerm         algebra.FromTerm
 keyspaceTerm     *algebra.KeyspaceTerm
 use              *algebra.Use
@@ -50,8 +53,12 @@ projection       *algebra.Projection
 order            *algebra.Order
 sortTerm         *algebra.SortTerm
 sortTerms        algebra.SortTerms
+windowTerm       *algebra.WindowTerm
+windowFrame      *algebra.WindowFrame
+windowExtent     *algebra.WindowFrameExtent
 
 keyspaceRef      *algebra.KeyspaceRef
+keyspaceRefs     []*algebra.KeyspaceRef
 
 pairs            algebra.Pairs
 set              *algebra.Set
@@ -102,6 +109,7 @@ tokOffset	 int
 %token CORRELATE
 %token COVER
 %token CREATE
+%token CURRENT
 %token DATABASE
 %token DATASET
 %token DATASTORE
@@ -128,6 +136,7 @@ tokOffset	 int
 %token FETCH
 %token FIRST
 %token FLATTEN
+%token FOLLOWING
 %token FOR
 %token FORCE
 %token FROM
@@ -187,14 +196,17 @@ tokOffset	 int
 %token PASSWORD
 %token PATH
 %token POOL
+%token PRECEDING
 %token PREPARE
 %token PRIMARY
 %token PRIVATE
 %token PRIVILEGE
 %token PROCEDURE
 %token PUBLIC
+%token RANGE
 %token RAW
 %token REALM
+%token RECURSIVE
 %token REDUCE
 %token RENAME
 %token RETURN
@@ -203,6 +215,8 @@ tokOffset	 int
 %token RIGHT
 %token ROLE
 %token ROLLBACK
+%token ROW
+%token ROWS
 %token SATISFIES
 %token SCHEMA
 %token SELECT
@@ -221,6 +235,7 @@ tokOffset	 int
 %token TRIGGER
 %token TRUE
 %token TRUNCATE
+%token UNBOUNDED
 %token UNDER
 %token UNION
 %token UNIQUE
@@ -252,9 +267,11 @@ tokOffset	 int
 %token COMMA COLON
 
 /* Precedence: lowest to highest */
+%nonassoc       UNBOUNDED                       /* UNBOUNDED PRECEDING is a frame extent, not an expression */
+%nonassoc       PRECEDING FOLLOWING
 %left           ORDER
 %left           UNION INTERESECT EXCEPT
-%left           JOIN NEST UNNEST FLATTEN INNER LEFT
+%left           JOIN NEST UNNEST FLATTEN INNER LEFT RIGHT
 %left           OR
 %left           AND
 %right          NOT
@@ -280,7 +297,8 @@ tokOffset	 int
 
 /* Types */
 %type <s>                STR
-%type <s>                IDENT IDENT_ICASE
+%type <s>                IDENT IDENT_ICASE ident
+%type <s>                CURRENT FOLLOWING PRECEDING RANGE ROW ROWS UNBOUNDED
 %type <s>                NAMED_PARAM
 %type <f>                NUM
 %type <n>                INT
@@ -291,7 +309,7 @@ tokOffset	 int
 %type <bindings>         members opt_members
 
 %type <expr>             expr c_expr b_expr
-%type <exprs>            exprs opt_exprs
+%type <exprs>            exprs opt_exprs opt_analyze_for
 %type <binding>          binding
 %type <bindings>         bindings
 
@@ -308,21 +326,29 @@ tokOffset	 int
 
 %type <expr>             function_expr
 %type <s>                function_name
+%type <windowTerm>       window_term
+%type <exprs>            opt_window_partition
+%type <windowFrame>      opt_window_frame window_frame_extents
+%type <b>                window_frame_modifier
+%type <windowExtent>     window_frame_extent
 
 %type <expr>             paren_expr
 %type <subquery>         subquery_expr
 
 %type <fullselect>       fullselect
+%type <withs>            with_clause with_list
+%type <with>             with_term
 %type <subresult>        select_term select_terms
 %type <subselect>        subselect
 %type <subselect>        select_from
 %type <subselect>        from_select
 %type <fromTerm>         from_term from opt_from
 %type <keyspaceTerm>     keyspace_term join_term index_join_term
+%type <ansiJoin>         ansi_join_term
 %type <subqueryTerm>     subquery_term
 %type <b>                opt_join_type
 %type <path>             path opt_subpath
-%type <s>                namespace_name keyspace_name
+%type <s>                namespace_name keyspace_name system_keyspace_name
 %type <use>              opt_use
 %type <expr>             use_keys on_keys on_key
 %type <indexRefs>        use_index index_refs
@@ -343,9 +369,16 @@ tokOffset	 int
 %type <b>                dir opt_dir
 
 %type <statement>        stmt explain prepare execute select_stmt dml_stmt ddl_stmt
-%type <statement>        infer infer_keyspace
+%type <statement>        infer infer_keyspace analyze analyze_keyspace
 %type <statement>        insert upsert delete update merge
 %type <statement>        index_stmt create_index drop_index alter_index build_index
+%type <statement>        function_stmt create_function drop_function
+%type <statement>        transaction_stmt start_transaction commit_transaction rollback_transaction
+%type <statement>        role_stmt grant_role revoke_role
+%type <ss>               role_list user_list
+%type <s>                role_name user_name
+%type <keyspaceRefs>     keyspace_list
+%type <ss>               function_signature opt_parameters parameters
 
 %type <keyspaceRef>      keyspace_ref
 %type <pairs>            values values_list next_values
@@ -420,12 +453,23 @@ prepare
 execute
 |
 infer
+|
+analyze
+|
+transaction_stmt
+|
+role_stmt
 ;
 
 explain:
 EXPLAIN stmt
 {
-    $$ = algebra.NewExplain($2, yylex.(*lexer).Remainder($<tokOffset>1))
+    $$ = algebra.NewExplain($2, yylex.(*lexer).Remainder($<tokOffset>1), false)
+}
+|
+EXPLAIN ANALYZE stmt
+{
+    $$ = algebra.NewExplain($3, yylex.(*lexer).RemainderAfter($<tokOffset>1, "ANALYZE"), true)
 }
 ;
 
@@ -442,7 +486,7 @@ opt_name:
     $$ = ""
 }
 |
-IDENT from_or_as
+ident from_or_as
 {
     $$ = $1
 }
@@ -511,6 +555,239 @@ WITH expr
 }
 ;
 
+/*************************************************
+ *
+ * CREATE FUNCTION
+ *
+ *************************************************/
+
+function_stmt:
+create_function
+|
+drop_function
+;
+
+create_function:
+CREATE FUNCTION function_signature LBRACE expr RBRACE
+{
+    $$ = algebra.NewCreateFunction($3[0], $3[1:], $5)
+}
+;
+
+/* The function is known while parsing its body, so that the body can call it. */
+function_signature:
+function_name LPAREN opt_parameters RPAREN
+{
+    $$ = append([]string{strings.ToLower($1)}, $3...)
+    yylex.(*lexer).setFunction($$[0], len($3))
+}
+;
+
+opt_parameters:
+/* empty */
+{
+    $$ = nil
+}
+|
+parameters
+;
+
+parameters:
+ident
+{
+    $$ = []string{$1}
+}
+|
+parameters COMMA ident
+{
+    $$ = append($1, $3)
+}
+;
+
+/*************************************************
+ *
+ * DROP FUNCTION
+ *
+ *************************************************/
+
+drop_function:
+DROP FUNCTION function_name
+{
+    $$ = algebra.NewDropFunction(strings.ToLower($3))
+}
+;
+
+/*************************************************
+ *
+ * ANALYZE
+ *
+ *************************************************/
+
+analyze:
+analyze_keyspace
+;
+
+analyze_keyspace:
+ANALYZE opt_keyspace keyspace_ref opt_analyze_for opt_infer_with
+{
+    $$ = algebra.NewAnalyzeKeyspace($3, $4, $5)
+}
+|
+UPDATE STATISTICS FOR keyspace_ref opt_analyze_for opt_infer_with
+{
+    $$ = algebra.NewAnalyzeKeyspace($4, $5, $6)
+}
+|
+UPDATE STATISTICS FOR keyspace_ref LPAREN exprs RPAREN opt_infer_with
+{
+    $$ = algebra.NewAnalyzeKeyspace($4, $6, $8)
+}
+;
+
+opt_analyze_for:
+/* empty */
+{
+    $$ = nil
+}
+|
+FOR exprs
+{
+    $$ = $2
+}
+;
+
+/*************************************************
+ *
+ * START TRANSACTION / COMMIT / ROLLBACK
+ *
+ *************************************************/
+
+transaction_stmt:
+start_transaction
+|
+commit_transaction
+|
+rollback_transaction
+;
+
+start_transaction:
+START TRANSACTION
+{
+    $$ = algebra.NewStartTransaction()
+}
+|
+BEGIN opt_work
+{
+    $$ = algebra.NewStartTransaction()
+}
+;
+
+commit_transaction:
+COMMIT opt_work
+{
+    $$ = algebra.NewCommitTransaction()
+}
+;
+
+rollback_transaction:
+ROLLBACK opt_work
+{
+    $$ = algebra.NewRollbackTransaction()
+}
+;
+
+opt_work:
+/* empty */
+{
+}
+|
+WORK
+|
+TRANSACTION
+;
+
+/*************************************************
+ *
+ * GRANT / REVOKE
+ *
+ *************************************************/
+
+role_stmt:
+grant_role
+|
+revoke_role
+;
+
+grant_role:
+GRANT role_list TO user_list
+{
+    $$ = algebra.NewGrantRole($2, nil, $4)
+}
+|
+GRANT role_list ON keyspace_list TO user_list
+{
+    $$ = algebra.NewGrantRole($2, $4, $6)
+}
+;
+
+revoke_role:
+REVOKE role_list FROM user_list
+{
+    $$ = algebra.NewRevokeRole($2, nil, $4)
+}
+|
+REVOKE role_list ON keyspace_list FROM user_list
+{
+    $$ = algebra.NewRevokeRole($2, $4, $6)
+}
+;
+
+role_list:
+role_name
+{
+    $$ = []string{$1}
+}
+|
+role_list COMMA role_name
+{
+    $$ = append($1, $3)
+}
+;
+
+role_name:
+ident
+;
+
+keyspace_list:
+named_keyspace_ref
+{
+    $$ = []*algebra.KeyspaceRef{$1}
+}
+|
+keyspace_list COMMA named_keyspace_ref
+{
+    $$ = append($1, $3)
+}
+;
+
+user_list:
+user_name
+{
+    $$ = []string{$1}
+}
+|
+user_list COMMA user_name
+{
+    $$ = append($1, $3)
+}
+;
+
+user_name:
+ident
+|
+STR
+;
+
 select_stmt:
 fullselect
 {
@@ -528,10 +805,25 @@ delete
 update
 |
 merge
+|
+with_clause dml_stmt
+{
+    stmt := $2.(interface {
+        With() algebra.Withs
+        SetWith(algebra.Withs)
+    })
+    if stmt.With() != nil {
+        yylex.Error("Duplicate WITH clause.")
+    }
+    stmt.SetWith($1)
+    $$ = $2
+}
 ;
 
 ddl_stmt:
 index_stmt
+|
+function_stmt
 ;
 
 index_stmt:
@@ -559,6 +851,49 @@ select_terms opt_order_by offset opt_limit
 {
     $$ = algebra.NewSelect($1, $2, $3, $4) /* OFFSET precedes LIMIT */
 }
+|
+with_clause fullselect
+{
+    if $2.With() != nil {
+        yylex.Error("Duplicate WITH clause.")
+    }
+    $2.SetWith($1)
+    $$ = $2
+}
+;
+
+with_clause:
+WITH with_list
+{
+    $$ = $2
+}
+|
+WITH RECURSIVE with_list
+{
+    for i, with := range $3 {
+        $3[i] = algebra.NewWith(with.Alias(), with.Query(), true)
+    }
+    $$ = $3
+}
+;
+
+with_list:
+with_term
+{
+    $$ = algebra.Withs{$1}
+}
+|
+with_list COMMA with_term
+{
+    $$ = append($1, $3)
+}
+;
+
+with_term:
+alias AS LPAREN fullselect RPAREN
+{
+    $$ = algebra.NewWith($1, $4, false)
+}
 ;
 
 select_terms:
@@ -764,7 +1099,7 @@ AS alias
 ;
 
 alias:
-IDENT
+ident
 ;
 
 
@@ -806,17 +1141,29 @@ from_term opt_join_type JOIN join_term
     $$ = algebra.NewJoin($1, $2, $4)
 }
 |
-from_term opt_join_type JOIN index_join_term FOR IDENT
+from_term opt_join_type JOIN index_join_term FOR ident
 {
     $$ = algebra.NewIndexJoin($1, $2, $4, $6)
 }
 |
+from_term opt_join_type JOIN ansi_join_term
+{
+    $4.SetLeft($1, $2, false)
+    $$ = $4
+}
+|
+from_term RIGHT opt_outer JOIN ansi_join_term
+{
+    $5.SetLeft($1, false, true)
+    $$ = $5
+}
+|
 from_term opt_join_type NEST join_term
 {
     $$ = algebra.NewNest($1, $2, $4)
 }
 |
-from_term opt_join_type NEST index_join_term FOR IDENT
+from_term opt_join_type NEST index_join_term FOR ident
 {
     $$ = algebra.NewIndexNest($1, $2, $4, $6)
 }
@@ -844,7 +1191,7 @@ namespace_name COLON keyspace_name opt_subpath opt_as_alias opt_use
     $$ = algebra.NewKeyspaceTerm($1, $3, $4, $5, $6.Keys(), $6.Indexes())
 }
 |
-SYSTEM COLON keyspace_name opt_subpath opt_as_alias opt_use
+SYSTEM COLON system_keyspace_name opt_subpath opt_as_alias opt_use
 {
     $$ = algebra.NewKeyspaceTerm("#system", $3, $4, $5, $6.Keys(), $6.Indexes())
 }
@@ -872,12 +1219,37 @@ namespace_name COLON keyspace_name opt_subpath opt_as_alias on_keys
     $$ = algebra.NewKeyspaceTerm($1, $3, $4, $5, $6, nil)
 }
 |
-SYSTEM COLON keyspace_name opt_subpath opt_as_alias on_keys
+SYSTEM COLON system_keyspace_name opt_subpath opt_as_alias on_keys
 {
     $$ = algebra.NewKeyspaceTerm("#system", $3, $4, $5, $6, nil)
 }
 ;
 
+ansi_join_term:
+keyspace_name opt_subpath opt_as_alias ON expr
+{
+    ksterm := algebra.NewKeyspaceTerm("", $1, $2, $3, nil, nil)
+    $$ = algebra.NewAnsiJoin(nil, false, false, ksterm, $5)
+}
+|
+namespace_name COLON keyspace_name opt_subpath opt_as_alias ON expr
+{
+    ksterm := algebra.NewKeyspaceTerm($1, $3, $4, $5, nil, nil)
+    $$ = algebra.NewAnsiJoin(nil, false, false, ksterm, $7)
+}
+|
+SYSTEM COLON system_keyspace_name opt_subpath opt_as_alias ON expr
+{
+    ksterm := algebra.NewKeyspaceTerm("#system", $3, $4, $5, nil, nil)
+    $$ = algebra.NewAnsiJoin(nil, false, false, ksterm, $7)
+}
+|
+subquery_term ON expr
+{
+    $$ = algebra.NewAnsiJoin(nil, false, false, $1, $3)
+}
+;
+
 index_join_term:
 keyspace_name opt_subpath opt_as_alias on_key
 {
@@ -889,18 +1261,27 @@ namespace_name COLON keyspace_name opt_subpath opt_as_alias on_key
     $$ = algebra.NewKeyspaceTerm($1, $3, $4, $5, $6, nil)
 }
 |
-SYSTEM COLON keyspace_name opt_subpath opt_as_alias on_key
+SYSTEM COLON system_keyspace_name opt_subpath opt_as_alias on_key
 {
     $$ = algebra.NewKeyspaceTerm("#system", $3, $4, $5, $6, nil)
 }
 ;
 
 namespace_name:
-IDENT
+ident
 ;
 
 keyspace_name:
-IDENT
+ident
+;
+
+system_keyspace_name:
+keyspace_name
+|
+STATISTICS
+{
+    $$ = "statistics"
+}
 ;
 
 opt_subpath:
@@ -1275,7 +1656,7 @@ INSERT INTO keyspace_ref LPAREN key_expr opt_value_expr RPAREN fullselect opt_re
 ;
 
 keyspace_ref:
-SYSTEM COLON keyspace_name opt_as_alias
+SYSTEM COLON system_keyspace_name opt_as_alias
 {
     $$ = algebra.NewKeyspaceRef("#system", $3, $4)
 }
@@ -1511,7 +1892,7 @@ variable COLON variable WITHIN path_expr
 ;
 
 variable:
-IDENT
+ident
 ;
 
 path_expr:
@@ -1691,7 +2072,7 @@ index_name
 ;
 
 index_name:
-IDENT
+ident
 ;
 
 named_keyspace_ref:
@@ -1737,6 +2118,14 @@ USING GSI
 {
     $$ = datastore.GSI
 }
+|
+USING IDENT
+{
+    if strings.ToLower($2) != string(datastore.FTS) {
+        yylex.Error(fmt.Sprintf("Invalid index type: %s", $2))
+    }
+    $$ = datastore.FTS
+}
 ;
 
 opt_index_with:
@@ -1894,12 +2283,12 @@ index_names COMMA index_name
  *************************************************/
 
 path:
-IDENT
+ident
 {
     $$ = expression.NewIdentifier($1)
 }
 |
-path DOT IDENT
+path DOT ident
 {
     $$ = expression.NewField($1, expression.NewFieldName($3, false))
 }
@@ -1940,7 +2329,7 @@ expr:
 c_expr
 |
 /* Nested */
-expr DOT IDENT
+expr DOT ident
 {
     $$ = expression.NewField($1, expression.NewFieldName($3, false))
 }
@@ -2158,7 +2547,7 @@ literal
 construction_expr
 |
 /* Identifier */
-IDENT
+ident
 {
     $$ = expression.NewIdentifier($1)
 }
@@ -2209,7 +2598,7 @@ b_expr:
 c_expr
 |
 /* Nested */
-b_expr DOT IDENT
+b_expr DOT ident
 {
     $$ = expression.NewField($1, expression.NewFieldName($3, false))
 }
@@ -2500,6 +2889,14 @@ function_name LPAREN opt_exprs RPAREN
         } else {
             $$ = f.Constructor()($3...);
         }
+    } else if _, ok := algebra.GetWindowFunction($1, nil); ok {
+        yylex.Error(fmt.Sprintf("Window function %s requires an OVER clause.", $1));
+    } else if params, ok := yylex.(*lexer).userFunction($1); ok {
+        if len($3) != params {
+            yylex.Error(fmt.Sprintf("Wrong number of arguments to function %s.", $1));
+        } else {
+            $$ = expression.NewUserFunction($1, $3...);
+        }
     } else {
         yylex.Error(fmt.Sprintf("Invalid function %s.", $1));
     }
@@ -2528,10 +2925,163 @@ function_name LPAREN STAR RPAREN
         }
     }
 }
+|
+function_name LPAREN opt_exprs RPAREN window_term
+{
+    $$ = nil;
+    var f expression.Function;
+    wf, ok := algebra.GetWindowFunction($1, $5);
+    if ok {
+        f = wf;
+    } else {
+        f, ok = algebra.GetAggregate($1, false);
+    }
+
+    if !ok {
+        yylex.Error(fmt.Sprintf("Invalid window function %s.", $1));
+    } else if len($3) < f.MinArgs() || len($3) > f.MaxArgs() {
+        yylex.Error(fmt.Sprintf("Wrong number of arguments to function %s.", $1));
+    } else if wf != nil {
+        $$ = wf.Constructor()($3...);
+    } else {
+        $$ = algebra.NewWindowAggregate(f.Constructor()($3...).(algebra.Aggregate), $5);
+    }
+}
+|
+function_name LPAREN DISTINCT expr RPAREN window_term
+{
+    agg, ok := algebra.GetAggregate($1, true);
+    if ok {
+        $$ = algebra.NewWindowAggregate(agg.Constructor()($4).(algebra.Aggregate), $6);
+    } else {
+        yylex.Error(fmt.Sprintf("Invalid aggregate function %s.", $1));
+    }
+}
+|
+function_name LPAREN STAR RPAREN window_term
+{
+    if strings.ToLower($1) != "count" {
+        yylex.Error(fmt.Sprintf("Invalid aggregate function %s(*).", $1));
+    } else {
+        agg, ok := algebra.GetAggregate($1, false);
+        if ok {
+            $$ = algebra.NewWindowAggregate(agg.Constructor()(nil).(algebra.Aggregate), $5);
+        } else {
+            yylex.Error(fmt.Sprintf("Invalid aggregate function %s.", $1));
+        }
+    }
+}
 ;
 
 function_name:
+ident
+;
+
+window_term:
+OVER LPAREN opt_window_partition opt_order_by opt_window_frame RPAREN
+{
+    $$ = algebra.NewWindowTerm($3, $4, $5);
+    if $5 != nil {
+        if err := $5.Validate($4); err != nil {
+            yylex.Error(err.Error());
+        }
+    }
+}
+;
+
+opt_window_partition:
+/* empty */
+{
+    $$ = nil
+}
+|
+PARTITION BY exprs
+{
+    $$ = $3
+}
+;
+
+opt_window_frame:
+/* empty */
+{
+    $$ = nil
+}
+|
+window_frame_modifier window_frame_extents
+{
+    $$ = algebra.NewWindowFrame($1, $2.Start(), $2.End())
+}
+;
+
+window_frame_modifier:
+ROWS
+{
+    $$ = true
+}
+|
+RANGE
+{
+    $$ = false
+}
+;
+
+window_frame_extents:
+window_frame_extent
+{
+    $$ = algebra.NewWindowFrame(false, $1, nil)
+}
+|
+BETWEEN window_frame_extent AND window_frame_extent
+{
+    $$ = algebra.NewWindowFrame(false, $2, $4)
+}
+;
+
+window_frame_extent:
+UNBOUNDED PRECEDING
+{
+    $$ = algebra.NewWindowFrameExtent(algebra.UNBOUNDED_PRECEDING, nil)
+}
+|
+UNBOUNDED FOLLOWING
+{
+    $$ = algebra.NewWindowFrameExtent(algebra.UNBOUNDED_FOLLOWING, nil)
+}
+|
+CURRENT ROW
+{
+    $$ = algebra.NewWindowFrameExtent(algebra.CURRENT_ROW, nil)
+}
+|
+expr PRECEDING
+{
+    $$ = algebra.NewWindowFrameExtent(algebra.VALUE_PRECEDING, $1)
+}
+|
+expr FOLLOWING
+{
+    $$ = algebra.NewWindowFrameExtent(algebra.VALUE_FOLLOWING, $1)
+}
+;
+
+/* The keywords of the window clause are not reserved, and are also
+   identifiers elsewhere. */
+ident:
 IDENT
+|
+CURRENT
+|
+FOLLOWING
+|
+PRECEDING
+|
+RANGE
+|
+ROW
+|
+ROWS
+|
+UNBOUNDED
 ;
 
 
@@ -0,0 +1,107 @@
+//  Copyright (c) 2016 Couchbase, Inc.
+//  Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file
+//  except in compliance with the License. You may obtain a copy of the License at
+//    http://www.apache.org/licenses/LICENSE-2.0
+//  Unless required by applicable law or agreed to in writing, software distributed under the
+//  License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+//  either express or implied. See the License for the specific language governing permissions
+//  and limitations under the License.
+
+package plan
+
+import (
+	"encoding/json"
+
+	"github.com/couchbase/query/algebra"
+	"github.com/couchbase/query/datastore"
+	"github.com/couchbase/query/expression"
+	"github.com/couchbase/query/expression/parser"
+	"github.com/couchbase/query/value"
+)
+
+// Analyze keyspace
+type AnalyzeKeyspace struct {
+	readwrite
+	keyspace datastore.Keyspace
+	node     *algebra.AnalyzeKeyspace
+}
+
+func NewAnalyzeKeyspace(keyspace datastore.Keyspace, node *algebra.AnalyzeKeyspace) *AnalyzeKeyspace {
+	return &AnalyzeKeyspace{
+		keyspace: keyspace,
+		node:     node,
+	}
+}
+
+func (this *AnalyzeKeyspace) Accept(visitor Visitor) (interface{}, error) {
+	return visitor.VisitAnalyzeKeyspace(this)
+}
+
+func (this *AnalyzeKeyspace) New() Operator {
+	return &AnalyzeKeyspace{}
+}
+
+func (this *AnalyzeKeyspace) Keyspace() datastore.Keyspace {
+	return this.keyspace
+}
+
+func (this *AnalyzeKeyspace) Node() *algebra.AnalyzeKeyspace {
+	return this.node
+}
+
+func (this *AnalyzeKeyspace) MarshalJSON() ([]byte, error) {
+	r := map[string]interface{}{"#operator": "AnalyzeKeyspace"}
+	r["keyspace"] = this.keyspace.Name()
+	r["namespace"] = this.keyspace.NamespaceId()
+
+	if this.node.Fields() != nil {
+		r["fields"] = this.node.Fields()
+	}
+
+	if this.node.With() != nil {
+		r["with"] = this.node.With()
+	}
+
+	return json.Marshal(r)
+}
+
+func (this *AnalyzeKeyspace) UnmarshalJSON(body []byte) error {
+	var _unmarshalled struct {
+		_      string          `json:"#operator"`
+		Keysp  string          `json:"keyspace"`
+		Namesp string          `json:"namespace"`
+		Fields []string        `json:"fields"`
+		With   json.RawMessage `json:"with"`
+	}
+
+	err := json.Unmarshal(body, &_unmarshalled)
+	if err != nil {
+		return err
+	}
+
+	this.keyspace, err = datastore.GetKeyspace(_unmarshalled.Namesp, _unmarshalled.Keysp)
+	if err != nil {
+		return err
+	}
+
+	ksref := algebra.NewKeyspaceRef(_unmarshalled.Namesp, _unmarshalled.Keysp, "")
+
+	var fields expression.Expressions
+	if len(_unmarshalled.Fields) > 0 {
+		fields = make(expression.Expressions, len(_unmarshalled.Fields))
+		for i, f := range _unmarshalled.Fields {
+			fields[i], err = parser.Parse(f)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	var with value.Value
+	if len(_unmarshalled.With) > 0 {
+		with = value.NewValue(_unmarshalled.With)
+	}
+
+	this.node = algebra.NewAnalyzeKeyspace(ksref, fields, with)
+	return nil
+}
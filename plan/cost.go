@@ -0,0 +1,46 @@
+//  Copyright (c) 2016 Couchbase, Inc.
+//  Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file
+//  except in compliance with the License. You may obtain a copy of the License at
+//    http://www.apache.org/licenses/LICENSE-2.0
+//  Unless required by applicable law or agreed to in writing, software distributed under the
+//  License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+//  either express or implied. See the License for the specific language governing permissions
+//  and limitations under the License.
+
+package plan
+
+// Optimizer estimates of a scan, reported by EXPLAIN. The cost is
+// relative, and the cardinality is the estimated number of items
+// the scan returns. Both are zero when no estimate is available.
+type estimates struct {
+	cost        float64
+	cardinality float64
+}
+
+func (this *estimates) Cost() float64 {
+	return this.cost
+}
+
+func (this *estimates) Cardinality() float64 {
+	return this.cardinality
+}
+
+func (this *estimates) SetEstimates(cost, cardinality float64) {
+	this.cost = cost
+	this.cardinality = cardinality
+}
+
+func (this *estimates) marshalEstimates(r map[string]interface{}) {
+	if this.cost > 0 {
+		r["cost"] = this.cost
+		r["cardinality"] = this.cardinality
+	}
+}
+
+// Scans that carry optimizer estimates.
+type CostedScan interface {
+	Operator
+	Cost() float64
+	Cardinality() float64
+	SetEstimates(cost, cardinality float64)
+}
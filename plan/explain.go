@@ -15,14 +15,16 @@ import (
 
 type Explain struct {
 	readonly
-	op   Operator
-	text string
+	op      Operator
+	text    string
+	analyze bool
 }
 
-func NewExplain(op Operator, text string) *Explain {
+func NewExplain(op Operator, text string, analyze bool) *Explain {
 	return &Explain{
-		op:   op,
-		text: text,
+		op:      op,
+		text:    text,
+		analyze: analyze,
 	}
 }
 
@@ -34,21 +36,38 @@ func (this *Explain) New() Operator {
 	return &Explain{}
 }
 
+// EXPLAIN ANALYZE executes the statement
+func (this *Explain) Readonly() bool {
+	return !this.analyze || this.op.Readonly()
+}
+
 func (this *Explain) Operator() Operator {
 	return this.op
 }
 
+func (this *Explain) Text() string {
+	return this.text
+}
+
+func (this *Explain) Analyze() bool {
+	return this.analyze
+}
+
 func (this *Explain) MarshalJSON() ([]byte, error) {
-	r := make(map[string]interface{}, 2)
+	r := make(map[string]interface{}, 3)
 	r["plan"] = this.op
 	r["text"] = this.text
+	if this.analyze {
+		r["analyze"] = this.analyze
+	}
 	return json.Marshal(r)
 }
 
 func (this *Explain) UnmarshalJSON(body []byte) error {
 	var _unmarshalled struct {
-		Op   json.RawMessage `json:"plan"`
-		Text string          `json:"text"`
+		Op      json.RawMessage `json:"plan"`
+		Text    string          `json:"text"`
+		Analyze bool            `json:"analyze"`
 	}
 
 	var op_type struct {
@@ -66,6 +85,7 @@ func (this *Explain) UnmarshalJSON(body []byte) error {
 	}
 
 	this.text = _unmarshalled.Text
+	this.analyze = _unmarshalled.Analyze
 
 	err = json.Unmarshal(_unmarshalled.Op, &op_type)
 	if err != nil {
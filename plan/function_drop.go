@@ -0,0 +1,61 @@
+//  Copyright (c) 2016 Couchbase, Inc.
+//  Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file
+//  except in compliance with the License. You may obtain a copy of the License at
+//    http://www.apache.org/licenses/LICENSE-2.0
+//  Unless required by applicable law or agreed to in writing, software distributed under the
+//  License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+//  either express or implied. See the License for the specific language governing permissions
+//  and limitations under the License.
+
+package plan
+
+import (
+	"encoding/json"
+
+	"github.com/couchbase/query/algebra"
+)
+
+// Drop function
+type DropFunction struct {
+	readwrite
+	node *algebra.DropFunction
+}
+
+func NewDropFunction(node *algebra.DropFunction) *DropFunction {
+	return &DropFunction{
+		node: node,
+	}
+}
+
+func (this *DropFunction) Accept(visitor Visitor) (interface{}, error) {
+	return visitor.VisitDropFunction(this)
+}
+
+func (this *DropFunction) New() Operator {
+	return &DropFunction{}
+}
+
+func (this *DropFunction) Node() *algebra.DropFunction {
+	return this.node
+}
+
+func (this *DropFunction) MarshalJSON() ([]byte, error) {
+	r := map[string]interface{}{"#operator": "DropFunction"}
+	r["name"] = this.node.Name()
+	return json.Marshal(r)
+}
+
+func (this *DropFunction) UnmarshalJSON(body []byte) error {
+	var _unmarshalled struct {
+		_    string `json:"#operator"`
+		Name string `json:"name"`
+	}
+
+	err := json.Unmarshal(body, &_unmarshalled)
+	if err != nil {
+		return err
+	}
+
+	this.node = algebra.NewDropFunction(_unmarshalled.Name)
+	return nil
+}
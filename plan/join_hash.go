@@ -0,0 +1,162 @@
+//  Copyright (c) 2016 Couchbase, Inc.
+//  Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file
+//  except in compliance with the License. You may obtain a copy of the License at
+//    http://www.apache.org/licenses/LICENSE-2.0
+//  Unless required by applicable law or agreed to in writing, software distributed under the
+//  License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+//  either express or implied. See the License for the specific language governing permissions
+//  and limitations under the License.
+
+package plan
+
+import (
+	"encoding/json"
+
+	"github.com/couchbase/query/algebra"
+	"github.com/couchbase/query/expression"
+	"github.com/couchbase/query/expression/parser"
+)
+
+// Hash join for ANSI joins with equality predicates. The child
+// produces the right source, which is collected into a hash table on
+// the build expressions. Each left item is then probed against the
+// hash table on the probe expressions, and the full ON clause is
+// evaluated on the matches. Serial.
+type HashJoin struct {
+	readonly
+	onclause   expression.Expression
+	buildExprs expression.Expressions
+	probeExprs expression.Expressions
+	alias      string
+	outer      bool
+	rightOuter bool
+	child      Operator
+}
+
+func NewHashJoin(join *algebra.AnsiJoin, buildExprs, probeExprs expression.Expressions,
+	child Operator) *HashJoin {
+	return &HashJoin{
+		onclause:   join.Onclause(),
+		buildExprs: buildExprs,
+		probeExprs: probeExprs,
+		alias:      join.Alias(),
+		outer:      join.Outer(),
+		rightOuter: join.RightOuter(),
+		child:      child,
+	}
+}
+
+func (this *HashJoin) Accept(visitor Visitor) (interface{}, error) {
+	return visitor.VisitHashJoin(this)
+}
+
+func (this *HashJoin) New() Operator {
+	return &HashJoin{}
+}
+
+func (this *HashJoin) Onclause() expression.Expression {
+	return this.onclause
+}
+
+func (this *HashJoin) BuildExprs() expression.Expressions {
+	return this.buildExprs
+}
+
+func (this *HashJoin) ProbeExprs() expression.Expressions {
+	return this.probeExprs
+}
+
+func (this *HashJoin) Alias() string {
+	return this.alias
+}
+
+func (this *HashJoin) Outer() bool {
+	return this.outer
+}
+
+func (this *HashJoin) RightOuter() bool {
+	return this.rightOuter
+}
+
+func (this *HashJoin) Child() Operator {
+	return this.child
+}
+
+func (this *HashJoin) MarshalJSON() ([]byte, error) {
+	r := map[string]interface{}{"#operator": "HashJoin"}
+	r["on_clause"] = expression.NewStringer().Visit(this.onclause)
+	r["build_exprs"] = marshalExprs(this.buildExprs)
+	r["probe_exprs"] = marshalExprs(this.probeExprs)
+	r["alias"] = this.alias
+	if this.outer {
+		r["outer"] = this.outer
+	}
+	if this.rightOuter {
+		r["right_outer"] = this.rightOuter
+	}
+	r["child"] = this.child
+	if this.duration != 0 {
+		r["#time"] = this.duration.String()
+	}
+	return json.Marshal(r)
+}
+
+func (this *HashJoin) UnmarshalJSON(body []byte) error {
+	var _unmarshalled struct {
+		_          string          `json:"#operator"`
+		Onclause   string          `json:"on_clause"`
+		BuildExprs []string        `json:"build_exprs"`
+		ProbeExprs []string        `json:"probe_exprs"`
+		Alias      string          `json:"alias"`
+		Outer      bool            `json:"outer"`
+		RightOuter bool            `json:"right_outer"`
+		Child      json.RawMessage `json:"child"`
+	}
+
+	err := json.Unmarshal(body, &_unmarshalled)
+	if err != nil {
+		return err
+	}
+
+	this.alias = _unmarshalled.Alias
+	this.outer = _unmarshalled.Outer
+	this.rightOuter = _unmarshalled.RightOuter
+
+	this.onclause, err = parser.Parse(_unmarshalled.Onclause)
+	if err != nil {
+		return err
+	}
+
+	this.buildExprs, err = unmarshalExprs(_unmarshalled.BuildExprs)
+	if err != nil {
+		return err
+	}
+
+	this.probeExprs, err = unmarshalExprs(_unmarshalled.ProbeExprs)
+	if err != nil {
+		return err
+	}
+
+	this.child, err = unmarshalOperator(_unmarshalled.Child)
+	return err
+}
+
+func marshalExprs(exprs expression.Expressions) []string {
+	s := make([]string, len(exprs))
+	for i, expr := range exprs {
+		s[i] = expression.NewStringer().Visit(expr)
+	}
+	return s
+}
+
+func unmarshalExprs(s []string) (expression.Expressions, error) {
+	exprs := make(expression.Expressions, len(s))
+	for i, expr := range s {
+		var err error
+		exprs[i], err = parser.Parse(expr)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return exprs, nil
+}
@@ -0,0 +1,114 @@
+//  Copyright (c) 2016 Couchbase, Inc.
+//  Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file
+//  except in compliance with the License. You may obtain a copy of the License at
+//    http://www.apache.org/licenses/LICENSE-2.0
+//  Unless required by applicable law or agreed to in writing, software distributed under the
+//  License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+//  either express or implied. See the License for the specific language governing permissions
+//  and limitations under the License.
+
+package plan
+
+import (
+	"encoding/json"
+
+	"github.com/couchbase/query/algebra"
+	"github.com/couchbase/query/expression"
+	"github.com/couchbase/query/expression/parser"
+)
+
+// Nested-loop join for ANSI joins without equality predicates. The
+// child produces the right source, which is collected once, and the
+// ON clause is evaluated for each pair of left and right items.
+// Serial.
+type NLJoin struct {
+	readonly
+	onclause   expression.Expression
+	alias      string
+	outer      bool
+	rightOuter bool
+	child      Operator
+}
+
+func NewNLJoin(join *algebra.AnsiJoin, child Operator) *NLJoin {
+	return &NLJoin{
+		onclause:   join.Onclause(),
+		alias:      join.Alias(),
+		outer:      join.Outer(),
+		rightOuter: join.RightOuter(),
+		child:      child,
+	}
+}
+
+func (this *NLJoin) Accept(visitor Visitor) (interface{}, error) {
+	return visitor.VisitNLJoin(this)
+}
+
+func (this *NLJoin) New() Operator {
+	return &NLJoin{}
+}
+
+func (this *NLJoin) Onclause() expression.Expression {
+	return this.onclause
+}
+
+func (this *NLJoin) Alias() string {
+	return this.alias
+}
+
+func (this *NLJoin) Outer() bool {
+	return this.outer
+}
+
+func (this *NLJoin) RightOuter() bool {
+	return this.rightOuter
+}
+
+func (this *NLJoin) Child() Operator {
+	return this.child
+}
+
+func (this *NLJoin) MarshalJSON() ([]byte, error) {
+	r := map[string]interface{}{"#operator": "NestedLoopJoin"}
+	r["on_clause"] = expression.NewStringer().Visit(this.onclause)
+	r["alias"] = this.alias
+	if this.outer {
+		r["outer"] = this.outer
+	}
+	if this.rightOuter {
+		r["right_outer"] = this.rightOuter
+	}
+	r["child"] = this.child
+	if this.duration != 0 {
+		r["#time"] = this.duration.String()
+	}
+	return json.Marshal(r)
+}
+
+func (this *NLJoin) UnmarshalJSON(body []byte) error {
+	var _unmarshalled struct {
+		_          string          `json:"#operator"`
+		Onclause   string          `json:"on_clause"`
+		Alias      string          `json:"alias"`
+		Outer      bool            `json:"outer"`
+		RightOuter bool            `json:"right_outer"`
+		Child      json.RawMessage `json:"child"`
+	}
+
+	err := json.Unmarshal(body, &_unmarshalled)
+	if err != nil {
+		return err
+	}
+
+	this.alias = _unmarshalled.Alias
+	this.outer = _unmarshalled.Outer
+	this.rightOuter = _unmarshalled.RightOuter
+
+	this.onclause, err = parser.Parse(_unmarshalled.Onclause)
+	if err != nil {
+		return err
+	}
+
+	this.child, err = unmarshalOperator(_unmarshalled.Child)
+	return err
+}
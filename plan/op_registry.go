@@ -50,17 +50,20 @@ var _OPERATORS = map[string]Operator{
 	"IndexCountScan": &IndexCountScan{},
 	"IntersectScan":  &IntersectScan{},
 	"UnionScan":      &UnionScan{},
+	"ExpressionScan": &ExpressionScan{},
 
 	// Fetch
 	"Fetch":      &Fetch{},
 	"DummyFetch": &DummyFetch{},
 
 	// Join
-	"Join":      &Join{},
-	"IndexJoin": &IndexJoin{},
-	"Nest":      &Nest{},
-	"IndexNest": &IndexNest{},
-	"Unnest":    &Unnest{},
+	"Join":           &Join{},
+	"IndexJoin":      &IndexJoin{},
+	"Nest":           &Nest{},
+	"IndexNest":      &IndexNest{},
+	"Unnest":         &Unnest{},
+	"HashJoin":       &HashJoin{},
+	"NestedLoopJoin": &NLJoin{},
 
 	// Let + Letting
 	"Let": &Let{},
@@ -73,6 +76,9 @@ var _OPERATORS = map[string]Operator{
 	"IntermediateGroup": &IntermediateGroup{},
 	"FinalGroup":        &FinalGroup{},
 
+	// Window
+	"Window": &Window{},
+
 	// Project
 	"InitialProject":    &InitialProject{},
 	"FinalProject":      &FinalProject{},
@@ -114,6 +120,7 @@ var _OPERATORS = map[string]Operator{
 	// Framework
 	"Alias":     &Alias{},
 	"Authorize": &Authorize{},
+	"With":      &With{},
 	"Parallel":  &Parallel{},
 	"Sequence":  &Sequence{},
 	"Discard":   &Discard{},
@@ -127,6 +134,22 @@ var _OPERATORS = map[string]Operator{
 	"AlterIndex":         &AlterIndex{},
 	"BuildIndexes":       &BuildIndexes{},
 
+	// Analyze
+	"AnalyzeKeyspace": &AnalyzeKeyspace{},
+
+	// Function DDL
+	"CreateFunction": &CreateFunction{},
+	"DropFunction":   &DropFunction{},
+
+	// Transactions
+	"StartTransaction":    &StartTransaction{},
+	"CommitTransaction":   &CommitTransaction{},
+	"RollbackTransaction": &RollbackTransaction{},
+
+	// Roles
+	"GrantRole":  &GrantRole{},
+	"RevokeRole": &RevokeRole{},
+
 	// Explain
 	"Explain": &Explain{},
 
@@ -0,0 +1,213 @@
+//  Copyright (c) 2016 Couchbase, Inc.
+//  Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file
+//  except in compliance with the License. You may obtain a copy of the License at
+//    http://www.apache.org/licenses/LICENSE-2.0
+//  Unless required by applicable law or agreed to in writing, software distributed under the
+//  License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+//  either express or implied. See the License for the specific language governing permissions
+//  and limitations under the License.
+
+package plan
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"time"
+	"unicode"
+
+	atomic "github.com/couchbase/go-couchbase/platform"
+	"github.com/couchbase/query/datastore"
+	"github.com/couchbase/query/util"
+)
+
+// Cache of the plans of ad-hoc statements, so that statements issued
+// repeatedly are parsed and planned only once. Entries are keyed by
+// namespace and statement text, with whitespace outside quotes
+// collapsed, and are only used while the index metadata is the one
+// the plan was built against: neither this node, nor the metadata of
+// the indexers the plan was chosen from, have changed any index.
+type PlanCacheEntry struct {
+	Statement string
+	Namespace string
+	Prepared  *Prepared
+	Version   uint64 // Index metadata version of this node at planning time
+	Added     time.Time
+	LastUse   time.Time
+	Uses      int32
+}
+
+var planCache struct {
+	cache *util.GenCache
+}
+
+const _PLAN_CACHE_LIMIT = 4096
+
+func init() {
+	planCache.cache = util.NewGenCache(_PLAN_CACHE_LIMIT)
+}
+
+func PlanCacheLimit() int {
+	return planCache.cache.Limit()
+}
+
+// Set the maximum number of cached plans; zero or less disables the cache
+func PlanCacheSetLimit(limit int) {
+	if limit <= 0 {
+		ClearPlanCache()
+	}
+	planCache.cache.SetLimit(limit)
+}
+
+// Returns whether the indexes have changed since the plan was built.
+func (this *PlanCacheEntry) Stale() bool {
+	return this.Version != datastore.IndexVersion() || this.Prepared.IndexerVersions().Changed()
+}
+
+// Returns the cached plan of the statement, if any, and if built
+// against the given index metadata version of this node, and the
+// current metadata of its indexers.
+func GetCachedPlan(statement, namespace string, version uint64) *Prepared {
+	if planCache.cache.Limit() <= 0 {
+		return nil
+	}
+
+	statement = normalizeStatement(statement)
+	id := planCacheId(statement, namespace)
+
+	var rv *Prepared
+	stale := false
+	planCache.cache.Get(id, func(e interface{}) {
+		entry := e.(*PlanCacheEntry)
+		if entry.Statement != statement || entry.Namespace != namespace {
+			return
+		}
+
+		if entry.Version != version {
+			stale = true
+			return
+		}
+
+		rv = entry.Prepared
+		entry.LastUse = time.Now()
+		atomic.AddInt32(&entry.Uses, 1)
+	})
+
+	// The indexers are checked outside the cache lock
+	if rv != nil && rv.IndexerVersions().Changed() {
+		rv = nil
+		stale = true
+	}
+
+	if stale {
+		planCache.cache.Delete(id, nil)
+	}
+
+	return rv
+}
+
+// Removes the cached plan of the statement, if it is the given plan,
+// as when one of its indexes is no longer found.
+func EvictCachedPlan(statement, namespace string, prepared *Prepared) {
+	statement = normalizeStatement(statement)
+	id := planCacheId(statement, namespace)
+
+	evict := false
+	planCache.cache.Get(id, func(e interface{}) {
+		evict = e.(*PlanCacheEntry).Prepared == prepared
+	})
+
+	if evict {
+		planCache.cache.Delete(id, nil)
+	}
+}
+
+func AddCachedPlan(statement, namespace string, version uint64, prepared *Prepared) {
+	if planCache.cache.Limit() <= 0 {
+		return
+	}
+
+	statement = normalizeStatement(statement)
+	now := time.Now()
+	planCache.cache.Add(&PlanCacheEntry{
+		Statement: statement,
+		Namespace: namespace,
+		Prepared:  prepared,
+		Version:   version,
+		Added:     now,
+		LastUse:   now,
+	}, planCacheId(statement, namespace))
+}
+
+func CachedPlanDo(id string, f func(*PlanCacheEntry)) {
+	planCache.cache.Get(id, func(e interface{}) {
+		f(e.(*PlanCacheEntry))
+	})
+}
+
+func DeleteCachedPlan(id string) bool {
+	return planCache.cache.Delete(id, nil)
+}
+
+func ClearPlanCache() {
+	for _, id := range planCache.cache.Names() {
+		if id != "" {
+			planCache.cache.Delete(id, nil)
+		}
+	}
+}
+
+func CountCachedPlans() int {
+	return planCache.cache.Size()
+}
+
+func CachedPlansForEach(f func(string, *PlanCacheEntry)) {
+	planCache.cache.ForEach(func(id string, e interface{}) {
+		f(id, e.(*PlanCacheEntry))
+	})
+}
+
+// Cache ids are hashes, to keep them short; entries are checked
+// against the statement and namespace on lookup.
+func planCacheId(statement, namespace string) string {
+	var h uint64 = 14695981039346656037
+	for _, c := range []byte(namespace + ":" + statement) {
+		h ^= uint64(c)
+		h *= 1099511628211
+	}
+	return fmt.Sprintf("%016x", h)
+}
+
+// Collapses runs of whitespace outside quotes into single spaces, so
+// that statements differing only in layout share a plan.
+func normalizeStatement(statement string) string {
+	var buf bytes.Buffer
+	var quote rune
+	escaped, space := false, false
+
+	for _, r := range strings.TrimSpace(statement) {
+		switch {
+		case escaped:
+			escaped = false
+		case quote != 0:
+			if r == '\\' {
+				escaped = true
+			} else if r == quote {
+				quote = 0
+			}
+		case r == '"' || r == '\'' || r == '`':
+			quote = r
+		case unicode.IsSpace(r):
+			space = true
+			continue
+		}
+
+		if space {
+			buf.WriteByte(' ')
+			space = false
+		}
+		buf.WriteRune(r)
+	}
+
+	return buf.String()
+}
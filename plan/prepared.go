@@ -20,6 +20,7 @@ import (
 	"time"
 
 	atomic "github.com/couchbase/go-couchbase/platform"
+	"github.com/couchbase/query/datastore"
 	"github.com/couchbase/query/errors"
 	"github.com/couchbase/query/value"
 )
@@ -30,6 +31,8 @@ type Prepared struct {
 	name         string
 	encoded_plan string
 	text         string
+	stmtType     string
+	indexers     datastore.IndexerVersions
 }
 
 func NewPrepared(operator Operator, signature value.Value) *Prepared {
@@ -102,6 +105,24 @@ func (this *Prepared) SetText(text string) {
 	this.text = text
 }
 
+// The type of the statement, as used to filter the audit trail
+func (this *Prepared) StatementType() string {
+	return this.stmtType
+}
+
+func (this *Prepared) SetStatementType(stmtType string) {
+	this.stmtType = stmtType
+}
+
+// The versions of the indexers the plan was chosen from
+func (this *Prepared) IndexerVersions() datastore.IndexerVersions {
+	return this.indexers
+}
+
+func (this *Prepared) SetIndexerVersions(indexers datastore.IndexerVersions) {
+	this.indexers = indexers
+}
+
 func (this *Prepared) EncodedPlan() string {
 	return this.encoded_plan
 }
@@ -0,0 +1,87 @@
+//  Copyright (c) 2016 Couchbase, Inc.
+//  Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file
+//  except in compliance with the License. You may obtain a copy of the License at
+//    http://www.apache.org/licenses/LICENSE-2.0
+//  Unless required by applicable law or agreed to in writing, software distributed under the
+//  License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+//  either express or implied. See the License for the specific language governing permissions
+//  and limitations under the License.
+
+package plan
+
+import (
+	"encoding/json"
+
+	"github.com/couchbase/query/algebra"
+)
+
+// Grant roles
+type GrantRole struct {
+	readwrite
+	node *algebra.GrantRole
+}
+
+func NewGrantRole(node *algebra.GrantRole) *GrantRole {
+	return &GrantRole{
+		node: node,
+	}
+}
+
+func (this *GrantRole) Accept(visitor Visitor) (interface{}, error) {
+	return visitor.VisitGrantRole(this)
+}
+
+func (this *GrantRole) New() Operator {
+	return &GrantRole{}
+}
+
+func (this *GrantRole) Node() *algebra.GrantRole {
+	return this.node
+}
+
+func (this *GrantRole) MarshalJSON() ([]byte, error) {
+	r := map[string]interface{}{"#operator": "GrantRole"}
+	r["roles"] = this.node.Roles()
+	if len(this.node.Keyspaces()) > 0 {
+		r["keyspaces"] = this.node.Keyspaces()
+	}
+	r["users"] = this.node.Users()
+	return json.Marshal(r)
+}
+
+func (this *GrantRole) UnmarshalJSON(body []byte) error {
+	var _unmarshalled struct {
+		_         string         `json:"#operator"`
+		Roles     []string       `json:"roles"`
+		Keyspaces []roleKeyspace `json:"keyspaces"`
+		Users     []string       `json:"users"`
+	}
+
+	err := json.Unmarshal(body, &_unmarshalled)
+	if err != nil {
+		return err
+	}
+
+	this.node = algebra.NewGrantRole(_unmarshalled.Roles,
+		newRoleKeyspaces(_unmarshalled.Keyspaces), _unmarshalled.Users)
+	return nil
+}
+
+// The keyspace of a role, as marshalled by algebra.KeyspaceRef
+type roleKeyspace struct {
+	Namespace string `json:"namespace"`
+	Keyspace  string `json:"keyspace"`
+}
+
+func newRoleKeyspaces(keyspaces []roleKeyspace) []*algebra.KeyspaceRef {
+	if len(keyspaces) == 0 {
+		return nil
+	}
+
+	rv := make([]*algebra.KeyspaceRef, len(keyspaces))
+	for i, ks := range keyspaces {
+		rv[i] = algebra.NewKeyspaceRef(ks.Namespace, ks.Keyspace, "")
+	}
+
+	return rv
+}
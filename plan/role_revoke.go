@@ -0,0 +1,68 @@
+//  Copyright (c) 2016 Couchbase, Inc.
+//  Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file
+//  except in compliance with the License. You may obtain a copy of the License at
+//    http://www.apache.org/licenses/LICENSE-2.0
+//  Unless required by applicable law or agreed to in writing, software distributed under the
+//  License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+//  either express or implied. See the License for the specific language governing permissions
+//  and limitations under the License.
+
+package plan
+
+import (
+	"encoding/json"
+
+	"github.com/couchbase/query/algebra"
+)
+
+// Revoke roles
+type RevokeRole struct {
+	readwrite
+	node *algebra.RevokeRole
+}
+
+func NewRevokeRole(node *algebra.RevokeRole) *RevokeRole {
+	return &RevokeRole{
+		node: node,
+	}
+}
+
+func (this *RevokeRole) Accept(visitor Visitor) (interface{}, error) {
+	return visitor.VisitRevokeRole(this)
+}
+
+func (this *RevokeRole) New() Operator {
+	return &RevokeRole{}
+}
+
+func (this *RevokeRole) Node() *algebra.RevokeRole {
+	return this.node
+}
+
+func (this *RevokeRole) MarshalJSON() ([]byte, error) {
+	r := map[string]interface{}{"#operator": "RevokeRole"}
+	r["roles"] = this.node.Roles()
+	if len(this.node.Keyspaces()) > 0 {
+		r["keyspaces"] = this.node.Keyspaces()
+	}
+	r["users"] = this.node.Users()
+	return json.Marshal(r)
+}
+
+func (this *RevokeRole) UnmarshalJSON(body []byte) error {
+	var _unmarshalled struct {
+		_         string         `json:"#operator"`
+		Roles     []string       `json:"roles"`
+		Keyspaces []roleKeyspace `json:"keyspaces"`
+		Users     []string       `json:"users"`
+	}
+
+	err := json.Unmarshal(body, &_unmarshalled)
+	if err != nil {
+		return err
+	}
+
+	this.node = algebra.NewRevokeRole(_unmarshalled.Roles,
+		newRoleKeyspaces(_unmarshalled.Keyspaces), _unmarshalled.Users)
+	return nil
+}
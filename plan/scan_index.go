@@ -21,6 +21,7 @@ import (
 
 type IndexScan struct {
 	readonly
+	estimates
 	index        datastore.Index
 	term         *algebra.KeyspaceTerm
 	spans        Spans
@@ -114,6 +115,8 @@ func (this *IndexScan) MarshalJSON() ([]byte, error) {
 		r["filter_covers"] = fc
 	}
 
+	this.marshalEstimates(r)
+
 	if this.duration != 0 {
 		r["#time"] = this.duration.String()
 	}
@@ -134,6 +137,8 @@ func (this *IndexScan) UnmarshalJSON(body []byte) error {
 		Limit        string                 `json:"limit"`
 		Covers       []string               `json:"covers"`
 		FilterCovers map[string]value.Value `json:"filter_covers"`
+		Cost         float64                `json:"cost"`
+		Cardinality  float64                `json:"cardinality"`
 	}
 
 	err := json.Unmarshal(body, &_unmarshalled)
@@ -152,6 +157,7 @@ func (this *IndexScan) UnmarshalJSON(body []byte) error {
 
 	this.spans = _unmarshalled.Spans
 	this.distinct = _unmarshalled.Distinct
+	this.SetEstimates(_unmarshalled.Cost, _unmarshalled.Cardinality)
 
 	if _unmarshalled.Limit != "" {
 		this.limit, err = parser.Parse(_unmarshalled.Limit)
@@ -16,6 +16,7 @@ import (
 // IntersectScan scans multiple indexes and intersects the results.
 type IntersectScan struct {
 	readonly
+	estimates
 	scans []Operator
 }
 
@@ -40,6 +41,7 @@ func (this *IntersectScan) Scans() []Operator {
 func (this *IntersectScan) MarshalJSON() ([]byte, error) {
 	r := map[string]interface{}{"#operator": "IntersectScan"}
 	r["scans"] = this.scans
+	this.marshalEstimates(r)
 	return json.Marshal(r)
 }
 
@@ -47,6 +49,8 @@ func (this *IntersectScan) UnmarshalJSON(body []byte) error {
 	var _unmarshalled struct {
 		_     string            `json:"#operator"`
 		Scans []json.RawMessage `json:"scans"`
+		Cost  float64           `json:"cost"`
+		Card  float64           `json:"cardinality"`
 	}
 
 	err := json.Unmarshal(body, &_unmarshalled)
@@ -54,6 +58,7 @@ func (this *IntersectScan) UnmarshalJSON(body []byte) error {
 		return err
 	}
 
+	this.SetEstimates(_unmarshalled.Cost, _unmarshalled.Card)
 	this.scans = make([]Operator, 0, len(_unmarshalled.Scans))
 
 	for _, raw_scan := range _unmarshalled.Scans {
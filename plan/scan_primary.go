@@ -21,6 +21,7 @@ import (
 
 type PrimaryScan struct {
 	readonly
+	estimates
 	index    datastore.PrimaryIndex
 	keyspace datastore.Keyspace
 	term     *algebra.KeyspaceTerm
@@ -71,6 +72,9 @@ func (this *PrimaryScan) MarshalJSON() ([]byte, error) {
 	if this.limit != nil {
 		r["limit"] = expression.NewStringer().Visit(this.limit)
 	}
+
+	this.marshalEstimates(r)
+
 	if this.duration != 0 {
 		r["#time"] = this.duration.String()
 	}
@@ -86,6 +90,8 @@ func (this *PrimaryScan) UnmarshalJSON(body []byte) error {
 		Keys  string              `json:"keyspace"`
 		Using datastore.IndexType `json:"using"`
 		Limit string              `json:"limit"`
+		Cost  float64             `json:"cost"`
+		Card  float64             `json:"cardinality"`
 	}
 
 	err := json.Unmarshal(body, &_unmarshalled)
@@ -93,6 +99,8 @@ func (this *PrimaryScan) UnmarshalJSON(body []byte) error {
 		return err
 	}
 
+	this.SetEstimates(_unmarshalled.Cost, _unmarshalled.Card)
+
 	if _unmarshalled.Limit != "" {
 		this.limit, err = parser.Parse(_unmarshalled.Limit)
 		if err != nil {
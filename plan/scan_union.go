@@ -16,6 +16,7 @@ import (
 // UnionScan scans multiple indexes and unions the results.
 type UnionScan struct {
 	readonly
+	estimates
 	scans []Operator
 }
 
@@ -40,6 +41,7 @@ func (this *UnionScan) Scans() []Operator {
 func (this *UnionScan) MarshalJSON() ([]byte, error) {
 	r := map[string]interface{}{"#operator": "UnionScan"}
 	r["scans"] = this.scans
+	this.marshalEstimates(r)
 	return json.Marshal(r)
 }
 
@@ -47,12 +49,15 @@ func (this *UnionScan) UnmarshalJSON(body []byte) error {
 	var _unmarshalled struct {
 		_     string            `json:"#operator"`
 		Scans []json.RawMessage `json:"scans"`
+		Cost  float64           `json:"cost"`
+		Card  float64           `json:"cardinality"`
 	}
 	err := json.Unmarshal(body, &_unmarshalled)
 	if err != nil {
 		return err
 	}
 
+	this.SetEstimates(_unmarshalled.Cost, _unmarshalled.Card)
 	this.scans = make([]Operator, 0, len(_unmarshalled.Scans))
 
 	for _, raw_scan := range _unmarshalled.Scans {
@@ -0,0 +1,41 @@
+//  Copyright (c) 2016 Couchbase, Inc.
+//  Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file
+//  except in compliance with the License. You may obtain a copy of the License at
+//    http://www.apache.org/licenses/LICENSE-2.0
+//  Unless required by applicable law or agreed to in writing, software distributed under the
+//  License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+//  either express or implied. See the License for the specific language governing permissions
+//  and limitations under the License.
+
+package plan
+
+import (
+	"encoding/json"
+)
+
+// Commit transaction
+type CommitTransaction struct {
+	readwrite
+}
+
+func NewCommitTransaction() *CommitTransaction {
+	return &CommitTransaction{}
+}
+
+func (this *CommitTransaction) Accept(visitor Visitor) (interface{}, error) {
+	return visitor.VisitCommitTransaction(this)
+}
+
+func (this *CommitTransaction) New() Operator {
+	return &CommitTransaction{}
+}
+
+func (this *CommitTransaction) MarshalJSON() ([]byte, error) {
+	r := map[string]interface{}{"#operator": "CommitTransaction"}
+	return json.Marshal(r)
+}
+
+func (this *CommitTransaction) UnmarshalJSON([]byte) error {
+	// NOP: CommitTransaction has no data structure
+	return nil
+}
@@ -0,0 +1,41 @@
+//  Copyright (c) 2016 Couchbase, Inc.
+//  Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file
+//  except in compliance with the License. You may obtain a copy of the License at
+//    http://www.apache.org/licenses/LICENSE-2.0
+//  Unless required by applicable law or agreed to in writing, software distributed under the
+//  License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+//  either express or implied. See the License for the specific language governing permissions
+//  and limitations under the License.
+
+package plan
+
+import (
+	"encoding/json"
+)
+
+// Start transaction
+type StartTransaction struct {
+	readonly
+}
+
+func NewStartTransaction() *StartTransaction {
+	return &StartTransaction{}
+}
+
+func (this *StartTransaction) Accept(visitor Visitor) (interface{}, error) {
+	return visitor.VisitStartTransaction(this)
+}
+
+func (this *StartTransaction) New() Operator {
+	return &StartTransaction{}
+}
+
+func (this *StartTransaction) MarshalJSON() ([]byte, error) {
+	r := map[string]interface{}{"#operator": "StartTransaction"}
+	return json.Marshal(r)
+}
+
+func (this *StartTransaction) UnmarshalJSON([]byte) error {
+	// NOP: StartTransaction has no data structure
+	return nil
+}
@@ -23,14 +23,17 @@ type SendUpsert struct {
 	alias    string
 	key      expression.Expression
 	value    expression.Expression
+	fetchOld bool
 }
 
-func NewSendUpsert(keyspace datastore.Keyspace, alias string, key, value expression.Expression) *SendUpsert {
+func NewSendUpsert(keyspace datastore.Keyspace, alias string, key, value expression.Expression,
+	fetchOld bool) *SendUpsert {
 	return &SendUpsert{
 		keyspace: keyspace,
 		alias:    alias,
 		key:      key,
 		value:    value,
+		fetchOld: fetchOld,
 	}
 }
 
@@ -58,6 +61,11 @@ func (this *SendUpsert) Value() expression.Expression {
 	return this.value
 }
 
+// Fetch the documents being replaced, for RETURNING OLD
+func (this *SendUpsert) FetchOld() bool {
+	return this.fetchOld
+}
+
 func (this *SendUpsert) MarshalJSON() ([]byte, error) {
 	r := map[string]interface{}{"#operator": "SendUpsert"}
 	r["keyspace"] = this.keyspace.Name()
@@ -71,6 +79,11 @@ func (this *SendUpsert) MarshalJSON() ([]byte, error) {
 	if this.value != nil {
 		r["value"] = this.value.String()
 	}
+
+	if this.fetchOld {
+		r["fetch_old"] = this.fetchOld
+	}
+
 	if this.duration != 0 {
 		r["#time"] = this.duration.String()
 	}
@@ -86,6 +99,7 @@ func (this *SendUpsert) UnmarshalJSON(body []byte) error {
 		Keys      string `json:"keyspace"`
 		Names     string `json:"namespace"`
 		Alias     string `json:"alias"`
+		FetchOld  bool   `json:"fetch_old"`
 	}
 
 	err := json.Unmarshal(body, &_unmarshalled)
@@ -108,6 +122,7 @@ func (this *SendUpsert) UnmarshalJSON(body []byte) error {
 	}
 
 	this.alias = _unmarshalled.Alias
+	this.fetchOld = _unmarshalled.FetchOld
 	this.keyspace, err = datastore.GetKeyspace(_unmarshalled.Names, _unmarshalled.Keys)
 	return nil
 }
@@ -0,0 +1,173 @@
+//  Copyright (c) 2016 Couchbase, Inc.
+//  Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file
+//  except in compliance with the License. You may obtain a copy of the License at
+//    http://www.apache.org/licenses/LICENSE-2.0
+//  Unless required by applicable law or agreed to in writing, software distributed under the
+//  License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+//  either express or implied. See the License for the specific language governing permissions
+//  and limitations under the License.
+
+package plan
+
+import (
+	"encoding/json"
+)
+
+// Evaluation of the common table expressions of a WITH clause,
+// before the child operator.
+type With struct {
+	readonly
+	bindings []*WithBinding
+	child    Operator
+}
+
+func NewWith(bindings []*WithBinding, child Operator) *With {
+	return &With{
+		bindings: bindings,
+		child:    child,
+	}
+}
+
+func (this *With) Accept(visitor Visitor) (interface{}, error) {
+	return visitor.VisitWith(this)
+}
+
+func (this *With) New() Operator {
+	return &With{}
+}
+
+func (this *With) Bindings() []*WithBinding {
+	return this.bindings
+}
+
+func (this *With) Readonly() bool {
+	if !this.child.Readonly() {
+		return false
+	}
+
+	for _, b := range this.bindings {
+		if !b.query.Readonly() || (b.step != nil && !b.step.Readonly()) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (this *With) Child() Operator {
+	return this.child
+}
+
+func (this *With) MarshalJSON() ([]byte, error) {
+	r := map[string]interface{}{"#operator": "With"}
+	r["bindings"] = this.bindings
+	r["child"] = this.child
+	if this.duration != 0 {
+		r["#time"] = this.duration.String()
+	}
+	return json.Marshal(r)
+}
+
+func (this *With) UnmarshalJSON(body []byte) error {
+	var _unmarshalled struct {
+		_        string          `json:"#operator"`
+		Bindings []*WithBinding  `json:"bindings"`
+		Child    json.RawMessage `json:"child"`
+	}
+
+	err := json.Unmarshal(body, &_unmarshalled)
+	if err != nil {
+		return err
+	}
+
+	this.bindings = _unmarshalled.Bindings
+	this.child, err = unmarshalOperator(_unmarshalled.Child)
+	return err
+}
+
+// A common table expression. The query of a recursive common table
+// expression is its anchor, and the step is evaluated repeatedly
+// until it returns no new results.
+type WithBinding struct {
+	alias    string
+	query    Operator
+	step     Operator
+	distinct bool
+}
+
+func NewWithBinding(alias string, query, step Operator, distinct bool) *WithBinding {
+	return &WithBinding{
+		alias:    alias,
+		query:    query,
+		step:     step,
+		distinct: distinct,
+	}
+}
+
+func (this *WithBinding) Alias() string {
+	return this.alias
+}
+
+func (this *WithBinding) Query() Operator {
+	return this.query
+}
+
+func (this *WithBinding) Step() Operator {
+	return this.step
+}
+
+func (this *WithBinding) Recursive() bool {
+	return this.step != nil
+}
+
+func (this *WithBinding) Distinct() bool {
+	return this.distinct
+}
+
+func (this *WithBinding) MarshalJSON() ([]byte, error) {
+	r := map[string]interface{}{"alias": this.alias}
+	r["query"] = this.query
+	if this.step != nil {
+		r["step"] = this.step
+		r["distinct"] = this.distinct
+	}
+	return json.Marshal(r)
+}
+
+func (this *WithBinding) UnmarshalJSON(body []byte) error {
+	var _unmarshalled struct {
+		Alias    string          `json:"alias"`
+		Query    json.RawMessage `json:"query"`
+		Step     json.RawMessage `json:"step"`
+		Distinct bool            `json:"distinct"`
+	}
+
+	err := json.Unmarshal(body, &_unmarshalled)
+	if err != nil {
+		return err
+	}
+
+	this.alias = _unmarshalled.Alias
+	this.distinct = _unmarshalled.Distinct
+
+	this.query, err = unmarshalOperator(_unmarshalled.Query)
+	if err != nil || len(_unmarshalled.Step) == 0 {
+		return err
+	}
+
+	this.step, err = unmarshalOperator(_unmarshalled.Step)
+	return err
+}
+
+func unmarshalOperator(body json.RawMessage) (Operator, error) {
+	var op_type struct {
+		Operator string `json:"#operator"`
+	}
+
+	err := json.Unmarshal(body, &op_type)
+	if err != nil {
+		return nil, err
+	}
+
+	return MakeOperator(op_type.Operator, body)
+}
@@ -21,6 +21,21 @@ import (
 func Build(stmt algebra.Statement, datastore, systemstore datastore.Datastore,
 	namespace string, subquery bool) (plan.Operator, error) {
 	builder := newBuilder(datastore, systemstore, namespace, subquery)
+	return build(stmt, builder, subquery)
+}
+
+// Builds the plan of a statement run within a transaction. Indexes
+// only see committed documents, so index scans are not covering, and
+// their documents are fetched and filtered, where the transaction's
+// writes are seen.
+func BuildTransaction(stmt algebra.Statement, datastore, systemstore datastore.Datastore,
+	namespace string, subquery bool) (plan.Operator, error) {
+	builder := newBuilder(datastore, systemstore, namespace, subquery)
+	builder.transactional = true
+	return build(stmt, builder, subquery)
+}
+
+func build(stmt algebra.Statement, builder *builder, subquery bool) (plan.Operator, error) {
 	o, err := stmt.Accept(builder)
 
 	if err != nil {
@@ -51,6 +66,8 @@ type builder struct {
 	systemstore     datastore.Datastore
 	namespace       string
 	subquery        bool
+	transactional   bool                      // Within a transaction, fetch and filter all scanned documents
+	indexers        datastore.IndexerVersions // Indexers whose indexes were considered; nil if not recorded
 	correlated      bool
 	maxParallelism  int
 	delayProjection bool                  // Used to allow ORDER BY non-projected expressions
@@ -15,16 +15,24 @@ import (
 )
 
 func (this *builder) VisitDelete(stmt *algebra.Delete) (interface{}, error) {
-	this.cover = stmt
 	this.where = stmt.Where()
 
+	// RETURNING OLD needs the deleted documents, so neither covering
+	// scans nor skipping the fetch are possible
+	fetchOld := stmt.Returning() != nil && stmt.Returning().ReferencesImage(algebra.RETURNING_OLD)
+	if fetchOld {
+		this.cover = nil
+	} else {
+		this.cover = stmt
+	}
+
 	ksref := stmt.KeyspaceRef()
 	keyspace, err := this.getNameKeyspace(ksref.Namespace(), ksref.Keyspace())
 	if err != nil {
 		return nil, err
 	}
 
-	err = this.beginMutate(keyspace, ksref, stmt.Keys(), stmt.Indexes(), stmt.Limit(), true)
+	err = this.beginMutate(keyspace, ksref, stmt.Keys(), stmt.Indexes(), stmt.Limit(), !fetchOld)
 	if err != nil {
 		return nil, err
 	}
@@ -59,5 +67,5 @@ func (this *builder) VisitDelete(stmt *algebra.Delete) (interface{}, error) {
 		this.children = append(this.children, plan.NewDiscard())
 	}
 
-	return plan.NewSequence(this.children...), nil
+	return this.visitWith(stmt.With(), plan.NewSequence(this.children...))
 }
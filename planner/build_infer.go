@@ -23,3 +23,13 @@ func (this *builder) VisitInferKeyspace(stmt *algebra.InferKeyspace) (interface{
 
 	return plan.NewInferKeyspace(keyspace, stmt), nil
 }
+
+func (this *builder) VisitAnalyzeKeyspace(stmt *algebra.AnalyzeKeyspace) (interface{}, error) {
+	ksref := stmt.Keyspace()
+	keyspace, err := this.getNameKeyspace(ksref.Namespace(), ksref.Keyspace())
+	if err != nil {
+		return nil, err
+	}
+
+	return plan.NewAnalyzeKeyspace(keyspace, stmt), nil
+}
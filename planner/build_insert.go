@@ -53,5 +53,5 @@ func (this *builder) VisitInsert(stmt *algebra.Insert) (interface{}, error) {
 
 	parallel := plan.NewParallel(plan.NewSequence(subChildren...), this.maxParallelism)
 	children = append(children, parallel)
-	return plan.NewSequence(children...), nil
+	return this.visitWith(stmt.With(), plan.NewSequence(children...))
 }
@@ -0,0 +1,166 @@
+//  Copyright (c) 2016 Couchbase, Inc.
+//  Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file
+//  except in compliance with the License. You may obtain a copy of the License at
+//    http://www.apache.org/licenses/LICENSE-2.0
+//  Unless required by applicable law or agreed to in writing, software distributed under the
+//  License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+//  either express or implied. See the License for the specific language governing permissions
+//  and limitations under the License.
+
+package planner
+
+import (
+	"github.com/couchbase/query/algebra"
+	"github.com/couchbase/query/expression"
+	"github.com/couchbase/query/plan"
+)
+
+/*
+Build the plan of the right source of an ANSI join. The right source
+does not depend on the left source, and is planned on its own. The
+predicates that only reference the right source are used to select
+its index, and to filter it.
+*/
+func (this *builder) buildAnsiJoinRight(node *algebra.AnsiJoin) (plan.Operator, error) {
+	right := node.Right()
+	builder := newBuilder(this.datastore, this.systemstore, this.namespace, this.subquery)
+	builder.transactional = this.transactional
+	builder.indexers = this.indexers
+	builder.from = right
+	builder.where = rightPredicate(node, this.where)
+	builder.children = make([]plan.Operator, 0, 4)
+	builder.subChildren = make([]plan.Operator, 0, 4)
+
+	_, err := right.Accept(builder)
+	if err != nil {
+		return nil, err
+	}
+
+	if builder.where != nil {
+		builder.subChildren = append(builder.subChildren, plan.NewFilter(builder.where))
+	}
+
+	children := builder.children
+	if len(builder.subChildren) > 0 {
+		children = append(children, plan.NewParallel(plan.NewSequence(builder.subChildren...), builder.maxParallelism))
+	}
+
+	return plan.NewSequence(children...), nil
+}
+
+/*
+Returns the conjunction of the terms of the ON and WHERE clauses
+that only reference the right source, and so can be applied to the
+right source before the join. ON terms cannot be applied when all
+the right source is kept, in a right outer join, and WHERE terms
+cannot be applied when the right source is null-extended, in a left
+outer join.
+*/
+func rightPredicate(node *algebra.AnsiJoin, where expression.Expression) expression.Expression {
+	var terms expression.Expressions
+	if !node.RightOuter() {
+		terms = append(terms, conjuncts(node.Onclause(), node.Alias())...)
+	}
+
+	if !node.Outer() && where != nil {
+		terms = append(terms, conjuncts(where, node.Alias())...)
+	}
+
+	switch len(terms) {
+	case 0:
+		return nil
+	case 1:
+		return terms[0]
+	default:
+		return expression.NewAnd(terms...)
+	}
+}
+
+/*
+Returns the terms of a conjunction that only reference the alias.
+*/
+func conjuncts(pred expression.Expression, alias string) expression.Expressions {
+	var terms expression.Expressions
+	if and, ok := pred.(*expression.And); ok {
+		terms = and.Operands()
+	} else {
+		terms = expression.Expressions{pred}
+	}
+
+	rv := make(expression.Expressions, 0, len(terms))
+	for _, term := range terms {
+		if dependsOnlyOn(term, alias) {
+			rv = append(rv, term.Copy())
+		}
+	}
+
+	return rv
+}
+
+/*
+Find the equality predicates of the ON clause that compare an
+expression of the right source with an expression of the left
+source. The right expressions are used to build the hash table of a
+hash join, and the left expressions to probe it.
+*/
+func equiJoinExprs(onclause expression.Expression, alias string) (build, probe expression.Expressions) {
+	var terms expression.Expressions
+	if and, ok := onclause.(*expression.And); ok {
+		terms = and.Operands()
+	} else {
+		terms = expression.Expressions{onclause}
+	}
+
+	right := expression.NewIdentifier(alias)
+	for _, term := range terms {
+		eq, ok := term.(*expression.Eq)
+		if !ok {
+			continue
+		}
+
+		first, second := eq.First(), eq.Second()
+		if dependsOnlyOn(first, alias) && !second.DependsOn(right) {
+			build = append(build, first)
+			probe = append(probe, second)
+		} else if dependsOnlyOn(second, alias) && !first.DependsOn(right) {
+			build = append(build, second)
+			probe = append(probe, first)
+		}
+	}
+
+	return
+}
+
+/*
+Returns true if the expression references the alias, and no other
+identifier.
+*/
+func dependsOnlyOn(expr expression.Expression, alias string) bool {
+	if _, ok := expr.(*algebra.Subquery); ok {
+		return false
+	}
+
+	if ident, ok := expr.(*expression.Identifier); ok {
+		return ident.Identifier() == alias
+	}
+
+	children := expr.Children()
+	if len(children) == 0 {
+		return false
+	}
+
+	found := false
+	for _, child := range children {
+		if child.Value() != nil {
+			continue
+		}
+
+		if !dependsOnlyOn(child, alias) {
+			return false
+		}
+
+		found = true
+	}
+
+	return found
+}
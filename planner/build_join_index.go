@@ -39,6 +39,12 @@ func (this *builder) buildIndexNest(keyspace datastore.Keyspace,
 
 func (this *builder) buildJoinScan(keyspace datastore.Keyspace, node *algebra.KeyspaceTerm, op string) (
 	datastore.Index, expression.Covers, error) {
+	// The index does not see the writes of the transaction
+	if this.transactional {
+		return nil, nil, errors.NewTransactionIndexJoinError(node.Alias(), op)
+	}
+
+	this.recordIndexers(keyspace)
 	indexes := _ALL_INDEX_POOL.Get()
 	defer _ALL_INDEX_POOL.Put(indexes)
 	indexes, err := allIndexes(keyspace, indexes)
@@ -46,6 +52,8 @@ func (this *builder) buildJoinScan(keyspace datastore.Keyspace, node *algebra.Ke
 		return nil, nil, err
 	}
 
+	indexes, _ = searchIndexes(indexes)
+
 	var pred expression.Expression
 	pred = expression.NewIsNotNull(node.Keys().Copy())
 	dnf := NewDNF(pred)
@@ -123,5 +123,5 @@ func (this *builder) VisitMerge(stmt *algebra.Merge) (interface{}, error) {
 		children = append(children, plan.NewDiscard())
 	}
 
-	return plan.NewSequence(children...), nil
+	return this.visitWith(stmt.With(), plan.NewSequence(children...))
 }
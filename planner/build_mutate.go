@@ -17,7 +17,7 @@ import (
 )
 
 func (this *builder) beginMutate(keyspace datastore.Keyspace, ksref *algebra.KeyspaceRef,
-	keys expression.Expression, indexes algebra.IndexRefs, limit expression.Expression, isDelete bool) error {
+	keys expression.Expression, indexes algebra.IndexRefs, limit expression.Expression, keysOnly bool) error {
 	ksref.SetDefaultNamespace(this.namespace)
 	term := algebra.NewKeyspaceTerm(ksref.Namespace(), ksref.Keyspace(), nil, ksref.As(), keys, indexes)
 
@@ -46,7 +46,7 @@ func (this *builder) beginMutate(keyspace datastore.Keyspace, ksref *algebra.Key
 		}
 	} else {
 		var fetch plan.Operator
-		if isDelete && this.where == nil && isKeyScan(scan) {
+		if keysOnly && this.where == nil && isKeyScan(scan) {
 			fetch = plan.NewDummyFetch(keyspace, term)
 		} else {
 			fetch = plan.NewFetch(keyspace, term)
@@ -15,13 +15,90 @@ import (
 	"github.com/couchbase/query/plan"
 )
 
-func BuildPrepared(stmt algebra.Statement, datastore, systemstore datastore.Datastore,
+func BuildPrepared(stmt algebra.Statement, store, systemstore datastore.Datastore,
 	namespace string, subquery bool) (*plan.Prepared, error) {
-	operator, err := Build(stmt, datastore, systemstore, namespace, subquery)
+	builder := newBuilder(store, systemstore, namespace, subquery)
+	builder.indexers = make(datastore.IndexerVersions)
+	operator, err := build(stmt, builder, subquery)
 	if err != nil {
 		return nil, err
 	}
 
+	prepared := newPrepared(stmt, operator)
+	prepared.SetIndexerVersions(builder.indexers)
+	return prepared, nil
+}
+
+// Builds the plan of a statement run within a transaction. The plan
+// is specific to the transaction, and is not cached.
+func BuildTransactionPrepared(stmt algebra.Statement, datastore, systemstore datastore.Datastore,
+	namespace string) (*plan.Prepared, error) {
+	operator, err := BuildTransaction(stmt, datastore, systemstore, namespace, false)
+	if err != nil {
+		return nil, err
+	}
+
+	return newPrepared(stmt, operator), nil
+}
+
+func newPrepared(stmt algebra.Statement, operator plan.Operator) *plan.Prepared {
 	signature := stmt.Signature()
-	return plan.NewPrepared(operator, signature), nil
+	prepared := plan.NewPrepared(operator, signature)
+	prepared.SetStatementType(StatementType(stmt))
+	return prepared
+}
+
+// StatementType returns the type of stmt, as recorded in the audit trail
+// and used by audit filters.
+func StatementType(stmt algebra.Statement) string {
+	switch stmt.(type) {
+	case *algebra.Select:
+		return "SELECT"
+	case *algebra.Insert:
+		return "INSERT"
+	case *algebra.Upsert:
+		return "UPSERT"
+	case *algebra.Update:
+		return "UPDATE"
+	case *algebra.Delete:
+		return "DELETE"
+	case *algebra.Merge:
+		return "MERGE"
+	case *algebra.CreatePrimaryIndex:
+		return "CREATE_PRIMARY_INDEX"
+	case *algebra.CreateIndex:
+		return "CREATE_INDEX"
+	case *algebra.DropIndex:
+		return "DROP_INDEX"
+	case *algebra.AlterIndex:
+		return "ALTER_INDEX"
+	case *algebra.BuildIndexes:
+		return "BUILD_INDEX"
+	case *algebra.Explain:
+		return "EXPLAIN"
+	case *algebra.Prepare:
+		return "PREPARE"
+	case *algebra.Execute:
+		return "EXECUTE"
+	case *algebra.InferKeyspace:
+		return "INFER"
+	case *algebra.AnalyzeKeyspace:
+		return "ANALYZE"
+	case *algebra.CreateFunction:
+		return "CREATE_FUNCTION"
+	case *algebra.DropFunction:
+		return "DROP_FUNCTION"
+	case *algebra.StartTransaction:
+		return "START_TRANSACTION"
+	case *algebra.CommitTransaction:
+		return "COMMIT"
+	case *algebra.RollbackTransaction:
+		return "ROLLBACK"
+	case *algebra.GrantRole:
+		return "GRANT"
+	case *algebra.RevokeRole:
+		return "REVOKE"
+	default:
+		return ""
+	}
 }
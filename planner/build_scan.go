@@ -37,6 +37,18 @@ func (this *builder) selectScan(keyspace datastore.Keyspace, node *algebra.Keysp
 
 	this.maxParallelism = 0 // Use default parallelism for index scans
 
+	// Within a transaction, the documents are fetched and filtered,
+	// so that the transaction's writes are seen, and neither covering
+	// nor pushdowns apply
+	if this.transactional {
+		cover := this.cover
+		defer func() { this.cover = cover }()
+		this.cover = nil
+		this.resetOrderLimit()
+		this.resetCountMin()
+		limit = nil
+	}
+
 	secondary, primary, err := this.buildScan(keyspace, node, limit)
 	if err != nil {
 		return nil, err
@@ -51,6 +63,8 @@ func (this *builder) selectScan(keyspace datastore.Keyspace, node *algebra.Keysp
 
 func (this *builder) buildScan(keyspace datastore.Keyspace, node *algebra.KeyspaceTerm, limit expression.Expression) (
 	secondary plan.Operator, primary *plan.PrimaryScan, err error) {
+	this.recordIndexers(keyspace)
+
 	var indexes, hintIndexes, otherIndexes []datastore.Index
 	hints := node.Indexes()
 	if hints != nil {
@@ -69,6 +83,17 @@ func (this *builder) buildScan(keyspace datastore.Keyspace, node *algebra.Keyspa
 		return
 	}
 
+	indexes, searches := searchIndexes(indexes)
+	if this.transactional {
+		// Full-text searches are not repeated at commit
+		searches = nil
+	}
+	if hints != nil {
+		hintIndexes = indexes
+	} else {
+		otherIndexes = indexes
+	}
+
 	pred := this.where
 	if pred != nil {
 		// Handle constant TRUE predicate
@@ -90,6 +115,7 @@ func (this *builder) buildScan(keyspace datastore.Keyspace, node *algebra.Keyspa
 		}
 	}
 
+	total := -1.0 // Cardinality of the keyspace, if estimated
 	if pred != nil {
 		// Handle constant FALSE predicate
 		cpred := pred.Value()
@@ -105,6 +131,12 @@ func (this *builder) buildScan(keyspace datastore.Keyspace, node *algebra.Keyspa
 		}
 
 		formalizer := expression.NewFormalizer(node.Alias(), nil)
+
+		secondary, err = this.buildSearchScan(node, pred, searches, formalizer)
+		if secondary != nil || err != nil {
+			return secondary, nil, err
+		}
+
 		primaryKey := expression.Expressions{id}
 		sargables, all, er := sargableIndexes(indexes, pred, pred, primaryKey, formalizer)
 		if er != nil {
@@ -116,6 +148,15 @@ func (this *builder) buildScan(keyspace datastore.Keyspace, node *algebra.Keyspa
 			return nil, nil, er
 		}
 
+		// Choose among the indexes by cost, if they can be estimated
+		if len(minimals) > 0 {
+			minimals, total, er = this.chooseScan(keyspace, node, id, minimals,
+				hints == nil && hasPrimaryIndex(indexes))
+			if er != nil {
+				return nil, nil, er
+			}
+		}
+
 		if limit != nil && len(minimals) == 0 {
 			// PrimaryScan with predicates disable pushdown
 			prevLimit := this.limit
@@ -130,9 +171,20 @@ func (this *builder) buildScan(keyspace datastore.Keyspace, node *algebra.Keyspa
 
 		if len(minimals) > 0 {
 			secondary, err = this.buildSecondaryScan(minimals, node, id, pred, limit)
+			if err == nil && total >= 0 {
+				setScanEstimates(secondary, minimals, total)
+			}
 			return secondary, nil, err
 		}
 
+		if or, ok := pred.(*expression.Or); ok && total < 0 {
+			// Try for a union of index scans
+			secondary, err = this.buildUnionScan(keyspace, node, id, or, indexes)
+			if secondary != nil || err != nil {
+				return secondary, nil, err
+			}
+		}
+
 		if this.from != nil {
 			// Try for an UNNEST scan
 			secondary, err = this.buildUnnestScan(node, this.from, pred, all)
@@ -148,9 +200,31 @@ func (this *builder) buildScan(keyspace datastore.Keyspace, node *algebra.Keyspa
 	}
 
 	primary, err = this.buildPrimaryScan(keyspace, node, limit, hintIndexes, otherIndexes)
+	if err == nil && total >= 0 {
+		primary.SetEstimates(total*_COST_PRIMARY_ENTRY, total)
+	}
+
 	return nil, primary, err
 }
 
+// Records the metadata versions of the indexers of the keyspace, before
+// their indexes are read, so that a cached plan is rebuilt once its
+// indexes change.
+func (this *builder) recordIndexers(keyspace datastore.Keyspace) {
+	if this.indexers == nil {
+		return
+	}
+
+	indexers, err := keyspace.Indexers()
+	if err != nil {
+		return
+	}
+
+	for _, indexer := range indexers {
+		this.indexers.Add(indexer)
+	}
+}
+
 func allHints(keyspace datastore.Keyspace, hints algebra.IndexRefs, indexes []datastore.Index) ([]datastore.Index, error) {
 	for _, hint := range hints {
 		indexer, err := keyspace.Indexer(hint.Using())
@@ -36,7 +36,7 @@ func (this *builder) buildCoveringPrimaryScan(keyspace datastore.Keyspace, node
 	}
 
 	keys := expression.Expressions{id}
-	entry := &indexEntry{keys, keys, nil, _EXACT_VALUED_SPANS, true}
+	entry := &indexEntry{keys: keys, sargKeys: keys, spans: _EXACT_VALUED_SPANS, exactSpans: true}
 	secondaries := map[datastore.Index]*indexEntry{primary: entry}
 
 	pred := expression.NewIsNotNull(id)
@@ -0,0 +1,120 @@
+//  Copyright (c) 2014 Couchbase, Inc.
+//  Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file
+//  except in compliance with the License. You may obtain a copy of the License at
+//    http://www.apache.org/licenses/LICENSE-2.0
+//  Unless required by applicable law or agreed to in writing, software distributed under the
+//  License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+//  either express or implied. See the License for the specific language governing permissions
+//  and limitations under the License.
+
+package planner
+
+import (
+	"github.com/couchbase/query/algebra"
+	"github.com/couchbase/query/datastore"
+	"github.com/couchbase/query/expression"
+	"github.com/couchbase/query/plan"
+)
+
+/*
+Full-text indexes answer only SEARCH() predicates. A SEARCH() term of
+the predicate whose text is the key of a full-text index becomes a
+scan of that index, with the search query as the bound of its span.
+The scan is inexact, so the predicate is still applied to the
+fetched documents.
+*/
+func (this *builder) buildSearchScan(node *algebra.KeyspaceTerm, pred expression.Expression,
+	indexes []datastore.Index, formalizer *expression.Formalizer) (plan.Operator, error) {
+	searches := searchTerms(pred)
+	if len(searches) == 0 {
+		return nil, nil
+	}
+
+	for _, index := range indexes {
+		keys := index.RangeKey()
+		if len(keys) != 1 {
+			continue
+		}
+
+		key, err := formalizer.Map(keys[0].Copy())
+		if err != nil {
+			return nil, err
+		}
+
+		cond := index.Condition()
+		if cond != nil {
+			cond, err = formalizer.Map(cond.Copy())
+			if err != nil {
+				return nil, err
+			}
+
+			dnf := NewDNF(cond)
+			cond, err = dnf.Map(cond)
+			if err != nil {
+				return nil, err
+			}
+
+			if !SubsetOf(pred, cond) {
+				continue
+			}
+		}
+
+		for _, search := range searches {
+			query := search.Second().Static()
+			if query == nil || !search.First().EquivalentTo(key) {
+				continue
+			}
+
+			// Full-text scans return documents in order of score
+			this.resetOrderLimit()
+			this.resetCountMin()
+
+			span := &plan.Span{}
+			span.Range.Low = expression.Expressions{query}
+			span.Range.High = span.Range.Low
+			span.Range.Inclusion = datastore.BOTH
+			span.Exact = false
+			return plan.NewIndexScan(index, node, plan.Spans{span}, false, nil, nil, nil), nil
+		}
+	}
+
+	return nil, nil
+}
+
+/*
+The SEARCH() functions that the predicate, or one of its conjuncts,
+consists of.
+*/
+func searchTerms(pred expression.Expression) []*expression.Search {
+	switch pred := pred.(type) {
+	case *expression.Search:
+		return []*expression.Search{pred}
+	case *expression.And:
+		var rv []*expression.Search
+		for _, op := range pred.Operands() {
+			if search, ok := op.(*expression.Search); ok {
+				rv = append(rv, search)
+			}
+		}
+		return rv
+	default:
+		return nil
+	}
+}
+
+/*
+Separates the full-text indexes, which are not used for other
+predicates.
+*/
+func searchIndexes(indexes []datastore.Index) (others, searches []datastore.Index) {
+	others = indexes[:0]
+	for _, index := range indexes {
+		if index.Type() == datastore.FTS {
+			searches = append(searches, index)
+		} else {
+			others = append(others, index)
+		}
+	}
+
+	return others, searches
+}
@@ -26,6 +26,8 @@ type indexEntry struct {
 	cond       expression.Expression
 	spans      plan.Spans
 	exactSpans bool
+	estimated  bool    // Cardinality was estimated from index statistics
+	card       float64 // Estimated number of index entries scanned
 }
 
 func (this *builder) buildSecondaryScan(secondaries map[datastore.Index]*indexEntry,
@@ -158,7 +160,7 @@ func sargableIndexes(indexes []datastore.Index, pred, subset expression.Expressi
 		}
 
 		n := SargableFor(pred, keys)
-		entry := &indexEntry{keys, keys[0:n], cond, nil, false}
+		entry := &indexEntry{keys: keys, sargKeys: keys[0:n], cond: cond}
 		all[index] = entry
 
 		if n > 0 {
@@ -18,6 +18,15 @@ import (
 // SELECT
 
 func (this *builder) VisitSelect(stmt *algebra.Select) (interface{}, error) {
+	op, err := this.visitSelect(stmt)
+	if err != nil {
+		return nil, err
+	}
+
+	return this.visitWith(stmt.With(), op)
+}
+
+func (this *builder) visitSelect(stmt *algebra.Select) (plan.Operator, error) {
 	// Restore previous values when exiting. VisitSelect()
 	// can be called multiple times by set operators
 	prevCover := this.cover
@@ -59,7 +68,7 @@ func (this *builder) VisitSelect(stmt *algebra.Select) (interface{}, error) {
 	}
 
 	if order == nil && offset == nil && limit == nil {
-		return sub, nil
+		return sub.(plan.Operator), nil
 	}
 
 	children := make([]plan.Operator, 0, 5)
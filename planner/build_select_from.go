@@ -91,6 +91,15 @@ func (this *builder) VisitSubqueryTerm(node *algebra.SubqueryTerm) (interface{},
 	return nil, nil
 }
 
+func (this *builder) VisitExpressionTerm(node *algebra.ExpressionTerm) (interface{}, error) {
+	this.resetOrderLimit()
+	this.resetCountMin()
+
+	scan := plan.NewExpressionScan(node.FromExpression(), node.Alias())
+	this.children = append(this.children, scan)
+	return nil, nil
+}
+
 func (this *builder) VisitJoin(node *algebra.Join) (interface{}, error) {
 	this.resetOrderLimit()
 	this.resetCountMin()
@@ -117,6 +126,37 @@ func (this *builder) VisitJoin(node *algebra.Join) (interface{}, error) {
 	return nil, nil
 }
 
+func (this *builder) VisitAnsiJoin(node *algebra.AnsiJoin) (interface{}, error) {
+	this.resetOrderLimit()
+	this.resetCountMin()
+
+	_, err := node.Left().Accept(this)
+	if err != nil {
+		return nil, err
+	}
+
+	right, err := this.buildAnsiJoinRight(node)
+	if err != nil {
+		return nil, err
+	}
+
+	// The join collects its right source once, so it is not
+	// parallelized
+	if len(this.subChildren) > 0 {
+		this.children = append(this.children, plan.NewParallel(plan.NewSequence(this.subChildren...), this.maxParallelism))
+		this.subChildren = make([]plan.Operator, 0, 16)
+	}
+
+	build, probe := equiJoinExprs(node.Onclause(), node.Alias())
+	if len(build) > 0 {
+		this.children = append(this.children, plan.NewHashJoin(node, build, probe, right))
+	} else {
+		this.children = append(this.children, plan.NewNLJoin(node, right))
+	}
+
+	return nil, nil
+}
+
 func (this *builder) VisitIndexJoin(node *algebra.IndexJoin) (interface{}, error) {
 	this.resetOrderLimit()
 	this.resetCountMin()
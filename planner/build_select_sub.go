@@ -44,6 +44,11 @@ func (this *builder) VisitSubselect(node *algebra.Subselect) (interface{}, error
 		return nil, err
 	}
 
+	windows, err := allWindowFunctions(node, this.order, aggs)
+	if err != nil {
+		return nil, err
+	}
+
 	this.where = node.Where()
 
 	group := node.Group()
@@ -110,6 +115,12 @@ func (this *builder) VisitSubselect(node *algebra.Subselect) (interface{}, error
 		this.resetOrderLimit()
 	}
 
+	// Window functions need all their input; avoid pushing ORDER BY
+	// and LIMIT down to index scan.
+	if len(windows) > 0 {
+		this.resetOrderLimit()
+	}
+
 	err = this.visitFrom(node, group)
 	if err != nil {
 		return nil, err
@@ -152,6 +163,10 @@ func (this *builder) VisitSubselect(node *algebra.Subselect) (interface{}, error
 			this.visitGroup(group, aggs)
 		}
 
+		if len(windows) > 0 {
+			this.visitWindows(windows)
+		}
+
 		projection := node.Projection()
 		this.subChildren = append(this.subChildren, plan.NewInitialProject(projection))
 
@@ -209,6 +224,26 @@ func (this *builder) visitGroup(group *algebra.Group, aggs map[string]algebra.Ag
 	}
 }
 
+func (this *builder) visitWindows(windows map[string]algebra.WindowFunction) {
+	fnn := make(sort.StringSlice, 0, len(windows))
+	for n, _ := range windows {
+		fnn = append(fnn, n)
+	}
+
+	fnn.Sort()
+	fnv := make(algebra.WindowFunctions, len(windows))
+	for i, n := range fnn {
+		fnv[i] = windows[n]
+	}
+
+	if len(this.subChildren) > 0 {
+		this.children = append(this.children, plan.NewParallel(plan.NewSequence(this.subChildren...), this.maxParallelism))
+	}
+
+	this.children = append(this.children, plan.NewWindow(fnv))
+	this.subChildren = make([]plan.Operator, 0, 8)
+}
+
 func allAggregates(node *algebra.Subselect, order *algebra.Order) (map[string]algebra.Aggregate, error) {
 	aggs := make(map[string]algebra.Aggregate)
 
@@ -287,6 +322,101 @@ func collectAggregates(aggs map[string]algebra.Aggregate, exprs ...expression.Ex
 	}
 }
 
+func allWindowFunctions(node *algebra.Subselect, order *algebra.Order,
+	aggs map[string]algebra.Aggregate) (map[string]algebra.WindowFunction, error) {
+	windows := make(map[string]algebra.WindowFunction)
+
+	for _, binding := range node.Let() {
+		collectWindowFunctions(windows, binding.Expression())
+		if len(windows) > 0 {
+			return nil, fmt.Errorf("Window functions not allowed in LET.")
+		}
+	}
+
+	if node.Where() != nil {
+		collectWindowFunctions(windows, node.Where())
+		if len(windows) > 0 {
+			return nil, fmt.Errorf("Window functions not allowed in WHERE.")
+		}
+	}
+
+	group := node.Group()
+	if group != nil {
+		collectWindowFunctions(windows, group.By()...)
+		if len(windows) > 0 {
+			return nil, fmt.Errorf("Window functions not allowed in GROUP BY.")
+		}
+
+		for _, binding := range group.Letting() {
+			collectWindowFunctions(windows, binding.Expression())
+			if len(windows) > 0 {
+				return nil, fmt.Errorf("Window functions not allowed in LETTING.")
+			}
+		}
+
+		if group.Having() != nil {
+			collectWindowFunctions(windows, group.Having())
+			if len(windows) > 0 {
+				return nil, fmt.Errorf("Window functions not allowed in HAVING.")
+			}
+		}
+	}
+
+	projection := node.Projection()
+	if projection != nil {
+		for _, term := range projection.Terms() {
+			if term.Expression() != nil {
+				collectWindowFunctions(windows, term.Expression())
+			}
+		}
+	}
+
+	if order != nil {
+		for _, term := range order.Terms() {
+			if term.Expression() != nil {
+				collectWindowFunctions(windows, term.Expression())
+			}
+		}
+	}
+
+	// Window functions are evaluated after aggregates, and all at once
+	for _, fn := range windows {
+		nested := make(map[string]algebra.WindowFunction)
+		collectWindowFunctions(nested, fn.Children()...)
+		if len(nested) > 0 {
+			return nil, fmt.Errorf("Window functions cannot be nested: %s.", fn.String())
+		}
+	}
+
+	for _, agg := range aggs {
+		nested := make(map[string]algebra.WindowFunction)
+		collectWindowFunctions(nested, agg.Children()...)
+		if len(nested) > 0 {
+			return nil, fmt.Errorf("Window functions not allowed in aggregates: %s.", agg.String())
+		}
+	}
+
+	return windows, nil
+}
+
+func collectWindowFunctions(windows map[string]algebra.WindowFunction, exprs ...expression.Expression) {
+	for _, expr := range exprs {
+		fn, ok := expr.(algebra.WindowFunction)
+		if ok {
+			windows[fn.String()] = fn
+			continue
+		}
+
+		_, ok = expr.(*algebra.Subquery)
+		if !ok {
+			children := expr.Children()
+			if len(children) > 0 {
+				collectWindowFunctions(windows, children...)
+			}
+		}
+	}
+}
+
 /*
 
 Constrain the WHERE condition to reflect the aggregate query. For
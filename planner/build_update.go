@@ -61,5 +61,5 @@ func (this *builder) VisitUpdate(stmt *algebra.Update) (interface{}, error) {
 		this.children = append(this.children, plan.NewDiscard())
 	}
 
-	return plan.NewSequence(this.children...), nil
+	return this.visitWith(stmt.With(), plan.NewSequence(this.children...))
 }
@@ -43,7 +43,8 @@ func (this *builder) VisitUpsert(stmt *algebra.Upsert) (interface{}, error) {
 	}
 
 	subChildren := make([]plan.Operator, 0, 4)
-	subChildren = append(subChildren, plan.NewSendUpsert(keyspace, ksref.Alias(), stmt.Key(), stmt.Value()))
+	subChildren = append(subChildren, plan.NewSendUpsert(keyspace, ksref.Alias(), stmt.Key(), stmt.Value(),
+		stmt.Returning() != nil && stmt.Returning().ReferencesImage(algebra.RETURNING_OLD)))
 
 	if stmt.Returning() != nil {
 		subChildren = append(subChildren, plan.NewInitialProject(stmt.Returning()), plan.NewFinalProject())
@@ -53,5 +54,5 @@ func (this *builder) VisitUpsert(stmt *algebra.Upsert) (interface{}, error) {
 
 	parallel := plan.NewParallel(plan.NewSequence(subChildren...), this.maxParallelism)
 	children = append(children, parallel)
-	return plan.NewSequence(children...), nil
+	return this.visitWith(stmt.With(), plan.NewSequence(children...))
 }
@@ -0,0 +1,57 @@
+//  Copyright (c) 2016 Couchbase, Inc.
+//  Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file
+//  except in compliance with the License. You may obtain a copy of the License at
+//    http://www.apache.org/licenses/LICENSE-2.0
+//  Unless required by applicable law or agreed to in writing, software distributed under the
+//  License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+//  either express or implied. See the License for the specific language governing permissions
+//  and limitations under the License.
+
+package planner
+
+import (
+	"github.com/couchbase/query/algebra"
+	"github.com/couchbase/query/plan"
+)
+
+// WITH
+
+func (this *builder) visitWith(withs algebra.Withs, op plan.Operator) (plan.Operator, error) {
+	if len(withs) == 0 {
+		return op, nil
+	}
+
+	bindings := make([]*plan.WithBinding, len(withs))
+	for i, with := range withs {
+		var err error
+		var query, step plan.Operator
+		if with.Recursive() {
+			query, err = this.buildWith(with.Anchor())
+			if err == nil {
+				step, err = this.buildWith(with.Step())
+			}
+		} else {
+			query, err = this.buildWith(with.Query())
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		bindings[i] = plan.NewWithBinding(with.Alias(), query, step, with.Distinct())
+	}
+
+	return plan.NewWith(bindings, op), nil
+}
+
+func (this *builder) buildWith(query *algebra.Select) (plan.Operator, error) {
+	builder := newBuilder(this.datastore, this.systemstore, this.namespace, true)
+	builder.transactional = this.transactional
+	builder.indexers = this.indexers
+	op, err := query.Accept(builder)
+	if err != nil {
+		return nil, err
+	}
+
+	return op.(plan.Operator), nil
+}
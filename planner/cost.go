@@ -0,0 +1,494 @@
+//  Copyright (c) 2016 Couchbase, Inc.
+//  Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file
+//  except in compliance with the License. You may obtain a copy of the License at
+//    http://www.apache.org/licenses/LICENSE-2.0
+//  Unless required by applicable law or agreed to in writing, software distributed under the
+//  License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+//  either express or implied. See the License for the specific language governing permissions
+//  and limitations under the License.
+
+package planner
+
+import (
+	"sort"
+
+	"github.com/couchbase/query/algebra"
+	"github.com/couchbase/query/datastore"
+	"github.com/couchbase/query/expression"
+	"github.com/couchbase/query/plan"
+	"github.com/couchbase/query/value"
+)
+
+// Relative costs for cost-based index selection. A primary scan
+// reads its index sequentially, so its entries are cheaper than those
+// of a secondary scan. Each document fetched costs the same, whatever
+// scan produced its key.
+const (
+	_COST_PRIMARY_ENTRY = 0.5
+	_COST_INDEX_ENTRY   = 1.0
+	_COST_FETCH         = 4.0
+)
+
+/*
+Chooses the secondary indexes to scan by cost, once the number of
+entries each index scans can be estimated from its statistics. A
+single index is scanned, or several are intersected if that reduces
+the documents fetched enough to pay for the extra scans. Returns the
+indexes unchanged if they cannot be estimated, and no indexes if a
+primary scan is cheaper and allowed. Also returns the cardinality of
+the keyspace, or a negative value if it is not known.
+*/
+func (this *builder) chooseScan(keyspace datastore.Keyspace, node *algebra.KeyspaceTerm,
+	id expression.Expression, minimals map[datastore.Index]*indexEntry, primary bool) (
+	map[datastore.Index]*indexEntry, float64, error) {
+	stats := this.keyspaceStatistics(keyspace)
+	for index, entry := range minimals {
+		if !estimateEntry(index, entry, stats) {
+			return minimals, -1, nil
+		}
+	}
+
+	total, ok := keyspaceCardinality(keyspace)
+	if !ok {
+		return minimals, -1, nil
+	}
+
+	chosen, card, cost := chooseIndexes(minimals, total)
+	cost += card * _COST_FETCH
+
+	// A covering scan fetches no documents
+	if this.cover != nil {
+		for _, index := range sortedIndexes(minimals) {
+			entry := minimals[index]
+			if entry.card*_COST_INDEX_ENTRY >= cost {
+				break
+			}
+
+			covered, err := this.coveredBy(node, id, index, entry)
+			if err != nil {
+				return nil, -1, err
+			}
+
+			if covered {
+				chosen = map[datastore.Index]*indexEntry{index: entry}
+				cost = entry.card * _COST_INDEX_ENTRY
+				break
+			}
+		}
+	}
+
+	if primary && primaryCost(total) < cost {
+		return map[datastore.Index]*indexEntry{}, total, nil
+	}
+
+	return chosen, total, nil
+}
+
+/*
+Builds a UnionScan for a disjunction whose terms are each sargable
+by some index, if its estimated cost is below that of a primary
+scan. Returns nil if any term cannot be sarged or estimated.
+*/
+func (this *builder) buildUnionScan(keyspace datastore.Keyspace, node *algebra.KeyspaceTerm,
+	id expression.Expression, or *expression.Or, indexes []datastore.Index) (plan.Operator, error) {
+	total, ok := keyspaceCardinality(keyspace)
+	if !ok {
+		return nil, nil
+	}
+
+	stats := this.keyspaceStatistics(keyspace)
+	formalizer := expression.NewFormalizer(node.Alias(), nil)
+	primaryKey := expression.Expressions{id}
+	scans := make([]plan.Operator, 0, len(or.Operands()))
+	cost, card := 0.0, 0.0
+
+	for _, term := range or.Operands() {
+		sargables, _, err := sargableIndexes(indexes, term, term, primaryKey, formalizer)
+		if err != nil {
+			return nil, err
+		}
+
+		minimals, err := minimalIndexes(sargables, term)
+		if err != nil || len(minimals) == 0 {
+			return nil, err
+		}
+
+		for index, entry := range minimals {
+			if !estimateEntry(index, entry, stats) {
+				return nil, nil
+			}
+		}
+
+		chosen, _, _ := chooseIndexes(minimals, total)
+		scan := buildIndexScans(chosen, node, term)
+		c, n, _ := setScanEstimates(scan, chosen, total)
+		scans = append(scans, scan)
+		cost += c
+		card += n
+	}
+
+	if card > total {
+		card = total
+	}
+
+	if cost+card*_COST_FETCH >= primaryCost(total) {
+		return nil, nil
+	}
+
+	this.resetOrderLimit()
+	this.resetCountMin()
+
+	union := plan.NewUnionScan(scans...)
+	union.SetEstimates(cost, card)
+	return union, nil
+}
+
+/*
+Builds the scan of the chosen indexes, intersecting them if there
+are several. No covering, ordering or limit is pushed down.
+*/
+func buildIndexScans(chosen map[datastore.Index]*indexEntry, node *algebra.KeyspaceTerm,
+	pred expression.Expression) plan.Operator {
+	scans := make([]plan.Operator, 0, len(chosen))
+	for _, index := range sortedIndexes(chosen) {
+		entry := chosen[index]
+		var op plan.Operator = plan.NewIndexScan(index, node, entry.spans, false, nil, nil, nil)
+
+		if indexHasArrayIndexKey(index) ||
+			(len(entry.spans) > 1 && (!entry.exactSpans || pred.MayOverlapSpans())) {
+			op = plan.NewDistinctScan(op)
+		}
+
+		scans = append(scans, op)
+	}
+
+	if len(scans) > 1 {
+		return plan.NewIntersectScan(scans...)
+	}
+
+	return scans[0]
+}
+
+/*
+Sets the estimates of scans built from estimated index entries, and
+returns their cost and cardinality. Returns false if the scan cannot
+be estimated.
+*/
+func setScanEstimates(op plan.Operator, entries map[datastore.Index]*indexEntry, total float64) (
+	cost, card float64, ok bool) {
+	switch op := op.(type) {
+	case *plan.IndexScan:
+		entry, found := entries[op.Index()]
+		if !found || !entry.estimated {
+			return 0, 0, false
+		}
+
+		cost, card = entry.card*_COST_INDEX_ENTRY, entry.card
+		op.SetEstimates(cost, card)
+		return cost, card, true
+	case *plan.DistinctScan:
+		return setScanEstimates(op.Scan(), entries, total)
+	case *plan.IntersectScan:
+		card = total
+		for _, scan := range op.Scans() {
+			c, n, ok := setScanEstimates(scan, entries, total)
+			if !ok {
+				return 0, 0, false
+			}
+
+			cost += c
+			card *= selectivity(n, total)
+		}
+
+		op.SetEstimates(cost, card)
+		return cost, card, true
+	default:
+		return 0, 0, false
+	}
+}
+
+/*
+Chooses the indexes to intersect, from the most selective, adding
+each index that lowers the estimated cost including fetches. Returns
+the cardinality and the cost of the scan, excluding fetches.
+*/
+func chooseIndexes(entries map[datastore.Index]*indexEntry, total float64) (
+	chosen map[datastore.Index]*indexEntry, card, cost float64) {
+	indexes := sortedIndexes(entries)
+	first := entries[indexes[0]]
+
+	chosen = map[datastore.Index]*indexEntry{indexes[0]: first}
+	card = first.card
+	if card > total {
+		card = total
+	}
+	cost = first.card * _COST_INDEX_ENTRY
+
+	for _, index := range indexes[1:] {
+		entry := entries[index]
+		ncard := card * selectivity(entry.card, total)
+		ncost := cost + entry.card*_COST_INDEX_ENTRY
+		if ncost+ncard*_COST_FETCH < cost+card*_COST_FETCH {
+			chosen[index] = entry
+			card, cost = ncard, ncost
+		}
+	}
+
+	return
+}
+
+/*
+Returns the indexes ordered by their estimated cardinality, then by
+name, so that choices do not depend on map order.
+*/
+func sortedIndexes(entries map[datastore.Index]*indexEntry) []datastore.Index {
+	sorter := &indexSorter{
+		indexes: make([]datastore.Index, 0, len(entries)),
+		entries: entries,
+	}
+
+	for index := range entries {
+		sorter.indexes = append(sorter.indexes, index)
+	}
+
+	sort.Sort(sorter)
+	return sorter.indexes
+}
+
+type indexSorter struct {
+	indexes []datastore.Index
+	entries map[datastore.Index]*indexEntry
+}
+
+func (this *indexSorter) Len() int {
+	return len(this.indexes)
+}
+
+func (this *indexSorter) Less(i, j int) bool {
+	ci, cj := this.entries[this.indexes[i]].card, this.entries[this.indexes[j]].card
+	if ci != cj {
+		return ci < cj
+	}
+
+	return this.indexes[i].Name() < this.indexes[j].Name()
+}
+
+func (this *indexSorter) Swap(i, j int) {
+	this.indexes[i], this.indexes[j] = this.indexes[j], this.indexes[i]
+}
+
+/*
+Estimates the number of entries scanned by the spans of an index
+entry, from the statistics of the index, or else from the histogram
+of its leading key gathered by ANALYZE. Returns false if the spans
+are not constant or neither statistics are available.
+*/
+func estimateEntry(index datastore.Index, entry *indexEntry,
+	keyspaceStats *datastore.KeyspaceStatistics) bool {
+	if entry.estimated {
+		return true
+	}
+
+	card := 0.0
+	for _, span := range entry.spans {
+		dspan, ok := constantSpan(span)
+		if !ok {
+			return false
+		}
+
+		stats, err := index.Statistics("", dspan)
+		if err != nil || stats == nil {
+			count, ok := histogramEntries(keyspaceStats, index, dspan)
+			if !ok {
+				return false
+			}
+
+			card += count
+			continue
+		}
+
+		count, err := stats.Count()
+		if err != nil {
+			return false
+		}
+
+		card += float64(count)
+	}
+
+	entry.card = card
+	entry.estimated = true
+	return true
+}
+
+/*
+Estimates the number of entries of a span from the histogram of the
+leading key of the index, scaled from the sample to the keyspace.
+A bin partly within the span counts for half its entries, and an
+equality span matches the average entries per value of its bin.
+*/
+func histogramEntries(stats *datastore.KeyspaceStatistics, index datastore.Index,
+	span *datastore.Span) (float64, bool) {
+	keys := index.RangeKey()
+	if stats == nil || stats.Sampled == 0 || len(keys) == 0 {
+		return 0, false
+	}
+
+	field := stats.Field(keys[0].String())
+	if field == nil {
+		return 0, false
+	}
+
+	var low, high value.Value
+	inclusion := span.Range.Inclusion
+	if len(span.Seek) > 0 {
+		low, high, inclusion = span.Seek[0], span.Seek[0], datastore.BOTH
+	} else {
+		if len(span.Range.Low) > 0 && span.Range.Low[0].Type() > value.NULL {
+			low = span.Range.Low[0]
+		}
+		if len(span.Range.High) > 0 {
+			high = span.Range.High[0]
+		}
+	}
+
+	equal := low != nil && high != nil && low.Collate(high) == 0
+	entries := 0.0
+	var prev value.Value
+	for _, bin := range field.Histogram {
+		max := value.NewValue(bin.Max)
+		switch {
+		case low != nil && max.Collate(low) < 0,
+			high != nil && prev != nil && prev.Collate(high) >= 0:
+			// Disjoint from the span
+		case equal:
+			if bin.Distinct > 0 {
+				entries += float64(bin.Count) / float64(bin.Distinct)
+			}
+		case (low == nil || prev != nil && prev.Collate(low) >= 0) &&
+			(high == nil || max.Collate(high) < 0 ||
+				max.Collate(high) == 0 && inclusion&datastore.HIGH != 0):
+			entries += float64(bin.Count)
+		default:
+			entries += float64(bin.Count) / 2
+		}
+
+		if equal && max.Collate(low) >= 0 {
+			break
+		}
+
+		prev = max
+	}
+
+	return entries * float64(stats.Documents) / float64(stats.Sampled), true
+}
+
+/*
+Returns the statistics gathered by ANALYZE on the keyspace, or nil.
+*/
+func (this *builder) keyspaceStatistics(keyspace datastore.Keyspace) *datastore.KeyspaceStatistics {
+	statistician, ok := this.datastore.(datastore.Statistician)
+	if !ok {
+		return nil
+	}
+
+	stats, err := statistician.KeyspaceStatistics(keyspace.NamespaceId(), keyspace.Name())
+	if err != nil {
+		return nil
+	}
+
+	return stats
+}
+
+/*
+Returns true if the index covers the expressions of the query.
+*/
+func (this *builder) coveredBy(node *algebra.KeyspaceTerm, id expression.Expression,
+	index datastore.Index, entry *indexEntry) (bool, error) {
+	keys := make(expression.Expressions, len(entry.keys), len(entry.keys)+1)
+	copy(keys, entry.keys)
+	if !index.IsPrimary() {
+		keys = append(keys, id)
+	}
+
+	coveringExprs, _, err := indexKeyExpressions(entry, keys)
+	if err != nil {
+		return false, err
+	}
+
+	for _, expr := range this.cover.Expressions() {
+		if !expr.CoveredBy(node.Alias(), coveringExprs) {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+func constantSpan(span *plan.Span) (*datastore.Span, bool) {
+	seek, ok1 := constantValues(span.Seek)
+	low, ok2 := constantValues(span.Range.Low)
+	high, ok3 := constantValues(span.Range.High)
+	if !ok1 || !ok2 || !ok3 {
+		return nil, false
+	}
+
+	return &datastore.Span{
+		Seek: seek,
+		Range: datastore.Range{
+			Low:       low,
+			High:      high,
+			Inclusion: span.Range.Inclusion,
+		},
+	}, true
+}
+
+func constantValues(exprs expression.Expressions) (value.Values, bool) {
+	if exprs == nil {
+		return nil, true
+	}
+
+	values := make(value.Values, len(exprs))
+	for i, expr := range exprs {
+		if expr == nil {
+			continue
+		}
+
+		values[i] = expr.Value()
+		if values[i] == nil {
+			return nil, false
+		}
+	}
+
+	return values, true
+}
+
+func keyspaceCardinality(keyspace datastore.Keyspace) (float64, bool) {
+	count, err := keyspace.Count()
+	if err != nil {
+		return 0, false
+	}
+
+	return float64(count), true
+}
+
+func primaryCost(total float64) float64 {
+	return total * (_COST_PRIMARY_ENTRY + _COST_FETCH)
+}
+
+func selectivity(card, total float64) float64 {
+	if total <= 0 || card >= total {
+		return 1.0
+	}
+
+	return card / total
+}
+
+func hasPrimaryIndex(indexes []datastore.Index) bool {
+	for _, index := range indexes {
+		if index.IsPrimary() {
+			return true
+		}
+	}
+
+	return false
+}
@@ -24,12 +24,17 @@ import (
 
 	"github.com/couchbase/query/accounting"
 	acct_resolver "github.com/couchbase/query/accounting/resolver"
+	"github.com/couchbase/query/audit"
+	audit_resolver "github.com/couchbase/query/audit/resolver"
+	"github.com/couchbase/query/auth"
+	auth_resolver "github.com/couchbase/query/auth/resolver"
 	config_resolver "github.com/couchbase/query/clustering/resolver"
 	datastore_package "github.com/couchbase/query/datastore"
 	"github.com/couchbase/query/datastore/resolver"
 	"github.com/couchbase/query/datastore/system"
 	"github.com/couchbase/query/logging"
 	log_resolver "github.com/couchbase/query/logging/resolver"
+	"github.com/couchbase/query/plan"
 	"github.com/couchbase/query/server"
 	"github.com/couchbase/query/server/http"
 	"github.com/couchbase/query/util"
@@ -38,6 +43,8 @@ import (
 var DATASTORE = flag.String("datastore", "", "Datastore address (http://URL or dir:PATH or mock:)")
 var CONFIGSTORE = flag.String("configstore", "stub:", "Configuration store address (http://URL or stub:)")
 var ACCTSTORE = flag.String("acctstore", "gometrics:", "Accounting store address (http://URL or stub:)")
+var CREDSTORE = flag.String("credential-store", "", "Credential store for engine users and roles (file:PATH); leave empty to use the datastore's credentials")
+var AUDITSINK = flag.String("audit-sink", "", "Audit sink for the audit trail of requests (file:PATH[?max_size=BYTES&max_files=N]); leave empty to disable auditing")
 var NAMESPACE = flag.String("namespace", "default", "Default namespace")
 var TIMEOUT = flag.Duration("timeout", 0*time.Second, "Server execution timeout, e.g. 500ms or 2s; use zero or negative value to disable")
 var READONLY = flag.Bool("readonly", false, "Read-only mode")
@@ -62,6 +69,11 @@ var DEBUG = flag.Bool("debug", false, "Debug mode")
 var KEEP_ALIVE_LENGTH = flag.Int("keep-alive-length", server.KEEP_ALIVE_DEFAULT, "maximum size of buffered result")
 var STATIC_PATH = flag.String("static-path", "static", "Path to static content")
 var PIPELINE_CAP = flag.Int("pipeline-cap", 512, "Maximum number of items each execution operator can buffer")
+var SORT_MEMORY = flag.Int64("sort-memory", 0, "Memory budget in bytes for ORDER BY per request, after which sorted runs are spilled to disk; use zero or negative value to disable")
+var GROUP_MEMORY = flag.Int64("group-memory", 0, "Memory budget in bytes for GROUP BY per request, after which groups are partitioned to disk; use zero or negative value to disable")
+var TRANSACTION_TIMEOUT = flag.Duration("transaction-timeout", 2*time.Minute, "Idle time after which a transaction is rolled back, e.g. 30s or 5m; use zero or negative value to disable")
+var MEMORY_QUOTA = flag.Int64("memory-quota", 0, "Default memory quota in bytes for the values held by the operators of a request, after which the request is aborted; use zero or negative value to disable")
+var NODE_QUOTA = flag.Int64("node-quota", 0, "Memory quota in bytes for the values held by the operators of all requests on this node, after which requests are aborted; use zero or negative value to disable")
 var PIPELINE_BATCH = flag.Int("pipeline-batch", 16, "Number of items execution operators can batch")
 var ENTERPRISE = flag.Bool("enterprise", true, "Enterprise mode")
 
@@ -72,6 +84,7 @@ var MEM_PROFILE = flag.String("memprofile", "", "write memory profile to this fi
 // Monitoring API
 var COMPLETED_THRESHOLD = flag.Int("completed-threshold", 1000, "cache completed query lasting longer than this many milliseconds")
 var COMPLETED_LIMIT = flag.Int("completed-limit", 4000, "maximum number of completed requests")
+var PLAN_CACHE_LIMIT = flag.Int("plan-cache-limit", 4096, "maximum number of cached ad-hoc statement plans; use zero or negative value to disable")
 
 func main() {
 	HideConsole(true)
@@ -114,6 +127,24 @@ func main() {
 	}
 	datastore_package.SetDatastore(datastore)
 
+	if *CREDSTORE != "" {
+		credstore, err := auth_resolver.NewCredentialStore(*CREDSTORE)
+		if err != nil {
+			logging.Errorp(err.Error())
+			os.Exit(1)
+		}
+		auth.SetCredentialStore(credstore)
+	}
+
+	if *AUDITSINK != "" {
+		auditor, err := audit_resolver.NewAuditor(*AUDITSINK)
+		if err != nil {
+			logging.Errorp(err.Error())
+			os.Exit(1)
+		}
+		audit.SetAuditor(auditor)
+	}
+
 	configstore, err := config_resolver.NewConfigstore(*CONFIGSTORE)
 	if err != nil {
 		logging.Errorp("Could not connect to configstore",
@@ -134,6 +165,7 @@ func main() {
 
 	// Start the completed requests log
 	accounting.RequestsInit(*COMPLETED_THRESHOLD, *COMPLETED_LIMIT)
+	plan.PlanCacheSetLimit(*PLAN_CACHE_LIMIT)
 
 	channel := make(server.RequestChannel, *REQUEST_CAP)
 	plusChannel := make(server.RequestChannel, *REQUEST_CAP)
@@ -159,6 +191,11 @@ func main() {
 	server.SetMemProfile(*MEM_PROFILE)
 	server.SetPipelineCap(*PIPELINE_CAP)
 	server.SetPipelineBatch(*PIPELINE_BATCH)
+	server.SetSortMemory(*SORT_MEMORY)
+	server.SetGroupMemory(*GROUP_MEMORY)
+	server.SetMemoryQuota(*MEMORY_QUOTA)
+	server.SetNodeQuota(*NODE_QUOTA)
+	server.SetTransactionTimeout(*TRANSACTION_TIMEOUT)
 	server.SetRequestSizeCap(*REQUEST_SIZE_CAP)
 	server.SetScanCap(*SCAN_CAP)
 
@@ -190,6 +227,8 @@ func main() {
 		logging.Pair{"plus-servicers", server.PlusServicers()},
 		logging.Pair{"pipeline-cap", server.PipelineCap()},
 		logging.Pair{"pipeline-batch", *PIPELINE_BATCH},
+		logging.Pair{"sort-memory", server.SortMemory()},
+		logging.Pair{"group-memory", server.GroupMemory()},
 		logging.Pair{"request-cap", *REQUEST_CAP},
 		logging.Pair{"request-size-cap", server.RequestSizeCap()},
 		logging.Pair{"timeout", server.Timeout()},
@@ -0,0 +1,91 @@
+//  Copyright (c) 2014 Couchbase, Inc.
+//  Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file
+//  except in compliance with the License. You may obtain a copy of the License at
+//    http://www.apache.org/licenses/LICENSE-2.0
+//  Unless required by applicable law or agreed to in writing, software distributed under the
+//  License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+//  either express or implied. See the License for the specific language governing permissions
+//  and limitations under the License.
+
+package server
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/couchbase/query/algebra"
+	"github.com/couchbase/query/datastore"
+	"github.com/couchbase/query/errors"
+	"github.com/couchbase/query/expression"
+	"github.com/couchbase/query/logging"
+	"github.com/couchbase/query/parser/n1ql"
+)
+
+// Loads the functions persisted by the datastore into the catalog of
+// user-defined functions. The body of a function may call functions
+// that are loaded after it, so the functions are loaded in rounds,
+// until a round loads none. Functions that cannot be loaded are
+// logged and skipped.
+func LoadUserFunctions(store datastore.Datastore) errors.Error {
+	functions, ok := store.(datastore.FunctionStore)
+	if !ok {
+		return nil
+	}
+
+	pending, err := functions.Functions()
+	if err != nil {
+		return err
+	}
+
+	errs := make(map[string]error, len(pending))
+	for len(pending) > 0 {
+		failed := make([]*datastore.FunctionDefinition, 0, len(pending))
+		for _, definition := range pending {
+			if _, ok := expression.GetUserFunction(definition.Name); ok {
+				continue
+			}
+
+			e := loadUserFunction(definition)
+			if e != nil {
+				errs[definition.Name] = e
+				failed = append(failed, definition)
+			}
+		}
+
+		if len(failed) == len(pending) {
+			for _, definition := range failed {
+				logging.Errorp("Cannot load function", logging.Pair{"name", definition.Name},
+					logging.Pair{"error", errs[definition.Name]})
+			}
+			break
+		}
+
+		pending = failed
+	}
+
+	return nil
+}
+
+// Parses the function as a CREATE FUNCTION statement, which checks
+// its body as when it was created.
+func loadUserFunction(definition *datastore.FunctionDefinition) error {
+	parameters := make([]string, len(definition.Parameters))
+	for i, p := range definition.Parameters {
+		parameters[i] = "`" + p + "`"
+	}
+
+	text := fmt.Sprintf("CREATE FUNCTION `%s`(%s) { %s }",
+		definition.Name, strings.Join(parameters, ", "), definition.Body)
+	stmt, err := n1ql.ParseStatement(text)
+	if err != nil {
+		return err
+	}
+
+	create, ok := stmt.(*algebra.CreateFunction)
+	if !ok {
+		return fmt.Errorf("Invalid definition of function %s.", definition.Name)
+	}
+
+	return expression.AddUserFunction(expression.NewUserFunctionDefinition(
+		create.Name(), create.Parameters(), create.Body()))
+}
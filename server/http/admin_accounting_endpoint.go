@@ -27,6 +27,7 @@ const (
 	accountingPrefix = adminPrefix + "/stats"
 	vitalsPrefix     = adminPrefix + "/vitals"
 	preparedsPrefix  = adminPrefix + "/prepareds"
+	planCachePrefix  = adminPrefix + "/plan_cache"
 	requestsPrefix   = adminPrefix + "/active_requests"
 	completedPrefix  = adminPrefix + "/completed_requests"
 	expvarsRoute     = "/debug/vars"
@@ -55,6 +56,12 @@ func (this *HttpEndpoint) registerAccountingHandlers() {
 	preparedsHandler := func(w http.ResponseWriter, req *http.Request) {
 		this.wrapAPI(w, req, doPrepareds)
 	}
+	planCacheHandler := func(w http.ResponseWriter, req *http.Request) {
+		this.wrapAPI(w, req, doPlanCache)
+	}
+	cachedPlanHandler := func(w http.ResponseWriter, req *http.Request) {
+		this.wrapAPI(w, req, doCachedPlan)
+	}
 	requestsHandler := func(w http.ResponseWriter, req *http.Request) {
 		this.wrapAPI(w, req, doActiveRequests)
 	}
@@ -73,6 +80,8 @@ func (this *HttpEndpoint) registerAccountingHandlers() {
 		vitalsPrefix:                  {handler: vitalsHandler, methods: []string{"GET"}},
 		preparedsPrefix:               {handler: preparedsHandler, methods: []string{"GET"}},
 		preparedsPrefix + "/{name}":   {handler: preparedHandler, methods: []string{"GET", "DELETE"}},
+		planCachePrefix:               {handler: planCacheHandler, methods: []string{"GET", "DELETE"}},
+		planCachePrefix + "/{id}":     {handler: cachedPlanHandler, methods: []string{"GET", "DELETE"}},
 		requestsPrefix:                {handler: requestsHandler, methods: []string{"GET"}},
 		requestsPrefix + "/{request}": {handler: requestHandler, methods: []string{"GET", "DELETE"}},
 		completedPrefix:               {handler: completedHandler, methods: []string{"GET"}},
@@ -194,6 +203,52 @@ func doPrepareds(endpoint *HttpEndpoint, w http.ResponseWriter, req *http.Reques
 	}
 }
 
+func doCachedPlan(endpoint *HttpEndpoint, w http.ResponseWriter, req *http.Request) (interface{}, errors.Error) {
+	vars := mux.Vars(req)
+	id := vars["id"]
+
+	switch req.Method {
+	case "DELETE":
+		return plan.DeleteCachedPlan(id), nil
+	case "GET":
+		var rv interface{}
+		plan.CachedPlanDo(id, func(entry *plan.PlanCacheEntry) {
+			rv = cachedPlanData(id, entry)
+		})
+		return rv, nil
+	default:
+		return nil, nil
+	}
+}
+
+func doPlanCache(endpoint *HttpEndpoint, w http.ResponseWriter, req *http.Request) (interface{}, errors.Error) {
+	switch req.Method {
+	case "DELETE":
+		plan.ClearPlanCache()
+		return true, nil
+	case "GET":
+		plans := make([]map[string]interface{}, 0, plan.CountCachedPlans())
+		plan.CachedPlansForEach(func(id string, entry *plan.PlanCacheEntry) {
+			plans = append(plans, cachedPlanData(id, entry))
+		})
+		return plans, nil
+	default:
+		return nil, nil
+	}
+}
+
+func cachedPlanData(id string, entry *plan.PlanCacheEntry) map[string]interface{} {
+	return map[string]interface{}{
+		"id":        id,
+		"statement": entry.Statement,
+		"namespace": entry.Namespace,
+		"uses":      entry.Uses,
+		"added":     entry.Added.String(),
+		"lastUse":   entry.LastUse.String(),
+		"stale":     entry.Stale(),
+	}
+}
+
 func doActiveRequest(endpoint *HttpEndpoint, w http.ResponseWriter, req *http.Request) (interface{}, errors.Error) {
 	vars := mux.Vars(req)
 	requestId := vars["request"]
@@ -0,0 +1,147 @@
+//  Copyright (c) 2014 Couchbase, Inc.
+//  Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file
+//  except in compliance with the License. You may obtain a copy of the License at
+//    http://www.apache.org/licenses/LICENSE-2.0
+//  Unless required by applicable law or agreed to in writing, software distributed under the
+//  License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+//  either express or implied. See the License for the specific language governing permissions
+//  and limitations under the License.
+
+package http
+
+import (
+	"net/http"
+
+	"github.com/couchbase/query/audit"
+	audit_resolver "github.com/couchbase/query/audit/resolver"
+	"github.com/couchbase/query/errors"
+)
+
+const (
+	auditPrefix = adminPrefix + "/audit"
+
+	_AUDITENABLED = "enabled"
+	_AUDITREDACT  = "redact-parameters"
+	_AUDITTYPES   = "statement-types"
+	_AUDITSINK    = "sink"
+)
+
+var _AUDIT_CHECKERS = map[string]checker{
+	_AUDITENABLED: checkBool,
+	_AUDITREDACT:  checkBool,
+	_AUDITTYPES:   checkStatementTypes,
+	_AUDITSINK:    checkString,
+}
+
+func (this *HttpEndpoint) registerAuditHandlers() {
+	auditHandler := func(w http.ResponseWriter, req *http.Request) {
+		this.wrapAPI(w, req, doAudit)
+	}
+
+	this.mux.HandleFunc(auditPrefix, auditHandler).Methods("GET", "POST")
+}
+
+func checkStatementTypes(val interface{}) bool {
+	types, ok := val.([]interface{})
+	if !ok {
+		return false
+	}
+
+	for _, t := range types {
+		if _, ok := t.(string); !ok {
+			return false
+		}
+	}
+
+	return true
+}
+
+func doAudit(endpoint *HttpEndpoint, w http.ResponseWriter, req *http.Request) (interface{}, errors.Error) {
+	// Admin auth required
+	err := endpoint.hasAdminAuth(req)
+	if err != nil {
+		return nil, err
+	}
+
+	settings := map[string]interface{}{}
+	switch req.Method {
+	case "GET":
+		return fillAuditSettings(settings), nil
+	case "POST":
+		decoder, e := getJsonDecoder(req.Body)
+		if e != nil {
+			return nil, e
+		}
+		er := decoder.Decode(&settings)
+		if er != nil {
+			return nil, errors.NewAdminDecodingError(er)
+		}
+		for setting, value := range settings {
+			if check_it, ok := _AUDIT_CHECKERS[setting]; !ok {
+				return nil, errors.NewAdminUnknownSettingError(setting)
+			} else if !check_it(value) {
+				return nil, errors.NewAdminSettingTypeError(setting, value)
+			}
+		}
+		err = setAuditSettings(settings)
+		if err != nil {
+			return nil, err
+		}
+		return fillAuditSettings(settings), nil
+	default:
+		return nil, nil
+	}
+}
+
+// Changes the sink first, as it resets whether auditing is enabled
+func setAuditSettings(settings map[string]interface{}) errors.Error {
+	if value, ok := settings[_AUDITSINK]; ok {
+		uri := value.(string)
+		if uri == "" {
+			audit.SetAuditor(nil)
+		} else {
+			auditor, err := audit_resolver.NewAuditor(uri)
+			if err != nil {
+				return err
+			}
+			audit.SetAuditor(auditor)
+		}
+	}
+
+	if value, ok := settings[_AUDITENABLED]; ok {
+		audit.SetEnabled(value.(bool))
+	}
+
+	if value, ok := settings[_AUDITREDACT]; ok {
+		audit.SetRedactParameters(value.(bool))
+	}
+
+	if value, ok := settings[_AUDITTYPES]; ok {
+		values := value.([]interface{})
+		types := make([]string, len(values))
+		for i, t := range values {
+			types[i] = t.(string)
+		}
+		audit.SetStatementTypes(types)
+	}
+
+	return nil
+}
+
+func fillAuditSettings(settings map[string]interface{}) map[string]interface{} {
+	sink := ""
+	if auditor := audit.GetAuditor(); auditor != nil {
+		sink = auditor.URL()
+	}
+
+	types := audit.StatementTypes()
+	if types == nil {
+		types = []string{}
+	}
+
+	settings[_AUDITENABLED] = audit.Enabled()
+	settings[_AUDITREDACT] = audit.RedactParameters()
+	settings[_AUDITTYPES] = types
+	settings[_AUDITSINK] = sink
+	return settings
+}
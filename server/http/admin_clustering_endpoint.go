@@ -22,6 +22,7 @@ import (
 	"github.com/couchbase/query/clustering"
 	"github.com/couchbase/query/errors"
 	"github.com/couchbase/query/logging"
+	"github.com/couchbase/query/plan"
 	"github.com/couchbase/query/server"
 	"github.com/couchbase/query/util"
 	"github.com/gorilla/mux"
@@ -322,9 +323,16 @@ const (
 	_PIPELINECAP     = "pipeline-cap"
 	_SCANCAP         = "scan-cap"
 	_SERVICERS       = "servicers"
+	_SORTMEMORY      = "sort-memory"
+	_GROUPMEMORY     = "group-memory"
+	_MEMORYQUOTA     = "memory-quota"
+	_NODEQUOTA       = "node-quota"
+	_WORKLOADCLASSES = "workload-classes"
 	_TIMEOUT         = "timeout"
+	_TXTIMEOUT       = "transaction-timeout"
 	_CMPTHRESHOLD    = "completed-threshold"
 	_CMPLIMIT        = "completed-limit"
+	_PLANCACHELIMIT  = "plan-cache-limit"
 )
 
 type checker func(interface{}) bool
@@ -353,6 +361,11 @@ func checkLogLevel(val interface{}) bool {
 	return ok
 }
 
+func checkWorkloadClasses(val interface{}) bool {
+	_, err := server.NewWorkloadClasses(val)
+	return err == nil
+}
+
 var _CHECKERS = map[string]checker{
 	_CPUPROFILE:      checkString,
 	_DEBUG:           checkBool,
@@ -365,9 +378,16 @@ var _CHECKERS = map[string]checker{
 	_PIPELINECAP:     checkNumber,
 	_SCANCAP:         checkNumber,
 	_SERVICERS:       checkNumber,
+	_SORTMEMORY:      checkNumber,
+	_GROUPMEMORY:     checkNumber,
+	_MEMORYQUOTA:     checkNumber,
+	_NODEQUOTA:       checkNumber,
+	_WORKLOADCLASSES: checkWorkloadClasses,
 	_TIMEOUT:         checkNumber,
+	_TXTIMEOUT:       checkNumber,
 	_CMPTHRESHOLD:    checkNumber,
 	_CMPLIMIT:        checkNumber,
+	_PLANCACHELIMIT:  checkNumber,
 }
 
 type setter func(*server.Server, interface{})
@@ -417,10 +437,34 @@ var _SETTERS = map[string]setter{
 		value, _ := o.(float64)
 		s.SetServicers(int(value))
 	},
+	_SORTMEMORY: func(s *server.Server, o interface{}) {
+		value, _ := o.(float64)
+		s.SetSortMemory(int64(value))
+	},
+	_GROUPMEMORY: func(s *server.Server, o interface{}) {
+		value, _ := o.(float64)
+		s.SetGroupMemory(int64(value))
+	},
+	_MEMORYQUOTA: func(s *server.Server, o interface{}) {
+		value, _ := o.(float64)
+		s.SetMemoryQuota(int64(value))
+	},
+	_NODEQUOTA: func(s *server.Server, o interface{}) {
+		value, _ := o.(float64)
+		s.SetNodeQuota(int64(value))
+	},
+	_WORKLOADCLASSES: func(s *server.Server, o interface{}) {
+		classes, _ := server.NewWorkloadClasses(o)
+		s.SetWorkloadClasses(classes)
+	},
 	_TIMEOUT: func(s *server.Server, o interface{}) {
 		value, _ := o.(float64)
 		s.SetTimeout(time.Duration(value))
 	},
+	_TXTIMEOUT: func(s *server.Server, o interface{}) {
+		value, _ := o.(float64)
+		s.SetTransactionTimeout(time.Duration(value))
+	},
 	_CMPTHRESHOLD: func(s *server.Server, o interface{}) {
 		value, _ := o.(float64)
 		accounting.RequestsSetThreshold(int(value))
@@ -429,6 +473,10 @@ var _SETTERS = map[string]setter{
 		value, _ := o.(float64)
 		accounting.RequestsSetLimit(int(value))
 	},
+	_PLANCACHELIMIT: func(s *server.Server, o interface{}) {
+		value, _ := o.(float64)
+		plan.PlanCacheSetLimit(int(value))
+	},
 }
 
 func doSettings(endpoint *HttpEndpoint, w http.ResponseWriter, req *http.Request) (interface{}, errors.Error) {
@@ -480,12 +528,24 @@ func fillSettings(settings map[string]interface{}, srvr *server.Server) map[stri
 	settings[_DEBUG] = srvr.Debug()
 	settings[_PIPELINEBATCH] = srvr.PipelineBatch()
 	settings[_PIPELINECAP] = srvr.PipelineCap()
+	settings[_SORTMEMORY] = srvr.SortMemory()
+	settings[_GROUPMEMORY] = srvr.GroupMemory()
+	settings[_MEMORYQUOTA] = srvr.MemoryQuota()
+	settings[_NODEQUOTA] = srvr.NodeQuota()
+	classes := srvr.WorkloadClasses()
+	workloads := make([]interface{}, len(classes))
+	for i, class := range classes {
+		workloads[i] = class.Settings()
+	}
+	settings[_WORKLOADCLASSES] = workloads
 	settings[_MAXPARALLELISM] = srvr.MaxParallelism()
 	settings[_TIMEOUT] = srvr.Timeout()
+	settings[_TXTIMEOUT] = srvr.TransactionTimeout()
 	settings[_KEEPALIVELENGTH] = srvr.KeepAlive()
 	settings[_LOGLEVEL] = srvr.LogLevel()
 	settings[_CMPTHRESHOLD] = accounting.RequestsThreshold()
 	settings[_CMPLIMIT] = accounting.RequestsLimit()
+	settings[_PLANCACHELIMIT] = plan.PlanCacheLimit()
 	return settings
 }
 
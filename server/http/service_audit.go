@@ -0,0 +1,75 @@
+//  Copyright (c) 2014 Couchbase, Inc.
+//  Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file
+//  except in compliance with the License. You may obtain a copy of the License at
+//    http://www.apache.org/licenses/LICENSE-2.0
+//  Unless required by applicable law or agreed to in writing, software distributed under the
+//  License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+//  either express or implied. See the License for the specific language governing permissions
+//  and limitations under the License.
+
+package http
+
+import (
+	"sort"
+	"time"
+
+	"github.com/couchbase/query/audit"
+	"github.com/couchbase/query/server"
+)
+
+// Records the request in the audit trail, once it has completed
+func auditRequest(request *httpRequest, requestTime time.Duration) {
+	if !audit.Enabled() {
+		return
+	}
+
+	audit.Record(newAuditEntry(&request.BaseRequest, request.req.RemoteAddr,
+		request.resultState(""), request.errorCodes, requestTime))
+}
+
+func newAuditEntry(request *server.BaseRequest, clientAddress string, status server.State,
+	errorCodes []int, requestTime time.Duration) *audit.Entry {
+	entry := &audit.Entry{
+		Timestamp:       request.RequestTime(),
+		RequestId:       request.Id().String(),
+		ClientContextId: request.ClientID().String(),
+		ClientAddress:   clientAddress,
+		StatementType:   request.StatementType(),
+		Statement:       request.Statement(),
+		Status:          string(status),
+		ErrorCodes:      errorCodes,
+		ElapsedTime:     requestTime.String(),
+	}
+
+	for user := range request.Credentials() {
+		entry.Users = append(entry.Users, user)
+	}
+	sort.Strings(entry.Users)
+
+	if prepared := request.Prepared(); prepared != nil {
+		entry.PreparedName = prepared.Name()
+	}
+
+	if args := request.NamedArgs(); len(args) > 0 {
+		entry.NamedArgs = make(map[string]interface{}, len(args))
+		for name, arg := range args {
+			entry.NamedArgs[name] = arg.Actual()
+		}
+	}
+
+	if args := request.PositionalArgs(); len(args) > 0 {
+		entry.PositionalArgs = make([]interface{}, len(args))
+		for i, arg := range args {
+			entry.PositionalArgs[i] = arg.Actual()
+		}
+	}
+
+	if privs := request.Privileges(); len(privs) > 0 {
+		entry.Privileges = make(map[string]string, len(privs))
+		for key, priv := range privs {
+			entry.Privileges[key] = priv.String()
+		}
+	}
+
+	return entry
+}
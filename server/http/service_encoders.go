@@ -0,0 +1,416 @@
+//  Copyright (c) 2016 Couchbase, Inc.
+//  Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file
+//  except in compliance with the License. You may obtain a copy of the License at
+//    http://www.apache.org/licenses/LICENSE-2.0
+//  Unless required by applicable law or agreed to in writing, software distributed under the
+//  License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+//  either express or implied. See the License for the specific language governing permissions
+//  and limitations under the License.
+
+package http
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/couchbase/query/errors"
+	"github.com/couchbase/query/server"
+	"github.com/couchbase/query/value"
+)
+
+// resultEncoder writes the response of a request in a format other than JSON.
+type resultEncoder interface {
+	contentType() string                                      // the Content-Type of the response
+	usesTrailers() bool                                       // true if the metadata is sent as http trailers
+	writePrefix(server_flag bool, signature value.Value) bool // start the response
+	writeResult(item value.Value) (int, bool)                 // write one result, returning its encoded size
+	writeSuffix(metrics bool, state server.State) bool        // write status, errors and metrics
+}
+
+func newResultEncoder(req *httpRequest, format Format) resultEncoder {
+	switch format {
+	case CSV:
+		return newDelimitedEncoder(req, ',', "text/csv; charset=utf-8")
+	case TSV:
+		return newDelimitedEncoder(req, '\t', "text/tab-separated-values; charset=utf-8")
+	case XML:
+		return &xmlEncoder{req: req}
+	default:
+		return nil
+	}
+}
+
+// Names of the http headers and trailers carrying the response metadata
+// of delimited formats
+const (
+	HEADER_REQUEST_ID        = "X-Query-Request-Id"
+	HEADER_CLIENT_CONTEXT_ID = "X-Query-Client-Context-Id"
+	TRAILER_STATUS           = "X-Query-Status"
+	TRAILER_ERRORS           = "X-Query-Errors"
+	TRAILER_WARNINGS         = "X-Query-Warnings"
+	TRAILER_METRICS          = "X-Query-Metrics"
+)
+
+// stringWriter adapts the request's responseDataManager to an io.Writer
+type stringWriter struct {
+	req *httpRequest
+}
+
+func (this *stringWriter) Write(b []byte) (int, error) {
+	if !this.req.writeString(string(b)) {
+		return 0, fmt.Errorf("Error writing response")
+	}
+	return len(b), nil
+}
+
+// delimitedEncoder writes results as CSV or TSV records.
+//
+// The header record is derived from the projection and the first result:
+// fields of nested objects are flattened into dotted column names, arrays
+// are written as JSON text, and NULL and MISSING values are written as empty
+// fields. Fields not present in the header are not written.
+//
+// Status, errors, warnings and metrics are sent as http trailers, encoded
+// as JSON.
+type delimitedEncoder struct {
+	req         *httpRequest
+	writer      *csv.Writer
+	content     string
+	signature   value.Value
+	columns     []string
+	wroteHeader bool
+}
+
+func newDelimitedEncoder(req *httpRequest, comma rune, content string) *delimitedEncoder {
+	rv := &delimitedEncoder{
+		req:     req,
+		writer:  csv.NewWriter(&stringWriter{req: req}),
+		content: content,
+	}
+	rv.writer.Comma = comma
+	return rv
+}
+
+func (this *delimitedEncoder) contentType() string {
+	return this.content
+}
+
+func (this *delimitedEncoder) usesTrailers() bool {
+	return true
+}
+
+func (this *delimitedEncoder) writePrefix(server_flag bool, signature value.Value) bool {
+	h := this.req.resp.Header()
+	h.Set(HEADER_REQUEST_ID, this.req.Id().String())
+	if this.req.ClientID().IsValid() {
+		h.Set(HEADER_CLIENT_CONTEXT_ID, this.req.ClientID().String())
+	}
+	this.signature = signature
+	return true
+}
+
+func (this *delimitedEncoder) writeResult(item value.Value) (int, bool) {
+	fields := make(map[string]string)
+	flatten("", item, fields)
+
+	if !this.wroteHeader {
+		this.columns = deriveColumns(this.signature, fields)
+		if !this.writeRecord(this.columns) {
+			return 0, false
+		}
+		this.wroteHeader = true
+	}
+
+	size := 0
+	record := make([]string, len(this.columns))
+	for i, c := range this.columns {
+		record[i] = fields[c]
+		size += len(record[i]) + 1
+	}
+	return size, this.writeRecord(record)
+}
+
+func (this *delimitedEncoder) writeRecord(record []string) bool {
+	if this.writer.Write(record) != nil {
+		return false
+	}
+	this.writer.Flush()
+	return this.writer.Error() == nil
+}
+
+func (this *delimitedEncoder) writeSuffix(metrics bool, state server.State) bool {
+	if !this.wroteHeader && this.signature != nil {
+		this.columns = deriveColumns(this.signature, nil)
+		if len(this.columns) > 0 && !this.writeRecord(this.columns) {
+			return false
+		}
+		this.wroteHeader = true
+	}
+
+	errs := this.req.collectErrors()
+	warnings := this.req.collectWarnings()
+
+	h := this.req.resp.Header()
+	h.Set(http.TrailerPrefix+TRAILER_STATUS, string(this.req.resultState(state)))
+	if len(errs) > 0 {
+		h.Set(http.TrailerPrefix+TRAILER_ERRORS, jsonString(errorsToMaps(errs)))
+	}
+	if len(warnings) > 0 {
+		h.Set(http.TrailerPrefix+TRAILER_WARNINGS, jsonString(errorsToMaps(warnings)))
+	}
+	if m := this.req.metricsMap(metrics); m != nil {
+		h.Set(http.TrailerPrefix+TRAILER_METRICS, jsonString(m))
+	}
+	return true
+}
+
+func jsonString(v interface{}) string {
+	bytes, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprintf("\"ERROR: %v\"", err)
+	}
+	return string(bytes)
+}
+
+// deriveColumns returns the header of a delimited response. Projected
+// fields come first, in name order; nested objects in the first result are
+// expanded into their flattened fields. If the projection contains a
+// star, or the result is not an object, the remaining fields of the first
+// result are added, also in name order.
+func deriveColumns(signature value.Value, fields map[string]string) []string {
+	columns := make([]string, 0, len(fields))
+	seen := make(map[string]bool, len(fields))
+	add := func(c string) {
+		if !seen[c] {
+			seen[c] = true
+			columns = append(columns, c)
+		}
+	}
+
+	flattened := make([]string, 0, len(fields))
+	for f, _ := range fields {
+		flattened = append(flattened, f)
+	}
+	sort.Strings(flattened)
+
+	all := true
+	if signature != nil && signature.Type() == value.OBJECT {
+		all = false
+		names := make([]string, 0, len(signature.Fields()))
+		for n, _ := range signature.Fields() {
+			if n == "*" {
+				all = true
+			} else {
+				names = append(names, n)
+			}
+		}
+		sort.Strings(names)
+
+		for _, n := range names {
+			expanded := false
+			for _, f := range flattened {
+				if strings.HasPrefix(f, n+".") {
+					add(f)
+					expanded = true
+				}
+			}
+			if !expanded {
+				add(n)
+			}
+		}
+	}
+
+	if all {
+		for _, f := range flattened {
+			add(f)
+		}
+	}
+	return columns
+}
+
+// The column of results that are not objects
+const _RAW_COLUMN = "$1"
+
+// flatten adds the fields of a result to the given map, with nested object
+// fields named by their dotted path
+func flatten(prefix string, item value.Value, fields map[string]string) {
+	switch item.Type() {
+	case value.OBJECT:
+		for n, v := range item.Fields() {
+			if prefix != "" {
+				n = prefix + "." + n
+			}
+			flatten(n, value.NewValue(v), fields)
+		}
+		return
+	case value.MISSING, value.NULL:
+		return
+	}
+
+	if prefix == "" {
+		prefix = _RAW_COLUMN
+	}
+	if item.Type() == value.STRING {
+		fields[prefix] = item.Actual().(string)
+	} else {
+		bytes, _ := item.MarshalJSON()
+		fields[prefix] = string(bytes)
+	}
+}
+
+// xmlEncoder writes the response as an XML document. Objects are written as
+// elements named after their fields, or as field elements with a name
+// attribute when the field name is not a valid XML name; array elements
+// are written as item elements.
+type xmlEncoder struct {
+	req     *httpRequest
+	started bool
+}
+
+func (this *xmlEncoder) contentType() string {
+	return "application/xml; charset=utf-8"
+}
+
+func (this *xmlEncoder) usesTrailers() bool {
+	return false
+}
+
+func (this *xmlEncoder) writePrefix(server_flag bool, signature value.Value) bool {
+	this.started = true
+	rv := this.writeHeader()
+
+	s := this.req.Signature()
+	if s != value.FALSE && (s != value.NONE || server_flag) {
+		rv = rv && this.writeElement("    ", "signature", signature)
+	}
+	return rv && this.req.writeString("    <results>\n")
+}
+
+func (this *xmlEncoder) writeHeader() bool {
+	rv := this.req.writeString(xml.Header) &&
+		this.req.writeString("<response>\n") &&
+		this.writeElement("    ", "requestID", value.NewValue(this.req.Id().String()))
+	if this.req.ClientID().IsValid() {
+		rv = rv && this.writeElement("    ", "clientContextID", value.NewValue(this.req.ClientID().String()))
+	}
+	return rv
+}
+
+func (this *xmlEncoder) writeResult(item value.Value) (int, bool) {
+	buf := &bytes.Buffer{}
+	encodeXML(buf, "        ", "result", item)
+	return buf.Len(), this.req.writeString(buf.String())
+}
+
+func (this *xmlEncoder) writeSuffix(metrics bool, state server.State) bool {
+	var rv bool
+	if this.started {
+		rv = this.req.writeString("    </results>\n")
+	} else {
+		rv = this.writeHeader()
+	}
+
+	errs := this.req.collectErrors()
+	warnings := this.req.collectWarnings()
+	if len(errs) > 0 {
+		rv = rv && this.writeErrors("errors", errs)
+	}
+	if len(warnings) > 0 {
+		rv = rv && this.writeErrors("warnings", warnings)
+	}
+
+	rv = rv && this.writeElement("    ", "status", value.NewValue(string(this.req.resultState(state))))
+	if m := this.req.metricsMap(metrics); m != nil {
+		rv = rv && this.writeElement("    ", "metrics", value.NewValue(m))
+	}
+	return rv && this.req.writeString("</response>\n")
+}
+
+func (this *xmlEncoder) writeErrors(name string, errs []errors.Error) bool {
+	buf := &bytes.Buffer{}
+	buf.WriteString("    <" + name + ">\n")
+	for _, err := range errs {
+		fmt.Fprintf(buf, "        <error code=\"%d\">", err.Code())
+		xml.EscapeText(buf, []byte(err.Error()))
+		buf.WriteString("</error>\n")
+	}
+	buf.WriteString("    </" + name + ">\n")
+	return this.req.writeString(buf.String())
+}
+
+func (this *xmlEncoder) writeElement(indent, name string, item value.Value) bool {
+	buf := &bytes.Buffer{}
+	encodeXML(buf, indent, name, item)
+	return this.req.writeString(buf.String())
+}
+
+func encodeXML(buf *bytes.Buffer, indent, name string, item value.Value) {
+	start, end := xmlTags(name)
+
+	switch item.Type() {
+	case value.MISSING:
+		return
+	case value.NULL:
+		buf.WriteString(indent + start[:len(start)-1] + " null=\"true\"/>\n")
+	case value.OBJECT:
+		fields := item.Fields()
+		names := make([]string, 0, len(fields))
+		for n, _ := range fields {
+			names = append(names, n)
+		}
+		sort.Strings(names)
+
+		buf.WriteString(indent + start + "\n")
+		for _, n := range names {
+			encodeXML(buf, indent+"    ", n, value.NewValue(fields[n]))
+		}
+		buf.WriteString(indent + end + "\n")
+	case value.ARRAY:
+		buf.WriteString(indent + start + "\n")
+		for _, v := range item.Actual().([]interface{}) {
+			encodeXML(buf, indent+"    ", "item", value.NewValue(v))
+		}
+		buf.WriteString(indent + end + "\n")
+	default:
+		buf.WriteString(indent + start)
+		if item.Type() == value.STRING {
+			xml.EscapeText(buf, []byte(item.Actual().(string)))
+		} else {
+			bytes, _ := item.MarshalJSON()
+			xml.EscapeText(buf, bytes)
+		}
+		buf.WriteString(end + "\n")
+	}
+}
+
+func xmlTags(name string) (string, string) {
+	if isXMLName(name) {
+		return "<" + name + ">", "</" + name + ">"
+	}
+
+	buf := &bytes.Buffer{}
+	xml.EscapeText(buf, []byte(name))
+	return "<field name=\"" + strings.Replace(buf.String(), "\"", "&quot;", -1) + "\">", "</field>"
+}
+
+func isXMLName(name string) bool {
+	if name == "" || strings.HasPrefix(strings.ToLower(name), "xml") {
+		return false
+	}
+	for i, r := range name {
+		switch {
+		case r == '_' || unicode.IsLetter(r):
+		case i > 0 && (r == '-' || r == '.' || unicode.IsDigit(r)):
+		default:
+			return false
+		}
+	}
+	return true
+}
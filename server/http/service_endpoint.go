@@ -134,6 +134,21 @@ func (this *HttpEndpoint) ServeHTTP(resp http.ResponseWriter, req *http.Request)
 		return
 	}
 
+	// Requests of a workload class are queued on the class queue
+	class, err := this.server.AdmitWorkload(request)
+	if err != nil {
+		request.Fail(err)
+		request.Failed(this.server)
+		return
+	}
+
+	if class != nil {
+		// Wait until the request exits.
+		<-request.CloseNotify()
+		class.Release(request)
+		return
+	}
+
 	if request.ScanConsistency() == datastore.UNBOUNDED {
 		select {
 		case this.server.Channel() <- request:
@@ -184,6 +199,8 @@ func (this *HttpEndpoint) registerHandlers(staticPath string) {
 
 	this.registerClusterHandlers()
 	this.registerAccountingHandlers()
+	this.registerAuditHandlers()
+	this.registerKeysHandlers()
 	this.registerStaticHandlers(staticPath)
 }
 
@@ -208,6 +225,7 @@ func (this *HttpEndpoint) doStats(request *httpRequest) {
 		request.Prepared())
 	request.LogRequest(request_time, service_time, request.resultCount,
 		request.resultSize, request.errorCount)
+	auditRequest(request, request_time)
 }
 
 func ServicePrefix() string {
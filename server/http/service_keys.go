@@ -0,0 +1,395 @@
+//  Copyright (c) 2014 Couchbase, Inc.
+//  Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file
+//  except in compliance with the License. You may obtain a copy of the License at
+//    http://www.apache.org/licenses/LICENSE-2.0
+//  Unless required by applicable law or agreed to in writing, software distributed under the
+//  License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+//  either express or implied. See the License for the specific language governing permissions
+//  and limitations under the License.
+
+package http
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"sort"
+	"time"
+
+	"github.com/couchbase/query/accounting"
+	"github.com/couchbase/query/audit"
+	"github.com/couchbase/query/auth"
+	"github.com/couchbase/query/datastore"
+	"github.com/couchbase/query/errors"
+	"github.com/couchbase/query/execution"
+	"github.com/couchbase/query/server"
+	"github.com/couchbase/query/value"
+	"github.com/gorilla/mux"
+)
+
+// The keys endpoint reads and writes documents by key, without
+// going through a statement:
+//
+//	GET    /query/service/keys/{namespace}/{keyspace}/{key}  fetch a document
+//	POST   /query/service/keys/{namespace}/{keyspace}/{key}  insert the document in the body
+//	PUT    /query/service/keys/{namespace}/{keyspace}/{key}  upsert the document in the body
+//	DELETE /query/service/keys/{namespace}/{keyspace}/{key}  delete a document
+//
+// Without a key in the path, GET and DELETE apply to the JSON array
+// of keys in the keys parameter, and POST and PUT to the JSON object
+// in the body, which maps keys to documents.
+const (
+	keysPrefix = servicePrefix + "/keys"
+
+	KEYS = "keys"
+)
+
+type keysOp struct {
+	statementType string
+	privilege     datastore.Privilege
+	phase         string
+}
+
+var _KEYS_OPS = map[string]*keysOp{
+	"GET":    &keysOp{"SELECT", datastore.PRIV_READ, "fetch"},
+	"POST":   &keysOp{"INSERT", datastore.PRIV_WRITE, "insert"},
+	"PUT":    &keysOp{"UPSERT", datastore.PRIV_WRITE, "upsert"},
+	"DELETE": &keysOp{"DELETE", datastore.PRIV_WRITE, "delete"},
+}
+
+func (this *HttpEndpoint) registerKeysHandlers() {
+	keysHandler := func(w http.ResponseWriter, req *http.Request) {
+		this.doKeys(w, req)
+	}
+
+	this.mux.HandleFunc(keysPrefix+"/{namespace}/{keyspace}", keysHandler).
+		Methods("GET", "POST", "PUT", "DELETE")
+	this.mux.HandleFunc(keysPrefix+"/{namespace}/{keyspace}/{key}", keysHandler).
+		Methods("GET", "POST", "PUT", "DELETE")
+}
+
+// A key-value request. It is authorized, accounted and logged like
+// a statement that accesses the keyspace with USE KEYS.
+type keysRequest struct {
+	server.BaseRequest
+	op           *keysOp
+	namespace    string
+	keyspace     string
+	single       bool
+	keys         []string
+	pairs        []value.Pair
+	results      []interface{}
+	resultSize   int
+	errs         []errors.Error
+	errorCodes   []int
+	httpRespCode int
+}
+
+func (this *HttpEndpoint) doKeys(w http.ResponseWriter, req *http.Request) {
+	vars := mux.Vars(req)
+	op := _KEYS_OPS[req.Method]
+	key, single := vars["key"]
+
+	var keys []string
+	var pairs []value.Pair
+	body, err := readKeysBody(w, req, this.server.RequestSizeCap())
+	if err == nil {
+		keys, pairs, err = getKeys(req, op, key, single, body)
+	}
+
+	var creds datastore.Credentials
+	if err == nil {
+		creds, err = getCredentials(&urlArgs{req: req}, req.Header["Authorization"])
+	}
+
+	statement := fmt.Sprintf("%s KEYS %s:%s", op.statementType, vars["namespace"], vars["keyspace"])
+	if len(keys) > 0 {
+		b, _ := json.Marshal(keys)
+		statement += " " + string(b)
+	}
+
+	request := &keysRequest{
+		op:        op,
+		namespace: vars["namespace"],
+		keyspace:  vars["keyspace"],
+		single:    single,
+		keys:      keys,
+		pairs:     pairs,
+	}
+	request.BaseRequest = *server.NewBaseRequest(statement, nil, nil, nil, request.namespace, 1,
+		value.NONE, value.TRUE, value.NONE, nil, "", creds)
+	request.SetStatementType(op.statementType)
+	request.SetPrivileges(datastore.Privileges{
+		request.namespace + ":" + request.keyspace: op.privilege,
+	})
+
+	if err == nil {
+		err = this.executeKeys(request)
+	}
+
+	if err != nil {
+		request.fail(err)
+	} else if len(request.errs) > 0 {
+		request.SetState(server.ERRORS)
+	} else {
+		request.SetState(server.SUCCESS)
+	}
+
+	request.write(w)
+	this.doKeysStats(request, req.RemoteAddr)
+}
+
+func readKeysBody(w http.ResponseWriter, req *http.Request, sizeCap int) ([]byte, errors.Error) {
+	if req.Method != "POST" && req.Method != "PUT" {
+		return nil, nil
+	}
+
+	if req.Body == nil {
+		return nil, errors.NewServiceErrorDecodeNil()
+	}
+
+	body, e := ioutil.ReadAll(http.MaxBytesReader(w, req.Body, int64(sizeCap)))
+	if e != nil {
+		return nil, errors.NewServiceErrorBadValue(e, "request body")
+	}
+
+	return body, nil
+}
+
+// The keys and, for inserts and upserts, the documents of the request
+func getKeys(req *http.Request, op *keysOp, key string, single bool, body []byte) (
+	[]string, []value.Pair, errors.Error) {
+	var doc value.Value
+	if body != nil {
+		doc = value.NewValue(body)
+		if doc.Type() == value.BINARY {
+			return nil, nil, errors.NewServiceErrorBadValue(nil, "document")
+		}
+	}
+
+	if single {
+		keys := []string{key}
+		if doc == nil {
+			return keys, nil, nil
+		}
+		return keys, []value.Pair{value.Pair{Name: key, Value: doc}}, nil
+	}
+
+	if doc != nil {
+		if doc.Type() != value.OBJECT {
+			return nil, nil, errors.NewServiceErrorTypeMismatch("request body", "object")
+		}
+
+		fields := doc.Fields()
+		keys := make([]string, 0, len(fields))
+		for k, _ := range fields {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+
+		pairs := make([]value.Pair, len(keys))
+		for i, k := range keys {
+			v, _ := doc.Field(k)
+			pairs[i] = value.Pair{Name: k, Value: v}
+		}
+		return keys, pairs, nil
+	}
+
+	if e := req.ParseForm(); e != nil {
+		return nil, nil, errors.NewServiceErrorBadValue(e, KEYS)
+	}
+
+	field, err := (&urlArgs{req: req}).formValue(KEYS)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if field == "" {
+		return nil, nil, errors.NewServiceErrorMissingValue(KEYS)
+	}
+
+	var keys []string
+	if e := json.Unmarshal([]byte(field), &keys); e != nil {
+		return nil, nil, errors.NewServiceErrorBadValue(e, KEYS)
+	}
+
+	return keys, nil, nil
+}
+
+func (this *HttpEndpoint) executeKeys(request *keysRequest) errors.Error {
+	if this.server.Readonly() && request.op.privilege != datastore.PRIV_READ {
+		return errors.NewServiceErrorReadonly("The server is read-only and cannot write documents.")
+	}
+
+	err := authorizeKeys(request.Privileges(), request.Credentials())
+	if err != nil {
+		return err
+	}
+
+	namespace, err := this.server.Datastore().NamespaceByName(request.namespace)
+	if err != nil {
+		request.httpRespCode = http.StatusNotFound
+		return err
+	}
+
+	keyspace, err := namespace.KeyspaceByName(request.keyspace)
+	if err != nil {
+		request.httpRespCode = http.StatusNotFound
+		return err
+	}
+
+	timer := time.Now()
+	defer func() {
+		request.AddPhaseTime(request.op.phase, time.Since(timer))
+	}()
+
+	switch request.op.statementType {
+	case "SELECT":
+		request.AddPhaseOperator(execution.FETCH)
+		pairs, errs := keyspace.Fetch(request.keys)
+		request.AddPhaseCount(execution.FETCH, uint64(len(pairs)))
+		for _, err := range errs {
+			if err.IsFatal() {
+				return err
+			}
+			request.errs = append(request.errs, err)
+		}
+
+		if request.single && len(pairs) == 0 && len(errs) == 0 {
+			request.httpRespCode = http.StatusNotFound
+			return errors.NewOtherKeyNotFoundError(nil, request.keys[0])
+		}
+
+		for _, pair := range pairs {
+			request.addResult(map[string]interface{}{
+				"id":    pair.Name,
+				"value": pair.Value,
+			})
+		}
+	case "INSERT", "UPSERT":
+		var pairs []value.Pair
+		if request.op.statementType == "INSERT" {
+			pairs, err = keyspace.Insert(request.pairs)
+		} else {
+			pairs, err = keyspace.Upsert(request.pairs)
+		}
+
+		request.AddMutationCount(uint64(len(pairs)))
+		for _, pair := range pairs {
+			request.addResult(map[string]interface{}{"id": pair.Name})
+		}
+
+		if err != nil {
+			return err
+		}
+	case "DELETE":
+		keys, err := keyspace.Delete(request.keys)
+		request.AddMutationCount(uint64(len(keys)))
+		for _, key := range keys {
+			request.addResult(map[string]interface{}{"id": key})
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Authorizes the request the way the Authorize operator authorizes
+// a statement
+func authorizeKeys(privileges datastore.Privileges, creds datastore.Credentials) errors.Error {
+	if store := auth.GetCredentialStore(); store != nil {
+		return auth.Authorize(store, privileges, creds)
+	}
+
+	if ds := datastore.GetDatastore(); ds != nil {
+		return ds.Authorize(privileges, creds)
+	}
+
+	return nil
+}
+
+func (this *keysRequest) addResult(result map[string]interface{}) {
+	b, _ := json.Marshal(result)
+	this.resultSize += len(b)
+	this.results = append(this.results, result)
+}
+
+func (this *keysRequest) fail(err errors.Error) {
+	this.errs = append(this.errs, err)
+	this.SetState(server.FATAL)
+	if this.httpRespCode == 0 {
+		this.httpRespCode = mapErrorToHttpResponse(err, http.StatusInternalServerError)
+	}
+}
+
+// Writes the response, in the layout of the response to a statement
+func (this *keysRequest) write(w http.ResponseWriter) {
+	metrics := map[string]interface{}{
+		"elapsedTime":   time.Since(this.RequestTime()).String(),
+		"executionTime": time.Since(this.ServiceTime()).String(),
+		"resultCount":   len(this.results),
+		"resultSize":    this.resultSize,
+	}
+
+	if count := this.MutationCount(); count > 0 {
+		metrics["mutationCount"] = count
+	}
+
+	if len(this.errs) > 0 {
+		metrics["errorCount"] = len(this.errs)
+	}
+
+	results := this.results
+	if results == nil {
+		results = []interface{}{}
+	}
+
+	response := map[string]interface{}{
+		"requestID": this.Id().String(),
+		"results":   results,
+		"status":    this.State(),
+		"metrics":   metrics,
+	}
+
+	if len(this.errs) > 0 {
+		response["errors"] = this.errs
+		for _, err := range this.errs {
+			this.errorCodes = append(this.errorCodes, int(err.Code()))
+		}
+	}
+
+	buf, e := json.MarshalIndent(response, "", "    ")
+	if e != nil {
+		http.Error(w, e.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	if this.httpRespCode == 0 {
+		this.httpRespCode = http.StatusOK
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(this.httpRespCode)
+	w.Write(buf)
+}
+
+func (this *HttpEndpoint) doKeysStats(request *keysRequest, clientAddress string) {
+	service_time := time.Since(request.ServiceTime())
+	request_time := time.Since(request.RequestTime())
+
+	if acctstore := this.server.AccountingStore(); acctstore != nil {
+		accounting.RecordMetrics(acctstore, request_time, service_time, len(request.results),
+			request.resultSize, len(request.errs), 0, request.Statement(), nil)
+	}
+	request.LogRequest(request_time, service_time, len(request.results),
+		request.resultSize, len(request.errs))
+
+	if audit.Enabled() {
+		audit.Record(newAuditEntry(&request.BaseRequest, clientAddress, request.State(),
+			request.errorCodes, request_time))
+	}
+}
@@ -40,7 +40,11 @@ type httpRequest struct {
 	resultCount     int
 	resultSize      int
 	errorCount      int
+	errorCodes      []int
 	warningCount    int
+	format          Format
+	compression     Compression
+	encoder         resultEncoder
 }
 
 func newHttpRequest(resp http.ResponseWriter, req *http.Request, bp BufferPool, size int) *httpRequest {
@@ -116,6 +120,11 @@ func newHttpRequest(resp http.ResponseWriter, req *http.Request, bp BufferPool,
 		timeout, err = httpArgs.getDuration(TIMEOUT)
 	}
 
+	var txId string
+	if err == nil {
+		txId, err = httpArgs.getString(TXID, "")
+	}
+
 	var max_parallelism int
 	if err == nil {
 		var maxp string
@@ -129,6 +138,19 @@ func newHttpRequest(resp http.ResponseWriter, req *http.Request, bp BufferPool,
 		}
 	}
 
+	var memory_quota int64
+	if err == nil {
+		var quota string
+		quota, err = httpArgs.getString(MEMORY_QUOTA, "")
+		if err == nil && quota != "" {
+			var e error
+			memory_quota, e = strconv.ParseInt(quota, 10, 64)
+			if e != nil || memory_quota < 0 {
+				err = errors.NewServiceErrorBadValue(e, "memory quota")
+			}
+		}
+	}
+
 	var readonly value.Tristate
 	if err == nil {
 		readonly, err = getReadonly(httpArgs, req.Method == "GET")
@@ -144,10 +166,6 @@ func newHttpRequest(resp http.ResponseWriter, req *http.Request, bp BufferPool,
 		format, err = getFormat(httpArgs)
 	}
 
-	if err == nil && format != JSON {
-		err = errors.NewServiceErrorNotImplemented("format", format.String())
-	}
-
 	var signature value.Tristate
 	if err == nil {
 		signature, err = httpArgs.getTristate(SIGNATURE)
@@ -158,7 +176,7 @@ func newHttpRequest(resp http.ResponseWriter, req *http.Request, bp BufferPool,
 		compression, err = getCompression(httpArgs)
 	}
 
-	if err == nil && compression != NONE {
+	if err == nil && compression != NONE && compression != ZIP && compression != DEFLATE {
 		err = errors.NewServiceErrorNotImplemented("compression", compression.String())
 	}
 
@@ -196,6 +214,11 @@ func newHttpRequest(resp http.ResponseWriter, req *http.Request, bp BufferPool,
 		client_id, err = getClientID(httpArgs)
 	}
 
+	profile := server.PROFILE_OFF
+	if err == nil {
+		profile, err = getProfile(httpArgs)
+	}
+
 	base := server.NewBaseRequest(statement, prepared, namedArgs, positionalArgs, namespace,
 		max_parallelism, readonly, metrics, signature, consistency, client_id, creds)
 
@@ -203,15 +226,30 @@ func newHttpRequest(resp http.ResponseWriter, req *http.Request, bp BufferPool,
 		BaseRequest: *base,
 		resp:        resp,
 		req:         req,
+		format:      format,
+		compression: compression,
 	}
 
 	rv.SetTimeout(rv, timeout)
+	rv.SetTxId(txId)
+	rv.SetMemoryQuota(memory_quota)
+	rv.SetProfile(profile)
 
-	rv.writer = NewBufferedWriter(rv, bp)
+	// Results in formats other than JSON are written by an encoder
+	rv.encoder = newResultEncoder(rv, format)
+	if rv.encoder != nil {
+		resp.Header().Set("Content-Type", rv.encoder.contentType())
+	}
 
 	// Abort if client closes connection; alternatively, return when request completes.
 	rv.httpCloseNotify = resp.(http.CloseNotifier).CloseNotify()
 
+	if compression != NONE {
+		rv.resp = newCompressedResponse(resp, compression)
+	}
+
+	rv.writer = NewBufferedWriter(rv, bp)
+
 	if err != nil {
 		rv.Fail(err)
 	}
@@ -240,6 +278,9 @@ const ( // Request argument names
 	SCAN_VECTORS      = "scan_vectors"
 	CREDS             = "creds"
 	CLIENT_CONTEXT_ID = "client_context_id"
+	TXID              = "txid"
+	MEMORY_QUOTA      = "memory_quota"
+	PROFILE           = "profile"
 )
 
 var _PARAMETERS = []string{
@@ -263,6 +304,9 @@ var _PARAMETERS = []string{
 	SIGNATURE,
 	PRETTY,
 	CLIENT_CONTEXT_ID,
+	TXID,
+	MEMORY_QUOTA,
+	PROFILE,
 }
 
 func isValidParameter(a string) bool {
@@ -396,6 +440,22 @@ func getFormat(a httpRequestArgs) (Format, errors.Error) {
 	return format, err
 }
 
+func getProfile(a httpRequestArgs) (server.Profile, errors.Error) {
+	profile_field, err := a.getString(PROFILE, "off")
+	if err != nil {
+		return server.PROFILE_OFF, err
+	}
+
+	switch strings.ToLower(profile_field) {
+	case "off", "":
+		return server.PROFILE_OFF, nil
+	case "timings":
+		return server.PROFILE_TIMINGS, nil
+	default:
+		return server.PROFILE_OFF, errors.NewServiceErrorUnrecognizedValue(PROFILE, profile_field)
+	}
+}
+
 func getReadonly(a httpRequestArgs, isGet bool) (value.Tristate, errors.Error) {
 	readonly, err := a.getTristate(READONLY)
 	if err == nil && isGet {
@@ -1112,6 +1172,7 @@ const (
 	RLE
 	LZMA
 	LZO
+	DEFLATE
 	UNDEFINED_COMPRESSION
 )
 
@@ -1119,8 +1180,10 @@ func newCompression(s string) Compression {
 	switch strings.ToUpper(s) {
 	case "NONE":
 		return NONE
-	case "ZIP":
+	case "ZIP", "GZIP":
 		return ZIP
+	case "DEFLATE":
+		return DEFLATE
 	case "RLE":
 		return RLE
 	case "LZMA":
@@ -1145,6 +1208,8 @@ func (c Compression) String() string {
 		s = "LZMA"
 	case LZO:
 		s = "LZO"
+	case DEFLATE:
+		s = "DEFLATE"
 	default:
 		s = "UNDEFINED_COMPRESSION"
 	}
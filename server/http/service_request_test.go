@@ -11,16 +11,21 @@ package http
 
 import (
 	"bytes"
+	"compress/gzip"
 	"encoding/json"
 	"fmt"
+	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
 	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 	"time"
 
+	"github.com/couchbase/query/accounting"
+	"github.com/couchbase/query/audit"
 	"github.com/couchbase/query/datastore"
 	"github.com/couchbase/query/datastore/resolver"
 	"github.com/couchbase/query/errors"
@@ -28,6 +33,7 @@ import (
 	"github.com/couchbase/query/plan"
 	"github.com/couchbase/query/timestamp"
 	"github.com/couchbase/query/value"
+	"github.com/gorilla/mux"
 
 	log_resolver "github.com/couchbase/query/logging/resolver"
 	"github.com/couchbase/query/server"
@@ -255,6 +261,307 @@ func TestRequestWithTimeout(t *testing.T) {
 	}
 }
 
+func TestCSVFormat(t *testing.T) {
+	res, err := doUrlEncodedPost(url.Values{
+		"statement": []string{`select 1 as a, {"x": "two, three", "y": [1, 2]} as b, null as c`},
+		"format":    []string{"csv"},
+		"metrics":   []string{"true"},
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error in HTTP request: %v", err)
+	}
+	body, _ := ioutil.ReadAll(res.Body)
+	res.Body.Close()
+
+	expected := "a,b.x,b.y,c\n1,\"two, three\",\"[1,2]\",\n"
+	if string(body) != expected {
+		t.Errorf("Expected CSV body %q, actual %q", expected, string(body))
+	}
+	if ct := res.Header.Get("Content-Type"); !strings.HasPrefix(ct, "text/csv") {
+		t.Errorf("Expected CSV content type, actual %v", ct)
+	}
+	if status := res.Trailer.Get(TRAILER_STATUS); status != string(server.SUCCESS) {
+		t.Errorf("Expected status trailer %v, actual %v", server.SUCCESS, status)
+	}
+	if res.Trailer.Get(TRAILER_METRICS) == "" {
+		t.Errorf("Expected metrics trailer")
+	}
+}
+
+func TestTSVFormat(t *testing.T) {
+	res, err := doUrlEncodedPost(url.Values{
+		"statement": []string{"select raw 1"},
+		"format":    []string{"tsv"},
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error in HTTP request: %v", err)
+	}
+	body, _ := ioutil.ReadAll(res.Body)
+	res.Body.Close()
+
+	expected := "$1\n1\n"
+	if string(body) != expected {
+		t.Errorf("Expected TSV body %q, actual %q", expected, string(body))
+	}
+}
+
+func TestXMLFormat(t *testing.T) {
+	res, err := doUrlEncodedPost(url.Values{
+		"statement": []string{`select 1 as a, ["x<y"] as b`},
+		"format":    []string{"xml"},
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error in HTTP request: %v", err)
+	}
+	body, _ := ioutil.ReadAll(res.Body)
+	res.Body.Close()
+
+	for _, s := range []string{"<results>", "<a>1</a>", "<item>x&lt;y</item>", "<status>success</status>"} {
+		if !strings.Contains(string(body), s) {
+			t.Errorf("Expected %v in XML body %s", s, body)
+		}
+	}
+}
+
+func TestProfile(t *testing.T) {
+	res, err := doUrlEncodedPost(url.Values{
+		"statement": []string{"select raw 1"},
+		"profile":   []string{"timings"},
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error in HTTP request: %v", err)
+	}
+	var body map[string]interface{}
+	err = json.NewDecoder(res.Body).Decode(&body)
+	res.Body.Close()
+	if err != nil {
+		t.Fatalf("Unexpected error decoding response: %v", err)
+	}
+
+	profile, ok := body["profile"].(map[string]interface{})
+	if !ok {
+		t.Fatalf("Expected profile in response %v", body)
+	}
+	timings, ok := profile["executionTimings"].(map[string]interface{})
+	if !ok || timings["#stats"] == nil {
+		t.Errorf("Expected operator statistics in profile %v", profile)
+	}
+
+	res, err = doUrlEncodedPost(url.Values{
+		"statement": []string{"select raw 1"},
+		"profile":   []string{"everything"},
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error in HTTP request: %v", err)
+	}
+	res.Body.Close()
+	if test_server.request().State() != server.FATAL {
+		t.Errorf("Expected request state: %v, actual: %v\n", server.FATAL, test_server.request().State())
+	}
+}
+
+func TestCompression(t *testing.T) {
+	u, _ := url.ParseRequestURI(test_server.URL())
+	u.Path = "/"
+	payload := url.Values{
+		"statement":   []string{"select 1 as a"},
+		"compression": []string{"zip"},
+	}
+	req, _ := http.NewRequest("POST", u.String(), bytes.NewBufferString(payload.Encode()))
+	req.Header.Add("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Add("Accept-Encoding", "gzip")
+	res, err := (&http.Client{}).Do(req)
+	if err != nil {
+		t.Fatalf("Unexpected error in HTTP request: %v", err)
+	}
+	defer res.Body.Close()
+
+	if enc := res.Header.Get("Content-Encoding"); enc != "gzip" {
+		t.Fatalf("Expected gzip content encoding, actual %v", enc)
+	}
+	r, err := gzip.NewReader(res.Body)
+	if err != nil {
+		t.Fatalf("Unexpected error reading compressed response: %v", err)
+	}
+	body, err := ioutil.ReadAll(r)
+	if err != nil {
+		t.Fatalf("Unexpected error reading compressed response: %v", err)
+	}
+
+	var response map[string]interface{}
+	if err = json.Unmarshal(body, &response); err != nil {
+		t.Fatalf("Unexpected error parsing response %s: %v", body, err)
+	}
+	if response["status"] != "success" {
+		t.Errorf("Expected status success, actual %v", response["status"])
+	}
+}
+
+func TestWorkloadClasses(t *testing.T) {
+	var settings interface{}
+	json.Unmarshal([]byte(`[{"name": "reports", "client_context_ids": ["report-*"], "max_concurrency": 1}]`), &settings)
+	classes, err := server.NewWorkloadClasses(settings)
+	if err != nil {
+		t.Fatalf("Unexpected error in workload classes: %v", err)
+	}
+
+	json.Unmarshal([]byte(`[{"name": "reports", "servicers": 0}]`), &settings)
+	if _, err = server.NewWorkloadClasses(settings); err == nil {
+		t.Errorf("Expected error for workload class without servicers")
+	}
+
+	srvr := test_server.query_server
+	srvr.SetWorkloadClasses(classes)
+	defer srvr.SetWorkloadClasses(nil)
+
+	admitted := make(chan *httpRequest, 1)
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		request := newHttpRequest(w, r, NewSyncPool(1024), 1024)
+		class, err := srvr.AdmitWorkload(request)
+		if err != nil {
+			request.Fail(err)
+			request.Failed(srvr)
+		} else if class != nil {
+			// Not released here, so that the user stays at the
+			// maximum concurrency of the class
+			<-request.CloseNotify()
+			admitted <- request
+		}
+	}))
+	defer ts.Close()
+
+	post := func(clientId string) int {
+		res, err := http.PostForm(ts.URL, url.Values{
+			"statement":         []string{"select 1"},
+			"client_context_id": []string{clientId},
+		})
+		if err != nil {
+			t.Fatalf("Unexpected error in HTTP request: %v", err)
+		}
+		ioutil.ReadAll(res.Body)
+		res.Body.Close()
+		return res.StatusCode
+	}
+
+	if code := post("report-1"); code != http.StatusOK {
+		t.Errorf("Expected status %v, actual %v", http.StatusOK, code)
+	}
+	request := <-admitted
+
+	if code := post("report-2"); code != http.StatusTooManyRequests {
+		t.Errorf("Expected status %v, actual %v", http.StatusTooManyRequests, code)
+	}
+
+	classes[0].Release(request)
+	if code := post("report-3"); code != http.StatusOK {
+		t.Errorf("Expected status %v, actual %v", http.StatusOK, code)
+	}
+	classes[0].Release(<-admitted)
+}
+
+func TestAudit(t *testing.T) {
+	dir, e := ioutil.TempDir("", "audit")
+	if e != nil {
+		t.Fatalf("Unexpected error creating temp dir: %v", e)
+	}
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "audit.log")
+	err := setAuditSettings(map[string]interface{}{
+		_AUDITSINK:   "file:" + path + "?max_size=1024&max_files=2",
+		_AUDITREDACT: true,
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error in audit settings: %v", err)
+	}
+	defer setAuditSettings(map[string]interface{}{
+		_AUDITSINK:   "",
+		_AUDITREDACT: false,
+		_AUDITTYPES:  []interface{}{},
+	})
+
+	srvr := test_server.query_server
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		request := newHttpRequest(w, r, NewSyncPool(1024), 1024)
+		if request.State() == server.FATAL {
+			request.Failed(srvr)
+		} else {
+			srvr.Channel() <- request
+			<-request.CloseNotify()
+		}
+		auditRequest(request, time.Since(request.RequestTime()))
+	}))
+	defer ts.Close()
+
+	post := func(values url.Values) {
+		res, err := http.PostForm(ts.URL, values)
+		if err != nil {
+			t.Fatalf("Unexpected error in HTTP request: %v", err)
+		}
+		ioutil.ReadAll(res.Body)
+		res.Body.Close()
+	}
+
+	entries := func(path string) []map[string]interface{} {
+		b, e := ioutil.ReadFile(path)
+		if e != nil {
+			t.Fatalf("Unexpected error reading audit trail: %v", e)
+		}
+		var rv []map[string]interface{}
+		for _, line := range strings.Split(strings.TrimSpace(string(b)), "\n") {
+			var entry map[string]interface{}
+			if e := json.Unmarshal([]byte(line), &entry); e != nil {
+				t.Fatalf("Unexpected error in audit entry %s: %v", line, e)
+			}
+			rv = append(rv, entry)
+		}
+		return rv
+	}
+
+	post(url.Values{"statement": []string{"select $1"}, "args": []string{"[\"secret\"]"}})
+	post(url.Values{"statement": []string{"selec 1"}})
+
+	logged := entries(path)
+	if len(logged) != 2 {
+		t.Fatalf("Expected 2 audit entries, actual %v", logged)
+	}
+	if logged[0]["statementType"] != "SELECT" || logged[0]["status"] != "success" ||
+		fmt.Sprint(logged[0]["positionalArgs"]) != "[<redacted>]" {
+		t.Errorf("Unexpected audit entry %v", logged[0])
+	}
+	if logged[1]["status"] != "fatal" || fmt.Sprint(logged[1]["errorCodes"]) != "[3000]" {
+		t.Errorf("Unexpected audit entry %v", logged[1])
+	}
+
+	// Filtered statement types are not recorded, unless authorization failed
+	setAuditSettings(map[string]interface{}{_AUDITTYPES: []interface{}{"dml"}})
+	post(url.Values{"statement": []string{"select 1"}})
+	audit.Record(&audit.Entry{StatementType: "SELECT", ErrorCodes: []int{errors.AUTHORIZATION_ERROR}})
+	if logged = entries(path); len(logged) != 3 || logged[2]["statementType"] != "SELECT" {
+		t.Errorf("Unexpected audit entries %v", logged)
+	}
+
+	// The trail is rotated at its maximum size
+	for i := 0; i < 20; i++ {
+		audit.Record(&audit.Entry{StatementType: "INSERT", Statement: strings.Repeat("x", 100)})
+	}
+	if _, e := os.Stat(path + ".2"); e != nil {
+		t.Errorf("Expected rotated audit trail: %v", e)
+	}
+	if _, e := os.Stat(path + ".3"); e == nil {
+		t.Errorf("Expected at most 2 rotated audit trails")
+	}
+
+	settings := fillAuditSettings(map[string]interface{}{})
+	if settings[_AUDITENABLED] != true || settings[_AUDITSINK] != "file:"+path {
+		t.Errorf("Unexpected audit settings %v", settings)
+	}
+	setAuditSettings(map[string]interface{}{_AUDITENABLED: false})
+	if audit.Enabled() {
+		t.Errorf("Expected auditing to be disabled")
+	}
+}
+
 func TestPrepareStatements(t *testing.T) {
 	preparedSequence(t, "doSelect", "SELECT b FROM p0:b0 LIMIT 5")
 	preparedSequence(t, "doInsert", "INSERT INTO p0:b0 VALUES ($1, $2)")
@@ -368,6 +675,104 @@ func doUrlRequest(t *testing.T, params map[string]string) {
 	}
 }
 
+func TestKeys(t *testing.T) {
+	dir, e := ioutil.TempDir("", "keys")
+	if e != nil {
+		t.Fatalf("Unexpected error creating temp dir: %v", e)
+	}
+	defer os.RemoveAll(dir)
+
+	if e := os.MkdirAll(filepath.Join(dir, "default", "things"), 0755); e != nil {
+		t.Fatalf("Unexpected error creating keyspace: %v", e)
+	}
+
+	store, err := resolver.NewDatastore("dir:" + dir)
+	if err != nil {
+		t.Fatalf("Unexpected error creating datastore: %v", err)
+	}
+
+	srvr, err := server.NewServer(store, nil, nil, nil, "default",
+		false, make(server.RequestChannel, 10), make(server.RequestChannel, 10),
+		4, 4, 0, 0, false, false, false)
+	if err != nil {
+		t.Fatalf("Unexpected error creating server: %v", err)
+	}
+	srvr.SetRequestSizeCap(1 << 20)
+	accounting.RequestsInit(0, 8)
+
+	endpoint := &HttpEndpoint{server: srvr, mux: mux.NewRouter()}
+	endpoint.registerKeysHandlers()
+	ts := httptest.NewServer(endpoint.mux)
+	defer ts.Close()
+
+	do := func(method, path, body string) (int, map[string]interface{}) {
+		req, e := http.NewRequest(method, ts.URL+keysPrefix+path, strings.NewReader(body))
+		if e != nil {
+			t.Fatalf("Unexpected error creating HTTP request: %v", e)
+		}
+		res, e := http.DefaultClient.Do(req)
+		if e != nil {
+			t.Fatalf("Unexpected error in HTTP request: %v", e)
+		}
+		defer res.Body.Close()
+
+		var rv map[string]interface{}
+		b, _ := ioutil.ReadAll(res.Body)
+		if e := json.Unmarshal(b, &rv); e != nil {
+			t.Fatalf("Unexpected error in response %s: %v", b, e)
+		}
+		return res.StatusCode, rv
+	}
+
+	code, res := do("PUT", "/default/things/a", `{"name": "a"}`)
+	if code != http.StatusOK || fmt.Sprint(res["results"]) != "[map[id:a]]" {
+		t.Errorf("Unexpected response to upsert %v %v", code, res)
+	}
+
+	code, res = do("POST", "/default/things", `{"c": {"name": "c"}, "b": {"name": "b"}}`)
+	if code != http.StatusOK || fmt.Sprint(res["results"]) != "[map[id:b] map[id:c]]" {
+		t.Errorf("Unexpected response to insert %v %v", code, res)
+	}
+	if metrics, _ := res["metrics"].(map[string]interface{}); metrics["mutationCount"] != 2.0 {
+		t.Errorf("Expected mutation count 2, actual %v", res["metrics"])
+	}
+
+	code, res = do("POST", "/default/things/a", `{"name": "again"}`)
+	if code == http.StatusOK || res["status"] != "fatal" || res["errors"] == nil {
+		t.Errorf("Expected insert of existing key to fail, actual %v %v", code, res)
+	}
+
+	code, res = do("GET", "/default/things/a", "")
+	if code != http.StatusOK || fmt.Sprint(res["results"]) != "[map[id:a value:map[name:a]]]" {
+		t.Errorf("Unexpected response to fetch %v %v", code, res)
+	}
+
+	code, res = do("GET", "/default/things?keys="+url.QueryEscape(`["b", "c", "d"]`), "")
+	if code != http.StatusOK || len(res["results"].([]interface{})) != 2 {
+		t.Errorf("Unexpected response to fetch of keys %v %v", code, res)
+	}
+
+	code, res = do("DELETE", "/default/things/b", "")
+	if code != http.StatusOK || fmt.Sprint(res["results"]) != "[map[id:b]]" {
+		t.Errorf("Unexpected response to delete %v %v", code, res)
+	}
+
+	code, res = do("GET", "/default/things/b", "")
+	if code != http.StatusNotFound || res["status"] != "fatal" {
+		t.Errorf("Expected missing key to be not found, actual %v %v", code, res)
+	}
+
+	code, res = do("GET", "/default/nothings/a", "")
+	if code != http.StatusNotFound {
+		t.Errorf("Expected missing keyspace to be not found, actual %v %v", code, res)
+	}
+
+	code, res = do("GET", "/default/things", "")
+	if code != http.StatusBadRequest {
+		t.Errorf("Expected missing keys to be a bad request, actual %v %v", code, res)
+	}
+}
+
 func makeMockServer() *server.Server {
 	store, err := resolver.NewDatastore("mock:")
 	if err != nil {
@@ -11,6 +11,8 @@ package http
 
 import (
 	"bytes"
+	"compress/gzip"
+	"compress/zlib"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -61,6 +63,8 @@ func mapErrorToHttpResponse(err errors.Error, def int) int {
 		return http.StatusBadRequest
 	case 1120:
 		return http.StatusNotAcceptable
+	case 1170: // workload class saturated
+		return http.StatusTooManyRequests
 	case 3000: // parse error range
 		return http.StatusBadRequest
 	case 4000, errors.NO_SUCH_PREPARED: // plan error range
@@ -91,6 +95,12 @@ func (this *httpRequest) setHttpCode(httpRespCode int) {
 func (this *httpRequest) Failed(srvr *server.Server) {
 	defer this.stopAndClose(server.FATAL)
 
+	if this.encoder != nil {
+		this.encoder.writeSuffix(srvr.Metrics(), "")
+		this.writer.noMoreData()
+		return
+	}
+
 	this.writeString("{\n")
 	this.writeRequestID()
 	this.writeClientContextID()
@@ -130,6 +140,10 @@ func (this *httpRequest) stopAndClose(state server.State) {
 }
 
 func (this *httpRequest) writePrefix(srvr *server.Server, signature value.Value) bool {
+	if this.encoder != nil {
+		return this.encoder.writePrefix(srvr.Signature(), signature)
+	}
+
 	return this.writeString("{\n") &&
 		this.writeRequestID() &&
 		this.writeClientContextID() &&
@@ -199,6 +213,18 @@ func (this *httpRequest) writeResults() bool {
 func (this *httpRequest) writeResult(item value.Value) bool {
 	var success bool
 
+	if this.encoder != nil {
+		var size int
+
+		size, success = this.encoder.writeResult(item)
+		this.resultSize += size
+		this.resultCount++
+		if !success {
+			this.SetState(server.CLOSED)
+		}
+		return success
+	}
+
 	bytes, err := json.MarshalIndent(item, "        ", "    ")
 	if err != nil {
 		this.Errors() <- errors.NewServiceErrorInvalidJSON(err)
@@ -236,19 +262,50 @@ func (this *httpRequest) writeValue(item value.Value) bool {
 }
 
 func (this *httpRequest) writeSuffix(metrics bool, state server.State) bool {
+	if this.encoder != nil {
+		return this.encoder.writeSuffix(metrics, state)
+	}
+
 	return this.writeString("\n    ]") &&
 		this.writeErrors() &&
 		this.writeWarnings() &&
 		this.writeState(state) &&
 		this.writeMetrics(metrics) &&
+		this.writeProfile() &&
 		this.writeString("\n}\n")
 }
 
+// writeProfile writes the plan annotated with the statistics of its
+// operators, if the request asked for it
+func (this *httpRequest) writeProfile() bool {
+	if this.Profile() != server.PROFILE_TIMINGS {
+		return true
+	}
+
+	profile := this.ExecutionProfile()
+	if profile == nil {
+		return true
+	}
+
+	e, err := json.MarshalIndent(profile, "        ", "    ")
+	if err != nil {
+		logging.Infop("Error writing profile", logging.Pair{"error", err})
+		return true
+	}
+
+	return this.writeString(fmt.Sprintf(",\n    \"profile\": {\n        \"executionTimings\": %s\n    }", e))
+}
+
 func (this *httpRequest) writeString(s string) bool {
 	return this.writer.writeString(s)
 }
 
 func (this *httpRequest) writeState(state server.State) bool {
+	return this.writeString(fmt.Sprintf(",\n    \"status\": \"%s\"", this.resultState(state)))
+}
+
+// resultState returns the status reported to the client for the given state
+func (this *httpRequest) resultState(state server.State) server.State {
 	if state == "" {
 		state = this.State()
 	}
@@ -261,7 +318,7 @@ func (this *httpRequest) writeState(state server.State) bool {
 		}
 	}
 
-	return this.writeString(fmt.Sprintf(",\n    \"status\": \"%s\"", state))
+	return state
 }
 
 func (this *httpRequest) writeErrors() bool {
@@ -287,6 +344,7 @@ loop:
 				}
 				ok = this.writeError(err, this.errorCount)
 				this.errorCount++
+				this.errorCodes = append(this.errorCodes, int(err.Code()))
 			}
 		default:
 			break loop
@@ -318,6 +376,62 @@ loop:
 	return this.warningCount == 0 || this.writeString("\n    ]")
 }
 
+// collectErrors drains the errors channel, for encoders that report
+// errors after the results have been written
+func (this *httpRequest) collectErrors() []errors.Error {
+	var errs []errors.Error
+	var err errors.Error
+	ok := true
+loop:
+	for ok {
+		select {
+		case err, ok = <-this.Errors():
+			if ok {
+				if this.errorCount == 0 && this.State() != server.FATAL {
+					this.setHttpCode(mapErrorToHttpResponse(err, http.StatusOK))
+				}
+				errs = append(errs, err)
+				this.errorCount++
+				this.errorCodes = append(this.errorCodes, int(err.Code()))
+			}
+		default:
+			break loop
+		}
+	}
+	return errs
+}
+
+// collectWarnings drains the warnings channel
+func (this *httpRequest) collectWarnings() []errors.Error {
+	var warnings []errors.Error
+	var err errors.Error
+	ok := true
+loop:
+	for ok {
+		select {
+		case err, ok = <-this.Warnings():
+			if ok {
+				warnings = append(warnings, err)
+				this.warningCount++
+			}
+		default:
+			break loop
+		}
+	}
+	return warnings
+}
+
+func errorsToMaps(errs []errors.Error) []map[string]interface{} {
+	rv := make([]map[string]interface{}, len(errs))
+	for i, err := range errs {
+		rv[i] = map[string]interface{}{
+			"code": err.Code(),
+			"msg":  err.Error(),
+		}
+	}
+	return rv
+}
+
 func (this *httpRequest) writeError(err errors.Error, count int) bool {
 	var rv bool
 	if count == 0 {
@@ -352,6 +466,7 @@ func (this *httpRequest) writeMetrics(metrics bool) bool {
 	rv := this.writeString(",\n    \"metrics\": {") &&
 		this.writeString(fmt.Sprintf("\n        \"elapsedTime\": \"%v\"", tr)) &&
 		this.writeString(fmt.Sprintf(",\n        \"executionTime\": \"%v\"", ts)) &&
+		this.writeString(fmt.Sprintf(",\n        \"queueWaitTime\": \"%v\"", this.QueueWaitTime())) &&
 		this.writeString(fmt.Sprintf(",\n        \"resultCount\": %d", this.resultCount)) &&
 		this.writeString(fmt.Sprintf(",\n        \"resultSize\": %d", this.resultSize))
 
@@ -363,6 +478,20 @@ func (this *httpRequest) writeMetrics(metrics bool) bool {
 		rv = rv && this.writeString(fmt.Sprintf(",\n        \"sortCount\": %d", this.SortCount()))
 	}
 
+	if this.SpillCount() > 0 {
+		rv = rv && this.writeString(fmt.Sprintf(",\n        \"spillCount\": %d", this.SpillCount())) &&
+			this.writeString(fmt.Sprintf(",\n        \"spillSize\": %d", this.SpillSize()))
+	}
+
+	if this.GroupSpillCount() > 0 {
+		rv = rv && this.writeString(fmt.Sprintf(",\n        \"groupSpillCount\": %d", this.GroupSpillCount())) &&
+			this.writeString(fmt.Sprintf(",\n        \"groupSpillSize\": %d", this.GroupSpillSize()))
+	}
+
+	if this.PeakMemory() > 0 {
+		rv = rv && this.writeString(fmt.Sprintf(",\n        \"peakMemory\": %d", this.PeakMemory()))
+	}
+
 	if this.errorCount > 0 {
 		rv = rv && this.writeString(fmt.Sprintf(",\n        \"errorCount\": %d", this.errorCount))
 	}
@@ -387,6 +516,70 @@ func (this *httpRequest) writeMetrics(metrics bool) bool {
 	return rv && this.writeString("\n    }")
 }
 
+// metricsMap returns the request metrics as a map, or nil if
+// metrics are not to be reported
+func (this *httpRequest) metricsMap(metrics bool) map[string]interface{} {
+	m := this.Metrics()
+	if m == value.FALSE ||
+		(m == value.NONE && !metrics) {
+		return nil
+	}
+
+	rv := map[string]interface{}{
+		"elapsedTime":   time.Since(this.RequestTime()).String(),
+		"executionTime": time.Since(this.ServiceTime()).String(),
+		"queueWaitTime": this.QueueWaitTime().String(),
+		"resultCount":   this.resultCount,
+		"resultSize":    this.resultSize,
+	}
+
+	if this.MutationCount() > 0 {
+		rv["mutationCount"] = this.MutationCount()
+	}
+
+	if this.SortCount() > 0 {
+		rv["sortCount"] = this.SortCount()
+	}
+
+	if this.SpillCount() > 0 {
+		rv["spillCount"] = this.SpillCount()
+		rv["spillSize"] = this.SpillSize()
+	}
+
+	if this.GroupSpillCount() > 0 {
+		rv["groupSpillCount"] = this.GroupSpillCount()
+		rv["groupSpillSize"] = this.GroupSpillSize()
+	}
+
+	if this.PeakMemory() > 0 {
+		rv["peakMemory"] = this.PeakMemory()
+	}
+
+	if this.errorCount > 0 {
+		rv["errorCount"] = this.errorCount
+	}
+
+	if this.warningCount > 0 {
+		rv["warningCount"] = this.warningCount
+	}
+
+	return rv
+}
+
+// hasContentLength reports whether the response can carry a Content-Length
+// header: compressed responses have a different length, and responses
+// carrying trailers must be chunked
+func (this *httpRequest) hasContentLength() bool {
+	return this.compression == NONE && (this.encoder == nil || !this.encoder.usesTrailers())
+}
+
+// closeResponse flushes out any data held by a compressing response writer
+func (this *httpRequest) closeResponse() {
+	if c, ok := this.resp.(io.Closer); ok {
+		c.Close()
+	}
+}
+
 // responseDataManager is an interface for managing response data. It is used by httpRequest to take care of
 // the data in a response.
 type responseDataManager interface {
@@ -450,11 +643,14 @@ func (this *bufferedWriter) noMoreData() {
 	w := this.req.resp // our request's response writer
 	r := this.req.req  // our request's http request
 	// calculate and set the Content-Length header:
-	content_len := strconv.Itoa(len(this.buffer.Bytes()))
-	w.Header().Set("Content-Length", content_len)
+	if this.req.hasContentLength() {
+		content_len := strconv.Itoa(len(this.buffer.Bytes()))
+		w.Header().Set("Content-Length", content_len)
+	}
 	// write response header and data buffered so far:
 	w.WriteHeader(this.req.httpCode())
 	io.Copy(w, this.buffer)
+	this.req.closeResponse()
 	// no more data in the response => return buffer to pool:
 	this.buffer_pool.PutBuffer(this.buffer)
 	r.Body.Close()
@@ -498,6 +694,65 @@ func (this *directWriter) noMoreData() {
 		return
 	}
 	r := this.req.req // our request's http request
+	this.req.closeResponse()
 	r.Body.Close()
 	this.closed = true
 }
+
+// compressedResponse is an http.ResponseWriter that compresses the
+// response body according to the request's compression parameter
+type compressedResponse struct {
+	http.ResponseWriter
+	encoding    string
+	compressor  compressor
+	wroteHeader bool
+}
+
+type compressor interface {
+	io.WriteCloser
+	Flush() error
+}
+
+func newCompressedResponse(w http.ResponseWriter, compression Compression) *compressedResponse {
+	rv := &compressedResponse{
+		ResponseWriter: w,
+	}
+	switch compression {
+	case DEFLATE:
+		rv.encoding = "deflate"
+		rv.compressor = zlib.NewWriter(w)
+	default:
+		rv.encoding = "gzip"
+		rv.compressor = gzip.NewWriter(w)
+	}
+	return rv
+}
+
+func (this *compressedResponse) WriteHeader(code int) {
+	if !this.wroteHeader {
+		h := this.Header()
+		h.Del("Content-Length")
+		h.Set("Content-Encoding", this.encoding)
+		this.wroteHeader = true
+	}
+	this.ResponseWriter.WriteHeader(code)
+}
+
+func (this *compressedResponse) Write(b []byte) (int, error) {
+	if !this.wroteHeader {
+		this.WriteHeader(http.StatusOK)
+	}
+	return this.compressor.Write(b)
+}
+
+func (this *compressedResponse) Flush() {
+	this.compressor.Flush()
+	this.ResponseWriter.(http.Flusher).Flush()
+}
+
+func (this *compressedResponse) Close() error {
+	if !this.wroteHeader {
+		this.WriteHeader(http.StatusOK)
+	}
+	return this.compressor.Close()
+}
@@ -0,0 +1,142 @@
+//  Copyright (c) 2016 Couchbase, Inc.
+//  Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file
+//  except in compliance with the License. You may obtain a copy of the License at
+//    http://www.apache.org/licenses/LICENSE-2.0
+//  Unless required by applicable law or agreed to in writing, software distributed under the
+//  License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+//  either express or implied. See the License for the specific language governing permissions
+//  and limitations under the License.
+
+package server
+
+import (
+	"sync"
+
+	"github.com/couchbase/query/errors"
+	"github.com/couchbase/query/execution"
+	"github.com/couchbase/query/plan"
+	"github.com/couchbase/query/value"
+)
+
+// An index chosen by a cached plan may be dropped after the plan is
+// taken from the cache. replanOutput holds back the resulting index
+// not found error, and the end of the results, as long as no results
+// have been returned, so that the statement can be planned again.
+type replanOutput struct {
+	execution.Output
+	sync.Mutex
+	sent    bool
+	missing errors.Error
+}
+
+func newReplanOutput(output execution.Output) *replanOutput {
+	return &replanOutput{Output: output}
+}
+
+func (this *replanOutput) Result(item value.Value) bool {
+	this.Lock()
+	missing := this.missing
+	this.sent = this.sent || missing == nil
+	this.Unlock()
+
+	if missing != nil {
+		return false
+	}
+
+	return this.Output.Result(item)
+}
+
+func (this *replanOutput) CloseResults() {
+	if this.indexMissing() == nil {
+		this.Output.CloseResults()
+	}
+}
+
+func (this *replanOutput) Fatal(err errors.Error) {
+	if !this.holdBack(err) {
+		this.Output.Fatal(err)
+	}
+}
+
+func (this *replanOutput) Error(err errors.Error) {
+	if !this.holdBack(err) {
+		this.Output.Error(err)
+	}
+}
+
+func (this *replanOutput) holdBack(err errors.Error) bool {
+	if !indexNotFound(err) {
+		return false
+	}
+
+	this.Lock()
+	defer this.Unlock()
+
+	if this.sent {
+		return false
+	}
+
+	if this.missing == nil {
+		this.missing = err
+	}
+
+	return true
+}
+
+// Returns the index not found error held back, if any.
+func (this *replanOutput) indexMissing() errors.Error {
+	this.Lock()
+	defer this.Unlock()
+	return this.missing
+}
+
+// Returns the error held back, and the end of the results, to the
+// request, when the statement cannot be planned again.
+func (this *replanOutput) release() {
+	this.Output.Error(this.indexMissing())
+	this.Output.CloseResults()
+}
+
+func indexNotFound(err errors.Error) bool {
+	return err.Code() == errors.INDEX_NOT_FOUND || err.Code() == errors.FILE_INDEX_NOT_FOUND
+}
+
+// Plans the statement of the request again, once an index of its
+// cached plan was not found, and runs the new plan. stop is the stop
+// channel of the first plan, which the request notifies.
+func (this *Server) replan(request Request, namespace string, prepared *plan.Prepared,
+	stop execution.StopChannel, output *replanOutput) {
+	plan.EvictCachedPlan(request.Statement(), namespace, prepared)
+	prepared.IndexerVersions().Refresh()
+
+	if request.State() != RUNNING {
+		output.release()
+		return
+	}
+
+	prepared, err := this.getPrepared(request, namespace)
+	if err != nil {
+		output.release()
+		return
+	}
+
+	context := this.newContext(request, namespace, prepared, nil, request.Output())
+	operator, er := execution.Build(prepared, context)
+	if er != nil {
+		output.release()
+		return
+	}
+
+	done := make(chan bool)
+	defer close(done)
+
+	go func() {
+		select {
+		case <-stop:
+			sendStop(operator.StopChannel())
+		case <-done:
+		}
+	}()
+
+	operator.RunOnce(context, nil)
+}
@@ -49,11 +49,15 @@ type Request interface {
 	Statement() string
 	Prepared() *plan.Prepared
 	SetPrepared(prepared *plan.Prepared)
+	StatementType() string
+	SetStatementType(stmtType string)
 	NamedArgs() map[string]value.Value
 	PositionalArgs() value.Values
 	Namespace() string
 	Timeout() time.Duration
+	TxId() string
 	MaxParallelism() int
+	MemoryQuota() int64
 	Readonly() value.Tristate
 	Metrics() value.Tristate
 	Signature() value.Tristate
@@ -74,6 +78,8 @@ type Request interface {
 	Credentials() datastore.Credentials
 	SetTimings(p plan.Operator)
 	GetTimings() plan.Operator
+	Profile() Profile
+	SetProfile(profile Profile)
 }
 
 type RequestID interface {
@@ -95,6 +101,15 @@ const (
 	UNDEFINED_CONSISTENCY
 )
 
+// Whether the statistics of the operators of a request are collected
+// and returned with its results
+type Profile int
+
+const (
+	PROFILE_OFF Profile = iota
+	PROFILE_TIMINGS
+)
+
 type ScanConfiguration interface {
 	ScanConsistency() datastore.ScanConsistency
 	ScanWait() time.Duration
@@ -131,19 +146,28 @@ func SetActives(ar ActiveRequests) {
 type BaseRequest struct {
 	// Aligned ints need to be delared right at the top
 	// of the struct to avoid alignment issues on x86 platforms
-	mutationCount atomic.AlignedUint64
-	sortCount     atomic.AlignedUint64
+	mutationCount   atomic.AlignedUint64
+	sortCount       atomic.AlignedUint64
+	spillCount      atomic.AlignedUint64
+	spillSize       atomic.AlignedUint64
+	groupSpillCount atomic.AlignedUint64
+	groupSpillSize  atomic.AlignedUint64
+	peakMemory      atomic.AlignedUint64
 
 	sync.RWMutex
 	id             *requestIDImpl
 	client_id      *clientContextIDImpl
 	statement      string
 	prepared       *plan.Prepared
+	stmtType       string
+	privileges     datastore.Privileges
 	namedArgs      map[string]value.Value
 	positionalArgs value.Values
 	namespace      string
 	timeout        time.Duration
+	txId           string
 	maxParallelism int
+	memoryQuota    int64
 	readonly       value.Tristate
 	signature      value.Tristate
 	metrics        value.Tristate
@@ -162,6 +186,8 @@ type BaseRequest struct {
 	stopResult     chan bool // stop consuming results
 	stopExecute    chan bool // stop executing request
 	timings        plan.Operator
+	profile        Profile
+	profiler       *execution.Profiler
 }
 
 type requestIDImpl struct {
@@ -277,10 +303,29 @@ func (this *BaseRequest) Timeout() time.Duration {
 	return this.timeout
 }
 
+// Sets the id of the transaction within which the request runs.
+func (this *BaseRequest) SetTxId(txId string) {
+	this.txId = txId
+}
+
+func (this *BaseRequest) TxId() string {
+	return this.txId
+}
+
 func (this *BaseRequest) MaxParallelism() int {
 	return this.maxParallelism
 }
 
+// Sets the memory quota, in bytes, of the request. Zero leaves the
+// server default in effect.
+func (this *BaseRequest) SetMemoryQuota(size int64) {
+	this.memoryQuota = size
+}
+
+func (this *BaseRequest) MemoryQuota() int64 {
+	return this.memoryQuota
+}
+
 func (this *BaseRequest) Readonly() value.Tristate {
 	return this.readonly
 }
@@ -321,6 +366,31 @@ func (this *BaseRequest) SetPrepared(prepared *plan.Prepared) {
 	this.prepared = prepared
 }
 
+func (this *BaseRequest) StatementType() string {
+	this.RLock()
+	defer this.RUnlock()
+	return this.stmtType
+}
+
+func (this *BaseRequest) SetStatementType(stmtType string) {
+	this.Lock()
+	defer this.Unlock()
+	this.stmtType = stmtType
+}
+
+// The privileges checked before executing the request
+func (this *BaseRequest) Privileges() datastore.Privileges {
+	this.RLock()
+	defer this.RUnlock()
+	return this.privileges
+}
+
+func (this *BaseRequest) SetPrivileges(privileges datastore.Privileges) {
+	this.Lock()
+	defer this.Unlock()
+	this.privileges = privileges
+}
+
 func (this *BaseRequest) SetState(state State) {
 
 	// Once we transition to TIMEOUT or CLOSE, we don't transition
@@ -360,6 +430,11 @@ func (this *BaseRequest) CloseNotify() chan bool {
 	return this.closeNotify
 }
 
+// Time spent waiting on a queue before being serviced
+func (this *BaseRequest) QueueWaitTime() time.Duration {
+	return this.serviceTime.Sub(this.requestTime)
+}
+
 func (this *BaseRequest) Servicing() {
 	this.serviceTime = time.Now()
 }
@@ -419,6 +494,40 @@ func (this *BaseRequest) SortCount() uint64 {
 	return atomic.LoadUint64(&this.sortCount)
 }
 
+func (this *BaseRequest) AddSortSpill(size uint64) {
+	atomic.AddUint64(&this.spillCount, 1)
+	atomic.AddUint64(&this.spillSize, size)
+}
+
+func (this *BaseRequest) SpillCount() uint64 {
+	return atomic.LoadUint64(&this.spillCount)
+}
+
+func (this *BaseRequest) SpillSize() uint64 {
+	return atomic.LoadUint64(&this.spillSize)
+}
+
+func (this *BaseRequest) AddGroupSpill(size uint64) {
+	atomic.AddUint64(&this.groupSpillCount, 1)
+	atomic.AddUint64(&this.groupSpillSize, size)
+}
+
+func (this *BaseRequest) GroupSpillCount() uint64 {
+	return atomic.LoadUint64(&this.groupSpillCount)
+}
+
+func (this *BaseRequest) GroupSpillSize() uint64 {
+	return atomic.LoadUint64(&this.groupSpillSize)
+}
+
+func (this *BaseRequest) SetPeakMemory(size uint64) {
+	atomic.StoreUint64(&this.peakMemory, size)
+}
+
+func (this *BaseRequest) PeakMemory() uint64 {
+	return atomic.LoadUint64(&this.peakMemory)
+}
+
 func (this *BaseRequest) AddPhaseCount(p execution.Phases, c uint64) {
 	atomic.AddUint64(&this.phaseStats[p].count, c)
 }
@@ -493,6 +602,39 @@ func (this *BaseRequest) GetTimings() plan.Operator {
 	return this.timings
 }
 
+func (this *BaseRequest) Profile() Profile {
+	return this.profile
+}
+
+func (this *BaseRequest) SetProfile(profile Profile) {
+	this.profile = profile
+}
+
+func (this *BaseRequest) SetProfiler(profiler *execution.Profiler) {
+	this.Lock()
+	defer this.Unlock()
+	this.profiler = profiler
+}
+
+// The plan of the request with the statistics of its operators, or nil
+// if the request was not profiled
+func (this *BaseRequest) ExecutionProfile() map[string]interface{} {
+	this.RLock()
+	profiler := this.profiler
+	this.RUnlock()
+
+	if profiler == nil {
+		return nil
+	}
+
+	profile, err := profiler.Profile()
+	if err != nil {
+		logging.Infop("Error profiling request", logging.Pair{"error", err})
+		return nil
+	}
+	return profile
+}
+
 func (this *BaseRequest) Results() value.ValueChannel {
 	return this.results
 }
@@ -536,9 +678,10 @@ func (this *BaseRequest) Close() {
 func (this *BaseRequest) LogRequest(requestTime time.Duration, serviceTime time.Duration,
 	resultCount int, resultSize int, errorCount int) {
 	accounting.LogRequest(requestTime, serviceTime, resultCount,
-		resultSize, errorCount, this.Statement(),
+		resultSize, errorCount, this.PeakMemory(), this.Statement(),
 		this.Prepared(), this.FmtPhaseTimes(),
 		this.FmtPhaseCounts(), this.FmtPhaseOperators(),
+		this.ExecutionProfile(),
 		string(this.State()), this.Id().String(),
 		this.ClientID().String())
 }
@@ -60,6 +60,7 @@ type Server struct {
 	memprofile  string
 	cpuprofile  string
 	enterprise  bool
+	workloads   []*WorkloadClass
 }
 
 // Default Keep Alive Length
@@ -94,6 +95,11 @@ func NewServer(store datastore.Datastore, sys datastore.Datastore, config cluste
 	store.SetLogLevel(logging.LogLevel())
 	rv.SetMaxParallelism(maxParallelism)
 
+	err := LoadUserFunctions(store)
+	if err != nil {
+		return nil, err
+	}
+
 	//	sys, err := system.NewDatastore(store)
 	//	if err != nil {
 	//		return nil, err
@@ -107,6 +113,10 @@ func (this *Server) Datastore() datastore.Datastore {
 	return this.datastore
 }
 
+func (this *Server) Readonly() bool {
+	return this.readonly
+}
+
 func (this *Server) Systemstore() datastore.Datastore {
 	return this.systemstore
 }
@@ -199,6 +209,46 @@ func (this *Server) SetPipelineCap(pipeline_cap int) {
 	execution.SetPipelineCap(pipeline_cap)
 }
 
+func (this *Server) SortMemory() int64 {
+	return execution.GetSortMemory()
+}
+
+func (this *Server) SetSortMemory(size int64) {
+	execution.SetSortMemory(size)
+}
+
+func (this *Server) GroupMemory() int64 {
+	return execution.GetGroupMemory()
+}
+
+func (this *Server) SetGroupMemory(size int64) {
+	execution.SetGroupMemory(size)
+}
+
+func (this *Server) MemoryQuota() int64 {
+	return execution.GetMemoryQuota()
+}
+
+func (this *Server) SetMemoryQuota(size int64) {
+	execution.SetMemoryQuota(size)
+}
+
+func (this *Server) TransactionTimeout() time.Duration {
+	return datastore.GetTransactionTimeout()
+}
+
+func (this *Server) SetTransactionTimeout(timeout time.Duration) {
+	datastore.SetTransactionTimeout(timeout)
+}
+
+func (this *Server) NodeQuota() int64 {
+	return execution.GetNodeQuota()
+}
+
+func (this *Server) SetNodeQuota(size int64) {
+	execution.SetNodeQuota(size)
+}
+
 func (this *Server) SetPipelineBatch(pipeline_batch int) {
 	execution.SetPipelineBatch(pipeline_batch)
 }
@@ -379,9 +429,14 @@ func (this *Server) serviceRequest(request Request) {
 		namespace = this.namespace
 	}
 
+	// Ad-hoc statements run cached plans
+	adhoc := request.Prepared() == nil && request.TxId() == ""
+
 	prepared, err := this.getPrepared(request, namespace)
 	if err != nil {
 		request.Fail(err)
+	} else {
+		request.SetStatementType(prepared.StatementType())
 	}
 
 	if (this.readonly || value.ToBool(request.Readonly())) &&
@@ -390,19 +445,29 @@ func (this *Server) serviceRequest(request Request) {
 			" and cannot accept this write statement."))
 	}
 
+	var transaction *datastore.Transaction
+	if request.TxId() != "" {
+		transaction, err = datastore.GetTransaction(request.TxId(), request.Credentials())
+		if err != nil {
+			request.Fail(err)
+		}
+	}
+
 	if request.State() == FATAL {
 		request.Failed(this)
 		return
 	}
 
-	maxParallelism := request.MaxParallelism()
-	if maxParallelism <= 0 {
-		maxParallelism = this.MaxParallelism()
+	// A read-only ad-hoc statement is planned again if an index of
+	// its plan is not found before any results are returned
+	var replan *replanOutput
+	output := request.Output()
+	if adhoc && prepared.Readonly() {
+		replan = newReplanOutput(output)
+		output = replan
 	}
 
-	context := execution.NewContext(request.Id().String(), this.datastore, this.systemstore, namespace,
-		this.readonly, maxParallelism, request.NamedArgs(), request.PositionalArgs(),
-		request.Credentials(), request.ScanConsistency(), request.ScanVectorSource(), request.Output())
+	context := this.newContext(request, namespace, prepared, transaction, output)
 
 	build := time.Now()
 	operator, er := execution.Build(prepared, context)
@@ -435,14 +500,58 @@ func (this *Server) serviceRequest(request Request) {
 	run := time.Now()
 	operator.RunOnce(context, nil)
 
+	if replan != nil && replan.indexMissing() != nil {
+		this.replan(request, namespace, prepared, operator.StopChannel(), replan)
+	}
+
 	if logging.LogLevel() >= logging.TRACE {
 		request.Output().AddPhaseTime("run", time.Since(run))
 		logPhases(request)
 	}
 }
 
+func (this *Server) newContext(request Request, namespace string, prepared *plan.Prepared,
+	transaction *datastore.Transaction, output execution.Output) *execution.Context {
+	maxParallelism := request.MaxParallelism()
+	if maxParallelism <= 0 {
+		maxParallelism = this.MaxParallelism()
+	}
+
+	context := execution.NewContext(request.Id().String(), this.datastore, this.systemstore, namespace,
+		this.readonly, maxParallelism, request.NamedArgs(), request.PositionalArgs(),
+		request.Credentials(), request.ScanConsistency(), request.ScanVectorSource(), output)
+	context.SetTransaction(transaction)
+
+	// A request may lower the server's memory quota, but not raise it
+	if quota := request.MemoryQuota(); quota > 0 &&
+		(context.MemoryQuota() <= 0 || quota < context.MemoryQuota()) {
+		context.SetMemoryQuota(quota)
+	}
+	if request.Profile() == PROFILE_TIMINGS {
+		context.SetProfiler(execution.NewProfiler(prepared))
+	}
+
+	return context
+}
+
 func (this *Server) getPrepared(request Request, namespace string) (*plan.Prepared, errors.Error) {
+	if request.TxId() != "" {
+		return this.getTransactionPrepared(request, namespace)
+	}
+
 	prepared := request.Prepared()
+
+	// The index metadata version is read before planning, so that a
+	// concurrent index change leaves the cached plan stale
+	var version uint64
+	if prepared == nil {
+		version = datastore.IndexVersion()
+		prepared = plan.GetCachedPlan(request.Statement(), namespace, version)
+		if prepared != nil {
+			this.incCounter(accounting.PLAN_CACHE_HIT)
+		}
+	}
+
 	if prepared == nil {
 		parse := time.Now()
 		stmt, err := n1ql.ParseStatement(request.Statement())
@@ -470,6 +579,11 @@ func (this *Server) getPrepared(request Request, namespace string) (*plan.Prepar
 			request.SetPrepared(prep)
 		}
 
+		if isCacheable(stmt) {
+			plan.AddCachedPlan(request.Statement(), namespace, version, prepared)
+			this.incCounter(accounting.PLAN_CACHE_MISS)
+		}
+
 		if logging.LogLevel() >= logging.TRACE {
 			request.Output().AddPhaseTime("plan", time.Since(prep))
 			request.Output().AddPhaseTime("parse", prep.Sub(parse))
@@ -485,6 +599,67 @@ func (this *Server) getPrepared(request Request, namespace string) (*plan.Prepar
 	return prepared, nil
 }
 
+// Within a transaction, statements are planned for the transaction,
+// including prepared statements, which are planned again from their
+// text. These plans are not cached.
+func (this *Server) getTransactionPrepared(request Request, namespace string) (*plan.Prepared, errors.Error) {
+	prepared := request.Prepared()
+	text := request.Statement()
+	if prepared != nil {
+		text = prepared.Text()
+	}
+
+	stmt, err := n1ql.ParseStatement(text)
+	if err != nil {
+		return nil, errors.NewParseSyntaxError(err, "")
+	}
+
+	exec, ok := stmt.(*algebra.Execute)
+	if ok && exec.Prepared() != nil {
+		var er errors.Error
+		prepared, er = plan.TrackPrepared(exec.Prepared())
+		if er != nil {
+			return nil, er
+		}
+
+		request.SetPrepared(prepared)
+		stmt, err = n1ql.ParseStatement(prepared.Text())
+		if err != nil {
+			return nil, errors.NewParseSyntaxError(err, "")
+		}
+	}
+
+	// The text of a prepared statement is its PREPARE statement
+	if prepare, ok := stmt.(*algebra.Prepare); ok && prepared != nil {
+		stmt = prepare.Statement()
+	}
+
+	txPrepared, err := planner.BuildTransactionPrepared(stmt, this.datastore, this.systemstore, namespace)
+	if err != nil {
+		return nil, errors.NewPlanError(err, "")
+	}
+
+	request.SetTimings(txPrepared.Operator)
+	return txPrepared, nil
+}
+
+// Only queries and DML are cached. Other statements are cheap to plan,
+// or have side effects while planning, such as PREPARE.
+func isCacheable(stmt algebra.Statement) bool {
+	switch stmt.(type) {
+	case *algebra.Select, *algebra.Insert, *algebra.Upsert, *algebra.Update, *algebra.Delete, *algebra.Merge:
+		return true
+	default:
+		return false
+	}
+}
+
+func (this *Server) incCounter(name string) {
+	if this.acctstore != nil {
+		this.acctstore.MetricRegistry().Counter(name).Inc(1)
+	}
+}
+
 func logExplain(prepared *plan.Prepared) {
 	var pl plan.Operator = prepared
 	explain, err := json.MarshalIndent(pl, "", "    ")
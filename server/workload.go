@@ -0,0 +1,348 @@
+//  Copyright (c) 2014 Couchbase, Inc.
+//  Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file
+//  except in compliance with the License. You may obtain a copy of the License at
+//    http://www.apache.org/licenses/LICENSE-2.0
+//  Unless required by applicable law or agreed to in writing, software distributed under the
+//  License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+//  either express or implied. See the License for the specific language governing permissions
+//  and limitations under the License.
+
+package server
+
+import (
+	"fmt"
+	"path"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/couchbase/query/errors"
+	"github.com/couchbase/query/logging"
+)
+
+// A workload class. Requests whose user or client context ID matches
+// one of the patterns of the class are queued on the class queue and
+// serviced by the class servicers, rather than by the server's, so
+// that one class of requests cannot starve another. Patterns are
+// shell patterns, as in path.Match.
+type WorkloadClass struct {
+	name           string
+	users          []string
+	clientIds      []string
+	servicers      int
+	queueDepth     int
+	maxConcurrency int
+	timeout        time.Duration
+
+	queue    RequestChannel
+	done     chan bool
+	wg       sync.WaitGroup
+	mutex    sync.Mutex
+	admitted map[string]int
+}
+
+// Workload class setting names
+const (
+	_WORKLOAD_NAME            = "name"
+	_WORKLOAD_USERS           = "users"
+	_WORKLOAD_CLIENT_IDS      = "client_context_ids"
+	_WORKLOAD_SERVICERS       = "servicers"
+	_WORKLOAD_QUEUE_DEPTH     = "queue_depth"
+	_WORKLOAD_MAX_CONCURRENCY = "max_concurrency"
+	_WORKLOAD_TIMEOUT         = "timeout"
+)
+
+// Creates workload classes from their settings, which are an array
+// of objects, as decoded from JSON. Classes are matched in order.
+func NewWorkloadClasses(settings interface{}) ([]*WorkloadClass, error) {
+	list, ok := settings.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("Workload classes must be an array, not %T.", settings)
+	}
+
+	rv := make([]*WorkloadClass, 0, len(list))
+	names := make(map[string]bool, len(list))
+	for _, s := range list {
+		class, err := newWorkloadClass(s)
+		if err != nil {
+			return nil, err
+		}
+
+		if names[class.name] {
+			return nil, fmt.Errorf("Duplicate workload class %s.", class.name)
+		}
+
+		names[class.name] = true
+		rv = append(rv, class)
+	}
+
+	return rv, nil
+}
+
+func newWorkloadClass(settings interface{}) (*WorkloadClass, error) {
+	fields, ok := settings.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("Workload class must be an object, not %T.", settings)
+	}
+
+	rv := &WorkloadClass{
+		servicers: 1,
+		admitted:  make(map[string]int),
+	}
+
+	var err error
+	for field, val := range fields {
+		switch field {
+		case _WORKLOAD_NAME:
+			if rv.name, ok = val.(string); !ok {
+				err = fmt.Errorf("Workload class name must be a string.")
+			}
+		case _WORKLOAD_USERS:
+			rv.users, err = workloadPatterns(field, val)
+		case _WORKLOAD_CLIENT_IDS:
+			rv.clientIds, err = workloadPatterns(field, val)
+		case _WORKLOAD_SERVICERS:
+			rv.servicers, err = workloadNumber(field, val)
+		case _WORKLOAD_QUEUE_DEPTH:
+			rv.queueDepth, err = workloadNumber(field, val)
+		case _WORKLOAD_MAX_CONCURRENCY:
+			rv.maxConcurrency, err = workloadNumber(field, val)
+		case _WORKLOAD_TIMEOUT:
+			var timeout int
+			timeout, err = workloadNumber(field, val)
+			rv.timeout = time.Duration(timeout)
+		default:
+			err = fmt.Errorf("Unknown workload class setting %s.", field)
+		}
+
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if rv.name == "" {
+		return nil, fmt.Errorf("Workload class must have a name.")
+	}
+
+	if rv.servicers <= 0 {
+		return nil, fmt.Errorf("Workload class %s must have at least one servicer.", rv.name)
+	}
+
+	if rv.queueDepth == 0 {
+		rv.queueDepth = rv.servicers
+	}
+
+	rv.queue = make(RequestChannel, rv.queueDepth)
+	return rv, nil
+}
+
+func workloadPatterns(field string, val interface{}) ([]string, error) {
+	list, ok := val.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("Workload class %s must be an array of strings.", field)
+	}
+
+	rv := make([]string, len(list))
+	for i, p := range list {
+		rv[i], ok = p.(string)
+		if !ok {
+			return nil, fmt.Errorf("Workload class %s must be an array of strings.", field)
+		}
+
+		if _, err := path.Match(rv[i], ""); err != nil {
+			return nil, fmt.Errorf("Invalid pattern %s in workload class %s.", rv[i], field)
+		}
+	}
+
+	return rv, nil
+}
+
+func workloadNumber(field string, val interface{}) (int, error) {
+	n, ok := val.(float64)
+	if !ok || n < 0 {
+		return 0, fmt.Errorf("Workload class %s must be a non-negative number.", field)
+	}
+
+	return int(n), nil
+}
+
+func (this *WorkloadClass) Name() string {
+	return this.name
+}
+
+func (this *WorkloadClass) Timeout() time.Duration {
+	return this.timeout
+}
+
+// Number of requests waiting on the class queue
+func (this *WorkloadClass) QueueLength() int {
+	return len(this.queue)
+}
+
+// Returns the settings of the class, as accepted by
+// NewWorkloadClasses.
+func (this *WorkloadClass) Settings() map[string]interface{} {
+	return map[string]interface{}{
+		_WORKLOAD_NAME:            this.name,
+		_WORKLOAD_USERS:           this.users,
+		_WORKLOAD_CLIENT_IDS:      this.clientIds,
+		_WORKLOAD_SERVICERS:       this.servicers,
+		_WORKLOAD_QUEUE_DEPTH:     this.queueDepth,
+		_WORKLOAD_MAX_CONCURRENCY: this.maxConcurrency,
+		_WORKLOAD_TIMEOUT:         this.timeout,
+	}
+}
+
+// Returns the admission key of the request, and whether the request
+// belongs to the class. Requests are admitted per user: the key is
+// the first matching user, or the first user of the request if it
+// matches on its client context ID.
+func (this *WorkloadClass) match(request Request) (string, bool) {
+	users := make([]string, 0, len(request.Credentials()))
+	for user := range request.Credentials() {
+		users = append(users, user)
+	}
+	sort.Strings(users)
+
+	for _, user := range users {
+		if matchPatterns(this.users, user) {
+			return user, true
+		}
+	}
+
+	clientId := request.ClientID().String()
+	if clientId != "" && matchPatterns(this.clientIds, clientId) {
+		if len(users) > 0 {
+			return users[0], true
+		}
+		return "", true
+	}
+
+	return "", false
+}
+
+func matchPatterns(patterns []string, s string) bool {
+	for _, p := range patterns {
+		if ok, _ := path.Match(p, s); ok {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Queues the request, unless its user has reached the maximum
+// concurrency of the class, or the class queue is full.
+func (this *WorkloadClass) admit(request Request, key string) errors.Error {
+	this.mutex.Lock()
+	defer this.mutex.Unlock()
+
+	if this.maxConcurrency > 0 && this.admitted[key] >= this.maxConcurrency {
+		return errors.NewServiceErrorWorkloadSaturated(this.name)
+	}
+
+	select {
+	case this.queue <- request:
+		this.admitted[key]++
+		return nil
+	default:
+		return errors.NewServiceErrorWorkloadSaturated(this.name)
+	}
+}
+
+// Releases the admission of a request, once it has completed.
+func (this *WorkloadClass) Release(request Request) {
+	key, _ := this.match(request)
+
+	this.mutex.Lock()
+	defer this.mutex.Unlock()
+
+	if n := this.admitted[key]; n > 1 {
+		this.admitted[key] = n - 1
+	} else {
+		delete(this.admitted, key)
+	}
+}
+
+func (this *WorkloadClass) serve(server *Server) {
+	this.done = make(chan bool)
+	this.wg.Add(this.servicers)
+	for i := 0; i < this.servicers; i++ {
+		go this.doServe(server)
+	}
+}
+
+func (this *WorkloadClass) doServe(server *Server) {
+	defer this.wg.Done()
+	for {
+		select {
+		case request := <-this.queue:
+			this.serviceRequest(server, request)
+		case <-this.done:
+			// Service the requests already queued before stopping
+			for {
+				select {
+				case request := <-this.queue:
+					this.serviceRequest(server, request)
+				default:
+					return
+				}
+			}
+		}
+	}
+}
+
+func (this *WorkloadClass) serviceRequest(server *Server, request Request) {
+	if this.timeout > 0 {
+		timer := time.AfterFunc(this.timeout, func() { request.Expire(TIMEOUT, this.timeout) })
+		defer timer.Stop()
+	}
+
+	server.serviceRequest(request)
+}
+
+// Stops the class servicers once the queued requests are serviced.
+func (this *WorkloadClass) stop() {
+	close(this.done)
+	this.wg.Wait()
+}
+
+func (this *Server) WorkloadClasses() []*WorkloadClass {
+	this.RLock()
+	defer this.RUnlock()
+	return this.workloads
+}
+
+// Replaces the workload classes of the server. The requests queued
+// on the previous classes are serviced before their servicers stop.
+func (this *Server) SetWorkloadClasses(classes []*WorkloadClass) {
+	this.Lock()
+	previous := this.workloads
+	this.workloads = classes
+	for _, class := range classes {
+		class.serve(this)
+	}
+	this.Unlock()
+
+	for _, class := range previous {
+		class.stop()
+	}
+
+	logging.Infop("SetWorkloadClasses", logging.Pair{"classes", len(classes)})
+}
+
+// Queues the request on its workload class, if any. Returns the class,
+// or nil if the request does not belong to one, and an error if the
+// class is saturated.
+func (this *Server) AdmitWorkload(request Request) (*WorkloadClass, errors.Error) {
+	this.RLock()
+	defer this.RUnlock()
+
+	for _, class := range this.workloads {
+		if key, ok := class.match(request); ok {
+			return class, class.admit(request, key)
+		}
+	}
+
+	return nil, nil
+}
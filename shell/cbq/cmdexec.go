@@ -22,6 +22,50 @@ import (
 	"github.com/peterh/liner"
 )
 
+/*
+The connection to the query service. It is opened on first use,
+and shared by statements and tab completion until the shell
+connects to another query service or disconnects.
+*/
+var conn n1ql.N1qlDB
+var connServer string
+
+func connection() (n1ql.N1qlDB, error) {
+	if conn != nil && connServer == serverFlag {
+		return conn, nil
+	}
+
+	closeConnection()
+	db, err := n1ql.OpenExtended(serverFlag)
+	if err != nil {
+		return nil, err
+	}
+
+	conn = db
+	connServer = serverFlag
+	return conn, nil
+}
+
+func closeConnection() {
+	if conn != nil {
+		conn.Close()
+		conn = nil
+		connServer = ""
+	}
+}
+
+/*
+Fetches the keyspace and field names for tab completion over
+the connection to the query service.
+*/
+func refreshCompletions() {
+	db, err := connection()
+	if err != nil {
+		db = nil
+	}
+	command.RefreshCompletions(db)
+}
+
 /*
 This method executes the input command or statement. It
 returns an error code and optionally a non empty error message.
@@ -101,15 +145,21 @@ func execute_input(line string, w io.Writer, interactive bool, liner *liner.Stat
 			//Not connected to a query service
 			return errors.NO_CONNECTION, ""
 		} else {
-			/* Try opening a connection to the endpoint. If successful, ping.
-			   If successful execute the n1ql command. Else try to connect
-			   again.
+			/* Open a connection to the endpoint, unless one is already
+			   open, and execute the n1ql command.
 			*/
-			dBn1ql, err := n1ql.OpenExtended(serverFlag)
+			dBn1ql, err := connection()
 			if err != nil {
 				return errors.DRIVER_OPEN, err.Error()
 			} else {
 				//Successfully logged into the server
+				//Page long results written to the terminal.
+				if interactive && w == io.Writer(os.Stdout) && command.PagerEnabled() {
+					if height := terminalHeight(); height > 1 {
+						w = command.NewPager(w, os.Stdin, height)
+					}
+				}
+
 				err_code, err_str := ExecN1QLStmt(line, dBn1ql, w)
 				if err_code != 0 {
 					return err_code, err_str
@@ -128,15 +178,8 @@ func ExecN1QLStmt(line string, dBn1ql n1ql.N1qlDB, w io.Writer) (int, string) {
 
 	if rows != nil {
 		// We have output. That is what we want. We can ignore the error, even if there is one.
-
-		_, werr := io.Copy(w, rows)
-
-		// For any captured write error
-		if werr != nil {
-			return errors.WRITER_OUTPUT, werr.Error()
-		}
-
-		return 0, ""
+		// The output is written in the format given by the output_format parameter.
+		return command.WriteResponse(w, rows)
 	}
 
 	if err != nil {
@@ -217,12 +260,14 @@ func ExecShellCmd(line string, liner *liner.State) (int, string) {
 	if SERVICE_URL != "" {
 		serverFlag = SERVICE_URL
 		command.SERVICE_URL = ""
+		refreshCompletions()
 	}
 
 	DISCONNECT = command.DISCONNECT
 	if DISCONNECT == true {
 		noQueryService = true
-
+		closeConnection()
+		command.RefreshCompletions(nil)
 	}
 
 	EXIT = command.EXIT
@@ -28,7 +28,9 @@ var (
 	NamedParam map[string]*Stack = map[string]*Stack{}
 	UserDefSV  map[string]*Stack = map[string]*Stack{}
 	PreDefSV   map[string]*Stack = map[string]*Stack{
-		"histfile": Stack_Helper(),
+		"histfile":    Stack_Helper(),
+		OUTPUT_FORMAT: Stack_Helper(),
+		PAGER:         Stack_Helper(),
 		//"autoconfig": Stack_Helper(),
 	}
 )
@@ -55,6 +57,18 @@ func init() {
 
 	}
 
+	err_code, err_str = PushValue_Helper(false, PreDefSV, OUTPUT_FORMAT, JSON_FORMAT)
+	if err_code != 0 {
+		s_err := HandleError(err_code, err_str)
+		PrintError(s_err)
+	}
+
+	err_code, err_str = PushValue_Helper(false, PreDefSV, PAGER, "true")
+	if err_code != 0 {
+		s_err := HandleError(err_code, err_str)
+		PrintError(s_err)
+	}
+
 	/*err_code, err_str = PushValue_Helper(false, PreDefSV, "autoconfig", "false")
 	if err_code != 0 {
 		s_err := HandleError(err_code, err_str)
@@ -66,6 +80,22 @@ func SetWriter(Wt io.Writer) {
 	W = Wt
 }
 
+/* Shell settings, such as the output format, are predefined
+   session parameters. They can also be given with a - prefix,
+   like query parameters, but are never sent to the query
+   service. This returns the name of the predefined parameter
+   for such settings, and the input parameter otherwise.
+*/
+func ShellSetting(param string) string {
+	if strings.HasPrefix(param, "-") && !strings.HasPrefix(param, "-$") {
+		name := strings.ToLower(param[1:])
+		if name == OUTPUT_FORMAT || name == PAGER {
+			return name
+		}
+	}
+	return param
+}
+
 /* The Resolve method is used to evaluate the input parameter
    to the \SET / \PUSH / \POP / \UNSET and \ECHO commands. It
    takes in a string, and resolves it to the appropriate value.
@@ -85,7 +115,7 @@ func Resolve(param string) (val value.Value, err_code int, err_str string) {
 	err_code = 0
 	err_str = ""
 
-	param = strings.TrimSpace(param)
+	param = ShellSetting(strings.TrimSpace(param))
 
 	if strings.HasPrefix(param, "\\\\") {
 		/* It is a Command alias */
@@ -318,6 +348,8 @@ func PushOrSet(args []string, pushvalue bool) (int, string) {
 	// Check what kind of parameter needs to be set or pushed
 	// depending on the pushvalue boolean value.
 
+	args[0] = ShellSetting(args[0])
+
 	if strings.HasPrefix(args[0], "-$") {
 
 		// For Named Parameters
@@ -448,6 +480,17 @@ func PushOrSet(args []string, pushvalue bool) (int, string) {
 			}
 		}
 
+		if vble == OUTPUT_FORMAT {
+			val, err_code, err_str := Resolve(args_str)
+			if err_code != 0 {
+				return err_code, err_str
+			}
+			format, ok := val.Actual().(string)
+			if !ok || !isOutputFormat(strings.ToLower(format)) {
+				return errors.INVALID_OUTPUT_FORMAT, ValToStr(val)
+			}
+		}
+
 		err_code, err_str := PushValue_Helper(pushvalue, PreDefSV, vble, args_str)
 		if err_code != 0 {
 			return err_code, err_str
@@ -0,0 +1,349 @@
+//  Copyright (c) 2015-2016 Couchbase, Inc.
+//  Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file
+//  except in compliance with the License. You may obtain a copy of the License at
+//    http://www.apache.org/licenses/LICENSE-2.0
+//  Unless required by applicable law or agreed to in writing, software distributed under the
+//  License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+//  either express or implied. See the License for the specific language governing permissions
+//  and limitations under the License.
+
+package command
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"unicode"
+
+	"github.com/couchbase/godbc/n1ql"
+	"github.com/couchbase/query/expression"
+	parser "github.com/couchbase/query/parser/n1ql"
+)
+
+/* Tab completion for the interactive shell. Keywords, shell
+   commands and function names are known up front. Keyspace
+   names and field names are fetched from the query service
+   and cached until the next \CONNECT. Keyspaces outside the
+   default namespace are qualified with their namespace.
+*/
+
+// Only this many keyspaces are sampled with INFER for field names
+const MAX_INFER_KEYSPACES = 16
+
+// Number of documents INFER samples in each keyspace
+const INFER_SAMPLE_SIZE = 100
+
+// Keyspaces in this namespace are completed without their namespace
+const DEFAULT_NAMESPACE = "default"
+
+// Characters that separate the word being completed from the rest of the line
+const _WORD_BREAKS = " \t\n(),;=<>!+-*/%[]{}|"
+
+/* The previous word of a N1QL statement after which a
+   keyspace name is expected.
+*/
+var _KEYSPACE_CONTEXT = map[string]bool{
+	"FROM":     true,
+	"JOIN":     true,
+	"NEST":     true,
+	"INTO":     true,
+	"UPDATE":   true,
+	"KEYSPACE": true,
+	"INFER":    true,
+}
+
+/* Shell commands whose arguments are predefined session
+   parameters.
+*/
+var _PARAM_COMMANDS = map[string]bool{
+	"\\set":   true,
+	"\\push":  true,
+	"\\pop":   true,
+	"\\unset": true,
+}
+
+var keywords []string = parser.Keywords()
+var functions []string = expression.FunctionNames()
+
+/* The cache of names read from the query service. The
+   generation is bumped on every refresh so that a slow refresh
+   for an earlier connection does not overwrite a newer one.
+*/
+var completionCache struct {
+	sync.RWMutex
+	enabled    bool
+	generation int
+	keyspaces  []string
+	fields     []string
+}
+
+/* Turns on completion for the interactive shell. Names are
+   only fetched from the query service once it is enabled.
+*/
+func EnableCompletion() {
+	completionCache.Lock()
+	completionCache.enabled = true
+	completionCache.Unlock()
+}
+
+/* Drops the cached keyspace and field names, and fetches them
+   again over the shell's connection to the query service, if
+   any. The names are fetched in the background so as not to
+   hold up the prompt.
+*/
+func RefreshCompletions(db n1ql.N1qlDB) {
+	completionCache.Lock()
+	defer completionCache.Unlock()
+
+	completionCache.generation++
+	completionCache.keyspaces = nil
+	completionCache.fields = nil
+	if !completionCache.enabled || db == nil {
+		return
+	}
+
+	go fetchCompletions(db, completionCache.generation)
+}
+
+func fetchCompletions(db n1ql.N1qlDB, generation int) {
+	var keyspaces []string
+	stmt := fmt.Sprintf("SELECT RAW CASE WHEN namespace_id = %q THEN name "+
+		"ELSE namespace_id || \":\" || name END FROM system:keyspaces", DEFAULT_NAMESPACE)
+	if queryResults(db, stmt, &keyspaces) != nil {
+		return
+	}
+
+	// Field names are only available where the datastore supports INFER
+	fields := []string{}
+	for i, keyspace := range keyspaces {
+		if i >= MAX_INFER_KEYSPACES {
+			break
+		}
+
+		var flavors [][]struct {
+			Properties map[string]interface{} `json:"properties"`
+		}
+		stmt := fmt.Sprintf("INFER %s WITH {\"sample_size\": %d}",
+			escapeKeyspace(keyspace), INFER_SAMPLE_SIZE)
+		if queryResults(db, stmt, &flavors) != nil {
+			continue
+		}
+
+		for _, flavor := range flavors {
+			for _, schema := range flavor {
+				for field, _ := range schema.Properties {
+					fields = append(fields, field)
+				}
+			}
+		}
+	}
+
+	setCompletions(generation, keyspaces, fields)
+}
+
+func setCompletions(generation int, keyspaces, fields []string) {
+	completionCache.Lock()
+	defer completionCache.Unlock()
+
+	if generation != completionCache.generation {
+		return
+	}
+	completionCache.keyspaces = uniqueSorted(keyspaces)
+	completionCache.fields = uniqueSorted(fields)
+}
+
+/* Runs a statement and decodes its results into rv. A response
+   with errors is reported as an error.
+*/
+func queryResults(db n1ql.N1qlDB, stmt string, rv interface{}) error {
+	rows, err := db.QueryRaw(stmt)
+	if rows == nil {
+		if err == nil {
+			err = fmt.Errorf("No response for %s", stmt)
+		}
+		return err
+	}
+	defer rows.Close()
+
+	var response struct {
+		Results json.RawMessage   `json:"results"`
+		Errors  []json.RawMessage `json:"errors"`
+	}
+	err = json.NewDecoder(rows).Decode(&response)
+	if err != nil {
+		return err
+	}
+
+	if len(response.Errors) > 0 {
+		return fmt.Errorf("%s", response.Errors[0])
+	}
+
+	return json.Unmarshal(response.Results, rv)
+}
+
+/* The word completer registered with liner. The word under the
+   cursor is completed from the keywords, commands, functions,
+   keyspaces or fields, depending on where it appears on the line.
+*/
+func Complete(line string, pos int) (head string, completions []string, tail string) {
+	runes := []rune(line)
+	if pos > len(runes) {
+		pos = len(runes)
+	}
+
+	head = string(runes[:pos])
+	tail = string(runes[pos:])
+
+	// Arguments of shell commands, such as -output_format, are
+	// separated by white space alone.
+	breaks := _WORD_BREAKS
+	if strings.HasPrefix(strings.TrimLeftFunc(head, unicode.IsSpace), "\\") {
+		breaks = " \t\n"
+	}
+
+	start := strings.LastIndexAny(head, breaks) + 1
+	word := head[start:]
+	head = head[:start]
+
+	return head, candidates(head, word), tail
+}
+
+func candidates(head, word string) []string {
+	before := strings.TrimLeftFunc(head, unicode.IsSpace)
+
+	// Shell commands
+	if strings.HasPrefix(before, "\\") {
+		args := strings.Fields(before)
+		if len(args) == 1 && _PARAM_COMMANDS[strings.ToLower(args[0])] {
+			return matchParams(word)
+		}
+		return nil
+	}
+
+	if before == "" && strings.HasPrefix(word, "\\") {
+		return matchCommands(word)
+	}
+
+	completionCache.RLock()
+	keyspaces := completionCache.keyspaces
+	fields := completionCache.fields
+	completionCache.RUnlock()
+
+	// Fields of a keyspace or alias, as in t.name
+	if i := strings.LastIndex(word, "."); i >= 0 {
+		return match(word[:i+1], word[i+1:], fields, "")
+	}
+
+	// Keyspace names
+	prev := ""
+	if words := strings.Fields(before); len(words) > 0 {
+		prev = strings.ToUpper(words[len(words)-1])
+	}
+	if _KEYSPACE_CONTEXT[prev] {
+		rv := []string{}
+		for _, keyspace := range keyspaces {
+			name := escapeKeyspace(keyspace)
+			if hasPrefixFold(name, word) || hasPrefixFold(keyspace, word) {
+				rv = append(rv, name)
+			}
+		}
+		return rv
+	}
+
+	if word == "" {
+		return nil
+	}
+
+	rv := []string{}
+	for _, keyword := range keywords {
+		if hasPrefixFold(keyword, word) {
+			rv = append(rv, matchCase(keyword, word))
+		}
+	}
+	rv = append(rv, match("", word, functions, "(")...)
+	rv = append(rv, match("", word, fields, "")...)
+	return uniqueSorted(rv)
+}
+
+func matchCommands(word string) []string {
+	rv := []string{}
+	for name, cmd := range COMMAND_LIST {
+		if cmd.CommandCompletion() && hasPrefixFold(name, word) {
+			rv = append(rv, matchCase(name, word))
+		}
+	}
+	sort.Strings(rv)
+	return rv
+}
+
+func matchParams(word string) []string {
+	rv := []string{}
+	for name, _ := range PreDefSV {
+		param := "-" + name
+		if hasPrefixFold(param, word) {
+			rv = append(rv, param)
+		}
+	}
+	sort.Strings(rv)
+	return rv
+}
+
+func match(prefix, word string, names []string, suffix string) []string {
+	rv := []string{}
+	for _, name := range names {
+		if hasPrefixFold(name, word) {
+			rv = append(rv, prefix+name+suffix)
+		}
+	}
+	sort.Strings(rv)
+	return rv
+}
+
+func hasPrefixFold(s, prefix string) bool {
+	return len(s) >= len(prefix) && strings.EqualFold(s[:len(prefix)], prefix)
+}
+
+/* Keywords and commands are completed in upper case when the
+   word typed so far is in upper case, and in lower case otherwise.
+*/
+func matchCase(name, word string) string {
+	if word != "" && word == strings.ToUpper(word) && word != strings.ToLower(word) {
+		return strings.ToUpper(name)
+	}
+	return strings.ToLower(name)
+}
+
+/* Names that are not plain identifiers, such as travel-sample,
+   are escaped with back ticks.
+*/
+func escapeName(name string) string {
+	for i, r := range name {
+		if r != '_' && !unicode.IsLetter(r) && (i == 0 || !unicode.IsDigit(r)) {
+			return "`" + name + "`"
+		}
+	}
+	return name
+}
+
+/* Escapes the namespace and the name of a keyspace, as in
+   `other-ns`:orders, separately.
+*/
+func escapeKeyspace(keyspace string) string {
+	if i := strings.Index(keyspace, ":"); i >= 0 {
+		return escapeName(keyspace[:i]) + ":" + escapeName(keyspace[i+1:])
+	}
+	return escapeName(keyspace)
+}
+
+func uniqueSorted(names []string) []string {
+	sort.Strings(names)
+	rv := names[:0]
+	for i, name := range names {
+		if i == 0 || name != names[i-1] {
+			rv = append(rv, name)
+		}
+	}
+	return rv
+}
@@ -0,0 +1,58 @@
+//  Copyright (c) 2015-2016 Couchbase, Inc.
+//  Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file
+//  except in compliance with the License. You may obtain a copy of the License at
+//    http://www.apache.org/licenses/LICENSE-2.0
+//  Unless required by applicable law or agreed to in writing, software distributed under the
+//  License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+//  either express or implied. See the License for the specific language governing permissions
+//  and limitations under the License.
+
+package command
+
+import (
+	"reflect"
+	"testing"
+)
+
+func complete(line string) []string {
+	_, completions, _ := Complete(line, len([]rune(line)))
+	return completions
+}
+
+func TestComplete(t *testing.T) {
+	setCompletions(completionCache.generation, []string{"orders", "travel-sample", "other-ns:products"},
+		[]string{"name", "number"})
+	defer RefreshCompletions(nil)
+
+	tests := []struct {
+		line     string
+		expected []string
+	}{
+		{"SEL", []string{"SELECT", "SELF", "self("}},
+		{"select * fr", []string{"from"}},
+		{"SELECT * FROM ", []string{"orders", "`other-ns`:products", "`travel-sample`"}},
+		{"SELECT * FROM oth", []string{"`other-ns`:products"}},
+		{"SELECT * FROM tr", []string{"`travel-sample`"}},
+		{"SELECT o.n", []string{"o.name", "o.number"}},
+		{"SELECT lowe", []string{"lower("}},
+		{"SELECT nam", []string{"name", "namespace"}},
+		{"\\con", []string{"\\connect"}},
+		{"\\SET -out", []string{"-output_format"}},
+		{"\\echo -out", nil},
+	}
+
+	for _, test := range tests {
+		completions := complete(test.line)
+		if len(completions) == 0 && len(test.expected) == 0 {
+			continue
+		}
+		if !reflect.DeepEqual(completions, test.expected) {
+			t.Errorf("Completing %q, expected %v, got %v", test.line, test.expected, completions)
+		}
+	}
+
+	head, _, tail := Complete("SELECT lowe FROM orders", 11)
+	if head != "SELECT " || tail != " FROM orders" {
+		t.Errorf("Expected the word under the cursor to be completed, got %q and %q", head, tail)
+	}
+}
@@ -25,7 +25,7 @@ func (this *Disconnect) Name() string {
 }
 
 func (this *Disconnect) CommandCompletion() bool {
-	return false
+	return true
 }
 
 func (this *Disconnect) MinArgs() int {
@@ -27,7 +27,7 @@ func (this *Echo) Name() string {
 }
 
 func (this *Echo) CommandCompletion() bool {
-	return false
+	return true
 }
 
 func (this *Echo) MinArgs() int {
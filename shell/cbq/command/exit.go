@@ -25,7 +25,7 @@ func (this *Exit) Name() string {
 }
 
 func (this *Exit) CommandCompletion() bool {
-	return false
+	return true
 }
 
 func (this *Exit) MinArgs() int {
@@ -0,0 +1,441 @@
+//  Copyright (c) 2015-2016 Couchbase, Inc.
+//  Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file
+//  except in compliance with the License. You may obtain a copy of the License at
+//    http://www.apache.org/licenses/LICENSE-2.0
+//  Unless required by applicable law or agreed to in writing, software distributed under the
+//  License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+//  either express or implied. See the License for the specific language governing permissions
+//  and limitations under the License.
+
+package command
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/couchbase/query/errors"
+)
+
+/* Output formats for query results, chosen with the
+   output_format predefined session parameter.
+*/
+const (
+	OUTPUT_FORMAT = "output_format"
+
+	JSON_FORMAT     = "json"
+	TABLE_FORMAT    = "table"
+	CSV_FORMAT      = "csv"
+	VERTICAL_FORMAT = "vertical"
+)
+
+// Longer values are truncated in table cells
+const MAX_COLUMN_WIDTH = 50
+
+func isOutputFormat(format string) bool {
+	switch format {
+	case JSON_FORMAT, TABLE_FORMAT, CSV_FORMAT, VERTICAL_FORMAT:
+		return true
+	}
+	return false
+}
+
+/* Returns the current output format. JSON is used if the
+   parameter is not set.
+*/
+func OutputFormat() string {
+	format := strings.ToLower(predefString(OUTPUT_FORMAT, JSON_FORMAT))
+	if !isOutputFormat(format) {
+		return JSON_FORMAT
+	}
+	return format
+}
+
+/* Returns the top value of the given predefined session
+   parameter as a string, or def if it is not set.
+*/
+func predefString(name, def string) string {
+	st_val, ok := PreDefSV[name]
+	if !ok {
+		return def
+	}
+
+	val, err_code, _ := st_val.Top()
+	if err_code != 0 {
+		return def
+	}
+
+	if s, ok := val.Actual().(string); ok {
+		return s
+	}
+	return ValToStr(val)
+}
+
+/* The response of the query service, with the results and
+   the signature kept apart from the other fields, whose
+   order is preserved.
+*/
+type response struct {
+	signature json.RawMessage
+	results   []json.RawMessage
+	names     []string
+	fields    map[string]json.RawMessage
+}
+
+/* The WriteResponse method writes the response of the query
+   service to w, in the current output format. JSON responses
+   are copied as they are.
+*/
+func WriteResponse(w io.Writer, body io.Reader) (int, string) {
+	format := OutputFormat()
+	if format == JSON_FORMAT {
+		_, werr := io.Copy(w, body)
+		if werr != nil {
+			return errors.WRITER_OUTPUT, werr.Error()
+		}
+		return 0, ""
+	}
+
+	raw, err := ioutil.ReadAll(body)
+	if err != nil {
+		return errors.READ_FILE, err.Error()
+	}
+
+	resp, err := parseResponse(raw)
+	if err != nil {
+		// Not a query response: show it as it is
+		return PrintStr(w, string(raw))
+	}
+
+	var werr error
+	switch format {
+	case TABLE_FORMAT:
+		werr = writeTable(w, resp)
+	case CSV_FORMAT:
+		werr = writeCSV(w, resp)
+	case VERTICAL_FORMAT:
+		werr = writeVertical(w, resp)
+	}
+
+	if werr != nil {
+		return errors.WRITER_OUTPUT, werr.Error()
+	}
+	return 0, ""
+}
+
+func parseResponse(raw []byte) (*response, error) {
+	names, fields, ok := decodeObject(raw)
+	if !ok {
+		return nil, fmt.Errorf("response is not an object")
+	}
+
+	rv := &response{
+		signature: fields["signature"],
+		fields:    fields,
+	}
+
+	if results, ok := fields["results"]; ok {
+		err := json.Unmarshal(results, &rv.results)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for _, name := range names {
+		switch name {
+		case "requestID", "clientContextID", "signature", "results":
+		default:
+			rv.names = append(rv.names, name)
+		}
+	}
+	return rv, nil
+}
+
+/* Decodes a JSON object, keeping the order of its fields.
+   The last return value is false if raw is not an object.
+*/
+func decodeObject(raw []byte) ([]string, map[string]json.RawMessage, bool) {
+	decoder := json.NewDecoder(bytes.NewReader(raw))
+	token, err := decoder.Token()
+	if err != nil || token != json.Delim('{') {
+		return nil, nil, false
+	}
+
+	var names []string
+	fields := map[string]json.RawMessage{}
+	for decoder.More() {
+		token, err = decoder.Token()
+		if err != nil {
+			return nil, nil, false
+		}
+		name, _ := token.(string)
+
+		var val json.RawMessage
+		err = decoder.Decode(&val)
+		if err != nil {
+			return nil, nil, false
+		}
+
+		if _, ok := fields[name]; !ok {
+			names = append(names, name)
+		}
+		fields[name] = val
+	}
+	return names, fields, true
+}
+
+/* A result row, with the value of each column. Results that
+   are not objects, such as those of SELECT RAW, have a single
+   column.
+*/
+type row map[string]json.RawMessage
+
+const _VALUE_COLUMN = "$1"
+
+/* Computes the columns and their types from the projection
+   signature. Projections of * contribute the fields of the
+   results, in their order of appearance.
+*/
+func columns(resp *response) ([]string, map[string]string, []row) {
+	sigNames, sigTypes, isObject := decodeObject(resp.signature)
+	types := map[string]string{}
+	for name, t := range sigTypes {
+		var s string
+		json.Unmarshal(t, &s)
+		types[name] = s
+	}
+
+	star := !isObject
+	for _, name := range sigNames {
+		if name == "*" {
+			star = true
+		}
+	}
+
+	var cols []string
+	seen := map[string]bool{}
+	add := func(name string) {
+		if !seen[name] {
+			seen[name] = true
+			cols = append(cols, name)
+		}
+	}
+
+	for _, name := range sigNames {
+		if name != "*" {
+			add(name)
+		}
+	}
+
+	rows := make([]row, len(resp.results))
+	for i, result := range resp.results {
+		names, fields, ok := decodeObject(result)
+		if !ok {
+			names = []string{_VALUE_COLUMN}
+			fields = map[string]json.RawMessage{_VALUE_COLUMN: result}
+		}
+
+		rows[i] = row(fields)
+		if star || !ok {
+			for _, name := range names {
+				add(name)
+			}
+		}
+	}
+	return cols, types, rows
+}
+
+/* The text of a value in a cell. Strings are shown without
+   quotes, other values as compact JSON. Missing values are
+   empty.
+*/
+func cellText(val json.RawMessage) string {
+	if val == nil {
+		return ""
+	}
+
+	var s *string
+	if json.Unmarshal(val, &s) == nil && s != nil {
+		return *s
+	}
+
+	var buf bytes.Buffer
+	if json.Compact(&buf, val) != nil {
+		return string(val)
+	}
+	return buf.String()
+}
+
+func isNumber(val json.RawMessage) bool {
+	var n json.Number
+	decoder := json.NewDecoder(bytes.NewReader(val))
+	decoder.UseNumber()
+	return decoder.Decode(&n) == nil
+}
+
+func truncate(s string, width int) string {
+	s = strings.Replace(s, "\n", " ", -1)
+	if utf8.RuneCountInString(s) <= width {
+		return s
+	}
+	return string([]rune(s)[:width-3]) + "..."
+}
+
+func pad(s string, width int, right bool) string {
+	n := width - utf8.RuneCountInString(s)
+	if n <= 0 {
+		return s
+	}
+	if right {
+		return strings.Repeat(" ", n) + s
+	}
+	return s + strings.Repeat(" ", n)
+}
+
+func writeTable(w io.Writer, resp *response) error {
+	cols, types, rows := columns(resp)
+
+	// Numbers are right aligned
+	widths := make([]int, len(cols))
+	right := make([]bool, len(cols))
+	cells := make([][]string, len(rows))
+	for i, col := range cols {
+		widths[i] = utf8.RuneCountInString(col)
+		right[i] = types[col] == "number"
+	}
+
+	for r, vals := range rows {
+		cells[r] = make([]string, len(cols))
+		for i, col := range cols {
+			text := truncate(cellText(vals[col]), MAX_COLUMN_WIDTH)
+			cells[r][i] = text
+			if n := utf8.RuneCountInString(text); n > widths[i] {
+				widths[i] = n
+			}
+		}
+	}
+
+	for i, col := range cols {
+		if types[col] != "" {
+			continue
+		}
+		numbers := len(rows) > 0
+		for _, vals := range rows {
+			if val, ok := vals[col]; ok && !isNumber(val) {
+				numbers = false
+				break
+			}
+		}
+		right[i] = numbers
+	}
+
+	var buf bytes.Buffer
+	separator := func() {
+		buf.WriteString("+")
+		for _, width := range widths {
+			buf.WriteString(strings.Repeat("-", width+2) + "+")
+		}
+		buf.WriteString("\n")
+	}
+	line := func(vals []string, align bool) {
+		buf.WriteString("|")
+		for i, val := range vals {
+			buf.WriteString(" " + pad(val, widths[i], align && right[i]) + " |")
+		}
+		buf.WriteString("\n")
+	}
+
+	if len(cols) > 0 {
+		separator()
+		line(cols, false)
+		separator()
+		for _, vals := range cells {
+			line(vals, true)
+		}
+		separator()
+	}
+
+	if len(rows) == 1 {
+		buf.WriteString("(1 row)\n")
+	} else {
+		buf.WriteString(fmt.Sprintf("(%d rows)\n", len(rows)))
+	}
+
+	_, err := w.Write(buf.Bytes())
+	if err != nil {
+		return err
+	}
+	return writeFields(w, resp, resp.names)
+}
+
+func writeVertical(w io.Writer, resp *response) error {
+	cols, _, rows := columns(resp)
+
+	width := 0
+	for _, col := range cols {
+		if n := utf8.RuneCountInString(col); n > width {
+			width = n
+		}
+	}
+
+	var buf bytes.Buffer
+	for r, vals := range rows {
+		buf.WriteString(fmt.Sprintf("%s %d. row %s\n", strings.Repeat("*", 27), r+1, strings.Repeat("*", 27)))
+		for _, col := range cols {
+			if val, ok := vals[col]; ok {
+				buf.WriteString(pad(col, width, true) + ": " + cellText(val) + "\n")
+			}
+		}
+	}
+
+	_, err := w.Write(buf.Bytes())
+	if err != nil {
+		return err
+	}
+	return writeFields(w, resp, resp.names)
+}
+
+/* CSV output only holds the results, so that it can be read
+   back by other tools. Errors and warnings are still shown.
+*/
+func writeCSV(w io.Writer, resp *response) error {
+	cols, _, rows := columns(resp)
+
+	writer := csv.NewWriter(w)
+	writer.Write(cols)
+	for _, vals := range rows {
+		record := make([]string, len(cols))
+		for i, col := range cols {
+			record[i] = cellText(vals[col])
+		}
+		writer.Write(record)
+	}
+	writer.Flush()
+	if err := writer.Error(); err != nil {
+		return err
+	}
+
+	var names []string
+	for _, name := range resp.names {
+		if name == "errors" || name == "warnings" {
+			names = append(names, name)
+		}
+	}
+	return writeFields(w, resp, names)
+}
+
+// Writes the given fields of the response, one per line
+func writeFields(w io.Writer, resp *response, names []string) error {
+	var buf bytes.Buffer
+	for _, name := range names {
+		buf.WriteString(name + ": " + cellText(resp.fields[name]) + "\n")
+	}
+
+	_, err := w.Write(buf.Bytes())
+	return err
+}
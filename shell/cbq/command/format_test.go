@@ -0,0 +1,123 @@
+//  Copyright (c) 2015-2016 Couchbase, Inc.
+//  Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file
+//  except in compliance with the License. You may obtain a copy of the License at
+//    http://www.apache.org/licenses/LICENSE-2.0
+//  Unless required by applicable law or agreed to in writing, software distributed under the
+//  License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+//  either express or implied. See the License for the specific language governing permissions
+//  and limitations under the License.
+
+package command
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+const testResponse = `{
+    "requestID": "1",
+    "signature": {"name": "json", "age": "number"},
+    "results": [
+        {"name": "dave", "age": 46},
+        {"name": "ian", "age": 7, "extra": true}
+    ],
+    "status": "success",
+    "metrics": {"resultCount": 2}
+}`
+
+func setFormat(t *testing.T, format string) {
+	errCode, errStr := PushOrSet([]string{"-output_format", format}, true)
+	if errCode != 0 {
+		t.Fatalf("%s", HandleError(errCode, errStr))
+	}
+}
+
+func writeResponse(t *testing.T, format string, response string) string {
+	setFormat(t, format)
+
+	var b bytes.Buffer
+	errCode, errStr := WriteResponse(&b, strings.NewReader(response))
+	if errCode != 0 {
+		t.Fatalf("%s", HandleError(errCode, errStr))
+	}
+	return b.String()
+}
+
+func TestOutputFormats(t *testing.T) {
+	defer setFormat(t, JSON_FORMAT)
+
+	if out := writeResponse(t, JSON_FORMAT, testResponse); out != testResponse {
+		t.Errorf("Expected the response unchanged, got %s", out)
+	}
+
+	expected := "+------+-----+\n" +
+		"| name | age |\n" +
+		"+------+-----+\n" +
+		"| dave |  46 |\n" +
+		"| ian  |   7 |\n" +
+		"+------+-----+\n" +
+		"(2 rows)\n" +
+		"status: success\n" +
+		"metrics: {\"resultCount\":2}\n"
+	if out := writeResponse(t, TABLE_FORMAT, testResponse); out != expected {
+		t.Errorf("Unexpected table output:\n%s", out)
+	}
+
+	expected = "name,age\ndave,46\nian,7\n"
+	if out := writeResponse(t, CSV_FORMAT, testResponse); out != expected {
+		t.Errorf("Unexpected csv output:\n%s", out)
+	}
+
+	expected = "*************************** 1. row ***************************\n" +
+		"name: dave\n" +
+		" age: 46\n" +
+		"*************************** 2. row ***************************\n" +
+		"name: ian\n" +
+		" age: 7\n" +
+		"status: success\n" +
+		"metrics: {\"resultCount\":2}\n"
+	if out := writeResponse(t, "VERTICAL", testResponse); out != expected {
+		t.Errorf("Unexpected vertical output:\n%s", out)
+	}
+
+	// Projections of * and RAW take their columns from the results
+	star := `{"signature": {"*": "*"}, "results": [{"b": 1, "a": "x"}, {"c": [1, 2]}]}`
+	expected = "b,a,c\n1,x,\n,,\"[1,2]\"\n"
+	if out := writeResponse(t, CSV_FORMAT, star); out != expected {
+		t.Errorf("Unexpected csv output:\n%s", out)
+	}
+
+	raw := `{"signature": "json", "results": ["x", null], "errors": [{"code": 5000, "msg": "failed"}]}`
+	expected = "$1\nx\nnull\nerrors: [{\"code\":5000,\"msg\":\"failed\"}]\n"
+	if out := writeResponse(t, CSV_FORMAT, raw); out != expected {
+		t.Errorf("Unexpected csv output:\n%s", out)
+	}
+
+	errCode, _ := PushOrSet([]string{"output_format", "xml"}, true)
+	if errCode != 142 {
+		t.Errorf("Expected invalid output format error, got %v", errCode)
+	}
+
+	val, errCode, errStr := Resolve("-output_format")
+	if errCode != 0 {
+		t.Errorf("%s", HandleError(errCode, errStr))
+	} else if val.Actual() != CSV_FORMAT {
+		t.Errorf("Unexpected output format %v", val)
+	}
+}
+
+func TestPager(t *testing.T) {
+	var b bytes.Buffer
+	p := NewPager(&b, strings.NewReader("\nq\n"), 3)
+	for i := 1; i <= 10; i++ {
+		p.Write([]byte(strings.Repeat("x", i) + "\n"))
+	}
+
+	// Two lines per screen, until the user quits on the second prompt
+	out := strings.Replace(b.String(), "\r"+strings.Repeat(" ", len(PAGER_PROMPT))+"\r", "", -1)
+	expected := "x\nxx\n" + PAGER_PROMPT + "xxx\nxxxx\n" + PAGER_PROMPT
+	if out != expected {
+		t.Errorf("Unexpected paged output:\n%q", out)
+	}
+}
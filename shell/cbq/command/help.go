@@ -26,7 +26,7 @@ func (this *Help) Name() string {
 }
 
 func (this *Help) CommandCompletion() bool {
-	return false
+	return true
 }
 
 func (this *Help) MinArgs() int {
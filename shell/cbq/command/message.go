@@ -141,7 +141,9 @@ const (
 
 	DSET = "Set the value of the given parameter to the input value. parameter is a prefixed name " +
 		"(-creds, -$rate, $user, histfile).\nIf no arguments are given, list all the existing parameters.\n" +
-		"\tExample : \n\t        \\SET -$r 9.5 ;\n\t        \\SET $Val -$r ;\n"
+		"output_format (json, table, csv or vertical) sets how results are shown, and pager (true or false) " +
+		"whether long results are shown one screen at a time.\n" +
+		"\tExample : \n\t        \\SET -$r 9.5 ;\n\t        \\SET $Val -$r ;\n\t        \\SET -output_format table ;\n"
 
 	DSOURCE = "Load input file into shell.\n\tExample : \n\t \\SOURCE temp1.txt ;\n"
 
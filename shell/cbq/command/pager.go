@@ -0,0 +1,85 @@
+//  Copyright (c) 2015-2016 Couchbase, Inc.
+//  Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file
+//  except in compliance with the License. You may obtain a copy of the License at
+//    http://www.apache.org/licenses/LICENSE-2.0
+//  Unless required by applicable law or agreed to in writing, software distributed under the
+//  License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+//  either express or implied. See the License for the specific language governing permissions
+//  and limitations under the License.
+
+package command
+
+import (
+	"bufio"
+	"bytes"
+	"io"
+	"strings"
+)
+
+/* Long results written to the terminal are paged when the
+   pager predefined session parameter is true.
+*/
+const PAGER = "pager"
+
+const PAGER_PROMPT = "-- More -- (Enter for the next page, q to quit)"
+
+func PagerEnabled() bool {
+	return strings.ToLower(predefString(PAGER, "true")) == "true"
+}
+
+/* The pager writes to the underlying writer one screen at
+   a time, and waits for the user before showing the next
+   screen. Once the user quits, the rest of the output is
+   discarded.
+*/
+type pager struct {
+	w      io.Writer
+	in     *bufio.Reader
+	height int
+	lines  int
+	quit   bool
+}
+
+func NewPager(w io.Writer, in io.Reader, height int) io.Writer {
+	return &pager{
+		w:      w,
+		in:     bufio.NewReader(in),
+		height: height,
+	}
+}
+
+func (this *pager) Write(p []byte) (int, error) {
+	n := len(p)
+	for len(p) > 0 && !this.quit {
+		// Keep the last line of the screen for the prompt
+		if this.lines >= this.height-1 {
+			this.prompt()
+			continue
+		}
+
+		i := bytes.IndexByte(p, '\n')
+		if i < 0 {
+			_, err := this.w.Write(p)
+			return n, err
+		}
+
+		_, err := this.w.Write(p[:i+1])
+		if err != nil {
+			return n, err
+		}
+		p = p[i+1:]
+		this.lines++
+	}
+	return n, nil
+}
+
+func (this *pager) prompt() {
+	io.WriteString(this.w, PAGER_PROMPT)
+	line, err := this.in.ReadString('\n')
+	io.WriteString(this.w, "\r"+strings.Repeat(" ", len(PAGER_PROMPT))+"\r")
+
+	if err != nil || strings.ToLower(strings.TrimSpace(line)) == "q" {
+		this.quit = true
+	}
+	this.lines = 0
+}
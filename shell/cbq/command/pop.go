@@ -28,7 +28,7 @@ func (this *Pop) Name() string {
 }
 
 func (this *Pop) CommandCompletion() bool {
-	return false
+	return true
 }
 
 func (this *Pop) MinArgs() int {
@@ -68,6 +68,7 @@ func (this *Pop) ExecCommand(args []string) (int, string) {
 
 	} else {
 		//Check what kind of parameter needs to be popped
+		args[0] = ShellSetting(args[0])
 
 		if strings.HasPrefix(args[0], "-$") {
 			// For Named Parameters
@@ -26,7 +26,7 @@ func (this *Redirect) Name() string {
 }
 
 func (this *Redirect) CommandCompletion() bool {
-	return false
+	return true
 }
 
 func (this *Redirect) MinArgs() int {
@@ -27,7 +27,7 @@ func (this *Unset) Name() string {
 }
 
 func (this *Unset) CommandCompletion() bool {
-	return false
+	return true
 }
 
 func (this *Unset) MinArgs() int {
@@ -50,6 +50,8 @@ func (this *Unset) ExecCommand(args []string) (int, string) {
 
 	} else {
 		//Check what kind of parameter needs to be Unset.
+		args[0] = ShellSetting(args[0])
+
 		// For query parameters
 		if strings.HasPrefix(args[0], "-$") {
 			// For Named Parameters
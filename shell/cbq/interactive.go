@@ -145,6 +145,15 @@ func HandleInteractiveMode(prompt string) {
 	}
 	// End handling the options
 
+	/* Complete keywords, commands, functions, keyspaces and fields
+	   on tab. Keyspaces and fields are read from the query service.
+	*/
+	liner.SetWordCompleter(command.Complete)
+	command.EnableCompletion()
+	if !noQueryService {
+		refreshCompletions()
+	}
+
 	for {
 		line, err := liner.Prompt(fullPrompt)
 		if err != nil {
@@ -14,6 +14,7 @@ package main
 import (
 	"fmt"
 	"io"
+	"os"
 
 	"github.com/couchbase/query/shell/cbq/command"
 	"golang.org/x/crypto/ssh/terminal"
@@ -27,3 +28,17 @@ func promptPassword(prompt string) ([]byte, error) {
 	}
 	return terminal.ReadPassword(0)
 }
+
+// The number of lines of the terminal, or 0 if stdout is not a terminal.
+func terminalHeight() int {
+	fd := int(os.Stdout.Fd())
+	if !terminal.IsTerminal(fd) {
+		return 0
+	}
+
+	_, height, err := terminal.GetSize(fd)
+	if err != nil {
+		return 0
+	}
+	return height
+}
@@ -20,3 +20,8 @@ func promptPassword(prompt string) ([]byte, error) {
 	}
 	return []byte(C.GoString(password)), nil
 }
+
+// Results are not paged on solaris.
+func terminalHeight() int {
+	return 0
+}
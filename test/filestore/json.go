@@ -63,9 +63,20 @@ func (this *MockQuery) Execute(srvr *server.Server, signature value.Value, stopN
 
 	this.NotifyStop(stopNotify)
 	this.writeResults()
+	this.response.err = this.firstError()
 	close(this.response.done)
 }
 
+// Returns the first error raised during execution, if any.
+func (this *MockQuery) firstError() errors.Error {
+	select {
+	case err := <-this.Errors():
+		return err
+	default:
+		return nil
+	}
+}
+
 func (this *MockQuery) Failed(srvr *server.Server) {
 	this.stopAndClose(server.FATAL)
 }
@@ -160,10 +171,27 @@ func (this *MockServer) doStats(request *MockQuery) {
 }
 
 func Run(mockServer *MockServer, q string) ([]interface{}, []errors.Error, errors.Error) {
+	return RunTransaction(mockServer, q, "")
+}
+
+// Runs the query within the transaction with the given id, if any.
+func RunTransaction(mockServer *MockServer, q, txId string) ([]interface{}, []errors.Error, errors.Error) {
+	return runRequest(mockServer, q, txId, nil)
+}
+
+// Runs the query on behalf of the given users.
+func RunWithCredentials(mockServer *MockServer, q string, creds datastore.Credentials) (
+	[]interface{}, []errors.Error, errors.Error) {
+	return runRequest(mockServer, q, "", creds)
+}
+
+func runRequest(mockServer *MockServer, q, txId string, creds datastore.Credentials) (
+	[]interface{}, []errors.Error, errors.Error) {
 	var metrics value.Tristate
 	scanConfiguration := &scanConfigImpl{}
 
-	base := server.NewBaseRequest(q, nil, nil, nil, "json", 0, value.FALSE, metrics, value.TRUE, scanConfiguration, "", nil)
+	base := server.NewBaseRequest(q, nil, nil, nil, "json", 0, value.FALSE, metrics, value.TRUE, scanConfiguration, "", creds)
+	base.SetTxId(txId)
 
 	mr := &MockResponse{
 		results: []interface{}{}, warnings: []errors.Error{}, done: make(chan bool),
@@ -13,10 +13,21 @@ import (
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
+	"os"
 	"path/filepath"
 	"reflect"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/couchbase/query/auth"
+	auth_file "github.com/couchbase/query/auth/file"
+	"github.com/couchbase/query/datastore"
+	"github.com/couchbase/query/errors"
+	"github.com/couchbase/query/execution"
+	"github.com/couchbase/query/expression"
+	"github.com/couchbase/query/plan"
+	"github.com/couchbase/query/server"
 	"github.com/dustin/go-jsonpointer"
 )
 
@@ -62,6 +73,222 @@ func TestSimpleSelect(t *testing.T) {
 	}
 }
 
+func TestOrderSpill(t *testing.T) {
+	qc := start()
+
+	// The second query sorts on MISSING and NULL keys, which must
+	// survive the round trip through the spilled runs
+	queries := []string{
+		"SELECT META(o).id, o.custId, ARRAY l.productId FOR l IN o.orderlines END AS products " +
+			"FROM default:orders o ORDER BY o.custId DESC, o.orderlines[0].productId, META(o).id",
+		"SELECT META(o).id FROM default:orders o " +
+			"ORDER BY CASE WHEN o.custId = \"ccc\" THEN NULL ELSE MISSING END, META(o).id DESC",
+	}
+
+	for _, q := range queries {
+		expected, _, err := Run(qc, q)
+		if err != nil || len(expected) == 0 {
+			t.Fatalf("did not expect err %v", err)
+		}
+
+		// Spill every item to its own sorted run
+		execution.SetSortMemory(1)
+		r, _, err := Run(qc, q)
+		execution.SetSortMemory(0)
+		if err != nil {
+			t.Fatalf("did not expect err %v", err)
+		}
+
+		if !reflect.DeepEqual(r, expected) {
+			t.Errorf("results don't match, actual: %#v, expected: %#v", r, expected)
+		}
+	}
+}
+
+func TestGroupSpill(t *testing.T) {
+	qc := start()
+
+	q := "SELECT l.productId, COUNT(*) AS n, SUM(l.qty) AS qty, AVG(l.qty) AS avg, " +
+		"COUNT(DISTINCT o.custId) AS custs, ARRAY_SORT(ARRAY_AGG(DISTINCT o.custId)) AS custIds " +
+		"FROM default:orders o UNNEST o.orderlines l GROUP BY l.productId ORDER BY l.productId"
+	expected, _, err := Run(qc, q)
+	if err != nil || len(expected) == 0 {
+		t.Fatalf("did not expect err %v", err)
+	}
+
+	// Spill the groups each time a group is cumulated
+	execution.SetGroupMemory(1)
+	defer execution.SetGroupMemory(0)
+
+	r, _, err := Run(qc, q)
+	if err != nil {
+		t.Fatalf("did not expect err %v", err)
+	}
+
+	if !reflect.DeepEqual(r, expected) {
+		t.Errorf("results don't match, actual: %#v, expected: %#v", r, expected)
+	}
+}
+
+func TestMemoryQuota(t *testing.T) {
+	qc := start()
+
+	q := "SELECT META(o).id, o.custId FROM default:orders o ORDER BY o.custId, META(o).id"
+	expected, _, err := Run(qc, q)
+	if err != nil || len(expected) == 0 {
+		t.Fatalf("did not expect err %v", err)
+	}
+
+	// The request is aborted as soon as ORDER BY holds an item
+	execution.SetMemoryQuota(1)
+	r, _, err := Run(qc, q)
+	execution.SetMemoryQuota(0)
+	if err == nil || err.Code() != 5290 || len(r) != 0 {
+		t.Errorf("expected request to exceed its memory quota, got %v results, err %v", len(r), err)
+	}
+
+	execution.SetNodeQuota(1)
+	r, _, err = Run(qc, q)
+	execution.SetNodeQuota(0)
+	if err == nil || err.Code() != 5300 || len(r) != 0 {
+		t.Errorf("expected request to exceed the node memory quota, got %v results, err %v", len(r), err)
+	}
+
+	// Window functions hold their whole input
+	execution.SetMemoryQuota(1)
+	r, _, err = Run(qc, "SELECT ROW_NUMBER() OVER (ORDER BY o.custId) AS rn FROM default:orders o")
+	execution.SetMemoryQuota(0)
+	if err == nil || err.Code() != 5290 || len(r) != 0 {
+		t.Errorf("expected window to exceed its memory quota, got %v results, err %v", len(r), err)
+	}
+
+	r, _, err = Run(qc, q)
+	if err != nil {
+		t.Fatalf("did not expect err %v", err)
+	}
+
+	if !reflect.DeepEqual(r, expected) {
+		t.Errorf("results don't match, actual: %#v, expected: %#v", r, expected)
+	}
+
+	// Operators release their memory as they exit
+	for i := 0; i < 100 && execution.NodeMemory() != 0; i++ {
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	if m := execution.NodeMemory(); m != 0 {
+		t.Errorf("expected operators to release their memory, got %v", m)
+	}
+}
+
+func TestCostBasedScans(t *testing.T) {
+	qc := start()
+
+	for _, q := range []string{
+		"CREATE INDEX nameidx ON default:contacts(name)",
+		"CREATE INDEX typeidx ON default:contacts(type)",
+		"CREATE INDEX hobbyidx ON default:contacts(hobbies[0])",
+	} {
+		_, _, err := Run(qc, q)
+		if err != nil {
+			t.Fatalf("did not expect err %v", err)
+		}
+	}
+
+	defer func() {
+		for _, name := range []string{"nameidx", "typeidx", "hobbyidx"} {
+			Run(qc, "DROP INDEX default:contacts."+name)
+		}
+	}()
+
+	cases := []struct {
+		query    string
+		operator string
+		index    string
+	}{
+		// The selective index is scanned alone, rather than intersected
+		{"SELECT * FROM default:contacts c WHERE c.type = \"contact\" AND c.name = \"fred\"",
+			"IndexScan", "nameidx"},
+		// An index that selects every document costs more than a primary scan
+		{"SELECT * FROM default:contacts c WHERE c.type = \"contact\"",
+			"PrimaryScan", "#primary"},
+		// Each term of the disjunction is scanned by its own index
+		{"SELECT * FROM default:contacts c WHERE c.name = \"fred\" OR c.hobbies[0] = \"golf\"",
+			"UnionScan", ""},
+	}
+
+	for _, c := range cases {
+		r, _, err := Run(qc, "EXPLAIN "+c.query)
+		if err != nil || len(r) != 1 {
+			t.Fatalf("did not expect err %v", err)
+		}
+
+		plan := r[0].(map[string]interface{})["plan"].(map[string]interface{})
+		scan := plan["~children"].([]interface{})[0].(map[string]interface{})
+		if scan["#operator"] != c.operator {
+			t.Errorf("expected %s for %s, actual: %v", c.operator, c.query, scan["#operator"])
+		}
+
+		if c.index != "" && scan["index"] != c.index {
+			t.Errorf("expected index %s for %s, actual: %v", c.index, c.query, scan["index"])
+		}
+
+		if _, ok := scan["cardinality"]; !ok {
+			t.Errorf("expected estimated cardinality for %s", c.query)
+		}
+	}
+
+	r, _, err := Run(qc, "SELECT c.name FROM default:contacts c "+
+		"WHERE c.name = \"fred\" OR c.hobbies[0] = \"golf\" ORDER BY c.name")
+	if err != nil {
+		t.Fatalf("did not expect err %v", err)
+	}
+
+	expected := []interface{}{
+		map[string]interface{}{"name": "dave"},
+		map[string]interface{}{"name": "fred"},
+		map[string]interface{}{"name": "ian"},
+	}
+
+	if !reflect.DeepEqual(r, expected) {
+		t.Errorf("results don't match, actual: %#v, expected: %#v", r, expected)
+	}
+}
+
+func TestAnalyzeKeyspace(t *testing.T) {
+	qc := start()
+
+	defer os.Remove(filepath.Join("json", "default", "contacts.statistics.json"))
+
+	_, _, err := Run(qc, "ANALYZE KEYSPACE default:contacts FOR name, type WITH {\"histogram_bins\": 2}")
+	if err != nil {
+		t.Fatalf("did not expect err %v", err)
+	}
+
+	r, _, err := Run(qc, "SELECT META(s).id, s.documents, s.sampled, "+
+		"ARRAY [f.field, f.`count`, f.`distinct`, ARRAY_LENGTH(f.histogram)] FOR f IN s.fields END AS fields "+
+		"FROM system:statistics s")
+	if err != nil {
+		t.Fatalf("did not expect err %v", err)
+	}
+
+	expected := []interface{}{
+		map[string]interface{}{
+			"id":        "default/contacts",
+			"documents": 6.0,
+			"sampled":   6.0,
+			"fields": []interface{}{
+				[]interface{}{"`name`", 6.0, 6.0, 2.0},
+				[]interface{}{"`type`", 6.0, 1.0, 1.0},
+			},
+		},
+	}
+
+	if !reflect.DeepEqual(r, expected) {
+		t.Errorf("results don't match, actual: %#v, expected: %#v", r, expected)
+	}
+}
+
 func TestAllCaseFiles(t *testing.T) {
 	qc := start()
 	matches, err := filepath.Glob("json/default/cases/case_*.json")
@@ -208,3 +435,505 @@ func doResultsMatch(t *testing.T, resultsActual, resultsExpected []interface{},
 		return
 	}
 }
+
+func TestUserFunctions(t *testing.T) {
+	qc := start()
+
+	for _, q := range []string{
+		"CREATE FUNCTION celsius(f) { (f - 32) * 5 / 9 }",
+		"CREATE FUNCTION fact(n) { CASE WHEN n <= 1 THEN 1 ELSE n * fact(n - 1) END }",
+	} {
+		_, _, err := Run(qc, q)
+		if err != nil {
+			t.Fatalf("did not expect err %v", err)
+		}
+	}
+
+	defer func() {
+		for _, name := range []string{"celsius", "fact"} {
+			Run(qc, "DROP FUNCTION "+name)
+		}
+	}()
+
+	// The functions are persisted by the datastore, and loaded again
+	// when the service starts
+	for _, name := range []string{"celsius", "fact"} {
+		expression.DeleteUserFunction(name)
+	}
+	err := server.LoadUserFunctions(qc.server.Datastore())
+	if err != nil {
+		t.Fatalf("did not expect err %v", err)
+	}
+
+	r, _, err := Run(qc, "SELECT celsius(212) AS c, FACT(5) AS f, ARRAY fact(x) FOR x IN [1, 2, 3] END AS a")
+	if err != nil {
+		t.Fatalf("did not expect err %v", err)
+	}
+
+	expected := []interface{}{
+		map[string]interface{}{"c": 100.0, "f": 120.0, "a": []interface{}{1.0, 2.0, 6.0}},
+	}
+	if !reflect.DeepEqual(r, expected) {
+		t.Errorf("results don't match, actual: %#v, expected: %#v", r, expected)
+	}
+
+	r, _, err = Run(qc, "SELECT f.name, f.parameters FROM system:functions f")
+	if err != nil {
+		t.Fatalf("did not expect err %v", err)
+	}
+
+	expected = []interface{}{
+		map[string]interface{}{"name": "celsius", "parameters": []interface{}{"f"}},
+		map[string]interface{}{"name": "fact", "parameters": []interface{}{"n"}},
+	}
+	if !reflect.DeepEqual(r, expected) {
+		t.Errorf("results don't match, actual: %#v, expected: %#v", r, expected)
+	}
+
+	for _, q := range []string{
+		"CREATE FUNCTION upper(s) { s }",
+		"CREATE FUNCTION bad(n) { m + 1 }",
+		"CREATE FUNCTION sub(n) { (SELECT n) }",
+		"SELECT celsius(1, 2)",
+	} {
+		_, _, err = Run(qc, q)
+		if err == nil {
+			t.Errorf("expected err for %s", q)
+		}
+	}
+
+	_, _, err = Run(qc, "DROP FUNCTION celsius")
+	if err != nil {
+		t.Fatalf("did not expect err %v", err)
+	}
+
+	_, _, err = Run(qc, "SELECT celsius(32)")
+	if err == nil {
+		t.Errorf("expected err for dropped function")
+	}
+}
+
+func TestTransactions(t *testing.T) {
+	qc := start()
+
+	for _, key := range []string{"txn_1", "txn_2", "txn_3"} {
+		defer os.Remove(filepath.Join("json", "default", "orders", key+".json"))
+	}
+
+	begin := func() string {
+		r, _, err := Run(qc, "START TRANSACTION")
+		if err != nil || len(r) != 1 {
+			t.Fatalf("did not expect err %v", err)
+		}
+		return r[0].(map[string]interface{})["txid"].(string)
+	}
+
+	check := func(q, txId string, expected []interface{}) {
+		r, _, err := RunTransaction(qc, q, txId)
+		if err != nil {
+			t.Fatalf("did not expect err %v", err)
+		}
+		if !reflect.DeepEqual(r, expected) {
+			t.Errorf("results of %s don't match, actual: %#v, expected: %#v", q, r, expected)
+		}
+	}
+
+	// Writes are only visible within the transaction, and discarded by ROLLBACK
+	txId := begin()
+	check("INSERT INTO default:orders (KEY, VALUE) VALUES (\"txn_1\", {\"n\": 1})", txId, []interface{}{})
+	check("SELECT o.n FROM default:orders o USE KEYS \"txn_1\"", txId,
+		[]interface{}{map[string]interface{}{"n": 1.0}})
+	check("SELECT META(o).id FROM default:orders o WHERE o.n = 1", txId,
+		[]interface{}{map[string]interface{}{"id": "txn_1"}})
+	check("SELECT o.n FROM default:orders o USE KEYS \"txn_1\"", "", []interface{}{})
+	check("ROLLBACK", txId, []interface{}{})
+	check("SELECT o.n FROM default:orders o USE KEYS \"txn_1\"", "", []interface{}{})
+
+	_, _, err := RunTransaction(qc, "SELECT 1", txId)
+	if err == nil {
+		t.Errorf("expected err for ended transaction")
+	}
+
+	// Only the credentials that started a transaction can use it
+	txId = begin()
+	_, _, err = runRequest(qc, "SELECT 1", txId, datastore.Credentials{"mallory": "pw"})
+	if err == nil || err.Code() != 17005 {
+		t.Errorf("expected credentials err, got %v", err)
+	}
+	check("ROLLBACK", txId, []interface{}{})
+
+	// COMMIT applies the writes
+	txId = begin()
+	check("INSERT INTO default:orders (KEY, VALUE) VALUES (\"txn_1\", {\"n\": 1})", txId, []interface{}{})
+	check("COMMIT WORK", txId, []interface{}{})
+	check("SELECT o.n FROM default:orders o USE KEYS \"txn_1\"", "",
+		[]interface{}{map[string]interface{}{"n": 1.0}})
+
+	// A conflicting write by another writer rolls back the whole transaction
+	txId = begin()
+	check("UPDATE default:orders USE KEYS \"txn_1\" SET n = 2", txId, []interface{}{})
+	check("UPSERT INTO default:orders (KEY, VALUE) VALUES (\"txn_2\", {\"n\": 2})", txId, []interface{}{})
+	check("UPDATE default:orders USE KEYS \"txn_1\" SET n = 3", "", []interface{}{})
+	check("SELECT o.n FROM default:orders o USE KEYS \"txn_1\"", txId,
+		[]interface{}{map[string]interface{}{"n": 2.0}})
+	_, _, err = RunTransaction(qc, "COMMIT", txId)
+	if err == nil || err.Code() != 17050 {
+		t.Errorf("expected conflict err, got %v", err)
+	}
+	check("SELECT META(o).id, o.n FROM default:orders o USE KEYS [\"txn_1\", \"txn_2\"]", "",
+		[]interface{}{map[string]interface{}{"id": "txn_1", "n": 3.0}})
+
+	fails := func(q, txId string, code int32) {
+		_, _, err := RunTransaction(qc, q, txId)
+		if err == nil || err.Code() != code {
+			t.Errorf("expected err %d for %s, got %v", code, q, err)
+		}
+	}
+
+	// Index, covering and count scans, and prepared statements, see the
+	// transaction's writes
+	check("CREATE INDEX txnidx ON default:orders(n)", "", []interface{}{})
+	defer Run(qc, "DROP INDEX default:orders.txnidx")
+	_, _, err = Run(qc, "PREPARE txnp FROM SELECT META(o).id FROM default:orders o WHERE o.n = 4")
+	if err != nil {
+		t.Fatalf("did not expect err %v", err)
+	}
+	defer Run(qc, "DELETE FROM system:prepareds p WHERE p.name = \"txnp\"")
+
+	txId = begin()
+	check("UPDATE default:orders USE KEYS \"txn_1\" SET n = 4", txId, []interface{}{})
+	check("INSERT INTO default:orders (KEY, VALUE) VALUES (\"txn_2\", {\"n\": 4})", txId, []interface{}{})
+	check("SELECT META(o).id FROM default:orders o WHERE o.n = 3", txId, []interface{}{})
+	check("SELECT META(o).id FROM default:orders o WHERE o.n = 4 ORDER BY META(o).id", txId,
+		[]interface{}{map[string]interface{}{"id": "txn_1"}, map[string]interface{}{"id": "txn_2"}})
+	check("DELETE FROM default:orders USE KEYS \"txn_1\"", txId, []interface{}{})
+	check("SELECT COUNT(*) AS c FROM default:orders o WHERE o.n > 0", txId,
+		[]interface{}{map[string]interface{}{"c": 1.0}})
+	check("EXECUTE txnp", txId, []interface{}{map[string]interface{}{"id": "txn_2"}})
+
+	// Writes by another writer outside the scanned ranges do not conflict
+	check("UPSERT INTO default:orders (KEY, VALUE) VALUES (\"txn_3\", {\"n\": -1})", "", []interface{}{})
+	check("COMMIT", txId, []interface{}{})
+	check("SELECT META(o).id, o.n FROM default:orders o WHERE o.n > -5 ORDER BY META(o).id", "",
+		[]interface{}{map[string]interface{}{"id": "txn_2", "n": 4.0},
+			map[string]interface{}{"id": "txn_3", "n": -1.0}})
+
+	// A document added by another writer within a scanned range fails the COMMIT
+	txId = begin()
+	check("SELECT META(o).id FROM default:orders o WHERE o.n > 3", txId,
+		[]interface{}{map[string]interface{}{"id": "txn_2"}})
+	check("UPDATE default:orders USE KEYS \"txn_2\" SET n = 8", txId, []interface{}{})
+	check("UPSERT INTO default:orders (KEY, VALUE) VALUES (\"txn_1\", {\"n\": 7})", "", []interface{}{})
+	fails("COMMIT", txId, 17055)
+	check("SELECT META(o).id, o.n FROM default:orders o WHERE o.n > 3 ORDER BY META(o).id", "",
+		[]interface{}{map[string]interface{}{"id": "txn_1", "n": 7.0},
+			map[string]interface{}{"id": "txn_2", "n": 4.0}})
+
+	// Documents only read by the transaction are validated at COMMIT
+	txId = begin()
+	check("SELECT o.n FROM default:orders o USE KEYS \"txn_1\"", txId,
+		[]interface{}{map[string]interface{}{"n": 7.0}})
+	check("UPDATE default:orders USE KEYS \"txn_1\" SET n = 6", "", []interface{}{})
+	check("SELECT o.n FROM default:orders o USE KEYS \"txn_1\"", txId,
+		[]interface{}{map[string]interface{}{"n": 7.0}})
+	fails("COMMIT", txId, 17050)
+}
+
+func TestPlanCache(t *testing.T) {
+	qc := start()
+
+	plan.ClearPlanCache()
+	defer plan.ClearPlanCache()
+
+	run := func(q string, expected []interface{}) {
+		r, _, err := Run(qc, q)
+		if err != nil {
+			t.Fatalf("did not expect err %v", err)
+		}
+		if !reflect.DeepEqual(r, expected) {
+			t.Errorf("results of %s don't match, actual: %#v, expected: %#v", q, r, expected)
+		}
+	}
+
+	names := []interface{}{map[string]interface{}{"name": "dave"}}
+	entries := "SELECT p.uses, p.stale FROM system:plan_cache p WHERE p.statement LIKE \"SELECT c.name %\""
+
+	// Statements differing only in whitespace share a plan
+	run("SELECT c.name FROM default:contacts c WHERE c.name = \"dave\"", names)
+	run("SELECT c.name\n  FROM default:contacts c\n  WHERE c.name = \"dave\"", names)
+	run(entries, []interface{}{map[string]interface{}{"uses": 1.0, "stale": false}})
+
+	// Creating an index invalidates the plan, which is rebuilt on next use
+	run("CREATE INDEX cacheidx ON default:contacts(name)", []interface{}{})
+	defer Run(qc, "DROP INDEX default:contacts.cacheidx")
+
+	run(entries, []interface{}{map[string]interface{}{"uses": 1.0, "stale": true}})
+	run("SELECT c.name FROM default:contacts c WHERE c.name = \"dave\"", names)
+	run(entries, []interface{}{map[string]interface{}{"uses": 0.0, "stale": false}})
+
+	// An index dropped through its indexer leaves the plan stale
+	// Plans are cached under the server's namespace
+	stmt := "SELECT c.name FROM default:contacts c WHERE c.name = \"dave\""
+	prepared := plan.GetCachedPlan(stmt, "json", datastore.IndexVersion())
+	if prepared == nil {
+		t.Fatalf("expected a cached plan")
+	}
+
+	namespace, _ := qc.server.Datastore().NamespaceByName("default")
+	keyspace, _ := namespace.KeyspaceByName("contacts")
+	indexer, _ := keyspace.Indexer(datastore.DEFAULT)
+	index, err := indexer.IndexByName("cacheidx")
+	if err != nil {
+		t.Fatalf("did not expect err %v", err)
+	}
+	if err = index.Drop(""); err != nil {
+		t.Fatalf("did not expect err %v", err)
+	}
+
+	run(entries, []interface{}{map[string]interface{}{"uses": 1.0, "stale": true}})
+
+	// A plan whose index is dropped once it is taken from the cache
+	// is evicted, and the statement is planned again
+	prepared.SetIndexerVersions(nil)
+	plan.AddCachedPlan(stmt, "json", datastore.IndexVersion(), prepared)
+	run(stmt, names)
+	if plan.GetCachedPlan(stmt, "json", datastore.IndexVersion()) == prepared {
+		t.Errorf("expected the plan of the dropped index to be evicted")
+	}
+
+	run("DELETE FROM system:plan_cache p WHERE p.statement LIKE \"SELECT c.name %\"", []interface{}{})
+	run(entries, []interface{}{})
+}
+
+func TestReturningImages(t *testing.T) {
+	qc := start()
+
+	defer os.Remove(filepath.Join("json", "default", "orders", "ret_1.json"))
+
+	run := func(q string, expected []interface{}) {
+		r, _, err := Run(qc, q)
+		if err != nil {
+			t.Fatalf("did not expect err %v", err)
+		}
+		if !reflect.DeepEqual(r, expected) {
+			t.Errorf("results of %s don't match, actual: %#v, expected: %#v", q, r, expected)
+		}
+	}
+
+	run("INSERT INTO default:orders (KEY, VALUE) VALUES (\"ret_1\", {\"status\": \"new\"}) "+
+		"RETURNING OLD.status, NEW.status",
+		[]interface{}{map[string]interface{}{"new_status": "new"}})
+
+	run("UPDATE default:orders USE KEYS \"ret_1\" SET status = \"shipped\" "+
+		"RETURNING OLD.status, NEW.status, META().id",
+		[]interface{}{map[string]interface{}{"old_status": "new", "new_status": "shipped", "id": "ret_1"}})
+
+	run("UPSERT INTO default:orders (KEY, VALUE) VALUES (\"ret_1\", {\"status\": \"done\"}) "+
+		"RETURNING OLD.status AS was, NEW.status AS now",
+		[]interface{}{map[string]interface{}{"was": "shipped", "now": "done"}})
+
+	// The images are not part of RETURNING *
+	run("UPDATE default:orders o USE KEYS \"ret_1\" SET o.status = \"closed\" RETURNING *",
+		[]interface{}{map[string]interface{}{"o": map[string]interface{}{"status": "closed"}}})
+
+	run("MERGE INTO default:orders o USING (SELECT \"ret_1\" AS id) s ON KEY s.id "+
+		"WHEN MATCHED THEN UPDATE SET o.status = \"merged\" RETURNING OLD.status, NEW.status",
+		[]interface{}{map[string]interface{}{"old_status": "closed", "new_status": "merged"}})
+
+	run("DELETE FROM default:orders USE KEYS [\"ret_1\", \"ret_2\"] RETURNING META(OLD).id, OLD.status",
+		[]interface{}{map[string]interface{}{"id": "ret_1", "old_status": "merged"}})
+}
+
+func TestRoles(t *testing.T) {
+	qc := start()
+
+	dir, e := ioutil.TempDir("", "roles")
+	if e != nil {
+		t.Fatalf("could not create temp dir: %v", e)
+	}
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "users.json")
+	e = ioutil.WriteFile(path, []byte(`[
+		{"id": "root", "password": "rootpw", "roles": [{"role": "admin"}]},
+		{"id": "alice", "name": "Alice", "password": "alicepw"}
+	]`), 0600)
+	if e != nil {
+		t.Fatalf("could not write users: %v", e)
+	}
+
+	store, err := auth_file.NewCredentialStore(path)
+	if err != nil {
+		t.Fatalf("did not expect err %v", err)
+	}
+	auth.SetCredentialStore(store)
+	defer auth.SetCredentialStore(nil)
+
+	// The plaintext passwords are replaced by their hashes
+	if bytes, _ := ioutil.ReadFile(path); strings.Contains(string(bytes), "alicepw") {
+		t.Errorf("expected the passwords to be hashed, got %s", bytes)
+	}
+
+	// A verified password is remembered, but only that password
+	for _, password := range []string{"alicepw", "alicepw", "wrong"} {
+		_, err = store.Authenticate("alice", password)
+		if (err == nil) != (password == "alicepw") {
+			t.Errorf("unexpected authentication of alice with %s: err %v", password, err)
+		}
+	}
+
+	root := datastore.Credentials{"root": "rootpw"}
+	alice := datastore.Credentials{"alice": "alicepw"}
+
+	run := func(q string, creds datastore.Credentials, expected []interface{}) {
+		r, _, err := RunWithCredentials(qc, q, creds)
+		if err != nil {
+			t.Fatalf("did not expect err %v", err)
+		}
+		if !reflect.DeepEqual(r, expected) {
+			t.Errorf("results of %s don't match, actual: %#v, expected: %#v", q, r, expected)
+		}
+	}
+
+	fails := func(q string, creds datastore.Credentials, code int32) {
+		r, _, err := RunWithCredentials(qc, q, creds)
+		if err == nil || err.Code() != code || len(r) != 0 {
+			t.Errorf("expected %s to fail with code %v, got %v results, err %v", q, code, len(r), err)
+		}
+	}
+
+	// Requests failing authorization return no results
+	denied := func(q string, creds datastore.Credentials) {
+		fails(q, creds, errors.AUTHORIZATION_ERROR)
+	}
+
+	userRoles := func(name string) []auth.Role {
+		user, err := store.User(name)
+		if err != nil {
+			t.Fatalf("did not expect err %v", err)
+		}
+		return user.Roles
+	}
+
+	names := []interface{}{map[string]interface{}{"name": "dave"}}
+	query := "SELECT c.name FROM default:contacts c WHERE c.name = \"dave\""
+	roles := "SELECT u.roles FROM system:user_info u WHERE u.id = \"alice\""
+
+	denied(query, alice)
+	denied(query, datastore.Credentials{"alice": "wrong"})
+	denied("GRANT query_select ON default:contacts TO alice", alice)
+	if roles := userRoles("alice"); len(roles) != 0 {
+		t.Errorf("unexpected roles for alice: %v", roles)
+	}
+
+	run("GRANT query_select ON default:contacts TO alice", root, []interface{}{})
+	run(query, alice, names)
+	run(roles, root, []interface{}{map[string]interface{}{"roles": []interface{}{
+		map[string]interface{}{"role": "query_select", "keyspace": "default:contacts"}}}})
+	denied(roles, alice)
+
+	run("GRANT query_system_catalog TO alice", root, []interface{}{})
+	run(roles, alice, []interface{}{map[string]interface{}{"roles": []interface{}{
+		map[string]interface{}{"role": "query_select", "keyspace": "default:contacts"},
+		map[string]interface{}{"role": "query_system_catalog"}}}})
+
+	run("REVOKE query_select ON default:contacts FROM alice", root, []interface{}{})
+	denied(query, alice)
+
+	// Grants are persisted to the credential store
+	if roles := userRoles("alice"); !reflect.DeepEqual(roles, []auth.Role{{Name: "query_system_catalog"}}) {
+		t.Errorf("unexpected roles for alice: %v", roles)
+	}
+
+	// Invalid grants leave the store unchanged
+	fails("GRANT query_select TO alice, bob", root, 10120)
+	fails("GRANT admin ON default:contacts TO alice", root, 10140)
+	if roles := userRoles("alice"); !reflect.DeepEqual(roles, []auth.Role{{Name: "query_system_catalog"}}) {
+		t.Errorf("unexpected roles for alice: %v", roles)
+	}
+}
+
+func TestExplainAnalyze(t *testing.T) {
+	qc := start()
+
+	r, _, err := Run(qc, "EXPLAIN ANALYZE SELECT c.name FROM default:contacts c WHERE c.name = \"dave\"")
+	if err != nil {
+		t.Fatalf("did not expect err %v", err)
+	}
+	if len(r) != 1 {
+		t.Fatalf("expected a single plan, got %v", r)
+	}
+
+	explain, ok := r[0].(map[string]interface{})
+	if !ok || explain["analyze"] != true {
+		t.Fatalf("unexpected EXPLAIN ANALYZE result %#v", r[0])
+	}
+	if explain["text"] != "SELECT c.name FROM default:contacts c WHERE c.name = \"dave\"" {
+		t.Errorf("unexpected statement text %v", explain["text"])
+	}
+
+	// Every operator that ran reports its statistics; the projection
+	// emits the single matching contact
+	var stats func(op map[string]interface{})
+	stats = func(op map[string]interface{}) {
+		s, ok := op["#stats"].(map[string]interface{})
+		if !ok {
+			t.Errorf("no statistics for operator %v", op["#operator"])
+		} else if op["#operator"] == "FinalProject" && s["#itemsOut"] != 1.0 {
+			t.Errorf("expected one item out of FinalProject, got %v", s["#itemsOut"])
+		}
+		children, _ := op["~children"].([]interface{})
+		if child, ok := op["~child"]; ok {
+			children = append(children, child)
+		}
+		for _, child := range children {
+			if c, ok := child.(map[string]interface{}); ok {
+				stats(c)
+			}
+		}
+	}
+
+	plan, ok := explain["plan"].(map[string]interface{})
+	if !ok {
+		t.Fatalf("unexpected plan %#v", explain["plan"])
+	}
+	stats(plan)
+}
+
+func TestExactIntegers(t *testing.T) {
+	qc := start()
+
+	defer os.Remove(filepath.Join("json", "default", "orders", "int_1.json"))
+
+	// Results are compared as strings, as the test client decodes
+	// numbers as float64
+	run := func(q string, expected []interface{}) {
+		r, _, err := Run(qc, q)
+		if err != nil {
+			t.Fatalf("did not expect err %v", err)
+		}
+		if !reflect.DeepEqual(r, expected) {
+			t.Errorf("results of %s don't match, actual: %#v, expected: %#v", q, r, expected)
+		}
+	}
+
+	str := func(s string) []interface{} {
+		return []interface{}{map[string]interface{}{"s": s}}
+	}
+
+	run("SELECT TOSTRING(9007199254740993 + 2) AS s", str("9007199254740995"))
+	run("SELECT TOSTRING(9223372036854775807 - 1) AS s", str("9223372036854775806"))
+	run("SELECT TOSTRING(SUM(x)) AS s FROM default:orders o USE KEYS \"1200\" UNNEST [9007199254740993, 2, 3] x", str("9007199254740998"))
+	run("SELECT TOSTRING(7 / 2) AS s", str("3.5"))
+	run("SELECT TOSTRING(9223372036854775807 + 1) AS s", str("9223372036854776000"))
+
+	// Integers collate exactly against floats
+	run("SELECT 9007199254740993 > 9007199254740992.0 AS gt, 2 = 2.0 AS eq",
+		[]interface{}{map[string]interface{}{"gt": true, "eq": true}})
+
+	// Stored integers survive a read-modify-write
+	run("INSERT INTO default:orders (KEY, VALUE) VALUES (\"int_1\", {\"counter\": 9007199254740993})",
+		[]interface{}{})
+	run("UPDATE default:orders USE KEYS \"int_1\" SET counter = counter + 1", []interface{}{})
+	run("SELECT TOSTRING(o.counter) AS s FROM default:orders o USE KEYS \"int_1\"", str("9007199254740994"))
+}
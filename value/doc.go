@@ -19,7 +19,9 @@ null.go: nullValue type is an empty struct. The variable NULL_VALUE is initializ
 
 boolean.go: Represented by boolValue.
 
-number.go: floatValue is defined as type float64. (There are other types such as int, float32 etc, but they are not used at this moment.)
+number.go: floatValue is defined as type float64. NumberValue is the interface of the numbers, with their arithmetic.
+
+int.go: intValue is defined as type int64. Integers that fit in 64 bits, from JSON or N1QL, are exact intValues.
 
 string.go: stringValue is defined as type string. The major difference is for the method Collate, when the type of input argument is stringValue. Here we compare the 2 strings and return -1 if the receiver is less than the input.
 
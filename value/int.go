@@ -0,0 +1,327 @@
+//  Copieright (c) 2014 Couchbase, Inc.
+//  Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file
+//  except in compliance with the License. You may obtain a copy of the License at
+//    http://www.apache.org/licenses/LICENSE-2.0
+//  Unless required by applicable law or agreed to in writing, software distributed under the
+//  License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+//  either express or implied. See the License for the specific language governing permissions
+//  and limitations under the License.
+
+package value
+
+import (
+	"math"
+	"strconv"
+
+	"github.com/couchbase/query/util"
+)
+
+/*
+Exact 64-bit integer number, represented by intValue. Integers
+read from JSON or N1QL that fit in 64 bits are intValues, so that
+ids, counters and amounts above 2^53 keep their precision.
+*/
+type intValue int64
+
+func (this intValue) String() string {
+	return strconv.FormatInt(int64(this), 10)
+}
+
+func (this intValue) MarshalJSON() ([]byte, error) {
+	return strconv.AppendInt(make([]byte, 0, 20), int64(this), 10), nil
+}
+
+/*
+Type Number
+*/
+func (this intValue) Type() Type {
+	return NUMBER
+}
+
+/*
+Cast receiver to int64(Go type).
+*/
+func (this intValue) Actual() interface{} {
+	return int64(this)
+}
+
+func (this intValue) Equals(other Value) Value {
+	other = other.unwrap()
+	switch other := other.(type) {
+	case missingValue:
+		return other
+	case *nullValue:
+		return other
+	case intValue:
+		if this == other {
+			return TRUE_VALUE
+		}
+	case floatValue:
+		if compareIntFloat(int64(this), float64(other)) == 0 {
+			return TRUE_VALUE
+		}
+	}
+
+	return FALSE_VALUE
+}
+
+func (this intValue) Collate(other Value) int {
+	other = other.unwrap()
+	switch other := other.(type) {
+	case intValue:
+		switch {
+		case this < other:
+			return -1
+		case this > other:
+			return 1
+		default:
+			return 0
+		}
+	case floatValue:
+		return compareIntFloat(int64(this), float64(other))
+	default:
+		return int(NUMBER - other.Type())
+	}
+}
+
+func (this intValue) Compare(other Value) Value {
+	other = other.unwrap()
+	switch other := other.(type) {
+	case missingValue:
+		return other
+	case *nullValue:
+		return other
+	default:
+		return NewValue(this.Collate(other))
+	}
+}
+
+/*
+Returns true in the event the receiver is not 0.
+*/
+func (this intValue) Truth() bool {
+	return this != 0
+}
+
+/*
+Return receiver
+*/
+func (this intValue) Copy() Value {
+	return this
+}
+
+/*
+Return receiver
+*/
+func (this intValue) CopyForUpdate() Value {
+	return this
+}
+
+/*
+Calls missingField.
+*/
+func (this intValue) Field(field string) (Value, bool) {
+	return missingField(field), false
+}
+
+/*
+Not valid for NUMBER.
+*/
+func (this intValue) SetField(field string, val interface{}) error {
+	return Unsettable(field)
+}
+
+/*
+Not valid for NUMBER.
+*/
+func (this intValue) UnsetField(field string) error {
+	return Unsettable(field)
+}
+
+/*
+Calls missingIndex.
+*/
+func (this intValue) Index(index int) (Value, bool) {
+	return missingIndex(index), false
+}
+
+/*
+Not valid for NUMBER.
+*/
+func (this intValue) SetIndex(index int, val interface{}) error {
+	return Unsettable(index)
+}
+
+/*
+Returns NULL_VALUE
+*/
+func (this intValue) Slice(start, end int) (Value, bool) {
+	return NULL_VALUE, false
+}
+
+/*
+Returns NULL_VALUE
+*/
+func (this intValue) SliceTail(start int) (Value, bool) {
+	return NULL_VALUE, false
+}
+
+/*
+Returns the input buffer as is.
+*/
+func (this intValue) Descendants(buffer []interface{}) []interface{} {
+	return buffer
+}
+
+/*
+As number has no fields, return nil.
+*/
+func (this intValue) Fields() map[string]interface{} {
+	return nil
+}
+
+func (this intValue) FieldNames(buffer []string) []string {
+	return nil
+}
+
+/*
+Returns the input buffer as is.
+*/
+func (this intValue) DescendantPairs(buffer []util.IPair) []util.IPair {
+	return buffer
+}
+
+/*
+The successor is the float just above the integer, where floats can
+represent it, and the next integer beyond that, where no float lies
+between two integers.
+*/
+func (this intValue) Successor() Value {
+	f := float64(this) + _NUMBER_SUCCESSOR_DELTA
+	if compareIntFloat(int64(this), f) < 0 {
+		return floatValue(f)
+	} else if this < math.MaxInt64 {
+		return this + 1
+	} else {
+		return floatValue(math.MaxInt64).Successor()
+	}
+}
+
+func (this intValue) unwrap() Value {
+	return this
+}
+
+func (this intValue) Add(n NumberValue) NumberValue {
+	switch n := n.(type) {
+	case intValue:
+		rv := this + n
+		if (rv > this) == (n > 0) {
+			return rv
+		}
+	}
+
+	return floatValue(float64(this) + n.Float64())
+}
+
+func (this intValue) Sub(n NumberValue) NumberValue {
+	switch n := n.(type) {
+	case intValue:
+		rv := this - n
+		if (rv < this) == (n > 0) {
+			return rv
+		}
+	}
+
+	return floatValue(float64(this) - n.Float64())
+}
+
+func (this intValue) Mult(n NumberValue) NumberValue {
+	switch n := n.(type) {
+	case intValue:
+		if this == 0 || n == 0 {
+			return intValue(0)
+		}
+
+		rv := this * n
+		if rv/n == this && !(this == -1 && n == math.MinInt64) && !(n == -1 && this == math.MinInt64) {
+			return rv
+		}
+	}
+
+	return floatValue(float64(this) * n.Float64())
+}
+
+/*
+Integers that divide exactly give an integer, all other quotients a
+float. The caller checks for division by zero.
+*/
+func (this intValue) Div(n NumberValue) NumberValue {
+	switch n := n.(type) {
+	case intValue:
+		if n != 0 && !(n == -1 && this == math.MinInt64) && this%n == 0 {
+			return this / n
+		}
+	}
+
+	return floatValue(float64(this) / n.Float64())
+}
+
+func (this intValue) Mod(n NumberValue) NumberValue {
+	switch n := n.(type) {
+	case intValue:
+		if n == -1 {
+			return intValue(0)
+		} else if n != 0 {
+			return this % n
+		}
+	}
+
+	return floatValue(math.Mod(float64(this), n.Float64()))
+}
+
+func (this intValue) Neg() NumberValue {
+	if this == math.MinInt64 {
+		return -floatValue(this)
+	}
+
+	return -this
+}
+
+func (this intValue) Int64() int64 {
+	return int64(this)
+}
+
+func (this intValue) Float64() float64 {
+	return float64(this)
+}
+
+/*
+Compares an integer and a float exactly, without converting the
+integer to a float. NaN sorts first, as among floats.
+*/
+func compareIntFloat(i int64, f float64) int {
+	switch {
+	case math.IsNaN(f):
+		return 1
+	case f >= _TWO_63:
+		return -1
+	case f < -_TWO_63:
+		return 1
+	}
+
+	t := math.Trunc(f)
+	switch ti := int64(t); {
+	case i < ti:
+		return -1
+	case i > ti:
+		return 1
+	case f > t:
+		return -1
+	case f < t:
+		return 1
+	default:
+		return 0
+	}
+}
+
+const _TWO_63 = float64(1 << 63)
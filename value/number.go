@@ -16,6 +16,55 @@ import (
 	"github.com/couchbase/query/util"
 )
 
+/*
+NumberValue is implemented by the numbers, the exact integers
+represented by intValue and the floats represented by floatValue.
+Arithmetic on two integers gives an integer, unless the result
+overflows 64 bits; arithmetic involving a float gives a float.
+*/
+type NumberValue interface {
+	Value
+	Add(n NumberValue) NumberValue
+	Sub(n NumberValue) NumberValue
+	Mult(n NumberValue) NumberValue
+	Div(n NumberValue) NumberValue
+	Mod(n NumberValue) NumberValue
+	Neg() NumberValue
+	Int64() int64
+	Float64() float64
+}
+
+/*
+Returns the number held by a Value, or nil if the Value is not a
+number.
+*/
+func AsNumberValue(v Value) NumberValue {
+	if v == nil {
+		return nil
+	}
+
+	n, _ := v.unwrap().(NumberValue)
+	return n
+}
+
+/*
+Returns the number as an integer, and whether it is one. Floats with
+an integral value within the range of int64 are integers.
+*/
+func IsInt(n NumberValue) (int64, bool) {
+	switch n := n.(type) {
+	case intValue:
+		return int64(n), true
+	case floatValue:
+		f := float64(n)
+		if f == math.Trunc(f) && f >= -_TWO_63 && f < _TWO_63 {
+			return int64(f), true
+		}
+	}
+
+	return 0, false
+}
+
 /*
 Number, represented by floatValue is defined as type float64.
 */
@@ -29,6 +78,12 @@ var ZERO_VALUE = NewValue(0.0)
 var ONE_VALUE = NewValue(1.0)
 var NEG_ONE_VALUE = NewValue(-1.0)
 
+/*
+The integers 0 and 1, to start sums and products.
+*/
+var ZERO_NUMBER NumberValue = intValue(0)
+var ONE_NUMBER NumberValue = intValue(1)
+
 var _NAN_BYTES = []byte("\"NaN\"")
 var _POS_INF_BYTES = []byte("\"+Infinity\"")
 var _NEG_INF_BYTES = []byte("\"-Infinity\"")
@@ -82,6 +137,10 @@ func (this floatValue) Equals(other Value) Value {
 		if this == other {
 			return TRUE_VALUE
 		}
+	case intValue:
+		if compareIntFloat(int64(other), float64(this)) == 0 {
+			return TRUE_VALUE
+		}
 	}
 
 	return FALSE_VALUE
@@ -142,6 +201,8 @@ func (this floatValue) Collate(other Value) int {
 		default:
 			return 0
 		}
+	case intValue:
+		return -compareIntFloat(int64(other), float64(this))
 	default:
 		return int(NUMBER - other.Type())
 	}
@@ -273,4 +334,39 @@ func (this floatValue) unwrap() Value {
 	return this
 }
 
+func (this floatValue) Add(n NumberValue) NumberValue {
+	return this + floatValue(n.Float64())
+}
+
+func (this floatValue) Sub(n NumberValue) NumberValue {
+	return this - floatValue(n.Float64())
+}
+
+func (this floatValue) Mult(n NumberValue) NumberValue {
+	return this * floatValue(n.Float64())
+}
+
+func (this floatValue) Div(n NumberValue) NumberValue {
+	return this / floatValue(n.Float64())
+}
+
+func (this floatValue) Mod(n NumberValue) NumberValue {
+	return floatValue(math.Mod(float64(this), n.Float64()))
+}
+
+func (this floatValue) Neg() NumberValue {
+	return -this
+}
+
+/*
+Truncates the float towards zero.
+*/
+func (this floatValue) Int64() int64 {
+	return int64(this)
+}
+
+func (this floatValue) Float64() float64 {
+	return float64(this)
+}
+
 var _NUMBER_SUCCESSOR_DELTA = float64(1.0e-8)
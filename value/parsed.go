@@ -10,7 +10,6 @@
 package value
 
 import (
-	"encoding/json"
 	"strconv"
 
 	"github.com/couchbase/query/util"
@@ -225,8 +224,7 @@ func (this *parsedValue) unwrap() Value {
 		if this.parsedType == BINARY {
 			this.parsed = binaryValue(this.raw)
 		} else {
-			var p interface{}
-			err := json.Unmarshal(this.raw, &p)
+			p, err := unmarshalJSON(this.raw)
 			if err != nil {
 				this.parsedType = BINARY
 				this.parsed = binaryValue(this.raw)
@@ -21,15 +21,16 @@ Set implements a hash set of values. It is defined as
 a struct containing different values. _nills_ which
 is a bool type, missings and nulls which are Value's,
 booleans, numbers, strings, arrays, objects and blobs
-which are maps from bool,float64,and string to Value
-respectively.
+which are maps from bool, number and string to Value
+respectively. Numbers are keyed by numberKey, so that equal
+integers and floats are the same member.
 */
 type Set struct {
 	nills    bool
 	missings Value
 	nulls    Value
 	booleans map[bool]Value
-	numbers  map[float64]Value
+	numbers  map[interface{}]Value
 	strings  map[string]Value
 	arrays   map[string]Value
 	objects  map[string]Value
@@ -50,7 +51,7 @@ func NewSet(objectCap int) *Set {
 
 	return &Set{
 		booleans: make(map[bool]Value, 2),
-		numbers:  make(map[float64]Value, mapCap),
+		numbers:  make(map[interface{}]Value, mapCap),
 		strings:  make(map[string]Value, mapCap),
 		arrays:   make(map[string]Value, _MAP_CAP),
 		objects:  make(map[string]Value, objectCap),
@@ -82,7 +83,7 @@ func (this *Set) Put(key, item Value) {
 	case BOOLEAN:
 		this.booleans[key.Actual().(bool)] = item
 	case NUMBER:
-		this.numbers[key.Actual().(float64)] = item
+		this.numbers[numberKey(key)] = item
 	case STRING:
 		this.strings[key.Actual().(string)] = item
 	case ARRAY:
@@ -118,7 +119,7 @@ func (this *Set) Remove(key Value) {
 	case BOOLEAN:
 		delete(this.booleans, key.Actual().(bool))
 	case NUMBER:
-		delete(this.numbers, key.Actual().(float64))
+		delete(this.numbers, numberKey(key))
 	case STRING:
 		delete(this.strings, key.Actual().(string))
 	case ARRAY:
@@ -152,7 +153,7 @@ func (this *Set) Has(key Value) bool {
 	case BOOLEAN:
 		_, ok = this.booleans[key.Actual().(bool)]
 	case NUMBER:
-		_, ok = this.numbers[key.Actual().(float64)]
+		_, ok = this.numbers[numberKey(key)]
 	case STRING:
 		_, ok = this.strings[key.Actual().(string)]
 	case ARRAY:
@@ -280,3 +281,16 @@ func (this *Set) Actuals() []interface{} {
 
 	return rv
 }
+
+/*
+Integral numbers are keyed by their int64 value, and all other
+numbers by their float64 value.
+*/
+func numberKey(key Value) interface{} {
+	n := AsNumberValue(key)
+	if i, ok := IsInt(n); ok {
+		return i
+	}
+
+	return n.Float64()
+}
@@ -0,0 +1,82 @@
+//  Copyright (c) 2014 Couchbase, Inc.
+//  Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file
+//  except in compliance with the License. You may obtain a copy of the License at
+//    http://www.apache.org/licenses/LICENSE-2.0
+//  Unless required by applicable law or agreed to in writing, software distributed under the
+//  License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+//  either express or implied. See the License for the specific language governing permissions
+//  and limitations under the License.
+
+package value
+
+import (
+	"reflect"
+)
+
+// Approximate overheads, in bytes, of the in-memory representations
+const (
+	_SIZE_SCALAR = 16 // interface header and boxed scalar
+	_SIZE_STRING = 16 // string header
+	_SIZE_SLICE  = 24 // slice header
+	_SIZE_MAP    = 48 // map header
+	_SIZE_ENTRY  = 16 // per map entry
+)
+
+/*
+Returns an estimate of the memory, in bytes, held by a value. It is
+used to account for the values held by execution operators, and is
+not meant to be exact. Values that have not yet been parsed are sized
+by their raw bytes, so estimating does not force a parse.
+*/
+func EstimateSize(v Value) int64 {
+	switch v := v.(type) {
+	case nil:
+		return 0
+	case *parsedValue:
+		if v.parsed == nil {
+			return int64(len(v.raw)) + _SIZE_SLICE
+		}
+		return EstimateSize(v.parsed)
+	case *annotatedValue:
+		return EstimateSize(v.Value) + int64(len(v.attachments))*_SIZE_ENTRY
+	case *ScopeValue:
+		return EstimateSize(v.Value)
+	case binaryValue:
+		return int64(len(v)) + _SIZE_SLICE
+	}
+
+	return estimateActual(v.Actual())
+}
+
+func estimateActual(a interface{}) int64 {
+	switch a := a.(type) {
+	case nil, bool, float64, int64, int:
+		return _SIZE_SCALAR
+	case string:
+		return int64(len(a)) + _SIZE_STRING
+	case []byte:
+		return int64(len(a)) + _SIZE_SLICE
+	case Value:
+		return EstimateSize(a)
+	case []interface{}:
+		size := int64(_SIZE_SLICE)
+		for _, e := range a {
+			size += estimateActual(e)
+		}
+		return size
+	case map[string]interface{}:
+		size := int64(_SIZE_MAP)
+		for k, e := range a {
+			size += int64(len(k)) + _SIZE_ENTRY + estimateActual(e)
+		}
+		return size
+	case map[string]Value:
+		size := int64(_SIZE_MAP)
+		for k, e := range a {
+			size += int64(len(k)) + _SIZE_ENTRY + EstimateSize(e)
+		}
+		return size
+	default:
+		return int64(reflect.TypeOf(a).Size())
+	}
+}
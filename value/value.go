@@ -15,8 +15,12 @@ for JSON data values, with delayed parsing.
 package value
 
 import (
+	"bytes"
 	"fmt"
+	"io"
+	"math"
 	"reflect"
+	"strconv"
 
 	"github.com/couchbase/query/util"
 	json "github.com/dustin/gojson"
@@ -319,12 +323,11 @@ type Value interface {
 }
 
 /*
-This is defined as a slice of reflect Types and has 3 pre-defined
-types (reflect.TypeOf returns the type of the input argument, string,
-boolean, and number.
+This is defined as a slice of reflect Types and has 2 pre-defined
+types (reflect.TypeOf returns the type of the input argument),
+boolean and string. Numbers are converted by their kind.
 */
 var _CONVERSIONS = []reflect.Type{
-	reflect.TypeOf(0.0),
 	reflect.TypeOf(false),
 	reflect.TypeOf(""),
 }
@@ -342,6 +345,8 @@ func NewValue(val interface{}) Value {
 		return val
 	case float64:
 		return floatValue(val)
+	case int64:
+		return intValue(val)
 	case string:
 		return stringValue(val)
 	case bool:
@@ -355,7 +360,7 @@ func NewValue(val interface{}) Value {
 	case map[string]interface{}:
 		return objectValue(val)
 	case int:
-		return floatValue(val)
+		return intValue(val)
 	case []Value:
 		rv := make([]interface{}, len(val))
 		for i, v := range val {
@@ -369,6 +374,21 @@ func NewValue(val interface{}) Value {
 		}
 		return sliceValue(rv)
 	default:
+		// Other Go types with an underlying numeric type
+		rv := reflect.ValueOf(val)
+		switch rv.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			return intValue(rv.Int())
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+			if u := rv.Uint(); u <= math.MaxInt64 {
+				return intValue(u)
+			} else {
+				return floatValue(u)
+			}
+		case reflect.Float32, reflect.Float64:
+			return floatValue(rv.Float())
+		}
+
 		for _, c := range _CONVERSIONS {
 			if reflect.TypeOf(val).ConvertibleTo(c) {
 				return NewValue(reflect.ValueOf(val).Convert(c).Interface())
@@ -389,8 +409,7 @@ func newValueFromBytes(bytes []byte) Value {
 	// Atomic types
 	switch parsedType {
 	case NUMBER, STRING, BOOLEAN, NULL:
-		var p interface{}
-		err := json.Unmarshal(bytes, &p)
+		p, err := unmarshalJSON(bytes)
 		if err != nil {
 			return binaryValue(bytes)
 		}
@@ -412,6 +431,57 @@ func newValueFromBytes(bytes []byte) Value {
 	}
 }
 
+/*
+Unmarshal JSON, keeping the numbers that are integers within 64 bits
+exact. Numbers are int64 or float64 in the result.
+*/
+func unmarshalJSON(raw []byte) (interface{}, error) {
+	decoder := json.NewDecoder(bytes.NewReader(raw))
+	decoder.UseNumber()
+
+	var p interface{}
+	err := decoder.Decode(&p)
+	if err != nil {
+		return nil, err
+	}
+
+	// Like json.Unmarshal, reject anything after the value
+	var extra interface{}
+	err = decoder.Decode(&extra)
+	if err != io.EOF {
+		return nil, fmt.Errorf("Unexpected data after JSON value.")
+	}
+
+	return convertNumbers(p), nil
+}
+
+/*
+Replace the json.Numbers produced by the decoder with int64 or
+float64, in place.
+*/
+func convertNumbers(p interface{}) interface{} {
+	switch p := p.(type) {
+	case json.Number:
+		i, err := strconv.ParseInt(string(p), 10, 64)
+		if err == nil {
+			return i
+		}
+
+		f, _ := strconv.ParseFloat(string(p), 64)
+		return f
+	case []interface{}:
+		for i, e := range p {
+			p[i] = convertNumbers(e)
+		}
+	case map[string]interface{}:
+		for k, e := range p {
+			p[k] = convertNumbers(e)
+		}
+	}
+
+	return p
+}
+
 /*
 Function takes an input interface and returns an interface.
 */
@@ -13,6 +13,7 @@ import (
 	"compress/gzip"
 	"encoding/json"
 	"io/ioutil"
+	"math"
 	"os"
 	"reflect"
 	"testing"
@@ -269,9 +270,9 @@ func TestValue(t *testing.T) {
 		{NewValue([]byte("null")), nil},
 		{NewValue([]byte("true")), true},
 		{NewValue([]byte("false")), false},
-		{NewValue([]byte("1")), 1.0},
+		{NewValue([]byte("1")), int64(1)},
 		{NewValue([]byte("3.14")), 3.14},
-		{NewValue([]byte("-7")), -7.0},
+		{NewValue([]byte("-7")), int64(-7)},
 		{NewValue([]byte("\"\"")), ""},
 		{NewValue([]byte("\"marty\"")), "marty"},
 		{NewValue([]byte("[\"marty\"]")), []interface{}{"marty"}},
@@ -558,3 +559,93 @@ func TestArraySetIndexLongerThanExistingArray(t *testing.T) {
 		t.Errorf("Expected [gerald] got %v", valval)
 	}
 }
+
+func TestEstimateSize(t *testing.T) {
+	small := NewValue(map[string]interface{}{"a": 1.0})
+	large := NewValue(map[string]interface{}{"a": 1.0, "b": []interface{}{"some string", 2.0, nil}})
+
+	if s, l := EstimateSize(small), EstimateSize(large); s <= 0 || l <= s {
+		t.Errorf("expected estimates to grow with values, got %v and %v", s, l)
+	}
+
+	raw := []byte(`{"a": "some string"}`)
+	if s := EstimateSize(NewValue(raw)); s < int64(len(raw)) {
+		t.Errorf("expected estimate of unparsed value to cover its bytes, got %v", s)
+	}
+
+	if s := EstimateSize(NewAnnotatedValue(large)); s < EstimateSize(large) {
+		t.Errorf("expected estimate of annotated value to cover its value, got %v", s)
+	}
+}
+
+func TestIntValues(t *testing.T) {
+	big := NewValue([]byte("{\"id\": 9007199254740993, \"f\": 1.5}"))
+	id, _ := big.Field("id")
+	if id.Actual() != int64(9007199254740993) {
+		t.Errorf("Expected exact id, got %#v", id.Actual())
+	}
+	if s := id.String(); s != "9007199254740993" {
+		t.Errorf("Expected id to marshal exactly, got %v", s)
+	}
+	big.SetField("g", 2)
+	bytes, _ := big.MarshalJSON()
+	if string(bytes) != "{\"f\":1.5,\"g\":2,\"id\":9007199254740993}" {
+		t.Errorf("Unexpected JSON %s", bytes)
+	}
+
+	// Integers and floats compare by their exact values
+	collations := []struct {
+		first, second Value
+		expected      int
+	}{
+		{NewValue(1), NewValue(1.0), 0},
+		{NewValue(9007199254740993), NewValue(9007199254740992.0), 1},
+		{NewValue(-3), NewValue(-2.5), -1},
+		{NewValue(int64(math.MaxInt64)), NewValue(math.Inf(1)), -1},
+		{NewValue(int64(math.MinInt64)), NewValue(math.NaN()), 1},
+	}
+	for _, c := range collations {
+		if r := c.first.Collate(c.second); r != c.expected {
+			t.Errorf("Expected %v collating %v and %v, got %v", c.expected, c.first, c.second, r)
+		}
+		if r := c.second.Collate(c.first); r != -c.expected {
+			t.Errorf("Expected %v collating %v and %v, got %v", -c.expected, c.second, c.first, r)
+		}
+		if eq := c.first.Equals(c.second).Truth(); eq != (c.expected == 0) {
+			t.Errorf("Unexpected equality %v of %v and %v", eq, c.first, c.second)
+		}
+	}
+
+	// Integer arithmetic is exact, and overflows to float
+	n := AsNumberValue(NewValue(int64(9007199254740993)))
+	arithmetic := []struct {
+		result   NumberValue
+		expected interface{}
+	}{
+		{n.Add(AsNumberValue(NewValue(2))), int64(9007199254740995)},
+		{n.Sub(AsNumberValue(NewValue(-2))), int64(9007199254740995)},
+		{n.Mult(AsNumberValue(NewValue(3))), int64(27021597764222979)},
+		{n.Div(AsNumberValue(NewValue(3))), int64(3002399751580331)},
+		{AsNumberValue(NewValue(7)).Div(AsNumberValue(NewValue(2))), 3.5},
+		{AsNumberValue(NewValue(-7)).Mod(AsNumberValue(NewValue(2))), int64(-1)},
+		{AsNumberValue(NewValue(3)).Add(AsNumberValue(NewValue(0.5))), 3.5},
+		{AsNumberValue(NewValue(int64(math.MaxInt64))).Add(AsNumberValue(NewValue(1))), 9223372036854775808.0},
+		{AsNumberValue(NewValue(int64(math.MinInt64))).Neg(), 9223372036854775808.0},
+		{AsNumberValue(NewValue(int64(1 << 40))).Mult(AsNumberValue(NewValue(1 << 40))), 1208925819614629174706176.0},
+	}
+	for i, a := range arithmetic {
+		if !reflect.DeepEqual(a.result.Actual(), a.expected) {
+			t.Errorf("Expected %#v, got %#v at index %d", a.expected, a.result.Actual(), i)
+		}
+	}
+
+	// Equal integers and floats are the same member of a set
+	set := NewSet(4)
+	set.Add(NewValue(2))
+	set.Add(NewValue(2.0))
+	set.Add(NewValue(int64(9007199254740993)))
+	set.Add(NewValue(9007199254740992.0))
+	if set.Len() != 3 {
+		t.Errorf("Expected 3 numbers in set, got %v", set.Len())
+	}
+}